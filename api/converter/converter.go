@@ -26,6 +26,12 @@ var (
 	// passed.
 	ErrCheckpointRequired = errors.New("checkpoint required")
 
+	// ErrDocumentKeyRequired is returned when an empty document key is passed.
+	ErrDocumentKeyRequired = errors.New("document key required")
+
+	// ErrTimeTicketRequired is returned when an empty time ticket is passed.
+	ErrTimeTicketRequired = errors.New("time ticket required")
+
 	// ErrUnsupportedOperation is returned when the given operation is not
 	// supported yet.
 	ErrUnsupportedOperation = errors.New("unsupported operation")
@@ -17,6 +17,8 @@
 package converter_test
 
 import (
+	"crypto/ed25519"
+	"fmt"
 	"math"
 	"testing"
 	gotime "time"
@@ -26,6 +28,7 @@ import (
 	"github.com/yorkie-team/yorkie/api"
 	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/proxy"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/pkg/types"
@@ -123,6 +126,73 @@ func TestConverter(t *testing.T) {
 		assert.Equal(t, doc.Marshal(), obj.Marshal())
 	})
 
+	t.Run("deterministic snapshot test", func(t *testing.T) {
+		doc := document.New("c1", "d1")
+
+		err := doc.Update(func(root *proxy.ObjectProxy) error {
+			obj := root.SetNewObject("k1")
+			for _, k := range []string{"a", "b", "c", "d", "e", "f", "g", "h"} {
+				obj.SetString(k, k)
+			}
+			obj.SetString("a", "overwritten")
+
+			root.SetNewRichText("k2").
+				Edit(0, 0, "Hello world", map[string]string{
+					"b": "1", "i": "1", "u": "1", "s": "1", "color": "red",
+				})
+			return nil
+		})
+		assert.NoError(t, err)
+
+		bytes, err := converter.ObjectToBytes(doc.RootObject())
+		assert.NoError(t, err)
+
+		// NOTE: Go randomizes map iteration order, so serialize several times
+		// to make sure that the order of the nodes does not leak into bytes.
+		for i := 0; i < 10; i++ {
+			other, err := converter.ObjectToBytes(doc.RootObject())
+			assert.NoError(t, err)
+			assert.Equal(t, bytes, other)
+		}
+
+		obj, err := converter.BytesToObject(bytes)
+		assert.NoError(t, err)
+		restored, err := converter.ObjectToBytes(obj)
+		assert.NoError(t, err)
+		assert.Equal(t, bytes, restored)
+	})
+
+	t.Run("concurrent snapshot test", func(t *testing.T) {
+		doc := document.New("c1", "d1")
+
+		err := doc.Update(func(root *proxy.ObjectProxy) error {
+			for i := 0; i < 10; i++ {
+				obj := root.SetNewObject(fmt.Sprintf("obj%d", i))
+				arr := obj.SetNewArray("arr")
+				for j := 0; j < 10; j++ {
+					arr.AddString(fmt.Sprintf("%d-%d", i, j))
+					arr.AddNewArray().AddInteger(j)
+				}
+				obj.SetNewText("text").Edit(0, 0, "Hello world")
+				obj.SetNewCounter("cnt", 0).Increase(i)
+			}
+			root.SetString("str", "value")
+			return nil
+		})
+		assert.NoError(t, err)
+
+		bytes, err := converter.ObjectToBytes(doc.RootObject())
+		assert.NoError(t, err)
+
+		for _, workers := range []int{0, 2, 4, 16} {
+			for i := 0; i < 10; i++ {
+				other, err := converter.ObjectToBytesConcurrently(doc.RootObject(), workers)
+				assert.NoError(t, err)
+				assert.Equal(t, bytes, other)
+			}
+		}
+	})
+
 	t.Run("change pack test", func(t *testing.T) {
 		d1 := document.New("c1", "d1")
 
@@ -189,6 +259,67 @@ func TestConverter(t *testing.T) {
 		assert.Equal(t, d1.Marshal(), d2.Marshal())
 	})
 
+	t.Run("change signature test", func(t *testing.T) {
+		publicKey, privateKey, err := ed25519.GenerateKey(nil)
+		assert.NoError(t, err)
+		otherKey, _, err := ed25519.GenerateKey(nil)
+		assert.NoError(t, err)
+
+		doc := document.New("c1", "d1")
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			root.SetNewRichText("k2").Edit(0, 0, "Hello", map[string]string{
+				"b": "1", "i": "1", "u": "1", "s": "1", "color": "red",
+			})
+			return nil
+		})
+		assert.NoError(t, err)
+
+		pack := doc.CreateChangePack()
+		assert.NoError(t, converter.SignChange(privateKey, pack.Changes[0]))
+
+		// NOTE: The signature survives the encoding, and the attributes of
+		// rich text encoded in any order produce the same digest.
+		pbPack, err := converter.ToChangePack(pack)
+		assert.NoError(t, err)
+		decoded, err := converter.FromChangePack(pbPack)
+		assert.NoError(t, err)
+		for i := 0; i < 10; i++ {
+			verified, err := converter.VerifyChange(publicKey, decoded.Changes[0])
+			assert.NoError(t, err)
+			assert.True(t, verified)
+		}
+
+		verified, err := converter.VerifyChange(otherKey, decoded.Changes[0])
+		assert.NoError(t, err)
+		assert.False(t, verified)
+
+		_, err = converter.VerifyChange([]byte{1, 2, 3}, decoded.Changes[0])
+		assert.ErrorIs(t, err, converter.ErrInvalidPublicKey)
+	})
+
+	t.Run("change id vector test", func(t *testing.T) {
+		id := change.InitialID.Next()
+		decoded, err := converter.FromChangePack(&api.ChangePack{
+			DocumentKey: &api.DocumentKey{Collection: "c1", Document: "d1"},
+			Checkpoint:  &api.Checkpoint{},
+			Changes:     []*api.Change{{Id: converter.ToChangeID(id)}},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, id.Vector(), decoded.Changes[0].ID().Vector())
+
+		// NOTE: The changes of the clients without vector clocks do not have it.
+		withoutVector := converter.ToChangeID(id)
+		withoutVector.Vector = nil
+		decoded, err = converter.FromChangePack(&api.ChangePack{
+			DocumentKey: &api.DocumentKey{Collection: "c1", Document: "d1"},
+			Checkpoint:  &api.Checkpoint{},
+			Changes:     []*api.Change{{Id: withoutVector}},
+		})
+		assert.NoError(t, err)
+		assert.Nil(t, decoded.Changes[0].ID().Vector())
+	})
+
 	t.Run("change pack error test", func(t *testing.T) {
 		_, err := converter.FromChangePack(nil)
 		assert.ErrorIs(t, err, converter.ErrPackRequired)
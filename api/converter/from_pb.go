@@ -70,15 +70,18 @@ func FromChangePack(pbPack *api.ChangePack) (*change.Pack, error) {
 	}
 
 	return &change.Pack{
-		DocumentKey:     fromDocumentKey(pbPack.DocumentKey),
+		DocumentKey:     FromDocumentKey(pbPack.DocumentKey),
 		Checkpoint:      fromCheckpoint(pbPack.Checkpoint),
 		Changes:         changes,
 		Snapshot:        pbPack.Snapshot,
 		MinSyncedTicket: minSyncedTicket,
+		HasMore:         pbPack.HasMore,
+		PreferredRegion: pbPack.PreferredRegion,
 	}, nil
 }
 
-func fromDocumentKey(pbKey *api.DocumentKey) *key.Key {
+// FromDocumentKey converts the given Protobuf format to model format.
+func FromDocumentKey(pbKey *api.DocumentKey) *key.Key {
 	return &key.Key{
 		Collection: pbKey.Collection,
 		Document:   pbKey.Document,
@@ -103,11 +106,13 @@ func fromChanges(pbChanges []*api.Change) ([]*change.Change, error) {
 		if err != nil {
 			return nil, err
 		}
-		changes = append(changes, change.New(
+		c := change.New(
 			changeID,
 			pbChange.Message,
 			operations,
-		))
+		)
+		c.SetSignature(pbChange.Signature)
+		changes = append(changes, c)
 	}
 
 	return changes, nil
@@ -118,18 +123,41 @@ func fromChangeID(id *api.ChangeID) (*change.ID, error) {
 	if err != nil {
 		return nil, err
 	}
+	vector, err := FromVectorClock(id.Vector)
+	if err != nil {
+		return nil, err
+	}
 	return change.NewID(
 		id.ClientSeq,
 		id.Lamport,
 		actorID,
-	), nil
+	).SetVector(vector), nil
+}
+
+// FromVectorClock converts the given map keyed by the hex of the actor IDs to
+// the vector clock. It returns nil if the map is empty, because the changes of
+// the clients without vector clocks do not have it.
+func FromVectorClock(encoded map[string]uint64) (change.VectorClock, error) {
+	if len(encoded) == 0 {
+		return nil, nil
+	}
+
+	vector := make(change.VectorClock, len(encoded))
+	for hex, lamport := range encoded {
+		actorID, err := time.ActorIDFromHex(hex)
+		if err != nil {
+			return nil, err
+		}
+		vector[*actorID] = lamport
+	}
+	return vector, nil
 }
 
 // FromDocumentKeys converts the given Protobuf formats to model format.
 func FromDocumentKeys(pbKeys []*api.DocumentKey) []*key.Key {
 	var keys []*key.Key
 	for _, pbKey := range pbKeys {
-		keys = append(keys, fromDocumentKey(pbKey))
+		keys = append(keys, FromDocumentKey(pbKey))
 	}
 	return keys
 }
@@ -145,6 +173,10 @@ func FromEventType(pbDocEventType api.DocEventType) (types.DocEventType, error)
 		return types.DocumentsUnwatchedEvent, nil
 	case api.DocEventType_METADATA_CHANGED:
 		return types.MetadataChangedEvent, nil
+	case api.DocEventType_DOCUMENTS_QUARANTINED:
+		return types.DocumentsQuarantinedEvent, nil
+	case api.DocEventType_PEERS_CHANGED:
+		return types.PeersChangedEvent, nil
 	}
 	return "", fmt.Errorf("%v: %w", pbDocEventType, ErrUnsupportedEventType)
 }
@@ -161,10 +193,22 @@ func FromDocEvent(docEvent *api.DocEvent) (*sync.DocEvent, error) {
 		return nil, err
 	}
 
+	var peers []types.Client
+	for _, pbPeer := range docEvent.Peers {
+		peer, err := FromClient(pbPeer)
+		if err != nil {
+			return nil, err
+		}
+		peers = append(peers, *peer)
+	}
+
 	return &sync.DocEvent{
-		Type:         eventType,
-		Publisher:    *client,
-		DocumentKeys: FromDocumentKeys(docEvent.DocumentKeys),
+		Type:          eventType,
+		Publisher:     *client,
+		DocumentKeys:  FromDocumentKeys(docEvent.DocumentKeys),
+		ServerSeq:     docEvent.ServerSeq,
+		FromServerSeq: docEvent.FromServerSeq,
+		Peers:         peers,
 	}, nil
 }
 
@@ -476,6 +520,15 @@ func fromTextNodePos(
 	), nil
 }
 
+// FromTimeTicket converts the given Protobuf format to model format.
+func FromTimeTicket(pbTicket *api.TimeTicket) (*time.Ticket, error) {
+	if pbTicket == nil {
+		return nil, ErrTimeTicketRequired
+	}
+
+	return fromTimeTicket(pbTicket)
+}
+
 func fromTimeTicket(pbTicket *api.TimeTicket) (*time.Ticket, error) {
 	if pbTicket == nil {
 		return nil, nil
@@ -0,0 +1,84 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package converter
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"errors"
+
+	"github.com/gogo/protobuf/jsonpb"
+
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+)
+
+var (
+	// ErrInvalidPublicKey is returned when the given public key is not an
+	// ed25519 public key.
+	ErrInvalidPublicKey = errors.New("invalid public key")
+)
+
+// ChangeDigest returns the digest of the given change to be signed. It covers
+// the ID, the message and the operations of the change, but not the signature
+// and the server sequence which is assigned by the agent.
+func ChangeDigest(c *change.Change) ([]byte, error) {
+	pbChange, err := toChange(c)
+	if err != nil {
+		return nil, err
+	}
+	pbChange.Signature = nil
+
+	// NOTE: The binary encoding of the maps in the operations such as the
+	// attributes of rich text depends on the iteration order of the maps. The
+	// JSON encoding sorts the keys of the maps, so that every party produces
+	// the same digest from the same change.
+	var buf bytes.Buffer
+	if err := (&jsonpb.Marshaler{}).Marshal(&buf, pbChange); err != nil {
+		return nil, err
+	}
+
+	digest := sha256.Sum256(buf.Bytes())
+	return digest[:], nil
+}
+
+// SignChange signs the given change with the given ed25519 private key and
+// sets the signature to the change.
+func SignChange(privateKey ed25519.PrivateKey, c *change.Change) error {
+	digest, err := ChangeDigest(c)
+	if err != nil {
+		return err
+	}
+
+	c.SetSignature(ed25519.Sign(privateKey, digest))
+	return nil
+}
+
+// VerifyChange returns whether the signature of the given change is made by
+// the owner of the given ed25519 public key.
+func VerifyChange(publicKey []byte, c *change.Change) (bool, error) {
+	if len(publicKey) != ed25519.PublicKeySize {
+		return false, ErrInvalidPublicKey
+	}
+
+	digest, err := ChangeDigest(c)
+	if err != nil {
+		return false, err
+	}
+
+	return ed25519.Verify(publicKey, digest, c.Signature()), nil
+}
@@ -19,6 +19,7 @@ package converter
 import (
 	"fmt"
 	"reflect"
+	"sync"
 
 	"github.com/gogo/protobuf/proto"
 
@@ -26,9 +27,26 @@ import (
 	"github.com/yorkie-team/yorkie/pkg/document/json"
 )
 
-// ObjectToBytes converts the given object to byte array.
+// ObjectToBytes converts the given object to byte array. The result is
+// deterministic: the same object always produces the same bytes, so that
+// snapshots taken on different nodes can be compared by their hashes.
 func ObjectToBytes(obj *json.Object) ([]byte, error) {
-	pbElem, err := toJSONElement(obj)
+	return ObjectToBytesConcurrently(obj, 1)
+}
+
+// ObjectToBytesConcurrently converts the given object to byte array like
+// ObjectToBytes, but the subtrees of the object are converted by up to the
+// given number of workers. The result is the same as ObjectToBytes regardless
+// of the scheduling of the workers.
+func ObjectToBytesConcurrently(obj *json.Object, workers int) ([]byte, error) {
+	// NOTE: A nil channel is never ready to send, so all elements are
+	// converted in the calling goroutine.
+	var slots chan struct{}
+	if workers > 1 {
+		slots = make(chan struct{}, workers-1)
+	}
+
+	pbElem, err := toJSONElement(obj, slots)
 	if err != nil {
 		return nil, err
 	}
@@ -40,12 +58,12 @@ func ObjectToBytes(obj *json.Object) ([]byte, error) {
 	return bytes, nil
 }
 
-func toJSONElement(elem json.Element) (*api.JSONElement, error) {
+func toJSONElement(elem json.Element, slots chan struct{}) (*api.JSONElement, error) {
 	switch elem := elem.(type) {
 	case *json.Object:
-		return toJSONObject(elem)
+		return toJSONObject(elem, slots)
 	case *json.Array:
-		return toJSONArray(elem)
+		return toJSONArray(elem, slots)
 	case *json.Primitive:
 		return toPrimitive(elem)
 	case *json.Text:
@@ -59,8 +77,48 @@ func toJSONElement(elem json.Element) (*api.JSONElement, error) {
 	}
 }
 
-func toJSONObject(obj *json.Object) (*api.JSONElement, error) {
-	pbRHTNodes, err := toRHTNodes(obj.RHTNodes())
+// toJSONElements converts the given elements. If a slot is available, the
+// element is converted in a new goroutine. The results are placed at the index
+// of each element, so the order is kept regardless of the scheduling.
+func toJSONElements(elems []json.Element, slots chan struct{}) ([]*api.JSONElement, error) {
+	pbElems := make([]*api.JSONElement, len(elems))
+	errs := make([]error, len(elems))
+
+	wg := sync.WaitGroup{}
+	for i, elem := range elems {
+		// NOTE: Primitives and counters are too small to be worth a goroutine.
+		switch elem.(type) {
+		case *json.Primitive, *json.Counter:
+			pbElems[i], errs[i] = toJSONElement(elem, slots)
+			continue
+		}
+
+		select {
+		case slots <- struct{}{}:
+			wg.Add(1)
+			go func(i int, elem json.Element) {
+				defer func() {
+					<-slots
+					wg.Done()
+				}()
+				pbElems[i], errs[i] = toJSONElement(elem, slots)
+			}(i, elem)
+		default:
+			pbElems[i], errs[i] = toJSONElement(elem, slots)
+		}
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return pbElems, nil
+}
+
+func toJSONObject(obj *json.Object, slots chan struct{}) (*api.JSONElement, error) {
+	pbRHTNodes, err := toRHTNodes(obj.RHTNodes(), slots)
 	if err != nil {
 		return nil, err
 	}
@@ -76,8 +134,8 @@ func toJSONObject(obj *json.Object) (*api.JSONElement, error) {
 	return pbElem, nil
 }
 
-func toJSONArray(arr *json.Array) (*api.JSONElement, error) {
-	pbRGANodes, err := toRGANodes(arr.RGANodes())
+func toJSONArray(arr *json.Array, slots chan struct{}) (*api.JSONElement, error) {
+	pbRGANodes, err := toRGANodes(arr.RGANodes(), slots)
 	if err != nil {
 		return nil, err
 	}
@@ -149,32 +207,42 @@ func toCounter(counter *json.Counter) (*api.JSONElement, error) {
 	}, nil
 }
 
-func toRHTNodes(rhtNodes []*json.RHTPQMapNode) ([]*api.RHTNode, error) {
-	var pbRHTNodes []*api.RHTNode
+func toRHTNodes(rhtNodes []*json.RHTPQMapNode, slots chan struct{}) ([]*api.RHTNode, error) {
+	var elems []json.Element
 	for _, rhtNode := range rhtNodes {
-		pbElem, err := toJSONElement(rhtNode.Element())
-		if err != nil {
-			return nil, err
-		}
+		elems = append(elems, rhtNode.Element())
+	}
 
+	pbElems, err := toJSONElements(elems, slots)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbRHTNodes []*api.RHTNode
+	for i, rhtNode := range rhtNodes {
 		pbRHTNodes = append(pbRHTNodes, &api.RHTNode{
 			Key:     rhtNode.Key(),
-			Element: pbElem,
+			Element: pbElems[i],
 		})
 	}
 	return pbRHTNodes, nil
 }
 
-func toRGANodes(rgaNodes []*json.RGATreeListNode) ([]*api.RGANode, error) {
-	var pbRGANodes []*api.RGANode
+func toRGANodes(rgaNodes []*json.RGATreeListNode, slots chan struct{}) ([]*api.RGANode, error) {
+	var elems []json.Element
 	for _, rgaNode := range rgaNodes {
-		pbElem, err := toJSONElement(rgaNode.Element())
-		if err != nil {
-			return nil, err
-		}
+		elems = append(elems, rgaNode.Element())
+	}
 
+	pbElems, err := toJSONElements(elems, slots)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbRGANodes []*api.RGANode
+	for i := range rgaNodes {
 		pbRGANodes = append(pbRGANodes, &api.RGANode{
-			Element: pbElem,
+			Element: pbElems[i],
 		})
 	}
 	return pbRGANodes, nil
@@ -23,6 +23,7 @@ import (
 	"github.com/yorkie-team/yorkie/api"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/jsonpatch"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
 	"github.com/yorkie-team/yorkie/pkg/document/operation"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
@@ -59,6 +60,8 @@ func ToChangePack(pack *change.Pack) (*api.ChangePack, error) {
 		Changes:         pbChanges,
 		Snapshot:        pack.Snapshot,
 		MinSyncedTicket: ToTimeTicket(pack.MinSyncedTicket),
+		HasMore:         pack.HasMore,
+		PreferredRegion: pack.PreferredRegion,
 	}, nil
 }
 
@@ -84,9 +87,24 @@ func ToChangeID(id *change.ID) *api.ChangeID {
 		ClientSeq: id.ClientSeq(),
 		Lamport:   id.Lamport(),
 		ActorId:   id.ActorID().Bytes(),
+		Vector:    ToVectorClock(id.Vector()),
 	}
 }
 
+// ToVectorClock converts the given vector clock to the map keyed by the hex
+// of the actor IDs. It returns nil if the vector clock is nil.
+func ToVectorClock(vector change.VectorClock) map[string]uint64 {
+	if vector == nil {
+		return nil
+	}
+
+	encoded := make(map[string]uint64, len(vector))
+	for actorID, lamport := range vector {
+		encoded[actorID.String()] = lamport
+	}
+	return encoded
+}
+
 // ToDocumentKeys converts the given model format to Protobuf format.
 func ToDocumentKeys(keys []*key.Key) []*api.DocumentKey {
 	var pbKeys []*api.DocumentKey
@@ -96,18 +114,35 @@ func ToDocumentKeys(keys []*key.Key) []*api.DocumentKey {
 	return pbKeys
 }
 
+// ToJSONPatches converts the given model format to Protobuf format.
+func ToJSONPatches(patches []*jsonpatch.Patch) []*api.JSONPatch {
+	var pbPatches []*api.JSONPatch
+	for _, patch := range patches {
+		pbPatches = append(pbPatches, &api.JSONPatch{
+			Op:    string(patch.Op),
+			Path:  patch.Path,
+			Value: string(patch.Value),
+		})
+	}
+	return pbPatches
+}
+
+// ToClients converts the given model to Protobuf format.
+func ToClients(clients []types.Client) []*api.Client {
+	var pbClients []*api.Client
+	for _, client := range clients {
+		pbClients = append(pbClients, ToClient(client))
+	}
+	return pbClients
+}
+
 // ToClientsMap converts the given model to Protobuf format.
 func ToClientsMap(clientsMap map[string][]types.Client) map[string]*api.Clients {
 	pbClientsMap := make(map[string]*api.Clients)
 
 	for k, clients := range clientsMap {
-		var pbClients []*api.Client
-		for _, client := range clients {
-			pbClients = append(pbClients, ToClient(client))
-		}
-
 		pbClientsMap[k] = &api.Clients{
-			Clients: pbClients,
+			Clients: ToClients(clients),
 		}
 	}
 
@@ -125,6 +160,10 @@ func ToDocEventType(eventType types.DocEventType) (api.DocEventType, error) {
 		return api.DocEventType_DOCUMENTS_UNWATCHED, nil
 	case types.MetadataChangedEvent:
 		return api.DocEventType_METADATA_CHANGED, nil
+	case types.DocumentsQuarantinedEvent:
+		return api.DocEventType_DOCUMENTS_QUARANTINED, nil
+	case types.PeersChangedEvent:
+		return api.DocEventType_PEERS_CHANGED, nil
 	default:
 		return 0, fmt.Errorf("%s: %w", eventType, ErrUnsupportedEventType)
 	}
@@ -138,9 +177,12 @@ func ToDocEvent(docEvent sync.DocEvent) (*api.DocEvent, error) {
 	}
 
 	return &api.DocEvent{
-		Type:         eventType,
-		Publisher:    ToClient(docEvent.Publisher),
-		DocumentKeys: ToDocumentKeys(docEvent.DocumentKeys),
+		Type:          eventType,
+		Publisher:     ToClient(docEvent.Publisher),
+		DocumentKeys:  ToDocumentKeys(docEvent.DocumentKeys),
+		ServerSeq:     docEvent.ServerSeq,
+		FromServerSeq: docEvent.FromServerSeq,
+		Peers:         ToClients(docEvent.Peers),
 	}, nil
 }
 
@@ -199,21 +241,31 @@ func toChanges(changes []*change.Change) ([]*api.Change, error) {
 	var pbChanges []*api.Change
 
 	for _, c := range changes {
-		pbOperations, err := ToOperations(c.Operations())
+		pbChange, err := toChange(c)
 		if err != nil {
 			return nil, err
 		}
 
-		pbChanges = append(pbChanges, &api.Change{
-			Id:         ToChangeID(c.ID()),
-			Message:    c.Message(),
-			Operations: pbOperations,
-		})
+		pbChanges = append(pbChanges, pbChange)
 	}
 
 	return pbChanges, nil
 }
 
+func toChange(c *change.Change) (*api.Change, error) {
+	pbOperations, err := ToOperations(c.Operations())
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.Change{
+		Id:         ToChangeID(c.ID()),
+		Message:    c.Message(),
+		Operations: pbOperations,
+		Signature:  c.Signature(),
+	}, nil
+}
+
 func toSet(set *operation.Set) (*api.Operation_Set_, error) {
 	pbElem, err := toJSONElementSimple(set.Value())
 	if err != nil {
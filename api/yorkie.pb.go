@@ -6,6 +6,9 @@ package api
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
+	types "github.com/gogo/protobuf/types"
 	proto "github.com/golang/protobuf/proto"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -93,10 +96,12 @@ func (ValueType) EnumDescriptor() ([]byte, []int) {
 type DocEventType int32
 
 const (
-	DocEventType_DOCUMENTS_CHANGED   DocEventType = 0
-	DocEventType_DOCUMENTS_WATCHED   DocEventType = 1
-	DocEventType_DOCUMENTS_UNWATCHED DocEventType = 2
-	DocEventType_METADATA_CHANGED    DocEventType = 3
+	DocEventType_DOCUMENTS_CHANGED     DocEventType = 0
+	DocEventType_DOCUMENTS_WATCHED     DocEventType = 1
+	DocEventType_DOCUMENTS_UNWATCHED   DocEventType = 2
+	DocEventType_METADATA_CHANGED      DocEventType = 3
+	DocEventType_DOCUMENTS_QUARANTINED DocEventType = 4
+	DocEventType_PEERS_CHANGED         DocEventType = 5
 )
 
 var DocEventType_name = map[int32]string{
@@ -104,13 +109,17 @@ var DocEventType_name = map[int32]string{
 	1: "DOCUMENTS_WATCHED",
 	2: "DOCUMENTS_UNWATCHED",
 	3: "METADATA_CHANGED",
+	4: "DOCUMENTS_QUARANTINED",
+	5: "PEERS_CHANGED",
 }
 
 var DocEventType_value = map[string]int32{
-	"DOCUMENTS_CHANGED":   0,
-	"DOCUMENTS_WATCHED":   1,
-	"DOCUMENTS_UNWATCHED": 2,
-	"METADATA_CHANGED":    3,
+	"DOCUMENTS_CHANGED":     0,
+	"DOCUMENTS_WATCHED":     1,
+	"DOCUMENTS_UNWATCHED":   2,
+	"METADATA_CHANGED":      3,
+	"DOCUMENTS_QUARANTINED": 4,
+	"PEERS_CHANGED":         5,
 }
 
 func (x DocEventType) String() string {
@@ -215,25 +224,25 @@ func (m *BroadcastEventResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BroadcastEventResponse proto.InternalMessageInfo
 
-type ActivateClientRequest struct {
-	ClientKey            string   `protobuf:"bytes,1,opt,name=client_key,json=clientKey,proto3" json:"client_key,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type CreateSnapshotRequest struct {
+	DocumentKey          *DocumentKey `protobuf:"bytes,1,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *ActivateClientRequest) Reset()         { *m = ActivateClientRequest{} }
-func (m *ActivateClientRequest) String() string { return proto.CompactTextString(m) }
-func (*ActivateClientRequest) ProtoMessage()    {}
-func (*ActivateClientRequest) Descriptor() ([]byte, []int) {
+func (m *CreateSnapshotRequest) Reset()         { *m = CreateSnapshotRequest{} }
+func (m *CreateSnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateSnapshotRequest) ProtoMessage()    {}
+func (*CreateSnapshotRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9df40050e88fbc16, []int{2}
 }
-func (m *ActivateClientRequest) XXX_Unmarshal(b []byte) error {
+func (m *CreateSnapshotRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ActivateClientRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CreateSnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ActivateClientRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CreateSnapshotRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -243,45 +252,44 @@ func (m *ActivateClientRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *ActivateClientRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ActivateClientRequest.Merge(m, src)
+func (m *CreateSnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateSnapshotRequest.Merge(m, src)
 }
-func (m *ActivateClientRequest) XXX_Size() int {
+func (m *CreateSnapshotRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ActivateClientRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ActivateClientRequest.DiscardUnknown(m)
+func (m *CreateSnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateSnapshotRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ActivateClientRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreateSnapshotRequest proto.InternalMessageInfo
 
-func (m *ActivateClientRequest) GetClientKey() string {
+func (m *CreateSnapshotRequest) GetDocumentKey() *DocumentKey {
 	if m != nil {
-		return m.ClientKey
+		return m.DocumentKey
 	}
-	return ""
+	return nil
 }
 
-type ActivateClientResponse struct {
-	ClientKey            string   `protobuf:"bytes,1,opt,name=client_key,json=clientKey,proto3" json:"client_key,omitempty"`
-	ClientId             []byte   `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+type CreateSnapshotResponse struct {
+	ServerSeq            uint64   `protobuf:"varint,1,opt,name=server_seq,json=serverSeq,proto3" json:"server_seq,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ActivateClientResponse) Reset()         { *m = ActivateClientResponse{} }
-func (m *ActivateClientResponse) String() string { return proto.CompactTextString(m) }
-func (*ActivateClientResponse) ProtoMessage()    {}
-func (*ActivateClientResponse) Descriptor() ([]byte, []int) {
+func (m *CreateSnapshotResponse) Reset()         { *m = CreateSnapshotResponse{} }
+func (m *CreateSnapshotResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateSnapshotResponse) ProtoMessage()    {}
+func (*CreateSnapshotResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9df40050e88fbc16, []int{3}
 }
-func (m *ActivateClientResponse) XXX_Unmarshal(b []byte) error {
+func (m *CreateSnapshotResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ActivateClientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CreateSnapshotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ActivateClientResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CreateSnapshotResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -291,51 +299,45 @@ func (m *ActivateClientResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *ActivateClientResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ActivateClientResponse.Merge(m, src)
+func (m *CreateSnapshotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateSnapshotResponse.Merge(m, src)
 }
-func (m *ActivateClientResponse) XXX_Size() int {
+func (m *CreateSnapshotResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ActivateClientResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ActivateClientResponse.DiscardUnknown(m)
+func (m *CreateSnapshotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateSnapshotResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ActivateClientResponse proto.InternalMessageInfo
-
-func (m *ActivateClientResponse) GetClientKey() string {
-	if m != nil {
-		return m.ClientKey
-	}
-	return ""
-}
+var xxx_messageInfo_CreateSnapshotResponse proto.InternalMessageInfo
 
-func (m *ActivateClientResponse) GetClientId() []byte {
+func (m *CreateSnapshotResponse) GetServerSeq() uint64 {
 	if m != nil {
-		return m.ClientId
+		return m.ServerSeq
 	}
-	return nil
+	return 0
 }
 
-type DeactivateClientRequest struct {
-	ClientId             []byte   `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type SetDocumentGCDisabledRequest struct {
+	DocumentKey          *DocumentKey `protobuf:"bytes,1,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
+	GcDisabled           bool         `protobuf:"varint,2,opt,name=gc_disabled,json=gcDisabled,proto3" json:"gc_disabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *DeactivateClientRequest) Reset()         { *m = DeactivateClientRequest{} }
-func (m *DeactivateClientRequest) String() string { return proto.CompactTextString(m) }
-func (*DeactivateClientRequest) ProtoMessage()    {}
-func (*DeactivateClientRequest) Descriptor() ([]byte, []int) {
+func (m *SetDocumentGCDisabledRequest) Reset()         { *m = SetDocumentGCDisabledRequest{} }
+func (m *SetDocumentGCDisabledRequest) String() string { return proto.CompactTextString(m) }
+func (*SetDocumentGCDisabledRequest) ProtoMessage()    {}
+func (*SetDocumentGCDisabledRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9df40050e88fbc16, []int{4}
 }
-func (m *DeactivateClientRequest) XXX_Unmarshal(b []byte) error {
+func (m *SetDocumentGCDisabledRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeactivateClientRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SetDocumentGCDisabledRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeactivateClientRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SetDocumentGCDisabledRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -345,44 +347,50 @@ func (m *DeactivateClientRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *DeactivateClientRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeactivateClientRequest.Merge(m, src)
+func (m *SetDocumentGCDisabledRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetDocumentGCDisabledRequest.Merge(m, src)
 }
-func (m *DeactivateClientRequest) XXX_Size() int {
+func (m *SetDocumentGCDisabledRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DeactivateClientRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeactivateClientRequest.DiscardUnknown(m)
+func (m *SetDocumentGCDisabledRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetDocumentGCDisabledRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeactivateClientRequest proto.InternalMessageInfo
+var xxx_messageInfo_SetDocumentGCDisabledRequest proto.InternalMessageInfo
 
-func (m *DeactivateClientRequest) GetClientId() []byte {
+func (m *SetDocumentGCDisabledRequest) GetDocumentKey() *DocumentKey {
 	if m != nil {
-		return m.ClientId
+		return m.DocumentKey
 	}
 	return nil
 }
 
-type DeactivateClientResponse struct {
-	ClientId             []byte   `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+func (m *SetDocumentGCDisabledRequest) GetGcDisabled() bool {
+	if m != nil {
+		return m.GcDisabled
+	}
+	return false
+}
+
+type SetDocumentGCDisabledResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeactivateClientResponse) Reset()         { *m = DeactivateClientResponse{} }
-func (m *DeactivateClientResponse) String() string { return proto.CompactTextString(m) }
-func (*DeactivateClientResponse) ProtoMessage()    {}
-func (*DeactivateClientResponse) Descriptor() ([]byte, []int) {
+func (m *SetDocumentGCDisabledResponse) Reset()         { *m = SetDocumentGCDisabledResponse{} }
+func (m *SetDocumentGCDisabledResponse) String() string { return proto.CompactTextString(m) }
+func (*SetDocumentGCDisabledResponse) ProtoMessage()    {}
+func (*SetDocumentGCDisabledResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9df40050e88fbc16, []int{5}
 }
-func (m *DeactivateClientResponse) XXX_Unmarshal(b []byte) error {
+func (m *SetDocumentGCDisabledResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeactivateClientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SetDocumentGCDisabledResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeactivateClientResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SetDocumentGCDisabledResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -392,45 +400,36 @@ func (m *DeactivateClientResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *DeactivateClientResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeactivateClientResponse.Merge(m, src)
+func (m *SetDocumentGCDisabledResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetDocumentGCDisabledResponse.Merge(m, src)
 }
-func (m *DeactivateClientResponse) XXX_Size() int {
+func (m *SetDocumentGCDisabledResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *DeactivateClientResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeactivateClientResponse.DiscardUnknown(m)
+func (m *SetDocumentGCDisabledResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetDocumentGCDisabledResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeactivateClientResponse proto.InternalMessageInfo
-
-func (m *DeactivateClientResponse) GetClientId() []byte {
-	if m != nil {
-		return m.ClientId
-	}
-	return nil
-}
+var xxx_messageInfo_SetDocumentGCDisabledResponse proto.InternalMessageInfo
 
-type AttachDocumentRequest struct {
-	ClientId             []byte      `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	ChangePack           *ChangePack `protobuf:"bytes,2,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+type GetServerInfoRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AttachDocumentRequest) Reset()         { *m = AttachDocumentRequest{} }
-func (m *AttachDocumentRequest) String() string { return proto.CompactTextString(m) }
-func (*AttachDocumentRequest) ProtoMessage()    {}
-func (*AttachDocumentRequest) Descriptor() ([]byte, []int) {
+func (m *GetServerInfoRequest) Reset()         { *m = GetServerInfoRequest{} }
+func (m *GetServerInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetServerInfoRequest) ProtoMessage()    {}
+func (*GetServerInfoRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9df40050e88fbc16, []int{6}
 }
-func (m *AttachDocumentRequest) XXX_Unmarshal(b []byte) error {
+func (m *GetServerInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AttachDocumentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetServerInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AttachDocumentRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetServerInfoRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -440,52 +439,39 @@ func (m *AttachDocumentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *AttachDocumentRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AttachDocumentRequest.Merge(m, src)
+func (m *GetServerInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetServerInfoRequest.Merge(m, src)
 }
-func (m *AttachDocumentRequest) XXX_Size() int {
+func (m *GetServerInfoRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AttachDocumentRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AttachDocumentRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_AttachDocumentRequest proto.InternalMessageInfo
-
-func (m *AttachDocumentRequest) GetClientId() []byte {
-	if m != nil {
-		return m.ClientId
-	}
-	return nil
+func (m *GetServerInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetServerInfoRequest.DiscardUnknown(m)
 }
 
-func (m *AttachDocumentRequest) GetChangePack() *ChangePack {
-	if m != nil {
-		return m.ChangePack
-	}
-	return nil
-}
+var xxx_messageInfo_GetServerInfoRequest proto.InternalMessageInfo
 
-type AttachDocumentResponse struct {
-	ClientId             []byte      `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	ChangePack           *ChangePack `protobuf:"bytes,2,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+type GetServerInfoResponse struct {
+	Version              string   `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	GitCommit            string   `protobuf:"bytes,2,opt,name=git_commit,json=gitCommit,proto3" json:"git_commit,omitempty"`
+	BuildDate            string   `protobuf:"bytes,3,opt,name=build_date,json=buildDate,proto3" json:"build_date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AttachDocumentResponse) Reset()         { *m = AttachDocumentResponse{} }
-func (m *AttachDocumentResponse) String() string { return proto.CompactTextString(m) }
-func (*AttachDocumentResponse) ProtoMessage()    {}
-func (*AttachDocumentResponse) Descriptor() ([]byte, []int) {
+func (m *GetServerInfoResponse) Reset()         { *m = GetServerInfoResponse{} }
+func (m *GetServerInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetServerInfoResponse) ProtoMessage()    {}
+func (*GetServerInfoResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9df40050e88fbc16, []int{7}
 }
-func (m *AttachDocumentResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetServerInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AttachDocumentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetServerInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AttachDocumentResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetServerInfoResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -495,52 +481,59 @@ func (m *AttachDocumentResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *AttachDocumentResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AttachDocumentResponse.Merge(m, src)
+func (m *GetServerInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetServerInfoResponse.Merge(m, src)
 }
-func (m *AttachDocumentResponse) XXX_Size() int {
+func (m *GetServerInfoResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *AttachDocumentResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AttachDocumentResponse.DiscardUnknown(m)
+func (m *GetServerInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetServerInfoResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AttachDocumentResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetServerInfoResponse proto.InternalMessageInfo
 
-func (m *AttachDocumentResponse) GetClientId() []byte {
+func (m *GetServerInfoResponse) GetVersion() string {
 	if m != nil {
-		return m.ClientId
+		return m.Version
 	}
-	return nil
+	return ""
 }
 
-func (m *AttachDocumentResponse) GetChangePack() *ChangePack {
+func (m *GetServerInfoResponse) GetGitCommit() string {
 	if m != nil {
-		return m.ChangePack
+		return m.GitCommit
 	}
-	return nil
+	return ""
 }
 
-type DetachDocumentRequest struct {
-	ClientId             []byte      `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	ChangePack           *ChangePack `protobuf:"bytes,2,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+func (m *GetServerInfoResponse) GetBuildDate() string {
+	if m != nil {
+		return m.BuildDate
+	}
+	return ""
 }
 
-func (m *DetachDocumentRequest) Reset()         { *m = DetachDocumentRequest{} }
-func (m *DetachDocumentRequest) String() string { return proto.CompactTextString(m) }
-func (*DetachDocumentRequest) ProtoMessage()    {}
-func (*DetachDocumentRequest) Descriptor() ([]byte, []int) {
+type CompactChangesRequest struct {
+	DocumentKeys         []*DocumentKey `protobuf:"bytes,1,rep,name=document_keys,json=documentKeys,proto3" json:"document_keys,omitempty"`
+	BeforeServerSeq      uint64         `protobuf:"varint,2,opt,name=before_server_seq,json=beforeServerSeq,proto3" json:"before_server_seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *CompactChangesRequest) Reset()         { *m = CompactChangesRequest{} }
+func (m *CompactChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*CompactChangesRequest) ProtoMessage()    {}
+func (*CompactChangesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9df40050e88fbc16, []int{8}
 }
-func (m *DetachDocumentRequest) XXX_Unmarshal(b []byte) error {
+func (m *CompactChangesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DetachDocumentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CompactChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DetachDocumentRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CompactChangesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -550,52 +543,52 @@ func (m *DetachDocumentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *DetachDocumentRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DetachDocumentRequest.Merge(m, src)
+func (m *CompactChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactChangesRequest.Merge(m, src)
 }
-func (m *DetachDocumentRequest) XXX_Size() int {
+func (m *CompactChangesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DetachDocumentRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DetachDocumentRequest.DiscardUnknown(m)
+func (m *CompactChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactChangesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DetachDocumentRequest proto.InternalMessageInfo
+var xxx_messageInfo_CompactChangesRequest proto.InternalMessageInfo
 
-func (m *DetachDocumentRequest) GetClientId() []byte {
+func (m *CompactChangesRequest) GetDocumentKeys() []*DocumentKey {
 	if m != nil {
-		return m.ClientId
+		return m.DocumentKeys
 	}
 	return nil
 }
 
-func (m *DetachDocumentRequest) GetChangePack() *ChangePack {
+func (m *CompactChangesRequest) GetBeforeServerSeq() uint64 {
 	if m != nil {
-		return m.ChangePack
+		return m.BeforeServerSeq
 	}
-	return nil
+	return 0
 }
 
-type DetachDocumentResponse struct {
-	ClientKey            string      `protobuf:"bytes,1,opt,name=client_key,json=clientKey,proto3" json:"client_key,omitempty"`
-	ChangePack           *ChangePack `protobuf:"bytes,2,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+type CompactChangesResponse struct {
+	CompactedDocuments   uint64   `protobuf:"varint,1,opt,name=compacted_documents,json=compactedDocuments,proto3" json:"compacted_documents,omitempty"`
+	CompactedChanges     uint64   `protobuf:"varint,2,opt,name=compacted_changes,json=compactedChanges,proto3" json:"compacted_changes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DetachDocumentResponse) Reset()         { *m = DetachDocumentResponse{} }
-func (m *DetachDocumentResponse) String() string { return proto.CompactTextString(m) }
-func (*DetachDocumentResponse) ProtoMessage()    {}
-func (*DetachDocumentResponse) Descriptor() ([]byte, []int) {
+func (m *CompactChangesResponse) Reset()         { *m = CompactChangesResponse{} }
+func (m *CompactChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*CompactChangesResponse) ProtoMessage()    {}
+func (*CompactChangesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9df40050e88fbc16, []int{9}
 }
-func (m *DetachDocumentResponse) XXX_Unmarshal(b []byte) error {
+func (m *CompactChangesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DetachDocumentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CompactChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DetachDocumentResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CompactChangesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -605,52 +598,51 @@ func (m *DetachDocumentResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *DetachDocumentResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DetachDocumentResponse.Merge(m, src)
+func (m *CompactChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactChangesResponse.Merge(m, src)
 }
-func (m *DetachDocumentResponse) XXX_Size() int {
+func (m *CompactChangesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *DetachDocumentResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DetachDocumentResponse.DiscardUnknown(m)
+func (m *CompactChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactChangesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DetachDocumentResponse proto.InternalMessageInfo
+var xxx_messageInfo_CompactChangesResponse proto.InternalMessageInfo
 
-func (m *DetachDocumentResponse) GetClientKey() string {
+func (m *CompactChangesResponse) GetCompactedDocuments() uint64 {
 	if m != nil {
-		return m.ClientKey
+		return m.CompactedDocuments
 	}
-	return ""
+	return 0
 }
 
-func (m *DetachDocumentResponse) GetChangePack() *ChangePack {
+func (m *CompactChangesResponse) GetCompactedChanges() uint64 {
 	if m != nil {
-		return m.ChangePack
+		return m.CompactedChanges
 	}
-	return nil
+	return 0
 }
 
-type WatchDocumentsRequest struct {
-	Client               *Client        `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
-	DocumentKeys         []*DocumentKey `protobuf:"bytes,2,rep,name=document_keys,json=documentKeys,proto3" json:"document_keys,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+type GetDocumentStatsRequest struct {
+	DocumentKey          *DocumentKey `protobuf:"bytes,1,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *WatchDocumentsRequest) Reset()         { *m = WatchDocumentsRequest{} }
-func (m *WatchDocumentsRequest) String() string { return proto.CompactTextString(m) }
-func (*WatchDocumentsRequest) ProtoMessage()    {}
-func (*WatchDocumentsRequest) Descriptor() ([]byte, []int) {
+func (m *GetDocumentStatsRequest) Reset()         { *m = GetDocumentStatsRequest{} }
+func (m *GetDocumentStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDocumentStatsRequest) ProtoMessage()    {}
+func (*GetDocumentStatsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9df40050e88fbc16, []int{10}
 }
-func (m *WatchDocumentsRequest) XXX_Unmarshal(b []byte) error {
+func (m *GetDocumentStatsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *WatchDocumentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetDocumentStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_WatchDocumentsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetDocumentStatsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -660,54 +652,48 @@ func (m *WatchDocumentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *WatchDocumentsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WatchDocumentsRequest.Merge(m, src)
+func (m *GetDocumentStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDocumentStatsRequest.Merge(m, src)
 }
-func (m *WatchDocumentsRequest) XXX_Size() int {
+func (m *GetDocumentStatsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *WatchDocumentsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_WatchDocumentsRequest.DiscardUnknown(m)
+func (m *GetDocumentStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDocumentStatsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_WatchDocumentsRequest proto.InternalMessageInfo
-
-func (m *WatchDocumentsRequest) GetClient() *Client {
-	if m != nil {
-		return m.Client
-	}
-	return nil
-}
+var xxx_messageInfo_GetDocumentStatsRequest proto.InternalMessageInfo
 
-func (m *WatchDocumentsRequest) GetDocumentKeys() []*DocumentKey {
+func (m *GetDocumentStatsRequest) GetDocumentKey() *DocumentKey {
 	if m != nil {
-		return m.DocumentKeys
+		return m.DocumentKey
 	}
 	return nil
 }
 
-type WatchDocumentsResponse struct {
-	// Types that are valid to be assigned to Body:
-	//	*WatchDocumentsResponse_Initialization_
-	//	*WatchDocumentsResponse_Event
-	Body                 isWatchDocumentsResponse_Body `protobuf_oneof:"body"`
-	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
-	XXX_unrecognized     []byte                        `json:"-"`
-	XXX_sizecache        int32                         `json:"-"`
+type GetDocumentStatsResponse struct {
+	ServerSeq            uint64   `protobuf:"varint,1,opt,name=server_seq,json=serverSeq,proto3" json:"server_seq,omitempty"`
+	SnapshotServerSeq    uint64   `protobuf:"varint,2,opt,name=snapshot_server_seq,json=snapshotServerSeq,proto3" json:"snapshot_server_seq,omitempty"`
+	ChangesSinceSnapshot uint64   `protobuf:"varint,3,opt,name=changes_since_snapshot,json=changesSinceSnapshot,proto3" json:"changes_since_snapshot,omitempty"`
+	SnapshotBytes        uint64   `protobuf:"varint,4,opt,name=snapshot_bytes,json=snapshotBytes,proto3" json:"snapshot_bytes,omitempty"`
+	ActorCount           uint64   `protobuf:"varint,5,opt,name=actor_count,json=actorCount,proto3" json:"actor_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *WatchDocumentsResponse) Reset()         { *m = WatchDocumentsResponse{} }
-func (m *WatchDocumentsResponse) String() string { return proto.CompactTextString(m) }
-func (*WatchDocumentsResponse) ProtoMessage()    {}
-func (*WatchDocumentsResponse) Descriptor() ([]byte, []int) {
+func (m *GetDocumentStatsResponse) Reset()         { *m = GetDocumentStatsResponse{} }
+func (m *GetDocumentStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDocumentStatsResponse) ProtoMessage()    {}
+func (*GetDocumentStatsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_9df40050e88fbc16, []int{11}
 }
-func (m *WatchDocumentsResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetDocumentStatsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *WatchDocumentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetDocumentStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_WatchDocumentsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetDocumentStatsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -717,82 +703,73 @@ func (m *WatchDocumentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *WatchDocumentsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WatchDocumentsResponse.Merge(m, src)
+func (m *GetDocumentStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDocumentStatsResponse.Merge(m, src)
 }
-func (m *WatchDocumentsResponse) XXX_Size() int {
+func (m *GetDocumentStatsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *WatchDocumentsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_WatchDocumentsResponse.DiscardUnknown(m)
+func (m *GetDocumentStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDocumentStatsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_WatchDocumentsResponse proto.InternalMessageInfo
-
-type isWatchDocumentsResponse_Body interface {
-	isWatchDocumentsResponse_Body()
-	MarshalTo([]byte) (int, error)
-	Size() int
-}
+var xxx_messageInfo_GetDocumentStatsResponse proto.InternalMessageInfo
 
-type WatchDocumentsResponse_Initialization_ struct {
-	Initialization *WatchDocumentsResponse_Initialization `protobuf:"bytes,1,opt,name=initialization,proto3,oneof" json:"initialization,omitempty"`
-}
-type WatchDocumentsResponse_Event struct {
-	Event *DocEvent `protobuf:"bytes,2,opt,name=event,proto3,oneof" json:"event,omitempty"`
+func (m *GetDocumentStatsResponse) GetServerSeq() uint64 {
+	if m != nil {
+		return m.ServerSeq
+	}
+	return 0
 }
 
-func (*WatchDocumentsResponse_Initialization_) isWatchDocumentsResponse_Body() {}
-func (*WatchDocumentsResponse_Event) isWatchDocumentsResponse_Body()           {}
-
-func (m *WatchDocumentsResponse) GetBody() isWatchDocumentsResponse_Body {
+func (m *GetDocumentStatsResponse) GetSnapshotServerSeq() uint64 {
 	if m != nil {
-		return m.Body
+		return m.SnapshotServerSeq
 	}
-	return nil
+	return 0
 }
 
-func (m *WatchDocumentsResponse) GetInitialization() *WatchDocumentsResponse_Initialization {
-	if x, ok := m.GetBody().(*WatchDocumentsResponse_Initialization_); ok {
-		return x.Initialization
+func (m *GetDocumentStatsResponse) GetChangesSinceSnapshot() uint64 {
+	if m != nil {
+		return m.ChangesSinceSnapshot
 	}
-	return nil
+	return 0
 }
 
-func (m *WatchDocumentsResponse) GetEvent() *DocEvent {
-	if x, ok := m.GetBody().(*WatchDocumentsResponse_Event); ok {
-		return x.Event
+func (m *GetDocumentStatsResponse) GetSnapshotBytes() uint64 {
+	if m != nil {
+		return m.SnapshotBytes
 	}
-	return nil
+	return 0
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*WatchDocumentsResponse) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*WatchDocumentsResponse_Initialization_)(nil),
-		(*WatchDocumentsResponse_Event)(nil),
+func (m *GetDocumentStatsResponse) GetActorCount() uint64 {
+	if m != nil {
+		return m.ActorCount
 	}
+	return 0
 }
 
-type WatchDocumentsResponse_Initialization struct {
-	PeersMapByDoc        map[string]*Clients `protobuf:"bytes,1,rep,name=peers_map_by_doc,json=peersMapByDoc,proto3" json:"peers_map_by_doc,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+type DryRunGarbageCollectionRequest struct {
+	DocumentKey          *DocumentKey `protobuf:"bytes,1,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
+	MinSyncedTicket      *TimeTicket  `protobuf:"bytes,2,opt,name=min_synced_ticket,json=minSyncedTicket,proto3" json:"min_synced_ticket,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *WatchDocumentsResponse_Initialization) Reset()         { *m = WatchDocumentsResponse_Initialization{} }
-func (m *WatchDocumentsResponse_Initialization) String() string { return proto.CompactTextString(m) }
-func (*WatchDocumentsResponse_Initialization) ProtoMessage()    {}
-func (*WatchDocumentsResponse_Initialization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{11, 0}
+func (m *DryRunGarbageCollectionRequest) Reset()         { *m = DryRunGarbageCollectionRequest{} }
+func (m *DryRunGarbageCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*DryRunGarbageCollectionRequest) ProtoMessage()    {}
+func (*DryRunGarbageCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{12}
 }
-func (m *WatchDocumentsResponse_Initialization) XXX_Unmarshal(b []byte) error {
+func (m *DryRunGarbageCollectionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *WatchDocumentsResponse_Initialization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DryRunGarbageCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_WatchDocumentsResponse_Initialization.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DryRunGarbageCollectionRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -802,45 +779,53 @@ func (m *WatchDocumentsResponse_Initialization) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *WatchDocumentsResponse_Initialization) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WatchDocumentsResponse_Initialization.Merge(m, src)
+func (m *DryRunGarbageCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DryRunGarbageCollectionRequest.Merge(m, src)
 }
-func (m *WatchDocumentsResponse_Initialization) XXX_Size() int {
+func (m *DryRunGarbageCollectionRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *WatchDocumentsResponse_Initialization) XXX_DiscardUnknown() {
-	xxx_messageInfo_WatchDocumentsResponse_Initialization.DiscardUnknown(m)
+func (m *DryRunGarbageCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DryRunGarbageCollectionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_WatchDocumentsResponse_Initialization proto.InternalMessageInfo
+var xxx_messageInfo_DryRunGarbageCollectionRequest proto.InternalMessageInfo
 
-func (m *WatchDocumentsResponse_Initialization) GetPeersMapByDoc() map[string]*Clients {
+func (m *DryRunGarbageCollectionRequest) GetDocumentKey() *DocumentKey {
 	if m != nil {
-		return m.PeersMapByDoc
+		return m.DocumentKey
 	}
 	return nil
 }
 
-type PushPullRequest struct {
-	ClientId             []byte      `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	ChangePack           *ChangePack `protobuf:"bytes,2,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+func (m *DryRunGarbageCollectionRequest) GetMinSyncedTicket() *TimeTicket {
+	if m != nil {
+		return m.MinSyncedTicket
+	}
+	return nil
 }
 
-func (m *PushPullRequest) Reset()         { *m = PushPullRequest{} }
-func (m *PushPullRequest) String() string { return proto.CompactTextString(m) }
-func (*PushPullRequest) ProtoMessage()    {}
-func (*PushPullRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{12}
+type DryRunGarbageCollectionResponse struct {
+	ServerSeq            uint64     `protobuf:"varint,1,opt,name=server_seq,json=serverSeq,proto3" json:"server_seq,omitempty"`
+	GcDisabled           bool       `protobuf:"varint,2,opt,name=gc_disabled,json=gcDisabled,proto3" json:"gc_disabled,omitempty"`
+	Garbage              []*Garbage `protobuf:"bytes,3,rep,name=garbage,proto3" json:"garbage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
-func (m *PushPullRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *DryRunGarbageCollectionResponse) Reset()         { *m = DryRunGarbageCollectionResponse{} }
+func (m *DryRunGarbageCollectionResponse) String() string { return proto.CompactTextString(m) }
+func (*DryRunGarbageCollectionResponse) ProtoMessage()    {}
+func (*DryRunGarbageCollectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{13}
+}
+func (m *DryRunGarbageCollectionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PushPullRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DryRunGarbageCollectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PushPullRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DryRunGarbageCollectionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -850,52 +835,61 @@ func (m *PushPullRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *PushPullRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PushPullRequest.Merge(m, src)
+func (m *DryRunGarbageCollectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DryRunGarbageCollectionResponse.Merge(m, src)
 }
-func (m *PushPullRequest) XXX_Size() int {
+func (m *DryRunGarbageCollectionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *PushPullRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PushPullRequest.DiscardUnknown(m)
+func (m *DryRunGarbageCollectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DryRunGarbageCollectionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PushPullRequest proto.InternalMessageInfo
+var xxx_messageInfo_DryRunGarbageCollectionResponse proto.InternalMessageInfo
 
-func (m *PushPullRequest) GetClientId() []byte {
+func (m *DryRunGarbageCollectionResponse) GetServerSeq() uint64 {
 	if m != nil {
-		return m.ClientId
+		return m.ServerSeq
 	}
-	return nil
+	return 0
 }
 
-func (m *PushPullRequest) GetChangePack() *ChangePack {
+func (m *DryRunGarbageCollectionResponse) GetGcDisabled() bool {
 	if m != nil {
-		return m.ChangePack
+		return m.GcDisabled
+	}
+	return false
+}
+
+func (m *DryRunGarbageCollectionResponse) GetGarbage() []*Garbage {
+	if m != nil {
+		return m.Garbage
 	}
 	return nil
 }
 
-type PushPullResponse struct {
-	ClientId             []byte      `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	ChangePack           *ChangePack `protobuf:"bytes,2,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
+type Garbage struct {
+	Id                   string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ParentId             string      `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	RemovedAt            *TimeTicket `protobuf:"bytes,3,opt,name=removed_at,json=removedAt,proto3" json:"removed_at,omitempty"`
+	Count                uint32      `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
 	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *PushPullResponse) Reset()         { *m = PushPullResponse{} }
-func (m *PushPullResponse) String() string { return proto.CompactTextString(m) }
-func (*PushPullResponse) ProtoMessage()    {}
-func (*PushPullResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{13}
+func (m *Garbage) Reset()         { *m = Garbage{} }
+func (m *Garbage) String() string { return proto.CompactTextString(m) }
+func (*Garbage) ProtoMessage()    {}
+func (*Garbage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{14}
 }
-func (m *PushPullResponse) XXX_Unmarshal(b []byte) error {
+func (m *Garbage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PushPullResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Garbage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PushPullResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Garbage.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -905,52 +899,65 @@ func (m *PushPullResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *PushPullResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PushPullResponse.Merge(m, src)
+func (m *Garbage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Garbage.Merge(m, src)
 }
-func (m *PushPullResponse) XXX_Size() int {
+func (m *Garbage) XXX_Size() int {
 	return m.Size()
 }
-func (m *PushPullResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PushPullResponse.DiscardUnknown(m)
+func (m *Garbage) XXX_DiscardUnknown() {
+	xxx_messageInfo_Garbage.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PushPullResponse proto.InternalMessageInfo
+var xxx_messageInfo_Garbage proto.InternalMessageInfo
 
-func (m *PushPullResponse) GetClientId() []byte {
+func (m *Garbage) GetId() string {
 	if m != nil {
-		return m.ClientId
+		return m.Id
 	}
-	return nil
+	return ""
 }
 
-func (m *PushPullResponse) GetChangePack() *ChangePack {
+func (m *Garbage) GetParentId() string {
 	if m != nil {
-		return m.ChangePack
+		return m.ParentId
+	}
+	return ""
+}
+
+func (m *Garbage) GetRemovedAt() *TimeTicket {
+	if m != nil {
+		return m.RemovedAt
 	}
 	return nil
 }
 
-type UpdateMetadataRequest struct {
-	Client               *Client        `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
-	DocumentKeys         []*DocumentKey `protobuf:"bytes,2,rep,name=document_keys,json=documentKeys,proto3" json:"document_keys,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+func (m *Garbage) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
 }
 
-func (m *UpdateMetadataRequest) Reset()         { *m = UpdateMetadataRequest{} }
-func (m *UpdateMetadataRequest) String() string { return proto.CompactTextString(m) }
-func (*UpdateMetadataRequest) ProtoMessage()    {}
-func (*UpdateMetadataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{14}
+type GetClientInfoRequest struct {
+	ClientId             []byte   `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *UpdateMetadataRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *GetClientInfoRequest) Reset()         { *m = GetClientInfoRequest{} }
+func (m *GetClientInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetClientInfoRequest) ProtoMessage()    {}
+func (*GetClientInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{15}
+}
+func (m *GetClientInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UpdateMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetClientInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UpdateMetadataRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetClientInfoRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -960,50 +967,49 @@ func (m *UpdateMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *UpdateMetadataRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateMetadataRequest.Merge(m, src)
+func (m *GetClientInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetClientInfoRequest.Merge(m, src)
 }
-func (m *UpdateMetadataRequest) XXX_Size() int {
+func (m *GetClientInfoRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *UpdateMetadataRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateMetadataRequest.DiscardUnknown(m)
+func (m *GetClientInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetClientInfoRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateMetadataRequest proto.InternalMessageInfo
-
-func (m *UpdateMetadataRequest) GetClient() *Client {
-	if m != nil {
-		return m.Client
-	}
-	return nil
-}
+var xxx_messageInfo_GetClientInfoRequest proto.InternalMessageInfo
 
-func (m *UpdateMetadataRequest) GetDocumentKeys() []*DocumentKey {
+func (m *GetClientInfoRequest) GetClientId() []byte {
 	if m != nil {
-		return m.DocumentKeys
+		return m.ClientId
 	}
 	return nil
 }
 
-type UpdateMetadataResponse struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type GetClientInfoResponse struct {
+	ClientId             []byte            `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientKey            string            `protobuf:"bytes,2,opt,name=client_key,json=clientKey,proto3" json:"client_key,omitempty"`
+	Status               string            `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Metadata             map[string]string `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Documents            []*ClientDocInfo  `protobuf:"bytes,5,rep,name=documents,proto3" json:"documents,omitempty"`
+	UpdatedAt            *types.Timestamp  `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *UpdateMetadataResponse) Reset()         { *m = UpdateMetadataResponse{} }
-func (m *UpdateMetadataResponse) String() string { return proto.CompactTextString(m) }
-func (*UpdateMetadataResponse) ProtoMessage()    {}
-func (*UpdateMetadataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{15}
+func (m *GetClientInfoResponse) Reset()         { *m = GetClientInfoResponse{} }
+func (m *GetClientInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetClientInfoResponse) ProtoMessage()    {}
+func (*GetClientInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{16}
 }
-func (m *UpdateMetadataResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetClientInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UpdateMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetClientInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UpdateMetadataResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetClientInfoResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1013,41 +1019,82 @@ func (m *UpdateMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *UpdateMetadataResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateMetadataResponse.Merge(m, src)
+func (m *GetClientInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetClientInfoResponse.Merge(m, src)
 }
-func (m *UpdateMetadataResponse) XXX_Size() int {
+func (m *GetClientInfoResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *UpdateMetadataResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateMetadataResponse.DiscardUnknown(m)
+func (m *GetClientInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetClientInfoResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateMetadataResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetClientInfoResponse proto.InternalMessageInfo
 
-type ChangePack struct {
-	DocumentKey          *DocumentKey `protobuf:"bytes,1,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
-	Checkpoint           *Checkpoint  `protobuf:"bytes,2,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
-	Snapshot             []byte       `protobuf:"bytes,3,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
-	Changes              []*Change    `protobuf:"bytes,4,rep,name=changes,proto3" json:"changes,omitempty"`
-	MinSyncedTicket      *TimeTicket  `protobuf:"bytes,5,opt,name=min_synced_ticket,json=minSyncedTicket,proto3" json:"min_synced_ticket,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+func (m *GetClientInfoResponse) GetClientId() []byte {
+	if m != nil {
+		return m.ClientId
+	}
+	return nil
 }
 
-func (m *ChangePack) Reset()         { *m = ChangePack{} }
-func (m *ChangePack) String() string { return proto.CompactTextString(m) }
-func (*ChangePack) ProtoMessage()    {}
-func (*ChangePack) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{16}
+func (m *GetClientInfoResponse) GetClientKey() string {
+	if m != nil {
+		return m.ClientKey
+	}
+	return ""
 }
-func (m *ChangePack) XXX_Unmarshal(b []byte) error {
+
+func (m *GetClientInfoResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *GetClientInfoResponse) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *GetClientInfoResponse) GetDocuments() []*ClientDocInfo {
+	if m != nil {
+		return m.Documents
+	}
+	return nil
+}
+
+func (m *GetClientInfoResponse) GetUpdatedAt() *types.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+type ClientDocInfo struct {
+	DocumentId           []byte       `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	DocumentKey          *DocumentKey `protobuf:"bytes,2,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
+	Status               string       `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Checkpoint           *Checkpoint  `protobuf:"bytes,4,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ClientDocInfo) Reset()         { *m = ClientDocInfo{} }
+func (m *ClientDocInfo) String() string { return proto.CompactTextString(m) }
+func (*ClientDocInfo) ProtoMessage()    {}
+func (*ClientDocInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{17}
+}
+func (m *ClientDocInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ChangePack) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ClientDocInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ChangePack.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ClientDocInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1057,74 +1104,65 @@ func (m *ChangePack) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *ChangePack) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChangePack.Merge(m, src)
+func (m *ClientDocInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientDocInfo.Merge(m, src)
 }
-func (m *ChangePack) XXX_Size() int {
+func (m *ClientDocInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *ChangePack) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChangePack.DiscardUnknown(m)
+func (m *ClientDocInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientDocInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChangePack proto.InternalMessageInfo
-
-func (m *ChangePack) GetDocumentKey() *DocumentKey {
-	if m != nil {
-		return m.DocumentKey
-	}
-	return nil
-}
+var xxx_messageInfo_ClientDocInfo proto.InternalMessageInfo
 
-func (m *ChangePack) GetCheckpoint() *Checkpoint {
+func (m *ClientDocInfo) GetDocumentId() []byte {
 	if m != nil {
-		return m.Checkpoint
+		return m.DocumentId
 	}
 	return nil
 }
 
-func (m *ChangePack) GetSnapshot() []byte {
+func (m *ClientDocInfo) GetDocumentKey() *DocumentKey {
 	if m != nil {
-		return m.Snapshot
+		return m.DocumentKey
 	}
 	return nil
 }
 
-func (m *ChangePack) GetChanges() []*Change {
+func (m *ClientDocInfo) GetStatus() string {
 	if m != nil {
-		return m.Changes
+		return m.Status
 	}
-	return nil
+	return ""
 }
 
-func (m *ChangePack) GetMinSyncedTicket() *TimeTicket {
+func (m *ClientDocInfo) GetCheckpoint() *Checkpoint {
 	if m != nil {
-		return m.MinSyncedTicket
+		return m.Checkpoint
 	}
 	return nil
 }
 
-type Change struct {
-	Id                   *ChangeID    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Message              string       `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Operations           []*Operation `protobuf:"bytes,3,rep,name=operations,proto3" json:"operations,omitempty"`
+type GetSnapshotMetaRequest struct {
+	DocumentKey          *DocumentKey `protobuf:"bytes,1,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *Change) Reset()         { *m = Change{} }
-func (m *Change) String() string { return proto.CompactTextString(m) }
-func (*Change) ProtoMessage()    {}
-func (*Change) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{17}
+func (m *GetSnapshotMetaRequest) Reset()         { *m = GetSnapshotMetaRequest{} }
+func (m *GetSnapshotMetaRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSnapshotMetaRequest) ProtoMessage()    {}
+func (*GetSnapshotMetaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{18}
 }
-func (m *Change) XXX_Unmarshal(b []byte) error {
+func (m *GetSnapshotMetaRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Change) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetSnapshotMetaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Change.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetSnapshotMetaRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1134,60 +1172,46 @@ func (m *Change) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Change) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Change.Merge(m, src)
+func (m *GetSnapshotMetaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSnapshotMetaRequest.Merge(m, src)
 }
-func (m *Change) XXX_Size() int {
+func (m *GetSnapshotMetaRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *Change) XXX_DiscardUnknown() {
-	xxx_messageInfo_Change.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Change proto.InternalMessageInfo
-
-func (m *Change) GetId() *ChangeID {
-	if m != nil {
-		return m.Id
-	}
-	return nil
+func (m *GetSnapshotMetaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSnapshotMetaRequest.DiscardUnknown(m)
 }
 
-func (m *Change) GetMessage() string {
-	if m != nil {
-		return m.Message
-	}
-	return ""
-}
+var xxx_messageInfo_GetSnapshotMetaRequest proto.InternalMessageInfo
 
-func (m *Change) GetOperations() []*Operation {
+func (m *GetSnapshotMetaRequest) GetDocumentKey() *DocumentKey {
 	if m != nil {
-		return m.Operations
+		return m.DocumentKey
 	}
 	return nil
 }
 
-type ChangeID struct {
-	ClientSeq            uint32   `protobuf:"varint,1,opt,name=client_seq,json=clientSeq,proto3" json:"client_seq,omitempty"`
-	Lamport              uint64   `protobuf:"varint,2,opt,name=lamport,proto3" json:"lamport,omitempty"`
-	ActorId              []byte   `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type GetSnapshotMetaResponse struct {
+	ServerSeq            uint64           `protobuf:"varint,1,opt,name=server_seq,json=serverSeq,proto3" json:"server_seq,omitempty"`
+	Hash                 string           `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	CreatedAt            *types.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *ChangeID) Reset()         { *m = ChangeID{} }
-func (m *ChangeID) String() string { return proto.CompactTextString(m) }
-func (*ChangeID) ProtoMessage()    {}
-func (*ChangeID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{18}
+func (m *GetSnapshotMetaResponse) Reset()         { *m = GetSnapshotMetaResponse{} }
+func (m *GetSnapshotMetaResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSnapshotMetaResponse) ProtoMessage()    {}
+func (*GetSnapshotMetaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{19}
 }
-func (m *ChangeID) XXX_Unmarshal(b []byte) error {
+func (m *GetSnapshotMetaResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ChangeID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetSnapshotMetaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ChangeID.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetSnapshotMetaResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1197,68 +1221,59 @@ func (m *ChangeID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *ChangeID) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChangeID.Merge(m, src)
+func (m *GetSnapshotMetaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSnapshotMetaResponse.Merge(m, src)
 }
-func (m *ChangeID) XXX_Size() int {
+func (m *GetSnapshotMetaResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ChangeID) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChangeID.DiscardUnknown(m)
+func (m *GetSnapshotMetaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSnapshotMetaResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChangeID proto.InternalMessageInfo
+var xxx_messageInfo_GetSnapshotMetaResponse proto.InternalMessageInfo
 
-func (m *ChangeID) GetClientSeq() uint32 {
+func (m *GetSnapshotMetaResponse) GetServerSeq() uint64 {
 	if m != nil {
-		return m.ClientSeq
+		return m.ServerSeq
 	}
 	return 0
 }
 
-func (m *ChangeID) GetLamport() uint64 {
+func (m *GetSnapshotMetaResponse) GetHash() string {
 	if m != nil {
-		return m.Lamport
+		return m.Hash
 	}
-	return 0
+	return ""
 }
 
-func (m *ChangeID) GetActorId() []byte {
+func (m *GetSnapshotMetaResponse) GetCreatedAt() *types.Timestamp {
 	if m != nil {
-		return m.ActorId
+		return m.CreatedAt
 	}
 	return nil
 }
 
-type Operation struct {
-	// Types that are valid to be assigned to Body:
-	//	*Operation_Set_
-	//	*Operation_Add_
-	//	*Operation_Move_
-	//	*Operation_Remove_
-	//	*Operation_Edit_
-	//	*Operation_Select_
-	//	*Operation_RichEdit_
-	//	*Operation_Style_
-	//	*Operation_Increase_
-	Body                 isOperation_Body `protobuf_oneof:"body"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+type StreamLogsRequest struct {
+	Level                string   `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	LoggerPrefixes       []string `protobuf:"bytes,2,rep,name=logger_prefixes,json=loggerPrefixes,proto3" json:"logger_prefixes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Operation) Reset()         { *m = Operation{} }
-func (m *Operation) String() string { return proto.CompactTextString(m) }
-func (*Operation) ProtoMessage()    {}
-func (*Operation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{19}
+func (m *StreamLogsRequest) Reset()         { *m = StreamLogsRequest{} }
+func (m *StreamLogsRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamLogsRequest) ProtoMessage()    {}
+func (*StreamLogsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{20}
 }
-func (m *Operation) XXX_Unmarshal(b []byte) error {
+func (m *StreamLogsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Operation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StreamLogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Operation.Marshal(b, m, deterministic)
+		return xxx_messageInfo_StreamLogsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1268,169 +1283,224 @@ func (m *Operation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Operation) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Operation.Merge(m, src)
+func (m *StreamLogsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamLogsRequest.Merge(m, src)
 }
-func (m *Operation) XXX_Size() int {
+func (m *StreamLogsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *Operation) XXX_DiscardUnknown() {
-	xxx_messageInfo_Operation.DiscardUnknown(m)
+func (m *StreamLogsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamLogsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Operation proto.InternalMessageInfo
+var xxx_messageInfo_StreamLogsRequest proto.InternalMessageInfo
 
-type isOperation_Body interface {
-	isOperation_Body()
-	MarshalTo([]byte) (int, error)
-	Size() int
+func (m *StreamLogsRequest) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
 }
 
-type Operation_Set_ struct {
-	Set *Operation_Set `protobuf:"bytes,1,opt,name=set,proto3,oneof" json:"set,omitempty"`
-}
-type Operation_Add_ struct {
-	Add *Operation_Add `protobuf:"bytes,2,opt,name=add,proto3,oneof" json:"add,omitempty"`
+func (m *StreamLogsRequest) GetLoggerPrefixes() []string {
+	if m != nil {
+		return m.LoggerPrefixes
+	}
+	return nil
 }
-type Operation_Move_ struct {
-	Move *Operation_Move `protobuf:"bytes,3,opt,name=move,proto3,oneof" json:"move,omitempty"`
+
+type StreamLogsResponse struct {
+	Time                 *types.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Level                string           `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	Logger               string           `protobuf:"bytes,3,opt,name=logger,proto3" json:"logger,omitempty"`
+	Message              string           `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Dropped              uint64           `protobuf:"varint,5,opt,name=dropped,proto3" json:"dropped,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
-type Operation_Remove_ struct {
-	Remove *Operation_Remove `protobuf:"bytes,4,opt,name=remove,proto3,oneof" json:"remove,omitempty"`
+
+func (m *StreamLogsResponse) Reset()         { *m = StreamLogsResponse{} }
+func (m *StreamLogsResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamLogsResponse) ProtoMessage()    {}
+func (*StreamLogsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{21}
 }
-type Operation_Edit_ struct {
-	Edit *Operation_Edit `protobuf:"bytes,5,opt,name=edit,proto3,oneof" json:"edit,omitempty"`
+func (m *StreamLogsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-type Operation_Select_ struct {
-	Select *Operation_Select `protobuf:"bytes,6,opt,name=select,proto3,oneof" json:"select,omitempty"`
+func (m *StreamLogsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamLogsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-type Operation_RichEdit_ struct {
-	RichEdit *Operation_RichEdit `protobuf:"bytes,7,opt,name=rich_edit,json=richEdit,proto3,oneof" json:"rich_edit,omitempty"`
+func (m *StreamLogsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamLogsResponse.Merge(m, src)
 }
-type Operation_Style_ struct {
-	Style *Operation_Style `protobuf:"bytes,8,opt,name=style,proto3,oneof" json:"style,omitempty"`
+func (m *StreamLogsResponse) XXX_Size() int {
+	return m.Size()
 }
-type Operation_Increase_ struct {
-	Increase *Operation_Increase `protobuf:"bytes,9,opt,name=increase,proto3,oneof" json:"increase,omitempty"`
+func (m *StreamLogsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamLogsResponse.DiscardUnknown(m)
 }
 
-func (*Operation_Set_) isOperation_Body()      {}
-func (*Operation_Add_) isOperation_Body()      {}
-func (*Operation_Move_) isOperation_Body()     {}
-func (*Operation_Remove_) isOperation_Body()   {}
-func (*Operation_Edit_) isOperation_Body()     {}
-func (*Operation_Select_) isOperation_Body()   {}
-func (*Operation_RichEdit_) isOperation_Body() {}
-func (*Operation_Style_) isOperation_Body()    {}
-func (*Operation_Increase_) isOperation_Body() {}
+var xxx_messageInfo_StreamLogsResponse proto.InternalMessageInfo
 
-func (m *Operation) GetBody() isOperation_Body {
+func (m *StreamLogsResponse) GetTime() *types.Timestamp {
 	if m != nil {
-		return m.Body
+		return m.Time
 	}
 	return nil
 }
 
-func (m *Operation) GetSet() *Operation_Set {
-	if x, ok := m.GetBody().(*Operation_Set_); ok {
-		return x.Set
+func (m *StreamLogsResponse) GetLevel() string {
+	if m != nil {
+		return m.Level
 	}
-	return nil
+	return ""
 }
 
-func (m *Operation) GetAdd() *Operation_Add {
-	if x, ok := m.GetBody().(*Operation_Add_); ok {
-		return x.Add
+func (m *StreamLogsResponse) GetLogger() string {
+	if m != nil {
+		return m.Logger
 	}
-	return nil
+	return ""
 }
 
-func (m *Operation) GetMove() *Operation_Move {
-	if x, ok := m.GetBody().(*Operation_Move_); ok {
-		return x.Move
+func (m *StreamLogsResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	return nil
+	return ""
 }
 
-func (m *Operation) GetRemove() *Operation_Remove {
-	if x, ok := m.GetBody().(*Operation_Remove_); ok {
-		return x.Remove
+func (m *StreamLogsResponse) GetDropped() uint64 {
+	if m != nil {
+		return m.Dropped
 	}
-	return nil
+	return 0
 }
 
-func (m *Operation) GetEdit() *Operation_Edit {
-	if x, ok := m.GetBody().(*Operation_Edit_); ok {
-		return x.Edit
-	}
-	return nil
+type QuarantineDocumentRequest struct {
+	DocumentKey          *DocumentKey `protobuf:"bytes,1,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *Operation) GetSelect() *Operation_Select {
-	if x, ok := m.GetBody().(*Operation_Select_); ok {
-		return x.Select
+func (m *QuarantineDocumentRequest) Reset()         { *m = QuarantineDocumentRequest{} }
+func (m *QuarantineDocumentRequest) String() string { return proto.CompactTextString(m) }
+func (*QuarantineDocumentRequest) ProtoMessage()    {}
+func (*QuarantineDocumentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{22}
+}
+func (m *QuarantineDocumentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuarantineDocumentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuarantineDocumentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *QuarantineDocumentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuarantineDocumentRequest.Merge(m, src)
+}
+func (m *QuarantineDocumentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuarantineDocumentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuarantineDocumentRequest.DiscardUnknown(m)
 }
 
-func (m *Operation) GetRichEdit() *Operation_RichEdit {
-	if x, ok := m.GetBody().(*Operation_RichEdit_); ok {
-		return x.RichEdit
+var xxx_messageInfo_QuarantineDocumentRequest proto.InternalMessageInfo
+
+func (m *QuarantineDocumentRequest) GetDocumentKey() *DocumentKey {
+	if m != nil {
+		return m.DocumentKey
 	}
 	return nil
 }
 
-func (m *Operation) GetStyle() *Operation_Style {
-	if x, ok := m.GetBody().(*Operation_Style_); ok {
-		return x.Style
-	}
-	return nil
+type QuarantineDocumentResponse struct {
+	Quarantined          bool     `protobuf:"varint,1,opt,name=quarantined,proto3" json:"quarantined,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Operation) GetIncrease() *Operation_Increase {
-	if x, ok := m.GetBody().(*Operation_Increase_); ok {
-		return x.Increase
+func (m *QuarantineDocumentResponse) Reset()         { *m = QuarantineDocumentResponse{} }
+func (m *QuarantineDocumentResponse) String() string { return proto.CompactTextString(m) }
+func (*QuarantineDocumentResponse) ProtoMessage()    {}
+func (*QuarantineDocumentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{23}
+}
+func (m *QuarantineDocumentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuarantineDocumentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuarantineDocumentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *QuarantineDocumentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuarantineDocumentResponse.Merge(m, src)
+}
+func (m *QuarantineDocumentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuarantineDocumentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuarantineDocumentResponse.DiscardUnknown(m)
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*Operation) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*Operation_Set_)(nil),
-		(*Operation_Add_)(nil),
-		(*Operation_Move_)(nil),
-		(*Operation_Remove_)(nil),
-		(*Operation_Edit_)(nil),
-		(*Operation_Select_)(nil),
-		(*Operation_RichEdit_)(nil),
-		(*Operation_Style_)(nil),
-		(*Operation_Increase_)(nil),
+var xxx_messageInfo_QuarantineDocumentResponse proto.InternalMessageInfo
+
+func (m *QuarantineDocumentResponse) GetQuarantined() bool {
+	if m != nil {
+		return m.Quarantined
 	}
+	return false
 }
 
-type Operation_Set struct {
-	ParentCreatedAt      *TimeTicket        `protobuf:"bytes,1,opt,name=parent_created_at,json=parentCreatedAt,proto3" json:"parent_created_at,omitempty"`
-	Key                  string             `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	Value                *JSONElementSimple `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
-	ExecutedAt           *TimeTicket        `protobuf:"bytes,4,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+type ReleaseDocumentRequest struct {
+	DocumentKey          *DocumentKey `protobuf:"bytes,1,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *Operation_Set) Reset()         { *m = Operation_Set{} }
-func (m *Operation_Set) String() string { return proto.CompactTextString(m) }
-func (*Operation_Set) ProtoMessage()    {}
-func (*Operation_Set) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{19, 0}
+func (m *ReleaseDocumentRequest) Reset()         { *m = ReleaseDocumentRequest{} }
+func (m *ReleaseDocumentRequest) String() string { return proto.CompactTextString(m) }
+func (*ReleaseDocumentRequest) ProtoMessage()    {}
+func (*ReleaseDocumentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{24}
 }
-func (m *Operation_Set) XXX_Unmarshal(b []byte) error {
+func (m *ReleaseDocumentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Operation_Set) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ReleaseDocumentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Operation_Set.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ReleaseDocumentRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1440,68 +1510,96 @@ func (m *Operation_Set) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *Operation_Set) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Operation_Set.Merge(m, src)
+func (m *ReleaseDocumentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseDocumentRequest.Merge(m, src)
 }
-func (m *Operation_Set) XXX_Size() int {
+func (m *ReleaseDocumentRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *Operation_Set) XXX_DiscardUnknown() {
-	xxx_messageInfo_Operation_Set.DiscardUnknown(m)
+func (m *ReleaseDocumentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseDocumentRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Operation_Set proto.InternalMessageInfo
+var xxx_messageInfo_ReleaseDocumentRequest proto.InternalMessageInfo
 
-func (m *Operation_Set) GetParentCreatedAt() *TimeTicket {
+func (m *ReleaseDocumentRequest) GetDocumentKey() *DocumentKey {
 	if m != nil {
-		return m.ParentCreatedAt
+		return m.DocumentKey
 	}
 	return nil
 }
 
-func (m *Operation_Set) GetKey() string {
-	if m != nil {
-		return m.Key
-	}
-	return ""
+type ReleaseDocumentResponse struct {
+	Released             bool     `protobuf:"varint,1,opt,name=released,proto3" json:"released,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Operation_Set) GetValue() *JSONElementSimple {
-	if m != nil {
-		return m.Value
+func (m *ReleaseDocumentResponse) Reset()         { *m = ReleaseDocumentResponse{} }
+func (m *ReleaseDocumentResponse) String() string { return proto.CompactTextString(m) }
+func (*ReleaseDocumentResponse) ProtoMessage()    {}
+func (*ReleaseDocumentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{25}
+}
+func (m *ReleaseDocumentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseDocumentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReleaseDocumentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *ReleaseDocumentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseDocumentResponse.Merge(m, src)
+}
+func (m *ReleaseDocumentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleaseDocumentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseDocumentResponse.DiscardUnknown(m)
 }
 
-func (m *Operation_Set) GetExecutedAt() *TimeTicket {
+var xxx_messageInfo_ReleaseDocumentResponse proto.InternalMessageInfo
+
+func (m *ReleaseDocumentResponse) GetReleased() bool {
 	if m != nil {
-		return m.ExecutedAt
+		return m.Released
 	}
-	return nil
+	return false
 }
 
-type Operation_Add struct {
-	ParentCreatedAt      *TimeTicket        `protobuf:"bytes,1,opt,name=parent_created_at,json=parentCreatedAt,proto3" json:"parent_created_at,omitempty"`
-	PrevCreatedAt        *TimeTicket        `protobuf:"bytes,2,opt,name=prev_created_at,json=prevCreatedAt,proto3" json:"prev_created_at,omitempty"`
-	Value                *JSONElementSimple `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
-	ExecutedAt           *TimeTicket        `protobuf:"bytes,4,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+type DocumentSettings struct {
+	SnapshotThreshold        uint64   `protobuf:"varint,1,opt,name=snapshot_threshold,json=snapshotThreshold,proto3" json:"snapshot_threshold,omitempty"`
+	SnapshotInterval         uint64   `protobuf:"varint,2,opt,name=snapshot_interval,json=snapshotInterval,proto3" json:"snapshot_interval,omitempty"`
+	MaxChangesPerPull        uint64   `protobuf:"varint,3,opt,name=max_changes_per_pull,json=maxChangesPerPull,proto3" json:"max_changes_per_pull,omitempty"`
+	DisableGarbageCollection bool     `protobuf:"varint,4,opt,name=disable_garbage_collection,json=disableGarbageCollection,proto3" json:"disable_garbage_collection,omitempty"`
+	PreferredRegion          string   `protobuf:"bytes,5,opt,name=preferred_region,json=preferredRegion,proto3" json:"preferred_region,omitempty"`
+	RequireSignedChanges     bool     `protobuf:"varint,6,opt,name=require_signed_changes,json=requireSignedChanges,proto3" json:"require_signed_changes,omitempty"`
+	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
+	XXX_unrecognized         []byte   `json:"-"`
+	XXX_sizecache            int32    `json:"-"`
 }
 
-func (m *Operation_Add) Reset()         { *m = Operation_Add{} }
-func (m *Operation_Add) String() string { return proto.CompactTextString(m) }
-func (*Operation_Add) ProtoMessage()    {}
-func (*Operation_Add) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{19, 1}
+func (m *DocumentSettings) Reset()         { *m = DocumentSettings{} }
+func (m *DocumentSettings) String() string { return proto.CompactTextString(m) }
+func (*DocumentSettings) ProtoMessage()    {}
+func (*DocumentSettings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{26}
 }
-func (m *Operation_Add) XXX_Unmarshal(b []byte) error {
+func (m *DocumentSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Operation_Add) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DocumentSettings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Operation_Add.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DocumentSettings.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1511,68 +1609,81 @@ func (m *Operation_Add) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *Operation_Add) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Operation_Add.Merge(m, src)
+func (m *DocumentSettings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DocumentSettings.Merge(m, src)
 }
-func (m *Operation_Add) XXX_Size() int {
+func (m *DocumentSettings) XXX_Size() int {
 	return m.Size()
 }
-func (m *Operation_Add) XXX_DiscardUnknown() {
-	xxx_messageInfo_Operation_Add.DiscardUnknown(m)
+func (m *DocumentSettings) XXX_DiscardUnknown() {
+	xxx_messageInfo_DocumentSettings.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Operation_Add proto.InternalMessageInfo
+var xxx_messageInfo_DocumentSettings proto.InternalMessageInfo
 
-func (m *Operation_Add) GetParentCreatedAt() *TimeTicket {
+func (m *DocumentSettings) GetSnapshotThreshold() uint64 {
 	if m != nil {
-		return m.ParentCreatedAt
+		return m.SnapshotThreshold
 	}
-	return nil
+	return 0
 }
 
-func (m *Operation_Add) GetPrevCreatedAt() *TimeTicket {
+func (m *DocumentSettings) GetSnapshotInterval() uint64 {
 	if m != nil {
-		return m.PrevCreatedAt
+		return m.SnapshotInterval
 	}
-	return nil
+	return 0
 }
 
-func (m *Operation_Add) GetValue() *JSONElementSimple {
+func (m *DocumentSettings) GetMaxChangesPerPull() uint64 {
 	if m != nil {
-		return m.Value
+		return m.MaxChangesPerPull
 	}
-	return nil
+	return 0
 }
 
-func (m *Operation_Add) GetExecutedAt() *TimeTicket {
+func (m *DocumentSettings) GetDisableGarbageCollection() bool {
 	if m != nil {
-		return m.ExecutedAt
+		return m.DisableGarbageCollection
 	}
-	return nil
+	return false
 }
 
-type Operation_Move struct {
-	ParentCreatedAt      *TimeTicket `protobuf:"bytes,1,opt,name=parent_created_at,json=parentCreatedAt,proto3" json:"parent_created_at,omitempty"`
-	PrevCreatedAt        *TimeTicket `protobuf:"bytes,2,opt,name=prev_created_at,json=prevCreatedAt,proto3" json:"prev_created_at,omitempty"`
-	CreatedAt            *TimeTicket `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	ExecutedAt           *TimeTicket `protobuf:"bytes,4,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+func (m *DocumentSettings) GetPreferredRegion() string {
+	if m != nil {
+		return m.PreferredRegion
+	}
+	return ""
 }
 
-func (m *Operation_Move) Reset()         { *m = Operation_Move{} }
-func (m *Operation_Move) String() string { return proto.CompactTextString(m) }
-func (*Operation_Move) ProtoMessage()    {}
-func (*Operation_Move) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{19, 2}
+func (m *DocumentSettings) GetRequireSignedChanges() bool {
+	if m != nil {
+		return m.RequireSignedChanges
+	}
+	return false
 }
-func (m *Operation_Move) XXX_Unmarshal(b []byte) error {
+
+type UpdateDocumentSettingsRequest struct {
+	DocumentKey          *DocumentKey      `protobuf:"bytes,1,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
+	Settings             *DocumentSettings `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+	UpdateMask           *types.FieldMask  `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *UpdateDocumentSettingsRequest) Reset()         { *m = UpdateDocumentSettingsRequest{} }
+func (m *UpdateDocumentSettingsRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateDocumentSettingsRequest) ProtoMessage()    {}
+func (*UpdateDocumentSettingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{27}
+}
+func (m *UpdateDocumentSettingsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Operation_Move) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateDocumentSettingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Operation_Move.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateDocumentSettingsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1582,67 +1693,58 @@ func (m *Operation_Move) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *Operation_Move) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Operation_Move.Merge(m, src)
+func (m *UpdateDocumentSettingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateDocumentSettingsRequest.Merge(m, src)
 }
-func (m *Operation_Move) XXX_Size() int {
+func (m *UpdateDocumentSettingsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *Operation_Move) XXX_DiscardUnknown() {
-	xxx_messageInfo_Operation_Move.DiscardUnknown(m)
+func (m *UpdateDocumentSettingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateDocumentSettingsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Operation_Move proto.InternalMessageInfo
-
-func (m *Operation_Move) GetParentCreatedAt() *TimeTicket {
-	if m != nil {
-		return m.ParentCreatedAt
-	}
-	return nil
-}
+var xxx_messageInfo_UpdateDocumentSettingsRequest proto.InternalMessageInfo
 
-func (m *Operation_Move) GetPrevCreatedAt() *TimeTicket {
+func (m *UpdateDocumentSettingsRequest) GetDocumentKey() *DocumentKey {
 	if m != nil {
-		return m.PrevCreatedAt
+		return m.DocumentKey
 	}
 	return nil
 }
 
-func (m *Operation_Move) GetCreatedAt() *TimeTicket {
+func (m *UpdateDocumentSettingsRequest) GetSettings() *DocumentSettings {
 	if m != nil {
-		return m.CreatedAt
+		return m.Settings
 	}
 	return nil
 }
 
-func (m *Operation_Move) GetExecutedAt() *TimeTicket {
+func (m *UpdateDocumentSettingsRequest) GetUpdateMask() *types.FieldMask {
 	if m != nil {
-		return m.ExecutedAt
+		return m.UpdateMask
 	}
 	return nil
 }
 
-type Operation_Remove struct {
-	ParentCreatedAt      *TimeTicket `protobuf:"bytes,1,opt,name=parent_created_at,json=parentCreatedAt,proto3" json:"parent_created_at,omitempty"`
-	CreatedAt            *TimeTicket `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	ExecutedAt           *TimeTicket `protobuf:"bytes,3,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+type UpdateDocumentSettingsResponse struct {
+	Settings             *DocumentSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *Operation_Remove) Reset()         { *m = Operation_Remove{} }
-func (m *Operation_Remove) String() string { return proto.CompactTextString(m) }
-func (*Operation_Remove) ProtoMessage()    {}
-func (*Operation_Remove) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{19, 3}
+func (m *UpdateDocumentSettingsResponse) Reset()         { *m = UpdateDocumentSettingsResponse{} }
+func (m *UpdateDocumentSettingsResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateDocumentSettingsResponse) ProtoMessage()    {}
+func (*UpdateDocumentSettingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{28}
 }
-func (m *Operation_Remove) XXX_Unmarshal(b []byte) error {
+func (m *UpdateDocumentSettingsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Operation_Remove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateDocumentSettingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Operation_Remove.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateDocumentSettingsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1652,63 +1754,44 @@ func (m *Operation_Remove) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *Operation_Remove) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Operation_Remove.Merge(m, src)
+func (m *UpdateDocumentSettingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateDocumentSettingsResponse.Merge(m, src)
 }
-func (m *Operation_Remove) XXX_Size() int {
+func (m *UpdateDocumentSettingsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *Operation_Remove) XXX_DiscardUnknown() {
-	xxx_messageInfo_Operation_Remove.DiscardUnknown(m)
+func (m *UpdateDocumentSettingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateDocumentSettingsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Operation_Remove proto.InternalMessageInfo
+var xxx_messageInfo_UpdateDocumentSettingsResponse proto.InternalMessageInfo
 
-func (m *Operation_Remove) GetParentCreatedAt() *TimeTicket {
+func (m *UpdateDocumentSettingsResponse) GetSettings() *DocumentSettings {
 	if m != nil {
-		return m.ParentCreatedAt
+		return m.Settings
 	}
 	return nil
 }
 
-func (m *Operation_Remove) GetCreatedAt() *TimeTicket {
-	if m != nil {
-		return m.CreatedAt
-	}
-	return nil
+type ExportDocumentHistoryRequest struct {
+	DocumentKey          *DocumentKey `protobuf:"bytes,1,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *Operation_Remove) GetExecutedAt() *TimeTicket {
-	if m != nil {
-		return m.ExecutedAt
-	}
-	return nil
+func (m *ExportDocumentHistoryRequest) Reset()         { *m = ExportDocumentHistoryRequest{} }
+func (m *ExportDocumentHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportDocumentHistoryRequest) ProtoMessage()    {}
+func (*ExportDocumentHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{29}
 }
-
-type Operation_Edit struct {
-	ParentCreatedAt      *TimeTicket            `protobuf:"bytes,1,opt,name=parent_created_at,json=parentCreatedAt,proto3" json:"parent_created_at,omitempty"`
-	From                 *TextNodePos           `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
-	To                   *TextNodePos           `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
-	CreatedAtMapByActor  map[string]*TimeTicket `protobuf:"bytes,4,rep,name=created_at_map_by_actor,json=createdAtMapByActor,proto3" json:"created_at_map_by_actor,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Content              string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
-	ExecutedAt           *TimeTicket            `protobuf:"bytes,6,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
-}
-
-func (m *Operation_Edit) Reset()         { *m = Operation_Edit{} }
-func (m *Operation_Edit) String() string { return proto.CompactTextString(m) }
-func (*Operation_Edit) ProtoMessage()    {}
-func (*Operation_Edit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{19, 4}
-}
-func (m *Operation_Edit) XXX_Unmarshal(b []byte) error {
+func (m *ExportDocumentHistoryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Operation_Edit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ExportDocumentHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Operation_Edit.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ExportDocumentHistoryRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1718,82 +1801,93 @@ func (m *Operation_Edit) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *Operation_Edit) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Operation_Edit.Merge(m, src)
+func (m *ExportDocumentHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportDocumentHistoryRequest.Merge(m, src)
 }
-func (m *Operation_Edit) XXX_Size() int {
+func (m *ExportDocumentHistoryRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *Operation_Edit) XXX_DiscardUnknown() {
-	xxx_messageInfo_Operation_Edit.DiscardUnknown(m)
+func (m *ExportDocumentHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportDocumentHistoryRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Operation_Edit proto.InternalMessageInfo
+var xxx_messageInfo_ExportDocumentHistoryRequest proto.InternalMessageInfo
 
-func (m *Operation_Edit) GetParentCreatedAt() *TimeTicket {
+func (m *ExportDocumentHistoryRequest) GetDocumentKey() *DocumentKey {
 	if m != nil {
-		return m.ParentCreatedAt
+		return m.DocumentKey
 	}
 	return nil
 }
 
-func (m *Operation_Edit) GetFrom() *TextNodePos {
-	if m != nil {
-		return m.From
-	}
-	return nil
+type ExportDocumentHistoryResponse struct {
+	Chunk                []byte   `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Operation_Edit) GetTo() *TextNodePos {
-	if m != nil {
-		return m.To
-	}
-	return nil
+func (m *ExportDocumentHistoryResponse) Reset()         { *m = ExportDocumentHistoryResponse{} }
+func (m *ExportDocumentHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportDocumentHistoryResponse) ProtoMessage()    {}
+func (*ExportDocumentHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{30}
 }
-
-func (m *Operation_Edit) GetCreatedAtMapByActor() map[string]*TimeTicket {
-	if m != nil {
-		return m.CreatedAtMapByActor
-	}
-	return nil
+func (m *ExportDocumentHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Operation_Edit) GetContent() string {
-	if m != nil {
-		return m.Content
+func (m *ExportDocumentHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportDocumentHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *ExportDocumentHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportDocumentHistoryResponse.Merge(m, src)
+}
+func (m *ExportDocumentHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportDocumentHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportDocumentHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *Operation_Edit) GetExecutedAt() *TimeTicket {
+var xxx_messageInfo_ExportDocumentHistoryResponse proto.InternalMessageInfo
+
+func (m *ExportDocumentHistoryResponse) GetChunk() []byte {
 	if m != nil {
-		return m.ExecutedAt
+		return m.Chunk
 	}
 	return nil
 }
 
-type Operation_Select struct {
-	ParentCreatedAt      *TimeTicket  `protobuf:"bytes,1,opt,name=parent_created_at,json=parentCreatedAt,proto3" json:"parent_created_at,omitempty"`
-	From                 *TextNodePos `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
-	To                   *TextNodePos `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
-	ExecutedAt           *TimeTicket  `protobuf:"bytes,4,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+type CheckAccessRequest struct {
+	Token                string                          `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Method               string                          `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Attributes           []*CheckAccessRequest_Attribute `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
 }
 
-func (m *Operation_Select) Reset()         { *m = Operation_Select{} }
-func (m *Operation_Select) String() string { return proto.CompactTextString(m) }
-func (*Operation_Select) ProtoMessage()    {}
-func (*Operation_Select) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{19, 5}
+func (m *CheckAccessRequest) Reset()         { *m = CheckAccessRequest{} }
+func (m *CheckAccessRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckAccessRequest) ProtoMessage()    {}
+func (*CheckAccessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{31}
 }
-func (m *Operation_Select) XXX_Unmarshal(b []byte) error {
+func (m *CheckAccessRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Operation_Select) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CheckAccessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Operation_Select.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CheckAccessRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1803,71 +1897,59 @@ func (m *Operation_Select) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *Operation_Select) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Operation_Select.Merge(m, src)
+func (m *CheckAccessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckAccessRequest.Merge(m, src)
 }
-func (m *Operation_Select) XXX_Size() int {
+func (m *CheckAccessRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *Operation_Select) XXX_DiscardUnknown() {
-	xxx_messageInfo_Operation_Select.DiscardUnknown(m)
+func (m *CheckAccessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckAccessRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Operation_Select proto.InternalMessageInfo
-
-func (m *Operation_Select) GetParentCreatedAt() *TimeTicket {
-	if m != nil {
-		return m.ParentCreatedAt
-	}
-	return nil
-}
+var xxx_messageInfo_CheckAccessRequest proto.InternalMessageInfo
 
-func (m *Operation_Select) GetFrom() *TextNodePos {
+func (m *CheckAccessRequest) GetToken() string {
 	if m != nil {
-		return m.From
+		return m.Token
 	}
-	return nil
+	return ""
 }
 
-func (m *Operation_Select) GetTo() *TextNodePos {
+func (m *CheckAccessRequest) GetMethod() string {
 	if m != nil {
-		return m.To
+		return m.Method
 	}
-	return nil
+	return ""
 }
 
-func (m *Operation_Select) GetExecutedAt() *TimeTicket {
+func (m *CheckAccessRequest) GetAttributes() []*CheckAccessRequest_Attribute {
 	if m != nil {
-		return m.ExecutedAt
+		return m.Attributes
 	}
 	return nil
 }
 
-type Operation_RichEdit struct {
-	ParentCreatedAt      *TimeTicket            `protobuf:"bytes,1,opt,name=parent_created_at,json=parentCreatedAt,proto3" json:"parent_created_at,omitempty"`
-	From                 *TextNodePos           `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
-	To                   *TextNodePos           `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
-	CreatedAtMapByActor  map[string]*TimeTicket `protobuf:"bytes,4,rep,name=created_at_map_by_actor,json=createdAtMapByActor,proto3" json:"created_at_map_by_actor,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Content              string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
-	Attributes           map[string]string      `protobuf:"bytes,6,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	ExecutedAt           *TimeTicket            `protobuf:"bytes,7,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+type CheckAccessRequest_Attribute struct {
+	DocumentKey          *DocumentKey `protobuf:"bytes,1,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
+	Verb                 string       `protobuf:"bytes,2,opt,name=verb,proto3" json:"verb,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *Operation_RichEdit) Reset()         { *m = Operation_RichEdit{} }
-func (m *Operation_RichEdit) String() string { return proto.CompactTextString(m) }
-func (*Operation_RichEdit) ProtoMessage()    {}
-func (*Operation_RichEdit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{19, 6}
+func (m *CheckAccessRequest_Attribute) Reset()         { *m = CheckAccessRequest_Attribute{} }
+func (m *CheckAccessRequest_Attribute) String() string { return proto.CompactTextString(m) }
+func (*CheckAccessRequest_Attribute) ProtoMessage()    {}
+func (*CheckAccessRequest_Attribute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{31, 0}
 }
-func (m *Operation_RichEdit) XXX_Unmarshal(b []byte) error {
+func (m *CheckAccessRequest_Attribute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Operation_RichEdit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CheckAccessRequest_Attribute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Operation_RichEdit.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CheckAccessRequest_Attribute.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1877,90 +1959,108 @@ func (m *Operation_RichEdit) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *Operation_RichEdit) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Operation_RichEdit.Merge(m, src)
+func (m *CheckAccessRequest_Attribute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckAccessRequest_Attribute.Merge(m, src)
 }
-func (m *Operation_RichEdit) XXX_Size() int {
+func (m *CheckAccessRequest_Attribute) XXX_Size() int {
 	return m.Size()
 }
-func (m *Operation_RichEdit) XXX_DiscardUnknown() {
-	xxx_messageInfo_Operation_RichEdit.DiscardUnknown(m)
+func (m *CheckAccessRequest_Attribute) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckAccessRequest_Attribute.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Operation_RichEdit proto.InternalMessageInfo
+var xxx_messageInfo_CheckAccessRequest_Attribute proto.InternalMessageInfo
 
-func (m *Operation_RichEdit) GetParentCreatedAt() *TimeTicket {
+func (m *CheckAccessRequest_Attribute) GetDocumentKey() *DocumentKey {
 	if m != nil {
-		return m.ParentCreatedAt
+		return m.DocumentKey
 	}
 	return nil
 }
 
-func (m *Operation_RichEdit) GetFrom() *TextNodePos {
+func (m *CheckAccessRequest_Attribute) GetVerb() string {
 	if m != nil {
-		return m.From
+		return m.Verb
 	}
-	return nil
+	return ""
 }
 
-func (m *Operation_RichEdit) GetTo() *TextNodePos {
-	if m != nil {
-		return m.To
-	}
-	return nil
+type CheckAccessResponse struct {
+	Allowed              bool     `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Operation_RichEdit) GetCreatedAtMapByActor() map[string]*TimeTicket {
-	if m != nil {
-		return m.CreatedAtMapByActor
-	}
-	return nil
+func (m *CheckAccessResponse) Reset()         { *m = CheckAccessResponse{} }
+func (m *CheckAccessResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckAccessResponse) ProtoMessage()    {}
+func (*CheckAccessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{32}
 }
-
-func (m *Operation_RichEdit) GetContent() string {
-	if m != nil {
-		return m.Content
+func (m *CheckAccessResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckAccessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckAccessResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *CheckAccessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckAccessResponse.Merge(m, src)
+}
+func (m *CheckAccessResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckAccessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckAccessResponse.DiscardUnknown(m)
 }
 
-func (m *Operation_RichEdit) GetAttributes() map[string]string {
+var xxx_messageInfo_CheckAccessResponse proto.InternalMessageInfo
+
+func (m *CheckAccessResponse) GetAllowed() bool {
 	if m != nil {
-		return m.Attributes
+		return m.Allowed
 	}
-	return nil
+	return false
 }
 
-func (m *Operation_RichEdit) GetExecutedAt() *TimeTicket {
+func (m *CheckAccessResponse) GetReason() string {
 	if m != nil {
-		return m.ExecutedAt
+		return m.Reason
 	}
-	return nil
+	return ""
 }
 
-type Operation_Style struct {
-	ParentCreatedAt      *TimeTicket       `protobuf:"bytes,1,opt,name=parent_created_at,json=parentCreatedAt,proto3" json:"parent_created_at,omitempty"`
-	From                 *TextNodePos      `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
-	To                   *TextNodePos      `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
-	Attributes           map[string]string `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	ExecutedAt           *TimeTicket       `protobuf:"bytes,5,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
+type ActivateClientRequest struct {
+	ClientKey            string            `protobuf:"bytes,1,opt,name=client_key,json=clientKey,proto3" json:"client_key,omitempty"`
+	Metadata             map[string]string `protobuf:"bytes,2,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	PublicKey            []byte            `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *Operation_Style) Reset()         { *m = Operation_Style{} }
-func (m *Operation_Style) String() string { return proto.CompactTextString(m) }
-func (*Operation_Style) ProtoMessage()    {}
-func (*Operation_Style) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{19, 7}
+func (m *ActivateClientRequest) Reset()         { *m = ActivateClientRequest{} }
+func (m *ActivateClientRequest) String() string { return proto.CompactTextString(m) }
+func (*ActivateClientRequest) ProtoMessage()    {}
+func (*ActivateClientRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{33}
 }
-func (m *Operation_Style) XXX_Unmarshal(b []byte) error {
+func (m *ActivateClientRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Operation_Style) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ActivateClientRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Operation_Style.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ActivateClientRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1970,74 +2070,59 @@ func (m *Operation_Style) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *Operation_Style) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Operation_Style.Merge(m, src)
+func (m *ActivateClientRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActivateClientRequest.Merge(m, src)
 }
-func (m *Operation_Style) XXX_Size() int {
+func (m *ActivateClientRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *Operation_Style) XXX_DiscardUnknown() {
-	xxx_messageInfo_Operation_Style.DiscardUnknown(m)
+func (m *ActivateClientRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActivateClientRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Operation_Style proto.InternalMessageInfo
+var xxx_messageInfo_ActivateClientRequest proto.InternalMessageInfo
 
-func (m *Operation_Style) GetParentCreatedAt() *TimeTicket {
+func (m *ActivateClientRequest) GetClientKey() string {
 	if m != nil {
-		return m.ParentCreatedAt
+		return m.ClientKey
 	}
-	return nil
+	return ""
 }
 
-func (m *Operation_Style) GetFrom() *TextNodePos {
+func (m *ActivateClientRequest) GetMetadata() map[string]string {
 	if m != nil {
-		return m.From
+		return m.Metadata
 	}
 	return nil
 }
 
-func (m *Operation_Style) GetTo() *TextNodePos {
+func (m *ActivateClientRequest) GetPublicKey() []byte {
 	if m != nil {
-		return m.To
+		return m.PublicKey
 	}
 	return nil
 }
 
-func (m *Operation_Style) GetAttributes() map[string]string {
-	if m != nil {
-		return m.Attributes
-	}
-	return nil
+type ActivateClientResponse struct {
+	ClientKey            string   `protobuf:"bytes,1,opt,name=client_key,json=clientKey,proto3" json:"client_key,omitempty"`
+	ClientId             []byte   `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Operation_Style) GetExecutedAt() *TimeTicket {
-	if m != nil {
-		return m.ExecutedAt
-	}
-	return nil
+func (m *ActivateClientResponse) Reset()         { *m = ActivateClientResponse{} }
+func (m *ActivateClientResponse) String() string { return proto.CompactTextString(m) }
+func (*ActivateClientResponse) ProtoMessage()    {}
+func (*ActivateClientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{34}
 }
-
-type Operation_Increase struct {
-	ParentCreatedAt      *TimeTicket        `protobuf:"bytes,1,opt,name=parent_created_at,json=parentCreatedAt,proto3" json:"parent_created_at,omitempty"`
-	Value                *JSONElementSimple `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	ExecutedAt           *TimeTicket        `protobuf:"bytes,3,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
-}
-
-func (m *Operation_Increase) Reset()         { *m = Operation_Increase{} }
-func (m *Operation_Increase) String() string { return proto.CompactTextString(m) }
-func (*Operation_Increase) ProtoMessage()    {}
-func (*Operation_Increase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{19, 8}
-}
-func (m *Operation_Increase) XXX_Unmarshal(b []byte) error {
+func (m *ActivateClientResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Operation_Increase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ActivateClientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Operation_Increase.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ActivateClientResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2047,62 +2132,51 @@ func (m *Operation_Increase) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *Operation_Increase) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Operation_Increase.Merge(m, src)
+func (m *ActivateClientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActivateClientResponse.Merge(m, src)
 }
-func (m *Operation_Increase) XXX_Size() int {
+func (m *ActivateClientResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *Operation_Increase) XXX_DiscardUnknown() {
-	xxx_messageInfo_Operation_Increase.DiscardUnknown(m)
+func (m *ActivateClientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActivateClientResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Operation_Increase proto.InternalMessageInfo
-
-func (m *Operation_Increase) GetParentCreatedAt() *TimeTicket {
-	if m != nil {
-		return m.ParentCreatedAt
-	}
-	return nil
-}
+var xxx_messageInfo_ActivateClientResponse proto.InternalMessageInfo
 
-func (m *Operation_Increase) GetValue() *JSONElementSimple {
+func (m *ActivateClientResponse) GetClientKey() string {
 	if m != nil {
-		return m.Value
+		return m.ClientKey
 	}
-	return nil
+	return ""
 }
 
-func (m *Operation_Increase) GetExecutedAt() *TimeTicket {
+func (m *ActivateClientResponse) GetClientId() []byte {
 	if m != nil {
-		return m.ExecutedAt
+		return m.ClientId
 	}
 	return nil
 }
 
-type JSONElementSimple struct {
-	CreatedAt            *TimeTicket `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	MovedAt              *TimeTicket `protobuf:"bytes,2,opt,name=moved_at,json=movedAt,proto3" json:"moved_at,omitempty"`
-	RemovedAt            *TimeTicket `protobuf:"bytes,3,opt,name=removed_at,json=removedAt,proto3" json:"removed_at,omitempty"`
-	Type                 ValueType   `protobuf:"varint,4,opt,name=type,proto3,enum=api.ValueType" json:"type,omitempty"`
-	Value                []byte      `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+type DeactivateClientRequest struct {
+	ClientId             []byte   `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *JSONElementSimple) Reset()         { *m = JSONElementSimple{} }
-func (m *JSONElementSimple) String() string { return proto.CompactTextString(m) }
-func (*JSONElementSimple) ProtoMessage()    {}
-func (*JSONElementSimple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{20}
+func (m *DeactivateClientRequest) Reset()         { *m = DeactivateClientRequest{} }
+func (m *DeactivateClientRequest) String() string { return proto.CompactTextString(m) }
+func (*DeactivateClientRequest) ProtoMessage()    {}
+func (*DeactivateClientRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{35}
 }
-func (m *JSONElementSimple) XXX_Unmarshal(b []byte) error {
+func (m *DeactivateClientRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JSONElementSimple) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DeactivateClientRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JSONElementSimple.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DeactivateClientRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2112,79 +2186,92 @@ func (m *JSONElementSimple) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *JSONElementSimple) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JSONElementSimple.Merge(m, src)
+func (m *DeactivateClientRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeactivateClientRequest.Merge(m, src)
 }
-func (m *JSONElementSimple) XXX_Size() int {
+func (m *DeactivateClientRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *JSONElementSimple) XXX_DiscardUnknown() {
-	xxx_messageInfo_JSONElementSimple.DiscardUnknown(m)
+func (m *DeactivateClientRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeactivateClientRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JSONElementSimple proto.InternalMessageInfo
+var xxx_messageInfo_DeactivateClientRequest proto.InternalMessageInfo
 
-func (m *JSONElementSimple) GetCreatedAt() *TimeTicket {
+func (m *DeactivateClientRequest) GetClientId() []byte {
 	if m != nil {
-		return m.CreatedAt
+		return m.ClientId
 	}
 	return nil
 }
 
-func (m *JSONElementSimple) GetMovedAt() *TimeTicket {
-	if m != nil {
-		return m.MovedAt
-	}
-	return nil
+type DeactivateClientResponse struct {
+	ClientId             []byte   `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *JSONElementSimple) GetRemovedAt() *TimeTicket {
-	if m != nil {
-		return m.RemovedAt
-	}
-	return nil
+func (m *DeactivateClientResponse) Reset()         { *m = DeactivateClientResponse{} }
+func (m *DeactivateClientResponse) String() string { return proto.CompactTextString(m) }
+func (*DeactivateClientResponse) ProtoMessage()    {}
+func (*DeactivateClientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{36}
 }
-
-func (m *JSONElementSimple) GetType() ValueType {
-	if m != nil {
-		return m.Type
+func (m *DeactivateClientResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeactivateClientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeactivateClientResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ValueType_NULL
+}
+func (m *DeactivateClientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeactivateClientResponse.Merge(m, src)
+}
+func (m *DeactivateClientResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeactivateClientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeactivateClientResponse.DiscardUnknown(m)
 }
 
-func (m *JSONElementSimple) GetValue() []byte {
+var xxx_messageInfo_DeactivateClientResponse proto.InternalMessageInfo
+
+func (m *DeactivateClientResponse) GetClientId() []byte {
 	if m != nil {
-		return m.Value
+		return m.ClientId
 	}
 	return nil
 }
 
-type JSONElement struct {
-	// Types that are valid to be assigned to Body:
-	//	*JSONElement_JsonObject
-	//	*JSONElement_JsonArray
-	//	*JSONElement_Primitive_
-	//	*JSONElement_Text_
-	//	*JSONElement_RichText_
-	//	*JSONElement_Counter_
-	Body                 isJSONElement_Body `protobuf_oneof:"Body"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+type AttachDocumentRequest struct {
+	ClientId             []byte      `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ChangePack           *ChangePack `protobuf:"bytes,2,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *JSONElement) Reset()         { *m = JSONElement{} }
-func (m *JSONElement) String() string { return proto.CompactTextString(m) }
-func (*JSONElement) ProtoMessage()    {}
-func (*JSONElement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{21}
+func (m *AttachDocumentRequest) Reset()         { *m = AttachDocumentRequest{} }
+func (m *AttachDocumentRequest) String() string { return proto.CompactTextString(m) }
+func (*AttachDocumentRequest) ProtoMessage()    {}
+func (*AttachDocumentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{37}
 }
-func (m *JSONElement) XXX_Unmarshal(b []byte) error {
+func (m *AttachDocumentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JSONElement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AttachDocumentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JSONElement.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AttachDocumentRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2194,133 +2281,52 @@ func (m *JSONElement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *JSONElement) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JSONElement.Merge(m, src)
+func (m *AttachDocumentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttachDocumentRequest.Merge(m, src)
 }
-func (m *JSONElement) XXX_Size() int {
+func (m *AttachDocumentRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *JSONElement) XXX_DiscardUnknown() {
-	xxx_messageInfo_JSONElement.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_JSONElement proto.InternalMessageInfo
-
-type isJSONElement_Body interface {
-	isJSONElement_Body()
-	MarshalTo([]byte) (int, error)
-	Size() int
-}
-
-type JSONElement_JsonObject struct {
-	JsonObject *JSONElement_JSONObject `protobuf:"bytes,1,opt,name=json_object,json=jsonObject,proto3,oneof" json:"json_object,omitempty"`
-}
-type JSONElement_JsonArray struct {
-	JsonArray *JSONElement_JSONArray `protobuf:"bytes,2,opt,name=json_array,json=jsonArray,proto3,oneof" json:"json_array,omitempty"`
-}
-type JSONElement_Primitive_ struct {
-	Primitive *JSONElement_Primitive `protobuf:"bytes,3,opt,name=primitive,proto3,oneof" json:"primitive,omitempty"`
-}
-type JSONElement_Text_ struct {
-	Text *JSONElement_Text `protobuf:"bytes,4,opt,name=text,proto3,oneof" json:"text,omitempty"`
-}
-type JSONElement_RichText_ struct {
-	RichText *JSONElement_RichText `protobuf:"bytes,5,opt,name=rich_text,json=richText,proto3,oneof" json:"rich_text,omitempty"`
-}
-type JSONElement_Counter_ struct {
-	Counter *JSONElement_Counter `protobuf:"bytes,6,opt,name=counter,proto3,oneof" json:"counter,omitempty"`
+func (m *AttachDocumentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttachDocumentRequest.DiscardUnknown(m)
 }
 
-func (*JSONElement_JsonObject) isJSONElement_Body() {}
-func (*JSONElement_JsonArray) isJSONElement_Body()  {}
-func (*JSONElement_Primitive_) isJSONElement_Body() {}
-func (*JSONElement_Text_) isJSONElement_Body()      {}
-func (*JSONElement_RichText_) isJSONElement_Body()  {}
-func (*JSONElement_Counter_) isJSONElement_Body()   {}
+var xxx_messageInfo_AttachDocumentRequest proto.InternalMessageInfo
 
-func (m *JSONElement) GetBody() isJSONElement_Body {
+func (m *AttachDocumentRequest) GetClientId() []byte {
 	if m != nil {
-		return m.Body
+		return m.ClientId
 	}
 	return nil
 }
 
-func (m *JSONElement) GetJsonObject() *JSONElement_JSONObject {
-	if x, ok := m.GetBody().(*JSONElement_JsonObject); ok {
-		return x.JsonObject
+func (m *AttachDocumentRequest) GetChangePack() *ChangePack {
+	if m != nil {
+		return m.ChangePack
 	}
 	return nil
 }
 
-func (m *JSONElement) GetJsonArray() *JSONElement_JSONArray {
-	if x, ok := m.GetBody().(*JSONElement_JsonArray); ok {
-		return x.JsonArray
-	}
-	return nil
+type AttachDocumentResponse struct {
+	ClientId             []byte      `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ChangePack           *ChangePack `protobuf:"bytes,2,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *JSONElement) GetPrimitive() *JSONElement_Primitive {
-	if x, ok := m.GetBody().(*JSONElement_Primitive_); ok {
-		return x.Primitive
-	}
-	return nil
+func (m *AttachDocumentResponse) Reset()         { *m = AttachDocumentResponse{} }
+func (m *AttachDocumentResponse) String() string { return proto.CompactTextString(m) }
+func (*AttachDocumentResponse) ProtoMessage()    {}
+func (*AttachDocumentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{38}
 }
-
-func (m *JSONElement) GetText() *JSONElement_Text {
-	if x, ok := m.GetBody().(*JSONElement_Text_); ok {
-		return x.Text
-	}
-	return nil
-}
-
-func (m *JSONElement) GetRichText() *JSONElement_RichText {
-	if x, ok := m.GetBody().(*JSONElement_RichText_); ok {
-		return x.RichText
-	}
-	return nil
-}
-
-func (m *JSONElement) GetCounter() *JSONElement_Counter {
-	if x, ok := m.GetBody().(*JSONElement_Counter_); ok {
-		return x.Counter
-	}
-	return nil
-}
-
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*JSONElement) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*JSONElement_JsonObject)(nil),
-		(*JSONElement_JsonArray)(nil),
-		(*JSONElement_Primitive_)(nil),
-		(*JSONElement_Text_)(nil),
-		(*JSONElement_RichText_)(nil),
-		(*JSONElement_Counter_)(nil),
-	}
-}
-
-type JSONElement_JSONObject struct {
-	Nodes                []*RHTNode  `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
-	CreatedAt            *TimeTicket `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	MovedAt              *TimeTicket `protobuf:"bytes,3,opt,name=moved_at,json=movedAt,proto3" json:"moved_at,omitempty"`
-	RemovedAt            *TimeTicket `protobuf:"bytes,4,opt,name=removed_at,json=removedAt,proto3" json:"removed_at,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
-}
-
-func (m *JSONElement_JSONObject) Reset()         { *m = JSONElement_JSONObject{} }
-func (m *JSONElement_JSONObject) String() string { return proto.CompactTextString(m) }
-func (*JSONElement_JSONObject) ProtoMessage()    {}
-func (*JSONElement_JSONObject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{21, 0}
-}
-func (m *JSONElement_JSONObject) XXX_Unmarshal(b []byte) error {
+func (m *AttachDocumentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JSONElement_JSONObject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AttachDocumentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JSONElement_JSONObject.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AttachDocumentResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2330,68 +2336,52 @@ func (m *JSONElement_JSONObject) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *JSONElement_JSONObject) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JSONElement_JSONObject.Merge(m, src)
+func (m *AttachDocumentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttachDocumentResponse.Merge(m, src)
 }
-func (m *JSONElement_JSONObject) XXX_Size() int {
+func (m *AttachDocumentResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *JSONElement_JSONObject) XXX_DiscardUnknown() {
-	xxx_messageInfo_JSONElement_JSONObject.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_JSONElement_JSONObject proto.InternalMessageInfo
-
-func (m *JSONElement_JSONObject) GetNodes() []*RHTNode {
-	if m != nil {
-		return m.Nodes
-	}
-	return nil
+func (m *AttachDocumentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttachDocumentResponse.DiscardUnknown(m)
 }
 
-func (m *JSONElement_JSONObject) GetCreatedAt() *TimeTicket {
-	if m != nil {
-		return m.CreatedAt
-	}
-	return nil
-}
+var xxx_messageInfo_AttachDocumentResponse proto.InternalMessageInfo
 
-func (m *JSONElement_JSONObject) GetMovedAt() *TimeTicket {
+func (m *AttachDocumentResponse) GetClientId() []byte {
 	if m != nil {
-		return m.MovedAt
+		return m.ClientId
 	}
 	return nil
 }
 
-func (m *JSONElement_JSONObject) GetRemovedAt() *TimeTicket {
+func (m *AttachDocumentResponse) GetChangePack() *ChangePack {
 	if m != nil {
-		return m.RemovedAt
+		return m.ChangePack
 	}
 	return nil
 }
 
-type JSONElement_JSONArray struct {
-	Nodes                []*RGANode  `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
-	CreatedAt            *TimeTicket `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	MovedAt              *TimeTicket `protobuf:"bytes,3,opt,name=moved_at,json=movedAt,proto3" json:"moved_at,omitempty"`
-	RemovedAt            *TimeTicket `protobuf:"bytes,4,opt,name=removed_at,json=removedAt,proto3" json:"removed_at,omitempty"`
+type DetachDocumentRequest struct {
+	ClientId             []byte      `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ChangePack           *ChangePack `protobuf:"bytes,2,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
 	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *JSONElement_JSONArray) Reset()         { *m = JSONElement_JSONArray{} }
-func (m *JSONElement_JSONArray) String() string { return proto.CompactTextString(m) }
-func (*JSONElement_JSONArray) ProtoMessage()    {}
-func (*JSONElement_JSONArray) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{21, 1}
+func (m *DetachDocumentRequest) Reset()         { *m = DetachDocumentRequest{} }
+func (m *DetachDocumentRequest) String() string { return proto.CompactTextString(m) }
+func (*DetachDocumentRequest) ProtoMessage()    {}
+func (*DetachDocumentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{39}
 }
-func (m *JSONElement_JSONArray) XXX_Unmarshal(b []byte) error {
+func (m *DetachDocumentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JSONElement_JSONArray) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DetachDocumentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JSONElement_JSONArray.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DetachDocumentRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2401,69 +2391,52 @@ func (m *JSONElement_JSONArray) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *JSONElement_JSONArray) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JSONElement_JSONArray.Merge(m, src)
+func (m *DetachDocumentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DetachDocumentRequest.Merge(m, src)
 }
-func (m *JSONElement_JSONArray) XXX_Size() int {
+func (m *DetachDocumentRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *JSONElement_JSONArray) XXX_DiscardUnknown() {
-	xxx_messageInfo_JSONElement_JSONArray.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_JSONElement_JSONArray proto.InternalMessageInfo
-
-func (m *JSONElement_JSONArray) GetNodes() []*RGANode {
-	if m != nil {
-		return m.Nodes
-	}
-	return nil
+func (m *DetachDocumentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DetachDocumentRequest.DiscardUnknown(m)
 }
 
-func (m *JSONElement_JSONArray) GetCreatedAt() *TimeTicket {
-	if m != nil {
-		return m.CreatedAt
-	}
-	return nil
-}
+var xxx_messageInfo_DetachDocumentRequest proto.InternalMessageInfo
 
-func (m *JSONElement_JSONArray) GetMovedAt() *TimeTicket {
+func (m *DetachDocumentRequest) GetClientId() []byte {
 	if m != nil {
-		return m.MovedAt
+		return m.ClientId
 	}
 	return nil
 }
 
-func (m *JSONElement_JSONArray) GetRemovedAt() *TimeTicket {
+func (m *DetachDocumentRequest) GetChangePack() *ChangePack {
 	if m != nil {
-		return m.RemovedAt
+		return m.ChangePack
 	}
 	return nil
 }
 
-type JSONElement_Primitive struct {
-	Type                 ValueType   `protobuf:"varint,1,opt,name=type,proto3,enum=api.ValueType" json:"type,omitempty"`
-	Value                []byte      `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	CreatedAt            *TimeTicket `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	MovedAt              *TimeTicket `protobuf:"bytes,4,opt,name=moved_at,json=movedAt,proto3" json:"moved_at,omitempty"`
-	RemovedAt            *TimeTicket `protobuf:"bytes,5,opt,name=removed_at,json=removedAt,proto3" json:"removed_at,omitempty"`
+type DetachDocumentResponse struct {
+	ClientKey            string      `protobuf:"bytes,1,opt,name=client_key,json=clientKey,proto3" json:"client_key,omitempty"`
+	ChangePack           *ChangePack `protobuf:"bytes,2,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
 	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *JSONElement_Primitive) Reset()         { *m = JSONElement_Primitive{} }
-func (m *JSONElement_Primitive) String() string { return proto.CompactTextString(m) }
-func (*JSONElement_Primitive) ProtoMessage()    {}
-func (*JSONElement_Primitive) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{21, 2}
+func (m *DetachDocumentResponse) Reset()         { *m = DetachDocumentResponse{} }
+func (m *DetachDocumentResponse) String() string { return proto.CompactTextString(m) }
+func (*DetachDocumentResponse) ProtoMessage()    {}
+func (*DetachDocumentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{40}
 }
-func (m *JSONElement_Primitive) XXX_Unmarshal(b []byte) error {
+func (m *DetachDocumentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JSONElement_Primitive) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DetachDocumentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JSONElement_Primitive.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DetachDocumentResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2473,75 +2446,53 @@ func (m *JSONElement_Primitive) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *JSONElement_Primitive) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JSONElement_Primitive.Merge(m, src)
+func (m *DetachDocumentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DetachDocumentResponse.Merge(m, src)
 }
-func (m *JSONElement_Primitive) XXX_Size() int {
+func (m *DetachDocumentResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *JSONElement_Primitive) XXX_DiscardUnknown() {
-	xxx_messageInfo_JSONElement_Primitive.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_JSONElement_Primitive proto.InternalMessageInfo
-
-func (m *JSONElement_Primitive) GetType() ValueType {
-	if m != nil {
-		return m.Type
-	}
-	return ValueType_NULL
-}
-
-func (m *JSONElement_Primitive) GetValue() []byte {
-	if m != nil {
-		return m.Value
-	}
-	return nil
+func (m *DetachDocumentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DetachDocumentResponse.DiscardUnknown(m)
 }
 
-func (m *JSONElement_Primitive) GetCreatedAt() *TimeTicket {
-	if m != nil {
-		return m.CreatedAt
-	}
-	return nil
-}
+var xxx_messageInfo_DetachDocumentResponse proto.InternalMessageInfo
 
-func (m *JSONElement_Primitive) GetMovedAt() *TimeTicket {
+func (m *DetachDocumentResponse) GetClientKey() string {
 	if m != nil {
-		return m.MovedAt
+		return m.ClientKey
 	}
-	return nil
+	return ""
 }
 
-func (m *JSONElement_Primitive) GetRemovedAt() *TimeTicket {
+func (m *DetachDocumentResponse) GetChangePack() *ChangePack {
 	if m != nil {
-		return m.RemovedAt
+		return m.ChangePack
 	}
 	return nil
 }
 
-type JSONElement_Text struct {
-	Nodes                []*TextNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
-	CreatedAt            *TimeTicket `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	MovedAt              *TimeTicket `protobuf:"bytes,3,opt,name=moved_at,json=movedAt,proto3" json:"moved_at,omitempty"`
-	RemovedAt            *TimeTicket `protobuf:"bytes,4,opt,name=removed_at,json=removedAt,proto3" json:"removed_at,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+type WatchDocumentsRequest struct {
+	Client               *Client        `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	DocumentKeys         []*DocumentKey `protobuf:"bytes,2,rep,name=document_keys,json=documentKeys,proto3" json:"document_keys,omitempty"`
+	Catchup              bool           `protobuf:"varint,3,opt,name=catchup,proto3" json:"catchup,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *JSONElement_Text) Reset()         { *m = JSONElement_Text{} }
-func (m *JSONElement_Text) String() string { return proto.CompactTextString(m) }
-func (*JSONElement_Text) ProtoMessage()    {}
-func (*JSONElement_Text) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{21, 3}
+func (m *WatchDocumentsRequest) Reset()         { *m = WatchDocumentsRequest{} }
+func (m *WatchDocumentsRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchDocumentsRequest) ProtoMessage()    {}
+func (*WatchDocumentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{41}
 }
-func (m *JSONElement_Text) XXX_Unmarshal(b []byte) error {
+func (m *WatchDocumentsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JSONElement_Text) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WatchDocumentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JSONElement_Text.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WatchDocumentsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2551,68 +2502,62 @@ func (m *JSONElement_Text) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *JSONElement_Text) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JSONElement_Text.Merge(m, src)
+func (m *WatchDocumentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchDocumentsRequest.Merge(m, src)
 }
-func (m *JSONElement_Text) XXX_Size() int {
+func (m *WatchDocumentsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *JSONElement_Text) XXX_DiscardUnknown() {
-	xxx_messageInfo_JSONElement_Text.DiscardUnknown(m)
+func (m *WatchDocumentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchDocumentsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JSONElement_Text proto.InternalMessageInfo
+var xxx_messageInfo_WatchDocumentsRequest proto.InternalMessageInfo
 
-func (m *JSONElement_Text) GetNodes() []*TextNode {
+func (m *WatchDocumentsRequest) GetClient() *Client {
 	if m != nil {
-		return m.Nodes
+		return m.Client
 	}
 	return nil
 }
 
-func (m *JSONElement_Text) GetCreatedAt() *TimeTicket {
+func (m *WatchDocumentsRequest) GetDocumentKeys() []*DocumentKey {
 	if m != nil {
-		return m.CreatedAt
+		return m.DocumentKeys
 	}
 	return nil
 }
 
-func (m *JSONElement_Text) GetMovedAt() *TimeTicket {
+func (m *WatchDocumentsRequest) GetCatchup() bool {
 	if m != nil {
-		return m.MovedAt
+		return m.Catchup
 	}
-	return nil
-}
-
-func (m *JSONElement_Text) GetRemovedAt() *TimeTicket {
-	if m != nil {
-		return m.RemovedAt
-	}
-	return nil
+	return false
 }
 
-type JSONElement_RichText struct {
-	Nodes                []*RichTextNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
-	CreatedAt            *TimeTicket     `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	MovedAt              *TimeTicket     `protobuf:"bytes,3,opt,name=moved_at,json=movedAt,proto3" json:"moved_at,omitempty"`
-	RemovedAt            *TimeTicket     `protobuf:"bytes,4,opt,name=removed_at,json=removedAt,proto3" json:"removed_at,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+type WatchDocumentsResponse struct {
+	// Types that are valid to be assigned to Body:
+	//	*WatchDocumentsResponse_Initialization_
+	//	*WatchDocumentsResponse_Event
+	//	*WatchDocumentsResponse_Keepalive_
+	Body                 isWatchDocumentsResponse_Body `protobuf_oneof:"body"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
 }
 
-func (m *JSONElement_RichText) Reset()         { *m = JSONElement_RichText{} }
-func (m *JSONElement_RichText) String() string { return proto.CompactTextString(m) }
-func (*JSONElement_RichText) ProtoMessage()    {}
-func (*JSONElement_RichText) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{21, 4}
+func (m *WatchDocumentsResponse) Reset()         { *m = WatchDocumentsResponse{} }
+func (m *WatchDocumentsResponse) String() string { return proto.CompactTextString(m) }
+func (*WatchDocumentsResponse) ProtoMessage()    {}
+func (*WatchDocumentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{42}
 }
-func (m *JSONElement_RichText) XXX_Unmarshal(b []byte) error {
+func (m *WatchDocumentsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JSONElement_RichText) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WatchDocumentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JSONElement_RichText.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WatchDocumentsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2622,69 +2567,95 @@ func (m *JSONElement_RichText) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *JSONElement_RichText) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JSONElement_RichText.Merge(m, src)
+func (m *WatchDocumentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchDocumentsResponse.Merge(m, src)
 }
-func (m *JSONElement_RichText) XXX_Size() int {
+func (m *WatchDocumentsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *JSONElement_RichText) XXX_DiscardUnknown() {
-	xxx_messageInfo_JSONElement_RichText.DiscardUnknown(m)
+func (m *WatchDocumentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchDocumentsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JSONElement_RichText proto.InternalMessageInfo
+var xxx_messageInfo_WatchDocumentsResponse proto.InternalMessageInfo
 
-func (m *JSONElement_RichText) GetNodes() []*RichTextNode {
+type isWatchDocumentsResponse_Body interface {
+	isWatchDocumentsResponse_Body()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type WatchDocumentsResponse_Initialization_ struct {
+	Initialization *WatchDocumentsResponse_Initialization `protobuf:"bytes,1,opt,name=initialization,proto3,oneof" json:"initialization,omitempty"`
+}
+type WatchDocumentsResponse_Event struct {
+	Event *DocEvent `protobuf:"bytes,2,opt,name=event,proto3,oneof" json:"event,omitempty"`
+}
+type WatchDocumentsResponse_Keepalive_ struct {
+	Keepalive *WatchDocumentsResponse_Keepalive `protobuf:"bytes,3,opt,name=keepalive,proto3,oneof" json:"keepalive,omitempty"`
+}
+
+func (*WatchDocumentsResponse_Initialization_) isWatchDocumentsResponse_Body() {}
+func (*WatchDocumentsResponse_Event) isWatchDocumentsResponse_Body()           {}
+func (*WatchDocumentsResponse_Keepalive_) isWatchDocumentsResponse_Body()      {}
+
+func (m *WatchDocumentsResponse) GetBody() isWatchDocumentsResponse_Body {
 	if m != nil {
-		return m.Nodes
+		return m.Body
 	}
 	return nil
 }
 
-func (m *JSONElement_RichText) GetCreatedAt() *TimeTicket {
-	if m != nil {
-		return m.CreatedAt
+func (m *WatchDocumentsResponse) GetInitialization() *WatchDocumentsResponse_Initialization {
+	if x, ok := m.GetBody().(*WatchDocumentsResponse_Initialization_); ok {
+		return x.Initialization
 	}
 	return nil
 }
 
-func (m *JSONElement_RichText) GetMovedAt() *TimeTicket {
-	if m != nil {
-		return m.MovedAt
+func (m *WatchDocumentsResponse) GetEvent() *DocEvent {
+	if x, ok := m.GetBody().(*WatchDocumentsResponse_Event); ok {
+		return x.Event
 	}
 	return nil
 }
 
-func (m *JSONElement_RichText) GetRemovedAt() *TimeTicket {
-	if m != nil {
-		return m.RemovedAt
+func (m *WatchDocumentsResponse) GetKeepalive() *WatchDocumentsResponse_Keepalive {
+	if x, ok := m.GetBody().(*WatchDocumentsResponse_Keepalive_); ok {
+		return x.Keepalive
 	}
 	return nil
 }
 
-type JSONElement_Counter struct {
-	Type                 ValueType   `protobuf:"varint,1,opt,name=type,proto3,enum=api.ValueType" json:"type,omitempty"`
-	Value                []byte      `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	CreatedAt            *TimeTicket `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	MovedAt              *TimeTicket `protobuf:"bytes,4,opt,name=moved_at,json=movedAt,proto3" json:"moved_at,omitempty"`
-	RemovedAt            *TimeTicket `protobuf:"bytes,5,opt,name=removed_at,json=removedAt,proto3" json:"removed_at,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*WatchDocumentsResponse) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*WatchDocumentsResponse_Initialization_)(nil),
+		(*WatchDocumentsResponse_Event)(nil),
+		(*WatchDocumentsResponse_Keepalive_)(nil),
+	}
 }
 
-func (m *JSONElement_Counter) Reset()         { *m = JSONElement_Counter{} }
-func (m *JSONElement_Counter) String() string { return proto.CompactTextString(m) }
-func (*JSONElement_Counter) ProtoMessage()    {}
-func (*JSONElement_Counter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{21, 5}
+type WatchDocumentsResponse_Initialization struct {
+	PeersMapByDoc        map[string]*Clients `protobuf:"bytes,1,rep,name=peers_map_by_doc,json=peersMapByDoc,proto3" json:"peers_map_by_doc,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ServerSeqs           map[string]uint64   `protobuf:"bytes,2,rep,name=server_seqs,json=serverSeqs,proto3" json:"server_seqs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
-func (m *JSONElement_Counter) XXX_Unmarshal(b []byte) error {
+
+func (m *WatchDocumentsResponse_Initialization) Reset()         { *m = WatchDocumentsResponse_Initialization{} }
+func (m *WatchDocumentsResponse_Initialization) String() string { return proto.CompactTextString(m) }
+func (*WatchDocumentsResponse_Initialization) ProtoMessage()    {}
+func (*WatchDocumentsResponse_Initialization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{42, 0}
+}
+func (m *WatchDocumentsResponse_Initialization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JSONElement_Counter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WatchDocumentsResponse_Initialization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JSONElement_Counter.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WatchDocumentsResponse_Initialization.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2694,73 +2665,50 @@ func (m *JSONElement_Counter) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *JSONElement_Counter) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JSONElement_Counter.Merge(m, src)
+func (m *WatchDocumentsResponse_Initialization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchDocumentsResponse_Initialization.Merge(m, src)
 }
-func (m *JSONElement_Counter) XXX_Size() int {
+func (m *WatchDocumentsResponse_Initialization) XXX_Size() int {
 	return m.Size()
 }
-func (m *JSONElement_Counter) XXX_DiscardUnknown() {
-	xxx_messageInfo_JSONElement_Counter.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_JSONElement_Counter proto.InternalMessageInfo
-
-func (m *JSONElement_Counter) GetType() ValueType {
-	if m != nil {
-		return m.Type
-	}
-	return ValueType_NULL
-}
-
-func (m *JSONElement_Counter) GetValue() []byte {
-	if m != nil {
-		return m.Value
-	}
-	return nil
+func (m *WatchDocumentsResponse_Initialization) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchDocumentsResponse_Initialization.DiscardUnknown(m)
 }
 
-func (m *JSONElement_Counter) GetCreatedAt() *TimeTicket {
-	if m != nil {
-		return m.CreatedAt
-	}
-	return nil
-}
+var xxx_messageInfo_WatchDocumentsResponse_Initialization proto.InternalMessageInfo
 
-func (m *JSONElement_Counter) GetMovedAt() *TimeTicket {
+func (m *WatchDocumentsResponse_Initialization) GetPeersMapByDoc() map[string]*Clients {
 	if m != nil {
-		return m.MovedAt
+		return m.PeersMapByDoc
 	}
 	return nil
 }
 
-func (m *JSONElement_Counter) GetRemovedAt() *TimeTicket {
+func (m *WatchDocumentsResponse_Initialization) GetServerSeqs() map[string]uint64 {
 	if m != nil {
-		return m.RemovedAt
+		return m.ServerSeqs
 	}
 	return nil
 }
 
-type RHTNode struct {
-	Key                  string       `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Element              *JSONElement `protobuf:"bytes,2,opt,name=element,proto3" json:"element,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+type WatchDocumentsResponse_Keepalive struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RHTNode) Reset()         { *m = RHTNode{} }
-func (m *RHTNode) String() string { return proto.CompactTextString(m) }
-func (*RHTNode) ProtoMessage()    {}
-func (*RHTNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{22}
+func (m *WatchDocumentsResponse_Keepalive) Reset()         { *m = WatchDocumentsResponse_Keepalive{} }
+func (m *WatchDocumentsResponse_Keepalive) String() string { return proto.CompactTextString(m) }
+func (*WatchDocumentsResponse_Keepalive) ProtoMessage()    {}
+func (*WatchDocumentsResponse_Keepalive) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{42, 1}
 }
-func (m *RHTNode) XXX_Unmarshal(b []byte) error {
+func (m *WatchDocumentsResponse_Keepalive) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RHTNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WatchDocumentsResponse_Keepalive) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RHTNode.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WatchDocumentsResponse_Keepalive.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2770,52 +2718,38 @@ func (m *RHTNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *RHTNode) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RHTNode.Merge(m, src)
+func (m *WatchDocumentsResponse_Keepalive) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchDocumentsResponse_Keepalive.Merge(m, src)
 }
-func (m *RHTNode) XXX_Size() int {
+func (m *WatchDocumentsResponse_Keepalive) XXX_Size() int {
 	return m.Size()
 }
-func (m *RHTNode) XXX_DiscardUnknown() {
-	xxx_messageInfo_RHTNode.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_RHTNode proto.InternalMessageInfo
-
-func (m *RHTNode) GetKey() string {
-	if m != nil {
-		return m.Key
-	}
-	return ""
+func (m *WatchDocumentsResponse_Keepalive) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchDocumentsResponse_Keepalive.DiscardUnknown(m)
 }
 
-func (m *RHTNode) GetElement() *JSONElement {
-	if m != nil {
-		return m.Element
-	}
-	return nil
-}
+var xxx_messageInfo_WatchDocumentsResponse_Keepalive proto.InternalMessageInfo
 
-type RGANode struct {
-	Next                 *RGANode     `protobuf:"bytes,1,opt,name=next,proto3" json:"next,omitempty"`
-	Element              *JSONElement `protobuf:"bytes,2,opt,name=element,proto3" json:"element,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+type PushPullRequest struct {
+	ClientId             []byte      `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ChangePack           *ChangePack `protobuf:"bytes,2,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *RGANode) Reset()         { *m = RGANode{} }
-func (m *RGANode) String() string { return proto.CompactTextString(m) }
-func (*RGANode) ProtoMessage()    {}
-func (*RGANode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{23}
+func (m *PushPullRequest) Reset()         { *m = PushPullRequest{} }
+func (m *PushPullRequest) String() string { return proto.CompactTextString(m) }
+func (*PushPullRequest) ProtoMessage()    {}
+func (*PushPullRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{43}
 }
-func (m *RGANode) XXX_Unmarshal(b []byte) error {
+func (m *PushPullRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RGANode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PushPullRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RGANode.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PushPullRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2825,54 +2759,52 @@ func (m *RGANode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *RGANode) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RGANode.Merge(m, src)
+func (m *PushPullRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushPullRequest.Merge(m, src)
 }
-func (m *RGANode) XXX_Size() int {
+func (m *PushPullRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RGANode) XXX_DiscardUnknown() {
-	xxx_messageInfo_RGANode.DiscardUnknown(m)
+func (m *PushPullRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushPullRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RGANode proto.InternalMessageInfo
+var xxx_messageInfo_PushPullRequest proto.InternalMessageInfo
 
-func (m *RGANode) GetNext() *RGANode {
+func (m *PushPullRequest) GetClientId() []byte {
 	if m != nil {
-		return m.Next
+		return m.ClientId
 	}
 	return nil
 }
 
-func (m *RGANode) GetElement() *JSONElement {
+func (m *PushPullRequest) GetChangePack() *ChangePack {
 	if m != nil {
-		return m.Element
+		return m.ChangePack
 	}
 	return nil
 }
 
-type TextNode struct {
-	Id                   *TextNodeID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Value                string      `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	RemovedAt            *TimeTicket `protobuf:"bytes,3,opt,name=removed_at,json=removedAt,proto3" json:"removed_at,omitempty"`
-	InsPrevId            *TextNodeID `protobuf:"bytes,4,opt,name=ins_prev_id,json=insPrevId,proto3" json:"ins_prev_id,omitempty"`
+type PushPullResponse struct {
+	ClientId             []byte      `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ChangePack           *ChangePack `protobuf:"bytes,2,opt,name=change_pack,json=changePack,proto3" json:"change_pack,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
 	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *TextNode) Reset()         { *m = TextNode{} }
-func (m *TextNode) String() string { return proto.CompactTextString(m) }
-func (*TextNode) ProtoMessage()    {}
-func (*TextNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{24}
+func (m *PushPullResponse) Reset()         { *m = PushPullResponse{} }
+func (m *PushPullResponse) String() string { return proto.CompactTextString(m) }
+func (*PushPullResponse) ProtoMessage()    {}
+func (*PushPullResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{44}
 }
-func (m *TextNode) XXX_Unmarshal(b []byte) error {
+func (m *PushPullResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TextNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PushPullResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TextNode.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PushPullResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2882,67 +2814,52 @@ func (m *TextNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *TextNode) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TextNode.Merge(m, src)
+func (m *PushPullResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushPullResponse.Merge(m, src)
 }
-func (m *TextNode) XXX_Size() int {
+func (m *PushPullResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *TextNode) XXX_DiscardUnknown() {
-	xxx_messageInfo_TextNode.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_TextNode proto.InternalMessageInfo
-
-func (m *TextNode) GetId() *TextNodeID {
-	if m != nil {
-		return m.Id
-	}
-	return nil
+func (m *PushPullResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushPullResponse.DiscardUnknown(m)
 }
 
-func (m *TextNode) GetValue() string {
-	if m != nil {
-		return m.Value
-	}
-	return ""
-}
+var xxx_messageInfo_PushPullResponse proto.InternalMessageInfo
 
-func (m *TextNode) GetRemovedAt() *TimeTicket {
+func (m *PushPullResponse) GetClientId() []byte {
 	if m != nil {
-		return m.RemovedAt
+		return m.ClientId
 	}
 	return nil
 }
 
-func (m *TextNode) GetInsPrevId() *TextNodeID {
+func (m *PushPullResponse) GetChangePack() *ChangePack {
 	if m != nil {
-		return m.InsPrevId
+		return m.ChangePack
 	}
 	return nil
 }
 
-type RichTextNodeAttr struct {
-	Key                  string      `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value                string      `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	UpdatedAt            *TimeTicket `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+type UpdateMetadataRequest struct {
+	Client               *Client        `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	DocumentKeys         []*DocumentKey `protobuf:"bytes,2,rep,name=document_keys,json=documentKeys,proto3" json:"document_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *RichTextNodeAttr) Reset()         { *m = RichTextNodeAttr{} }
-func (m *RichTextNodeAttr) String() string { return proto.CompactTextString(m) }
-func (*RichTextNodeAttr) ProtoMessage()    {}
-func (*RichTextNodeAttr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{25}
+func (m *UpdateMetadataRequest) Reset()         { *m = UpdateMetadataRequest{} }
+func (m *UpdateMetadataRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateMetadataRequest) ProtoMessage()    {}
+func (*UpdateMetadataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{45}
 }
-func (m *RichTextNodeAttr) XXX_Unmarshal(b []byte) error {
+func (m *UpdateMetadataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RichTextNodeAttr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RichTextNodeAttr.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateMetadataRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2952,62 +2869,91 @@ func (m *RichTextNodeAttr) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *RichTextNodeAttr) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RichTextNodeAttr.Merge(m, src)
+func (m *UpdateMetadataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateMetadataRequest.Merge(m, src)
 }
-func (m *RichTextNodeAttr) XXX_Size() int {
+func (m *UpdateMetadataRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RichTextNodeAttr) XXX_DiscardUnknown() {
-	xxx_messageInfo_RichTextNodeAttr.DiscardUnknown(m)
+func (m *UpdateMetadataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateMetadataRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RichTextNodeAttr proto.InternalMessageInfo
+var xxx_messageInfo_UpdateMetadataRequest proto.InternalMessageInfo
 
-func (m *RichTextNodeAttr) GetKey() string {
+func (m *UpdateMetadataRequest) GetClient() *Client {
 	if m != nil {
-		return m.Key
+		return m.Client
 	}
-	return ""
+	return nil
 }
 
-func (m *RichTextNodeAttr) GetValue() string {
+func (m *UpdateMetadataRequest) GetDocumentKeys() []*DocumentKey {
 	if m != nil {
-		return m.Value
+		return m.DocumentKeys
 	}
-	return ""
+	return nil
 }
 
-func (m *RichTextNodeAttr) GetUpdatedAt() *TimeTicket {
-	if m != nil {
-		return m.UpdatedAt
+type UpdateMetadataResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateMetadataResponse) Reset()         { *m = UpdateMetadataResponse{} }
+func (m *UpdateMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateMetadataResponse) ProtoMessage()    {}
+func (*UpdateMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{46}
+}
+func (m *UpdateMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *UpdateMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateMetadataResponse.Merge(m, src)
+}
+func (m *UpdateMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateMetadataResponse.DiscardUnknown(m)
 }
 
-type RichTextNode struct {
-	Id                   *TextNodeID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Attributes           map[string]*RichTextNodeAttr `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Value                string                       `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
-	RemovedAt            *TimeTicket                  `protobuf:"bytes,4,opt,name=removed_at,json=removedAt,proto3" json:"removed_at,omitempty"`
-	InsPrevId            *TextNodeID                  `protobuf:"bytes,5,opt,name=ins_prev_id,json=insPrevId,proto3" json:"ins_prev_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
-	XXX_unrecognized     []byte                       `json:"-"`
-	XXX_sizecache        int32                        `json:"-"`
+var xxx_messageInfo_UpdateMetadataResponse proto.InternalMessageInfo
+
+type UpdateClientMetadataRequest struct {
+	ClientId             []byte            `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Metadata             map[string]string `protobuf:"bytes,2,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *RichTextNode) Reset()         { *m = RichTextNode{} }
-func (m *RichTextNode) String() string { return proto.CompactTextString(m) }
-func (*RichTextNode) ProtoMessage()    {}
-func (*RichTextNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{26}
+func (m *UpdateClientMetadataRequest) Reset()         { *m = UpdateClientMetadataRequest{} }
+func (m *UpdateClientMetadataRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateClientMetadataRequest) ProtoMessage()    {}
+func (*UpdateClientMetadataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{47}
 }
-func (m *RichTextNode) XXX_Unmarshal(b []byte) error {
+func (m *UpdateClientMetadataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RichTextNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *UpdateClientMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RichTextNode.Marshal(b, m, deterministic)
+		return xxx_messageInfo_UpdateClientMetadataRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3017,73 +2963,91 @@ func (m *RichTextNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *RichTextNode) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RichTextNode.Merge(m, src)
+func (m *UpdateClientMetadataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateClientMetadataRequest.Merge(m, src)
 }
-func (m *RichTextNode) XXX_Size() int {
+func (m *UpdateClientMetadataRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *RichTextNode) XXX_DiscardUnknown() {
-	xxx_messageInfo_RichTextNode.DiscardUnknown(m)
+func (m *UpdateClientMetadataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateClientMetadataRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RichTextNode proto.InternalMessageInfo
+var xxx_messageInfo_UpdateClientMetadataRequest proto.InternalMessageInfo
 
-func (m *RichTextNode) GetId() *TextNodeID {
+func (m *UpdateClientMetadataRequest) GetClientId() []byte {
 	if m != nil {
-		return m.Id
+		return m.ClientId
 	}
 	return nil
 }
 
-func (m *RichTextNode) GetAttributes() map[string]*RichTextNodeAttr {
+func (m *UpdateClientMetadataRequest) GetMetadata() map[string]string {
 	if m != nil {
-		return m.Attributes
+		return m.Metadata
 	}
 	return nil
 }
 
-func (m *RichTextNode) GetValue() string {
-	if m != nil {
-		return m.Value
-	}
-	return ""
+type UpdateClientMetadataResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RichTextNode) GetRemovedAt() *TimeTicket {
-	if m != nil {
-		return m.RemovedAt
-	}
-	return nil
+func (m *UpdateClientMetadataResponse) Reset()         { *m = UpdateClientMetadataResponse{} }
+func (m *UpdateClientMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateClientMetadataResponse) ProtoMessage()    {}
+func (*UpdateClientMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{48}
 }
-
-func (m *RichTextNode) GetInsPrevId() *TextNodeID {
-	if m != nil {
-		return m.InsPrevId
+func (m *UpdateClientMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateClientMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateClientMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *UpdateClientMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateClientMetadataResponse.Merge(m, src)
+}
+func (m *UpdateClientMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateClientMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateClientMetadataResponse.DiscardUnknown(m)
 }
 
-type TextNodeID struct {
-	CreatedAt            *TimeTicket `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Offset               int32       `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+var xxx_messageInfo_UpdateClientMetadataResponse proto.InternalMessageInfo
+
+type PullJSONPatchesRequest struct {
+	DocumentKey          *DocumentKey `protobuf:"bytes,1,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
+	ServerSeq            uint64       `protobuf:"varint,2,opt,name=server_seq,json=serverSeq,proto3" json:"server_seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *TextNodeID) Reset()         { *m = TextNodeID{} }
-func (m *TextNodeID) String() string { return proto.CompactTextString(m) }
-func (*TextNodeID) ProtoMessage()    {}
-func (*TextNodeID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{27}
+func (m *PullJSONPatchesRequest) Reset()         { *m = PullJSONPatchesRequest{} }
+func (m *PullJSONPatchesRequest) String() string { return proto.CompactTextString(m) }
+func (*PullJSONPatchesRequest) ProtoMessage()    {}
+func (*PullJSONPatchesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{49}
 }
-func (m *TextNodeID) XXX_Unmarshal(b []byte) error {
+func (m *PullJSONPatchesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TextNodeID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PullJSONPatchesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TextNodeID.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PullJSONPatchesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3093,52 +3057,54 @@ func (m *TextNodeID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *TextNodeID) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TextNodeID.Merge(m, src)
+func (m *PullJSONPatchesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PullJSONPatchesRequest.Merge(m, src)
 }
-func (m *TextNodeID) XXX_Size() int {
+func (m *PullJSONPatchesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *TextNodeID) XXX_DiscardUnknown() {
-	xxx_messageInfo_TextNodeID.DiscardUnknown(m)
+func (m *PullJSONPatchesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PullJSONPatchesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TextNodeID proto.InternalMessageInfo
+var xxx_messageInfo_PullJSONPatchesRequest proto.InternalMessageInfo
 
-func (m *TextNodeID) GetCreatedAt() *TimeTicket {
+func (m *PullJSONPatchesRequest) GetDocumentKey() *DocumentKey {
 	if m != nil {
-		return m.CreatedAt
+		return m.DocumentKey
 	}
 	return nil
 }
 
-func (m *TextNodeID) GetOffset() int32 {
+func (m *PullJSONPatchesRequest) GetServerSeq() uint64 {
 	if m != nil {
-		return m.Offset
+		return m.ServerSeq
 	}
 	return 0
 }
 
-type Metadata struct {
-	Clock                int32             `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
-	Data                 map[string]string `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+type PullJSONPatchesResponse struct {
+	ServerSeq            uint64       `protobuf:"varint,1,opt,name=server_seq,json=serverSeq,proto3" json:"server_seq,omitempty"`
+	Patches              []*JSONPatch `protobuf:"bytes,2,rep,name=patches,proto3" json:"patches,omitempty"`
+	Document             string       `protobuf:"bytes,3,opt,name=document,proto3" json:"document,omitempty"`
+	HasMore              bool         `protobuf:"varint,4,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *Metadata) Reset()         { *m = Metadata{} }
-func (m *Metadata) String() string { return proto.CompactTextString(m) }
-func (*Metadata) ProtoMessage()    {}
-func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{28}
+func (m *PullJSONPatchesResponse) Reset()         { *m = PullJSONPatchesResponse{} }
+func (m *PullJSONPatchesResponse) String() string { return proto.CompactTextString(m) }
+func (*PullJSONPatchesResponse) ProtoMessage()    {}
+func (*PullJSONPatchesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{50}
 }
-func (m *Metadata) XXX_Unmarshal(b []byte) error {
+func (m *PullJSONPatchesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Metadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PullJSONPatchesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Metadata.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PullJSONPatchesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3148,52 +3114,67 @@ func (m *Metadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Metadata) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Metadata.Merge(m, src)
+func (m *PullJSONPatchesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PullJSONPatchesResponse.Merge(m, src)
 }
-func (m *Metadata) XXX_Size() int {
+func (m *PullJSONPatchesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *Metadata) XXX_DiscardUnknown() {
-	xxx_messageInfo_Metadata.DiscardUnknown(m)
+func (m *PullJSONPatchesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PullJSONPatchesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Metadata proto.InternalMessageInfo
+var xxx_messageInfo_PullJSONPatchesResponse proto.InternalMessageInfo
 
-func (m *Metadata) GetClock() int32 {
+func (m *PullJSONPatchesResponse) GetServerSeq() uint64 {
 	if m != nil {
-		return m.Clock
+		return m.ServerSeq
 	}
 	return 0
 }
 
-func (m *Metadata) GetData() map[string]string {
+func (m *PullJSONPatchesResponse) GetPatches() []*JSONPatch {
 	if m != nil {
-		return m.Data
+		return m.Patches
 	}
 	return nil
 }
 
-type Client struct {
-	Id                   []byte    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Metadata             *Metadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+func (m *PullJSONPatchesResponse) GetDocument() string {
+	if m != nil {
+		return m.Document
+	}
+	return ""
 }
 
-func (m *Client) Reset()         { *m = Client{} }
-func (m *Client) String() string { return proto.CompactTextString(m) }
-func (*Client) ProtoMessage()    {}
-func (*Client) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{29}
+func (m *PullJSONPatchesResponse) GetHasMore() bool {
+	if m != nil {
+		return m.HasMore
+	}
+	return false
 }
-func (m *Client) XXX_Unmarshal(b []byte) error {
+
+type FetchSnapshotRequest struct {
+	DocumentKey          *DocumentKey `protobuf:"bytes,1,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
+	ServerSeq            uint64       `protobuf:"varint,2,opt,name=server_seq,json=serverSeq,proto3" json:"server_seq,omitempty"`
+	Offset               uint64       `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *FetchSnapshotRequest) Reset()         { *m = FetchSnapshotRequest{} }
+func (m *FetchSnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*FetchSnapshotRequest) ProtoMessage()    {}
+func (*FetchSnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{51}
+}
+func (m *FetchSnapshotRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Client) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FetchSnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Client.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FetchSnapshotRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3203,51 +3184,61 @@ func (m *Client) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Client) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Client.Merge(m, src)
+func (m *FetchSnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FetchSnapshotRequest.Merge(m, src)
 }
-func (m *Client) XXX_Size() int {
+func (m *FetchSnapshotRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *Client) XXX_DiscardUnknown() {
-	xxx_messageInfo_Client.DiscardUnknown(m)
+func (m *FetchSnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FetchSnapshotRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Client proto.InternalMessageInfo
+var xxx_messageInfo_FetchSnapshotRequest proto.InternalMessageInfo
 
-func (m *Client) GetId() []byte {
+func (m *FetchSnapshotRequest) GetDocumentKey() *DocumentKey {
 	if m != nil {
-		return m.Id
+		return m.DocumentKey
 	}
 	return nil
 }
 
-func (m *Client) GetMetadata() *Metadata {
+func (m *FetchSnapshotRequest) GetServerSeq() uint64 {
 	if m != nil {
-		return m.Metadata
+		return m.ServerSeq
 	}
-	return nil
+	return 0
 }
 
-type Clients struct {
-	Clients              []*Client `protobuf:"bytes,1,rep,name=clients,proto3" json:"clients,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+func (m *FetchSnapshotRequest) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
 }
 
-func (m *Clients) Reset()         { *m = Clients{} }
-func (m *Clients) String() string { return proto.CompactTextString(m) }
-func (*Clients) ProtoMessage()    {}
-func (*Clients) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{30}
+type FetchSnapshotResponse struct {
+	ServerSeq            uint64   `protobuf:"varint,1,opt,name=server_seq,json=serverSeq,proto3" json:"server_seq,omitempty"`
+	TotalSize            uint64   `protobuf:"varint,2,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	Offset               uint64   `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Chunk                []byte   `protobuf:"bytes,4,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *Clients) XXX_Unmarshal(b []byte) error {
+
+func (m *FetchSnapshotResponse) Reset()         { *m = FetchSnapshotResponse{} }
+func (m *FetchSnapshotResponse) String() string { return proto.CompactTextString(m) }
+func (*FetchSnapshotResponse) ProtoMessage()    {}
+func (*FetchSnapshotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{52}
+}
+func (m *FetchSnapshotResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Clients) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FetchSnapshotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Clients.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FetchSnapshotResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3257,45 +3248,67 @@ func (m *Clients) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Clients) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Clients.Merge(m, src)
+func (m *FetchSnapshotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FetchSnapshotResponse.Merge(m, src)
 }
-func (m *Clients) XXX_Size() int {
+func (m *FetchSnapshotResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *Clients) XXX_DiscardUnknown() {
-	xxx_messageInfo_Clients.DiscardUnknown(m)
+func (m *FetchSnapshotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FetchSnapshotResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Clients proto.InternalMessageInfo
+var xxx_messageInfo_FetchSnapshotResponse proto.InternalMessageInfo
 
-func (m *Clients) GetClients() []*Client {
+func (m *FetchSnapshotResponse) GetServerSeq() uint64 {
 	if m != nil {
-		return m.Clients
+		return m.ServerSeq
+	}
+	return 0
+}
+
+func (m *FetchSnapshotResponse) GetTotalSize() uint64 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+func (m *FetchSnapshotResponse) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *FetchSnapshotResponse) GetChunk() []byte {
+	if m != nil {
+		return m.Chunk
 	}
 	return nil
 }
 
-type DocumentKey struct {
-	Collection           string   `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
-	Document             string   `protobuf:"bytes,2,opt,name=document,proto3" json:"document,omitempty"`
+type JSONPatch struct {
+	Op                   string   `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
+	Path                 string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Value                string   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DocumentKey) Reset()         { *m = DocumentKey{} }
-func (m *DocumentKey) String() string { return proto.CompactTextString(m) }
-func (*DocumentKey) ProtoMessage()    {}
-func (*DocumentKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{31}
+func (m *JSONPatch) Reset()         { *m = JSONPatch{} }
+func (m *JSONPatch) String() string { return proto.CompactTextString(m) }
+func (*JSONPatch) ProtoMessage()    {}
+func (*JSONPatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{53}
 }
-func (m *DocumentKey) XXX_Unmarshal(b []byte) error {
+func (m *JSONPatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DocumentKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JSONPatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DocumentKey.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JSONPatch.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3305,52 +3318,64 @@ func (m *DocumentKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *DocumentKey) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DocumentKey.Merge(m, src)
+func (m *JSONPatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JSONPatch.Merge(m, src)
 }
-func (m *DocumentKey) XXX_Size() int {
+func (m *JSONPatch) XXX_Size() int {
 	return m.Size()
 }
-func (m *DocumentKey) XXX_DiscardUnknown() {
-	xxx_messageInfo_DocumentKey.DiscardUnknown(m)
+func (m *JSONPatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_JSONPatch.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DocumentKey proto.InternalMessageInfo
+var xxx_messageInfo_JSONPatch proto.InternalMessageInfo
 
-func (m *DocumentKey) GetCollection() string {
+func (m *JSONPatch) GetOp() string {
 	if m != nil {
-		return m.Collection
+		return m.Op
 	}
 	return ""
 }
 
-func (m *DocumentKey) GetDocument() string {
+func (m *JSONPatch) GetPath() string {
 	if m != nil {
-		return m.Document
+		return m.Path
 	}
 	return ""
 }
 
-type Checkpoint struct {
-	ServerSeq            uint64   `protobuf:"varint,1,opt,name=server_seq,json=serverSeq,proto3" json:"server_seq,omitempty"`
-	ClientSeq            uint32   `protobuf:"varint,2,opt,name=client_seq,json=clientSeq,proto3" json:"client_seq,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *JSONPatch) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
 }
 
-func (m *Checkpoint) Reset()         { *m = Checkpoint{} }
-func (m *Checkpoint) String() string { return proto.CompactTextString(m) }
-func (*Checkpoint) ProtoMessage()    {}
-func (*Checkpoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{32}
+type ChangePack struct {
+	DocumentKey          *DocumentKey `protobuf:"bytes,1,opt,name=document_key,json=documentKey,proto3" json:"document_key,omitempty"`
+	Checkpoint           *Checkpoint  `protobuf:"bytes,2,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	Snapshot             []byte       `protobuf:"bytes,3,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	Changes              []*Change    `protobuf:"bytes,4,rep,name=changes,proto3" json:"changes,omitempty"`
+	MinSyncedTicket      *TimeTicket  `protobuf:"bytes,5,opt,name=min_synced_ticket,json=minSyncedTicket,proto3" json:"min_synced_ticket,omitempty"`
+	HasMore              bool         `protobuf:"varint,6,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	PreferredRegion      string       `protobuf:"bytes,7,opt,name=preferred_region,json=preferredRegion,proto3" json:"preferred_region,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
-func (m *Checkpoint) XXX_Unmarshal(b []byte) error {
+
+func (m *ChangePack) Reset()         { *m = ChangePack{} }
+func (m *ChangePack) String() string { return proto.CompactTextString(m) }
+func (*ChangePack) ProtoMessage()    {}
+func (*ChangePack) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{54}
+}
+func (m *ChangePack) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Checkpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ChangePack) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Checkpoint.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ChangePack.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3360,53 +3385,89 @@ func (m *Checkpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Checkpoint) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Checkpoint.Merge(m, src)
+func (m *ChangePack) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangePack.Merge(m, src)
 }
-func (m *Checkpoint) XXX_Size() int {
+func (m *ChangePack) XXX_Size() int {
 	return m.Size()
 }
-func (m *Checkpoint) XXX_DiscardUnknown() {
-	xxx_messageInfo_Checkpoint.DiscardUnknown(m)
+func (m *ChangePack) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangePack.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Checkpoint proto.InternalMessageInfo
+var xxx_messageInfo_ChangePack proto.InternalMessageInfo
 
-func (m *Checkpoint) GetServerSeq() uint64 {
+func (m *ChangePack) GetDocumentKey() *DocumentKey {
 	if m != nil {
-		return m.ServerSeq
+		return m.DocumentKey
 	}
-	return 0
+	return nil
 }
 
-func (m *Checkpoint) GetClientSeq() uint32 {
+func (m *ChangePack) GetCheckpoint() *Checkpoint {
 	if m != nil {
-		return m.ClientSeq
+		return m.Checkpoint
 	}
-	return 0
+	return nil
 }
 
-type TextNodePos struct {
-	CreatedAt            *TimeTicket `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Offset               int32       `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	RelativeOffset       int32       `protobuf:"varint,3,opt,name=relative_offset,json=relativeOffset,proto3" json:"relative_offset,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+func (m *ChangePack) GetSnapshot() []byte {
+	if m != nil {
+		return m.Snapshot
+	}
+	return nil
 }
 
-func (m *TextNodePos) Reset()         { *m = TextNodePos{} }
-func (m *TextNodePos) String() string { return proto.CompactTextString(m) }
-func (*TextNodePos) ProtoMessage()    {}
-func (*TextNodePos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{33}
+func (m *ChangePack) GetChanges() []*Change {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
 }
-func (m *TextNodePos) XXX_Unmarshal(b []byte) error {
+
+func (m *ChangePack) GetMinSyncedTicket() *TimeTicket {
+	if m != nil {
+		return m.MinSyncedTicket
+	}
+	return nil
+}
+
+func (m *ChangePack) GetHasMore() bool {
+	if m != nil {
+		return m.HasMore
+	}
+	return false
+}
+
+func (m *ChangePack) GetPreferredRegion() string {
+	if m != nil {
+		return m.PreferredRegion
+	}
+	return ""
+}
+
+type Change struct {
+	Id                   *ChangeID    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Message              string       `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Operations           []*Operation `protobuf:"bytes,3,rep,name=operations,proto3" json:"operations,omitempty"`
+	Signature            []byte       `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *Change) Reset()         { *m = Change{} }
+func (m *Change) String() string { return proto.CompactTextString(m) }
+func (*Change) ProtoMessage()    {}
+func (*Change) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{55}
+}
+func (m *Change) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TextNodePos) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Change) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TextNodePos.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Change.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3416,60 +3477,68 @@ func (m *TextNodePos) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *TextNodePos) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TextNodePos.Merge(m, src)
+func (m *Change) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Change.Merge(m, src)
 }
-func (m *TextNodePos) XXX_Size() int {
+func (m *Change) XXX_Size() int {
 	return m.Size()
 }
-func (m *TextNodePos) XXX_DiscardUnknown() {
-	xxx_messageInfo_TextNodePos.DiscardUnknown(m)
+func (m *Change) XXX_DiscardUnknown() {
+	xxx_messageInfo_Change.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TextNodePos proto.InternalMessageInfo
+var xxx_messageInfo_Change proto.InternalMessageInfo
 
-func (m *TextNodePos) GetCreatedAt() *TimeTicket {
+func (m *Change) GetId() *ChangeID {
 	if m != nil {
-		return m.CreatedAt
+		return m.Id
 	}
 	return nil
 }
 
-func (m *TextNodePos) GetOffset() int32 {
+func (m *Change) GetMessage() string {
 	if m != nil {
-		return m.Offset
+		return m.Message
 	}
-	return 0
+	return ""
 }
 
-func (m *TextNodePos) GetRelativeOffset() int32 {
+func (m *Change) GetOperations() []*Operation {
 	if m != nil {
-		return m.RelativeOffset
+		return m.Operations
 	}
-	return 0
+	return nil
 }
 
-type TimeTicket struct {
-	Lamport              uint64   `protobuf:"varint,1,opt,name=lamport,proto3" json:"lamport,omitempty"`
-	Delimiter            uint32   `protobuf:"varint,2,opt,name=delimiter,proto3" json:"delimiter,omitempty"`
-	ActorId              []byte   `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *Change) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
 }
 
-func (m *TimeTicket) Reset()         { *m = TimeTicket{} }
-func (m *TimeTicket) String() string { return proto.CompactTextString(m) }
-func (*TimeTicket) ProtoMessage()    {}
-func (*TimeTicket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{34}
+type ChangeID struct {
+	ClientSeq            uint32            `protobuf:"varint,1,opt,name=client_seq,json=clientSeq,proto3" json:"client_seq,omitempty"`
+	Lamport              uint64            `protobuf:"varint,2,opt,name=lamport,proto3" json:"lamport,omitempty"`
+	ActorId              []byte            `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Vector               map[string]uint64 `protobuf:"bytes,4,rep,name=vector,proto3" json:"vector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
-func (m *TimeTicket) XXX_Unmarshal(b []byte) error {
+
+func (m *ChangeID) Reset()         { *m = ChangeID{} }
+func (m *ChangeID) String() string { return proto.CompactTextString(m) }
+func (*ChangeID) ProtoMessage()    {}
+func (*ChangeID) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{56}
+}
+func (m *ChangeID) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TimeTicket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ChangeID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TimeTicket.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ChangeID.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3479,60 +3548,75 @@ func (m *TimeTicket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *TimeTicket) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TimeTicket.Merge(m, src)
+func (m *ChangeID) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeID.Merge(m, src)
 }
-func (m *TimeTicket) XXX_Size() int {
+func (m *ChangeID) XXX_Size() int {
 	return m.Size()
 }
-func (m *TimeTicket) XXX_DiscardUnknown() {
-	xxx_messageInfo_TimeTicket.DiscardUnknown(m)
+func (m *ChangeID) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeID.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TimeTicket proto.InternalMessageInfo
+var xxx_messageInfo_ChangeID proto.InternalMessageInfo
 
-func (m *TimeTicket) GetLamport() uint64 {
+func (m *ChangeID) GetClientSeq() uint32 {
 	if m != nil {
-		return m.Lamport
+		return m.ClientSeq
 	}
 	return 0
 }
 
-func (m *TimeTicket) GetDelimiter() uint32 {
+func (m *ChangeID) GetLamport() uint64 {
 	if m != nil {
-		return m.Delimiter
+		return m.Lamport
 	}
 	return 0
 }
 
-func (m *TimeTicket) GetActorId() []byte {
+func (m *ChangeID) GetActorId() []byte {
 	if m != nil {
 		return m.ActorId
 	}
 	return nil
 }
 
-type DocEvent struct {
-	Type                 DocEventType   `protobuf:"varint,1,opt,name=type,proto3,enum=api.DocEventType" json:"type,omitempty"`
-	Publisher            *Client        `protobuf:"bytes,2,opt,name=publisher,proto3" json:"publisher,omitempty"`
-	DocumentKeys         []*DocumentKey `protobuf:"bytes,3,rep,name=document_keys,json=documentKeys,proto3" json:"document_keys,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+func (m *ChangeID) GetVector() map[string]uint64 {
+	if m != nil {
+		return m.Vector
+	}
+	return nil
 }
 
-func (m *DocEvent) Reset()         { *m = DocEvent{} }
-func (m *DocEvent) String() string { return proto.CompactTextString(m) }
-func (*DocEvent) ProtoMessage()    {}
-func (*DocEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9df40050e88fbc16, []int{35}
+type Operation struct {
+	// Types that are valid to be assigned to Body:
+	//	*Operation_Set_
+	//	*Operation_Add_
+	//	*Operation_Move_
+	//	*Operation_Remove_
+	//	*Operation_Edit_
+	//	*Operation_Select_
+	//	*Operation_RichEdit_
+	//	*Operation_Style_
+	//	*Operation_Increase_
+	Body                 isOperation_Body `protobuf_oneof:"body"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
-func (m *DocEvent) XXX_Unmarshal(b []byte) error {
+
+func (m *Operation) Reset()         { *m = Operation{} }
+func (m *Operation) String() string { return proto.CompactTextString(m) }
+func (*Operation) ProtoMessage()    {}
+func (*Operation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{57}
+}
+func (m *Operation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DocEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Operation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DocEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Operation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3542,5475 +3626,16414 @@ func (m *DocEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *DocEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DocEvent.Merge(m, src)
+func (m *Operation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Operation.Merge(m, src)
 }
-func (m *DocEvent) XXX_Size() int {
+func (m *Operation) XXX_Size() int {
 	return m.Size()
 }
-func (m *DocEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_DocEvent.DiscardUnknown(m)
+func (m *Operation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Operation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DocEvent proto.InternalMessageInfo
+var xxx_messageInfo_Operation proto.InternalMessageInfo
 
-func (m *DocEvent) GetType() DocEventType {
+type isOperation_Body interface {
+	isOperation_Body()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type Operation_Set_ struct {
+	Set *Operation_Set `protobuf:"bytes,1,opt,name=set,proto3,oneof" json:"set,omitempty"`
+}
+type Operation_Add_ struct {
+	Add *Operation_Add `protobuf:"bytes,2,opt,name=add,proto3,oneof" json:"add,omitempty"`
+}
+type Operation_Move_ struct {
+	Move *Operation_Move `protobuf:"bytes,3,opt,name=move,proto3,oneof" json:"move,omitempty"`
+}
+type Operation_Remove_ struct {
+	Remove *Operation_Remove `protobuf:"bytes,4,opt,name=remove,proto3,oneof" json:"remove,omitempty"`
+}
+type Operation_Edit_ struct {
+	Edit *Operation_Edit `protobuf:"bytes,5,opt,name=edit,proto3,oneof" json:"edit,omitempty"`
+}
+type Operation_Select_ struct {
+	Select *Operation_Select `protobuf:"bytes,6,opt,name=select,proto3,oneof" json:"select,omitempty"`
+}
+type Operation_RichEdit_ struct {
+	RichEdit *Operation_RichEdit `protobuf:"bytes,7,opt,name=rich_edit,json=richEdit,proto3,oneof" json:"rich_edit,omitempty"`
+}
+type Operation_Style_ struct {
+	Style *Operation_Style `protobuf:"bytes,8,opt,name=style,proto3,oneof" json:"style,omitempty"`
+}
+type Operation_Increase_ struct {
+	Increase *Operation_Increase `protobuf:"bytes,9,opt,name=increase,proto3,oneof" json:"increase,omitempty"`
+}
+
+func (*Operation_Set_) isOperation_Body()      {}
+func (*Operation_Add_) isOperation_Body()      {}
+func (*Operation_Move_) isOperation_Body()     {}
+func (*Operation_Remove_) isOperation_Body()   {}
+func (*Operation_Edit_) isOperation_Body()     {}
+func (*Operation_Select_) isOperation_Body()   {}
+func (*Operation_RichEdit_) isOperation_Body() {}
+func (*Operation_Style_) isOperation_Body()    {}
+func (*Operation_Increase_) isOperation_Body() {}
+
+func (m *Operation) GetBody() isOperation_Body {
 	if m != nil {
-		return m.Type
+		return m.Body
 	}
-	return DocEventType_DOCUMENTS_CHANGED
+	return nil
 }
 
-func (m *DocEvent) GetPublisher() *Client {
-	if m != nil {
-		return m.Publisher
+func (m *Operation) GetSet() *Operation_Set {
+	if x, ok := m.GetBody().(*Operation_Set_); ok {
+		return x.Set
 	}
 	return nil
 }
 
-func (m *DocEvent) GetDocumentKeys() []*DocumentKey {
-	if m != nil {
-		return m.DocumentKeys
+func (m *Operation) GetAdd() *Operation_Add {
+	if x, ok := m.GetBody().(*Operation_Add_); ok {
+		return x.Add
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("api.ValueType", ValueType_name, ValueType_value)
-	proto.RegisterEnum("api.DocEventType", DocEventType_name, DocEventType_value)
-	proto.RegisterType((*BroadcastEventRequest)(nil), "api.BroadcastEventRequest")
-	proto.RegisterType((*BroadcastEventResponse)(nil), "api.BroadcastEventResponse")
-	proto.RegisterType((*ActivateClientRequest)(nil), "api.ActivateClientRequest")
-	proto.RegisterType((*ActivateClientResponse)(nil), "api.ActivateClientResponse")
-	proto.RegisterType((*DeactivateClientRequest)(nil), "api.DeactivateClientRequest")
-	proto.RegisterType((*DeactivateClientResponse)(nil), "api.DeactivateClientResponse")
-	proto.RegisterType((*AttachDocumentRequest)(nil), "api.AttachDocumentRequest")
-	proto.RegisterType((*AttachDocumentResponse)(nil), "api.AttachDocumentResponse")
-	proto.RegisterType((*DetachDocumentRequest)(nil), "api.DetachDocumentRequest")
-	proto.RegisterType((*DetachDocumentResponse)(nil), "api.DetachDocumentResponse")
-	proto.RegisterType((*WatchDocumentsRequest)(nil), "api.WatchDocumentsRequest")
-	proto.RegisterType((*WatchDocumentsResponse)(nil), "api.WatchDocumentsResponse")
-	proto.RegisterType((*WatchDocumentsResponse_Initialization)(nil), "api.WatchDocumentsResponse.Initialization")
-	proto.RegisterMapType((map[string]*Clients)(nil), "api.WatchDocumentsResponse.Initialization.PeersMapByDocEntry")
-	proto.RegisterType((*PushPullRequest)(nil), "api.PushPullRequest")
-	proto.RegisterType((*PushPullResponse)(nil), "api.PushPullResponse")
-	proto.RegisterType((*UpdateMetadataRequest)(nil), "api.UpdateMetadataRequest")
-	proto.RegisterType((*UpdateMetadataResponse)(nil), "api.UpdateMetadataResponse")
-	proto.RegisterType((*ChangePack)(nil), "api.ChangePack")
-	proto.RegisterType((*Change)(nil), "api.Change")
-	proto.RegisterType((*ChangeID)(nil), "api.ChangeID")
-	proto.RegisterType((*Operation)(nil), "api.Operation")
-	proto.RegisterType((*Operation_Set)(nil), "api.Operation.Set")
-	proto.RegisterType((*Operation_Add)(nil), "api.Operation.Add")
-	proto.RegisterType((*Operation_Move)(nil), "api.Operation.Move")
-	proto.RegisterType((*Operation_Remove)(nil), "api.Operation.Remove")
-	proto.RegisterType((*Operation_Edit)(nil), "api.Operation.Edit")
-	proto.RegisterMapType((map[string]*TimeTicket)(nil), "api.Operation.Edit.CreatedAtMapByActorEntry")
-	proto.RegisterType((*Operation_Select)(nil), "api.Operation.Select")
-	proto.RegisterType((*Operation_RichEdit)(nil), "api.Operation.RichEdit")
-	proto.RegisterMapType((map[string]string)(nil), "api.Operation.RichEdit.AttributesEntry")
-	proto.RegisterMapType((map[string]*TimeTicket)(nil), "api.Operation.RichEdit.CreatedAtMapByActorEntry")
-	proto.RegisterType((*Operation_Style)(nil), "api.Operation.Style")
-	proto.RegisterMapType((map[string]string)(nil), "api.Operation.Style.AttributesEntry")
-	proto.RegisterType((*Operation_Increase)(nil), "api.Operation.Increase")
-	proto.RegisterType((*JSONElementSimple)(nil), "api.JSONElementSimple")
-	proto.RegisterType((*JSONElement)(nil), "api.JSONElement")
-	proto.RegisterType((*JSONElement_JSONObject)(nil), "api.JSONElement.JSONObject")
-	proto.RegisterType((*JSONElement_JSONArray)(nil), "api.JSONElement.JSONArray")
-	proto.RegisterType((*JSONElement_Primitive)(nil), "api.JSONElement.Primitive")
-	proto.RegisterType((*JSONElement_Text)(nil), "api.JSONElement.Text")
-	proto.RegisterType((*JSONElement_RichText)(nil), "api.JSONElement.RichText")
-	proto.RegisterType((*JSONElement_Counter)(nil), "api.JSONElement.Counter")
-	proto.RegisterType((*RHTNode)(nil), "api.RHTNode")
-	proto.RegisterType((*RGANode)(nil), "api.RGANode")
-	proto.RegisterType((*TextNode)(nil), "api.TextNode")
-	proto.RegisterType((*RichTextNodeAttr)(nil), "api.RichTextNodeAttr")
-	proto.RegisterType((*RichTextNode)(nil), "api.RichTextNode")
-	proto.RegisterMapType((map[string]*RichTextNodeAttr)(nil), "api.RichTextNode.AttributesEntry")
-	proto.RegisterType((*TextNodeID)(nil), "api.TextNodeID")
-	proto.RegisterType((*Metadata)(nil), "api.Metadata")
-	proto.RegisterMapType((map[string]string)(nil), "api.Metadata.DataEntry")
-	proto.RegisterType((*Client)(nil), "api.Client")
-	proto.RegisterType((*Clients)(nil), "api.Clients")
-	proto.RegisterType((*DocumentKey)(nil), "api.DocumentKey")
-	proto.RegisterType((*Checkpoint)(nil), "api.Checkpoint")
-	proto.RegisterType((*TextNodePos)(nil), "api.TextNodePos")
-	proto.RegisterType((*TimeTicket)(nil), "api.TimeTicket")
-	proto.RegisterType((*DocEvent)(nil), "api.DocEvent")
+func (m *Operation) GetMove() *Operation_Move {
+	if x, ok := m.GetBody().(*Operation_Move_); ok {
+		return x.Move
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("api/yorkie.proto", fileDescriptor_9df40050e88fbc16) }
+func (m *Operation) GetRemove() *Operation_Remove {
+	if x, ok := m.GetBody().(*Operation_Remove_); ok {
+		return x.Remove
+	}
+	return nil
+}
 
-var fileDescriptor_9df40050e88fbc16 = []byte{
-	// 2425 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x1a, 0x4d, 0x6f, 0xdb, 0xc8,
-	0x55, 0xd4, 0x37, 0x9f, 0x64, 0x99, 0x99, 0xc4, 0x8e, 0x56, 0xf9, 0x68, 0xc2, 0x6c, 0x9a, 0x8f,
-	0x0d, 0x9c, 0xc0, 0xdb, 0x4d, 0xb6, 0xbb, 0xd8, 0x02, 0xb4, 0x24, 0x58, 0xda, 0xc4, 0xb2, 0x4b,
-	0x29, 0x9b, 0xe6, 0xa4, 0xd2, 0xe4, 0x38, 0x66, 0x2c, 0x89, 0x0c, 0x49, 0x19, 0x51, 0x0f, 0x3d,
-	0xf6, 0xd0, 0x6b, 0x7b, 0x68, 0xaf, 0x45, 0x81, 0xfd, 0x03, 0x05, 0x7a, 0x68, 0x81, 0x1c, 0x7a,
-	0xc9, 0x6d, 0xdb, 0x63, 0x51, 0xa0, 0x28, 0xd2, 0x4b, 0xcf, 0xfd, 0x05, 0xc5, 0x7c, 0x90, 0x22,
-	0x29, 0xda, 0xb2, 0x9a, 0xcd, 0xae, 0xb1, 0x37, 0xce, 0xbc, 0xef, 0x79, 0x6f, 0xde, 0x7b, 0x9c,
-	0x19, 0x90, 0x34, 0xdb, 0xbc, 0x3b, 0xb1, 0x9c, 0x03, 0x13, 0xaf, 0xd9, 0x8e, 0xe5, 0x59, 0x28,
-	0xa3, 0xd9, 0xa6, 0xdc, 0x87, 0x95, 0x0d, 0xc7, 0xd2, 0x0c, 0x5d, 0x73, 0xbd, 0xe6, 0x21, 0x1e,
-	0x79, 0x2a, 0x7e, 0x31, 0xc6, 0xae, 0x87, 0xae, 0x42, 0xd9, 0x1e, 0xef, 0x0e, 0x4c, 0x77, 0x1f,
-	0x3b, 0x7d, 0xd3, 0xa8, 0x0a, 0x57, 0x84, 0x9b, 0x65, 0xb5, 0x14, 0xcc, 0xb5, 0x0d, 0x74, 0x0d,
-	0x72, 0x98, 0x90, 0x54, 0xd3, 0x57, 0x84, 0x9b, 0xa5, 0xf5, 0xa5, 0x35, 0xcd, 0x36, 0xd7, 0x1a,
-	0x96, 0xce, 0xf8, 0x30, 0x98, 0x5c, 0x85, 0xd5, 0xb8, 0x00, 0xd7, 0xb6, 0x46, 0x2e, 0x96, 0xef,
-	0xc3, 0x8a, 0xa2, 0x7b, 0xe6, 0xa1, 0xe6, 0xe1, 0xfa, 0xc0, 0x0c, 0x89, 0xbe, 0x04, 0xa0, 0xd3,
-	0x89, 0xfe, 0x01, 0x9e, 0x50, 0xc1, 0xa2, 0x2a, 0xb2, 0x99, 0x87, 0x78, 0x22, 0xf7, 0x60, 0x35,
-	0x4e, 0xc7, 0x38, 0xce, 0x21, 0x44, 0x17, 0x80, 0x0f, 0x88, 0x3d, 0x69, 0x6a, 0x4f, 0x91, 0x4d,
-	0xb4, 0x0d, 0xf9, 0x3e, 0x9c, 0x6f, 0x60, 0x2d, 0x51, 0x9f, 0x08, 0x9d, 0x10, 0xa3, 0x7b, 0x00,
-	0xd5, 0x59, 0x3a, 0xae, 0xcf, 0xb1, 0x84, 0x7b, 0xb0, 0xa2, 0x78, 0x9e, 0xa6, 0xef, 0x37, 0x2c,
-	0x7d, 0x3c, 0x3c, 0xa1, 0x38, 0x74, 0x0f, 0x4a, 0xfa, 0xbe, 0x36, 0x7a, 0x86, 0xfb, 0xb6, 0xa6,
-	0x1f, 0xf0, 0x95, 0x5f, 0xa6, 0x2b, 0x5f, 0xa7, 0xf3, 0x3b, 0x9a, 0x7e, 0xa0, 0x82, 0x1e, 0x7c,
-	0xcb, 0xcf, 0x60, 0x35, 0x2e, 0xe7, 0x04, 0xea, 0xfd, 0x1f, 0x82, 0xf6, 0x60, 0xa5, 0x81, 0xbf,
-	0x01, 0x83, 0x4c, 0x58, 0x8d, 0xcb, 0x39, 0x99, 0xff, 0x17, 0x17, 0xe5, 0xc2, 0xca, 0x13, 0xcd,
-	0x9b, 0x4a, 0x72, 0x7d, 0x93, 0xae, 0x41, 0x9e, 0xf1, 0xa5, 0x52, 0x4a, 0xeb, 0x25, 0xc6, 0x85,
-	0xb9, 0x9f, 0x83, 0xd0, 0x47, 0xb0, 0x64, 0x70, 0x42, 0xa2, 0x90, 0x5b, 0x4d, 0x5f, 0xc9, 0xdc,
-	0x2c, 0xad, 0x4b, 0xfe, 0x3e, 0xa1, 0x90, 0x87, 0x78, 0xa2, 0x96, 0x8d, 0xe9, 0xc0, 0x95, 0xff,
-	0x93, 0x86, 0xd5, 0xb8, 0x54, 0x6e, 0x60, 0x0f, 0x2a, 0xe6, 0xc8, 0xf4, 0x4c, 0x6d, 0x60, 0xfe,
-	0x4c, 0xf3, 0x4c, 0x6b, 0xc4, 0xc5, 0xdf, 0xa6, 0x2c, 0x93, 0x89, 0xd6, 0xda, 0x11, 0x8a, 0x56,
-	0x4a, 0x8d, 0xf1, 0x40, 0xd7, 0x8f, 0xdb, 0xc7, 0xad, 0x14, 0xdf, 0xc9, 0xb5, 0xd7, 0x02, 0x54,
-	0xa2, 0xbc, 0xd0, 0x1e, 0x48, 0x36, 0xc6, 0x8e, 0xdb, 0x1f, 0x6a, 0x76, 0x7f, 0x77, 0xd2, 0x37,
-	0x2c, 0xbd, 0x2a, 0x50, 0x23, 0x3f, 0x3b, 0xb9, 0x46, 0x6b, 0x3b, 0x84, 0xc5, 0x96, 0x66, 0x6f,
-	0x4c, 0x88, 0xd0, 0x91, 0xe7, 0x4c, 0xd4, 0x25, 0x3b, 0x3c, 0x57, 0xeb, 0x00, 0x9a, 0x45, 0x42,
-	0x12, 0x64, 0xa6, 0x7e, 0x26, 0x9f, 0x48, 0x86, 0xdc, 0xa1, 0x36, 0x18, 0x63, 0x6e, 0x49, 0x39,
-	0xe4, 0x15, 0x57, 0x65, 0xa0, 0x4f, 0xd2, 0x1f, 0x0b, 0x1b, 0x79, 0xc8, 0xee, 0x5a, 0xc6, 0x44,
-	0xfe, 0x29, 0x2c, 0xef, 0x8c, 0xdd, 0xfd, 0x9d, 0xf1, 0x60, 0xf0, 0x8e, 0x82, 0x55, 0x03, 0x69,
-	0x2a, 0xe1, 0xdd, 0xec, 0x3b, 0x17, 0x56, 0x1e, 0xdb, 0x86, 0xe6, 0xe1, 0x2d, 0xec, 0x69, 0x86,
-	0xe6, 0x69, 0xdf, 0x44, 0x90, 0x56, 0x61, 0x35, 0x2e, 0x94, 0xa7, 0xf5, 0xff, 0x0a, 0x00, 0x53,
-	0x4d, 0xd1, 0x87, 0x50, 0x0e, 0xf3, 0xe7, 0xaa, 0xcc, 0xb2, 0x2f, 0x85, 0xd8, 0xa3, 0xbb, 0x00,
-	0xfa, 0x3e, 0xd6, 0x0f, 0x6c, 0xcb, 0x0c, 0xc2, 0xd2, 0x5f, 0x03, 0x7f, 0x5a, 0x0d, 0xa1, 0xa0,
-	0x1a, 0x14, 0xdd, 0x91, 0x66, 0xbb, 0xfb, 0x96, 0x57, 0xcd, 0xb0, 0x15, 0xf5, 0xc7, 0xe8, 0x3a,
-	0x14, 0xd8, 0x6a, 0xb9, 0xd5, 0x2c, 0xb5, 0xad, 0x14, 0x5a, 0x4d, 0xd5, 0x87, 0xa1, 0x4f, 0xe1,
-	0xcc, 0xd0, 0x1c, 0xf5, 0xdd, 0xc9, 0x48, 0xc7, 0x46, 0xdf, 0x33, 0xf5, 0x03, 0xec, 0x55, 0x73,
-	0x21, 0xd1, 0x3d, 0x73, 0x88, 0x7b, 0x74, 0x5a, 0x5d, 0x1e, 0x9a, 0xa3, 0x2e, 0x45, 0x64, 0x13,
-	0xf2, 0x0b, 0xc8, 0x33, 0x7e, 0xe8, 0x12, 0xa4, 0xb9, 0x57, 0xfd, 0x9d, 0xc4, 0x00, 0xed, 0x86,
-	0x9a, 0x36, 0x0d, 0x54, 0x85, 0xc2, 0x10, 0xbb, 0xae, 0xf6, 0x8c, 0xc5, 0xa8, 0xa8, 0xfa, 0x43,
-	0xb4, 0x06, 0x60, 0xd9, 0xd8, 0xa1, 0x5b, 0xc2, 0xad, 0x66, 0xa8, 0xa6, 0x15, 0xca, 0x60, 0xdb,
-	0x9f, 0x56, 0x43, 0x18, 0xf2, 0x2e, 0x14, 0x7d, 0xce, 0xa1, 0xc4, 0xe7, 0xe2, 0x17, 0x54, 0xf8,
-	0x92, 0x9f, 0xf8, 0xba, 0xf8, 0x05, 0xba, 0x08, 0x85, 0x81, 0x36, 0xb4, 0x2d, 0x87, 0xad, 0x65,
-	0x76, 0x23, 0x7d, 0x4f, 0x50, 0xfd, 0x29, 0xf4, 0x1e, 0x14, 0x35, 0xdd, 0xb3, 0x68, 0x95, 0x67,
-	0x6b, 0x57, 0xa0, 0xe3, 0xb6, 0x21, 0xbf, 0x5e, 0x05, 0x31, 0x90, 0x8e, 0xbe, 0x0f, 0x19, 0x17,
-	0xfb, 0xc1, 0x84, 0xa2, 0xaa, 0xad, 0x75, 0x31, 0x49, 0x15, 0x04, 0x81, 0xe0, 0x69, 0x86, 0xc1,
-	0xdd, 0x16, 0xc7, 0x53, 0x0c, 0x83, 0xe0, 0x69, 0x86, 0x81, 0x6e, 0x41, 0x76, 0x68, 0x1d, 0x62,
-	0x2a, 0xb4, 0xb4, 0x7e, 0x36, 0x86, 0xb8, 0x65, 0x1d, 0xe2, 0x56, 0x4a, 0xa5, 0x28, 0xe8, 0x2e,
-	0xe4, 0x1d, 0x4c, 0x91, 0xb3, 0x14, 0x79, 0x25, 0x86, 0xac, 0x52, 0x60, 0x2b, 0xa5, 0x72, 0x34,
-	0xc2, 0x1b, 0x1b, 0xa6, 0xef, 0xc0, 0x38, 0xef, 0xa6, 0x61, 0x12, 0x6d, 0x29, 0x0a, 0xe1, 0xed,
-	0xe2, 0x01, 0xd6, 0xbd, 0x6a, 0x3e, 0x91, 0x77, 0x97, 0x02, 0x09, 0x6f, 0x86, 0x86, 0xee, 0x83,
-	0xe8, 0x98, 0xfa, 0x7e, 0x9f, 0x0a, 0x28, 0x50, 0x9a, 0xf3, 0x71, 0x7d, 0x4c, 0x7d, 0x9f, 0x0b,
-	0x29, 0x3a, 0xfc, 0x1b, 0xdd, 0x81, 0x9c, 0xeb, 0x4d, 0x06, 0xb8, 0x5a, 0xa4, 0x34, 0xe7, 0xe2,
-	0x72, 0x08, 0x8c, 0xa4, 0x5b, 0x8a, 0x84, 0x3e, 0x82, 0xa2, 0x39, 0xd2, 0x1d, 0xac, 0xb9, 0xb8,
-	0x2a, 0x26, 0x0a, 0x69, 0x73, 0x30, 0x11, 0xe2, 0xa3, 0xd6, 0xfe, 0x20, 0x40, 0xa6, 0x8b, 0x3d,
-	0x12, 0xce, 0xb6, 0xe6, 0x90, 0x90, 0x20, 0x00, 0x0f, 0x1b, 0x7d, 0xcd, 0x77, 0xdd, 0x6c, 0x38,
-	0x33, 0xcc, 0x3a, 0x43, 0x54, 0x3c, 0x3f, 0xb3, 0xa6, 0xa7, 0x99, 0xf5, 0x8e, 0x9f, 0x59, 0x99,
-	0xb3, 0x56, 0x29, 0x8b, 0xcf, 0xbb, 0xdb, 0x9d, 0xe6, 0x00, 0x93, 0x5d, 0xdb, 0x35, 0x87, 0xf6,
-	0x00, 0xf3, 0x1c, 0x4b, 0x92, 0x18, 0x7e, 0x89, 0xf5, 0x31, 0x17, 0x9b, 0x4d, 0x16, 0x0b, 0x3e,
-	0x8e, 0xe2, 0xd5, 0xfe, 0x21, 0x40, 0x46, 0x31, 0x8c, 0xb7, 0x53, 0xfb, 0x01, 0x2c, 0xdb, 0x0e,
-	0x3e, 0x0c, 0x93, 0xa6, 0x93, 0x49, 0x97, 0x08, 0xde, 0x94, 0xf0, 0x5d, 0x5b, 0xf7, 0x4f, 0x01,
-	0xb2, 0x24, 0x9e, 0xbf, 0x25, 0xf3, 0xd6, 0x00, 0x42, 0x34, 0x99, 0x64, 0x1a, 0x51, 0x0f, 0xf0,
-	0x17, 0x37, 0xf0, 0x4b, 0x01, 0xf2, 0x6c, 0x0f, 0xbe, 0x9d, 0x89, 0x51, 0x4d, 0xd3, 0x8b, 0x6a,
-	0x9a, 0x99, 0xaf, 0xe9, 0xaf, 0x33, 0x90, 0xa5, 0xbb, 0xf1, 0xad, 0xf4, 0x7c, 0x1f, 0xb2, 0x7b,
-	0x8e, 0x35, 0xe4, 0x1a, 0xb2, 0x6a, 0xd6, 0xc3, 0x2f, 0xbd, 0x8e, 0x65, 0xe0, 0x1d, 0xcb, 0x55,
-	0x29, 0x14, 0x5d, 0x81, 0xb4, 0x67, 0x71, 0xa5, 0x66, 0x71, 0xd2, 0x9e, 0x85, 0x76, 0xe1, 0xfc,
-	0x54, 0xba, 0xdf, 0x45, 0xd1, 0xec, 0xcb, 0x6b, 0xd5, 0x9d, 0x84, 0xcc, 0xb5, 0x16, 0xe8, 0x41,
-	0xfb, 0x21, 0x85, 0xa0, 0xb3, 0xb6, 0xe9, 0xac, 0x3e, 0x0b, 0x21, 0x25, 0x47, 0xb7, 0x46, 0x1e,
-	0xe9, 0x03, 0x72, 0xac, 0xe4, 0xf0, 0x61, 0x7c, 0xf5, 0xf2, 0xf3, 0x57, 0xef, 0x09, 0x54, 0x8f,
-	0x12, 0x9e, 0xd0, 0x8e, 0x5d, 0x8f, 0xb6, 0x63, 0x33, 0x9c, 0xa7, 0x1d, 0x59, 0xed, 0x95, 0x00,
-	0x79, 0x96, 0x68, 0x4f, 0x87, 0x63, 0x16, 0xdf, 0x02, 0xbf, 0xcf, 0x42, 0xd1, 0x4f, 0xfb, 0xa7,
-	0xc3, 0x86, 0xbd, 0x79, 0xc1, 0x75, 0xef, 0x88, 0xaa, 0xf5, 0xb5, 0x05, 0xd8, 0x26, 0x80, 0xe6,
-	0x79, 0x8e, 0xb9, 0x3b, 0xf6, 0xb0, 0x5b, 0xcd, 0x53, 0xa1, 0x37, 0x8e, 0x12, 0xaa, 0x04, 0x98,
-	0x4c, 0x56, 0x88, 0x34, 0xee, 0x8e, 0xc2, 0xb7, 0x18, 0xa9, 0x9f, 0xc1, 0x72, 0x4c, 0xd3, 0x04,
-	0x7e, 0xe7, 0xc2, 0xfc, 0xc4, 0x30, 0xf9, 0x5f, 0xd2, 0x90, 0xa3, 0x95, 0xfe, 0x74, 0xc4, 0x48,
-	0x23, 0xe2, 0x21, 0x16, 0x16, 0xef, 0x27, 0x35, 0x26, 0x8b, 0xb8, 0x27, 0x37, 0xdf, 0x3d, 0x6f,
-	0xb9, 0x8a, 0x5f, 0x0a, 0x50, 0xf4, 0xdb, 0x9f, 0xb7, 0x5b, 0xc8, 0x3b, 0x51, 0xcf, 0x2f, 0x56,
-	0xfa, 0xe7, 0xd7, 0x9b, 0xe0, 0x57, 0xf3, 0xef, 0x02, 0x9c, 0x99, 0x61, 0x1b, 0xab, 0x77, 0xc2,
-	0xdc, 0x7a, 0x77, 0x1b, 0x8a, 0xa4, 0xc8, 0x1e, 0x57, 0x1d, 0x0b, 0x14, 0x81, 0xd5, 0x52, 0xd6,
-	0x0c, 0x1f, 0x5b, 0xf5, 0x39, 0x8a, 0xe2, 0x21, 0x19, 0xb2, 0xde, 0xc4, 0x66, 0x1d, 0x76, 0x85,
-	0xff, 0x7a, 0x7c, 0x41, 0xac, 0xee, 0x4d, 0x6c, 0xac, 0x52, 0xd8, 0xd4, 0x23, 0x39, 0xfa, 0xa3,
-	0xc0, 0x06, 0xf2, 0x2f, 0xcb, 0x50, 0x0a, 0xd9, 0x86, 0x7e, 0x04, 0xa5, 0xe7, 0xae, 0x35, 0xea,
-	0x5b, 0xbb, 0xcf, 0x49, 0x5b, 0xcd, 0xcc, 0xba, 0x10, 0x5f, 0x59, 0xfa, 0xbd, 0x4d, 0x51, 0x5a,
-	0x29, 0x15, 0x08, 0x05, 0x1b, 0xa1, 0x4f, 0x81, 0x8e, 0xfa, 0x9a, 0xe3, 0x68, 0x13, 0x6e, 0x67,
-	0x2d, 0x91, 0x5c, 0x21, 0x18, 0xad, 0x94, 0x2a, 0x12, 0x7c, 0x3a, 0x40, 0x9f, 0x80, 0x68, 0x3b,
-	0xe6, 0xd0, 0xf4, 0xcc, 0xe0, 0xd7, 0x62, 0x96, 0x76, 0xc7, 0xc7, 0x20, 0xb4, 0x01, 0x3a, 0xfa,
-	0x00, 0xb2, 0x1e, 0x7e, 0xe9, 0x45, 0x7e, 0x32, 0xc2, 0x64, 0x64, 0xf7, 0x90, 0xff, 0x06, 0x82,
-	0x84, 0x3e, 0xe6, 0xbf, 0x01, 0x94, 0x82, 0x85, 0xfc, 0x7b, 0x33, 0x14, 0x24, 0xbb, 0x71, 0x2a,
-	0xfa, 0x23, 0x40, 0xbe, 0xd1, 0x0f, 0x48, 0xc2, 0x1c, 0x8f, 0x3c, 0xec, 0xf0, 0x9a, 0x5b, 0x9d,
-	0xa1, 0xab, 0x33, 0x78, 0x2b, 0xa5, 0xfa, 0xa8, 0xb5, 0x3f, 0x0b, 0x00, 0xd3, 0x25, 0x43, 0x32,
-	0xe4, 0x46, 0x96, 0x81, 0x5d, 0x7e, 0xe0, 0xc2, 0xce, 0x3a, 0xd4, 0x56, 0x8f, 0xec, 0x6e, 0x95,
-	0x81, 0x16, 0x6e, 0xa7, 0xc2, 0xe1, 0x95, 0x59, 0x28, 0xbc, 0xb2, 0xf3, 0xc2, 0xab, 0xf6, 0x27,
-	0x01, 0xc4, 0xc0, 0x65, 0x47, 0x68, 0xbf, 0xa9, 0x9c, 0x56, 0xed, 0xff, 0x26, 0x80, 0x18, 0x04,
-	0x4d, 0xb0, 0x55, 0x84, 0x93, 0x6c, 0x95, 0x74, 0x68, 0xab, 0x2c, 0xdc, 0x8a, 0x87, 0x6d, 0xca,
-	0x2e, 0x64, 0x53, 0x6e, 0xae, 0x4d, 0x7f, 0x14, 0x20, 0x4b, 0xe3, 0xf1, 0x5a, 0xd4, 0x19, 0x4b,
-	0x91, 0x4a, 0x71, 0x1a, 0xbd, 0xf1, 0x4a, 0x60, 0xbd, 0x16, 0xd5, 0xfe, 0x46, 0x54, 0xfb, 0x33,
-	0x2c, 0x94, 0x38, 0xf4, 0xb4, 0x5a, 0xf0, 0x95, 0x00, 0x05, 0xbe, 0xc7, 0xbf, 0x1b, 0xd1, 0x44,
-	0x0a, 0xdd, 0x06, 0x29, 0x74, 0x9b, 0x50, 0xe0, 0x59, 0x28, 0xa1, 0xa2, 0xdf, 0x86, 0x02, 0x66,
-	0x19, 0x2e, 0xd2, 0xb9, 0x84, 0x32, 0x9f, 0xea, 0x23, 0xc8, 0x4f, 0xa0, 0xc0, 0x13, 0x02, 0xba,
-	0x02, 0xd9, 0x11, 0xc9, 0xb2, 0x42, 0xe8, 0x58, 0xd7, 0x4f, 0x16, 0x14, 0xb2, 0x10, 0xe3, 0xdf,
-	0x09, 0x50, 0xf4, 0x63, 0x03, 0x7d, 0x2f, 0x74, 0x5e, 0xb7, 0x1c, 0x09, 0x7c, 0x7e, 0x62, 0x97,
-	0xd8, 0x84, 0x2c, 0x5c, 0x5c, 0xef, 0x42, 0xc9, 0x1c, 0xb9, 0x7d, 0xfa, 0xff, 0x6e, 0x1a, 0xd1,
-	0xc5, 0x9f, 0xca, 0x13, 0xcd, 0x91, 0xbb, 0xe3, 0xe0, 0xc3, 0xb6, 0x21, 0x3f, 0x07, 0x29, 0x1c,
-	0xc3, 0xa4, 0x59, 0x3a, 0x69, 0x87, 0x44, 0x94, 0x1b, 0xd3, 0xc3, 0xd9, 0x63, 0x95, 0xe3, 0x28,
-	0x8a, 0x27, 0xbf, 0x4a, 0x43, 0x39, 0x2c, 0x6c, 0xfe, 0xa2, 0x28, 0x91, 0xb6, 0x91, 0x1d, 0x19,
-	0x5f, 0x9d, 0xd9, 0x78, 0xc7, 0xf6, 0x8c, 0xe7, 0xc2, 0x67, 0x2e, 0x47, 0xac, 0x6b, 0x76, 0xd1,
-	0x75, 0xcd, 0xcd, 0x5b, 0xd7, 0x5a, 0xef, 0x24, 0x8d, 0xe7, 0x07, 0xd1, 0xa6, 0x70, 0x65, 0xc6,
-	0x32, 0xc2, 0x22, 0xd4, 0x8f, 0xca, 0x3d, 0x80, 0xa9, 0xb8, 0x85, 0xbb, 0xba, 0x55, 0xc8, 0x5b,
-	0x7b, 0x7b, 0x2e, 0x66, 0xb1, 0x9b, 0x53, 0xf9, 0x48, 0xfe, 0x85, 0x00, 0x45, 0xff, 0x7c, 0x9d,
-	0xac, 0x97, 0x3e, 0xb0, 0xf4, 0x03, 0xca, 0x2f, 0xa7, 0xb2, 0x01, 0xe9, 0x58, 0x08, 0x94, 0xbb,
-	0x80, 0x9d, 0x10, 0xfa, 0x24, 0x6b, 0x0d, 0xcd, 0xd3, 0xd8, 0xc2, 0x53, 0xa4, 0xda, 0x03, 0x10,
-	0x83, 0xa9, 0x45, 0xda, 0x6d, 0xb9, 0x0e, 0x79, 0x76, 0x6d, 0x80, 0x2a, 0x41, 0x64, 0x94, 0x69,
-	0x20, 0xdc, 0x82, 0xe2, 0x90, 0x8b, 0x8b, 0x5c, 0x1f, 0x05, 0xd7, 0x02, 0x01, 0x58, 0xbe, 0x07,
-	0x05, 0x7e, 0x15, 0x43, 0x8f, 0xe4, 0xd9, 0x27, 0x4f, 0xda, 0x91, 0xab, 0x09, 0x1f, 0x26, 0xb7,
-	0xa1, 0x14, 0xba, 0x22, 0x40, 0x97, 0x01, 0x74, 0x6b, 0x30, 0xc0, 0x7a, 0x70, 0xf3, 0x25, 0xaa,
-	0xa1, 0x19, 0x54, 0x83, 0xa2, 0x7f, 0x89, 0xc0, 0x4d, 0x08, 0xc6, 0x72, 0x07, 0x60, 0x7a, 0x75,
-	0x80, 0xae, 0x02, 0xb8, 0xd8, 0x39, 0xc4, 0x4e, 0x70, 0x5e, 0xce, 0xce, 0xc4, 0x45, 0x36, 0xdb,
-	0xc5, 0x2f, 0x62, 0x47, 0xea, 0xe9, 0xd8, 0x91, 0xba, 0xfc, 0x73, 0x28, 0x85, 0x7e, 0xa5, 0xbe,
-	0x2e, 0x8f, 0xa3, 0x1b, 0xb0, 0xec, 0xe0, 0x81, 0x46, 0x9a, 0x8c, 0x3e, 0x47, 0xc8, 0x50, 0x84,
-	0x8a, 0x3f, 0xbd, 0xcd, 0x42, 0x43, 0x07, 0x98, 0x72, 0x0e, 0x1f, 0xf0, 0x0b, 0xb3, 0x07, 0xfc,
-	0x17, 0x41, 0x34, 0xf0, 0x80, 0xf4, 0x2e, 0xd8, 0xf1, 0x2d, 0x09, 0x26, 0x8e, 0x3b, 0xfe, 0xff,
-	0x95, 0x00, 0x45, 0xff, 0x1e, 0x10, 0x5d, 0x8f, 0x54, 0xa9, 0x33, 0x91, 0x4b, 0xc2, 0x50, 0xa1,
-	0xba, 0x05, 0x62, 0xf0, 0x46, 0x80, 0x47, 0x44, 0xc4, 0xb9, 0x53, 0xe8, 0xec, 0xd5, 0x53, 0xe6,
-	0x24, 0x57, 0x4f, 0xb7, 0xbf, 0x12, 0x40, 0x0c, 0xca, 0x23, 0x2a, 0x42, 0xb6, 0xf3, 0xf8, 0xd1,
-	0x23, 0x29, 0x85, 0x4a, 0x50, 0xd8, 0xd8, 0xde, 0x7e, 0xd4, 0x54, 0x3a, 0x92, 0x40, 0x06, 0xed,
-	0x4e, 0xaf, 0xb9, 0xd9, 0x54, 0xa5, 0x34, 0xc1, 0x79, 0xb4, 0xdd, 0xd9, 0x94, 0x32, 0x08, 0x20,
-	0xdf, 0xd8, 0x7e, 0xbc, 0xf1, 0xa8, 0x29, 0x65, 0xc9, 0x77, 0xb7, 0xa7, 0xb6, 0x3b, 0x9b, 0x52,
-	0x0e, 0x89, 0x90, 0xdb, 0x78, 0xda, 0x6b, 0x76, 0xa5, 0x3c, 0x41, 0x6e, 0x28, 0xbd, 0xa6, 0x54,
-	0x40, 0xcb, 0xec, 0xaf, 0xa6, 0xbf, 0xbd, 0xf1, 0x79, 0xb3, 0xde, 0x93, 0x8a, 0xa8, 0xc2, 0x1a,
-	0xf0, 0xbe, 0xa2, 0xaa, 0xca, 0x53, 0x49, 0x24, 0xa8, 0xbd, 0xe6, 0x4f, 0x7a, 0x12, 0xa0, 0x25,
-	0x10, 0xd5, 0x76, 0xbd, 0xd5, 0xa7, 0xc3, 0x12, 0xa1, 0xe4, 0xd2, 0xfb, 0xf5, 0x4e, 0x4f, 0x2a,
-	0xa3, 0x32, 0x14, 0x89, 0x06, 0x74, 0xb4, 0x44, 0xf8, 0x30, 0x2d, 0xe8, 0xb8, 0x72, 0xfb, 0x00,
-	0xca, 0xe1, 0x95, 0x44, 0x2b, 0x70, 0xa6, 0xb1, 0x5d, 0x7f, 0xbc, 0xd5, 0xec, 0xf4, 0xba, 0xfd,
-	0x7a, 0x4b, 0xe9, 0x6c, 0x36, 0x1b, 0x52, 0x2a, 0x3a, 0xfd, 0x44, 0xe9, 0xd5, 0x5b, 0xcd, 0x86,
-	0x24, 0xa0, 0xf3, 0x70, 0x76, 0x3a, 0xfd, 0xb8, 0xe3, 0x03, 0xd2, 0xe8, 0x1c, 0x48, 0x5b, 0xcd,
-	0x9e, 0xd2, 0x50, 0x7a, 0x4a, 0xc0, 0x25, 0xb3, 0xfe, 0xdb, 0x2c, 0xe4, 0x9f, 0xd2, 0x77, 0x20,
-	0xe8, 0x21, 0x54, 0xa2, 0x2f, 0x29, 0x10, 0xfb, 0x53, 0x4a, 0x7c, 0x96, 0x51, 0xbb, 0x90, 0x08,
-	0xe3, 0xb7, 0x7e, 0x29, 0xf4, 0x63, 0x90, 0xe2, 0x0f, 0x21, 0xd0, 0x45, 0xe6, 0xca, 0xe4, 0x77,
-	0x15, 0xb5, 0x4b, 0x47, 0x40, 0x03, 0x96, 0x44, 0xbf, 0xc8, 0xd3, 0x05, 0x5f, 0xbf, 0xa4, 0x77,
-	0x13, 0xbe, 0x7e, 0x89, 0x6f, 0x1d, 0x18, 0xb3, 0xe8, 0xb3, 0x01, 0xce, 0x2c, 0xf1, 0xcd, 0x02,
-	0x67, 0x96, 0xfc, 0xce, 0x40, 0x4e, 0xa1, 0x2d, 0xa8, 0x44, 0xef, 0xb6, 0x39, 0xb3, 0xc4, 0xd7,
-	0x02, 0x9c, 0x59, 0xf2, 0x65, 0xb8, 0x9c, 0xba, 0x27, 0xa0, 0x1f, 0x42, 0xd1, 0xbf, 0x25, 0x46,
-	0xec, 0x5a, 0x28, 0x76, 0x2d, 0x5d, 0x5b, 0x89, 0xcd, 0x86, 0xcd, 0x8a, 0x5e, 0xc4, 0x72, 0x4d,
-	0x12, 0xaf, 0x84, 0xb9, 0x26, 0x47, 0xdc, 0xdc, 0xa6, 0xd6, 0xbf, 0x20, 0x29, 0x7a, 0xec, 0x92,
-	0xb4, 0xf0, 0x10, 0x2a, 0xd1, 0x77, 0x3b, 0x9c, 0x6f, 0xe2, 0x6b, 0x21, 0xce, 0xf7, 0x88, 0x87,
-	0x3e, 0xa9, 0x0d, 0xe9, 0xf5, 0x9b, 0xcb, 0xc2, 0x5f, 0xdf, 0x5c, 0x16, 0xfe, 0xf5, 0xe6, 0xb2,
-	0xf0, 0x9b, 0x7f, 0x5f, 0x4e, 0xed, 0xe6, 0xe9, 0x1b, 0xa4, 0x0f, 0xff, 0x17, 0x00, 0x00, 0xff,
-	0xff, 0x43, 0x66, 0xb0, 0xc7, 0x97, 0x24, 0x00, 0x00,
+func (m *Operation) GetEdit() *Operation_Edit {
+	if x, ok := m.GetBody().(*Operation_Edit_); ok {
+		return x.Edit
+	}
+	return nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// YorkieClient is the client API for Yorkie service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type YorkieClient interface {
-	ActivateClient(ctx context.Context, in *ActivateClientRequest, opts ...grpc.CallOption) (*ActivateClientResponse, error)
-	DeactivateClient(ctx context.Context, in *DeactivateClientRequest, opts ...grpc.CallOption) (*DeactivateClientResponse, error)
-	AttachDocument(ctx context.Context, in *AttachDocumentRequest, opts ...grpc.CallOption) (*AttachDocumentResponse, error)
-	DetachDocument(ctx context.Context, in *DetachDocumentRequest, opts ...grpc.CallOption) (*DetachDocumentResponse, error)
-	WatchDocuments(ctx context.Context, in *WatchDocumentsRequest, opts ...grpc.CallOption) (Yorkie_WatchDocumentsClient, error)
-	PushPull(ctx context.Context, in *PushPullRequest, opts ...grpc.CallOption) (*PushPullResponse, error)
-	UpdateMetadata(ctx context.Context, in *UpdateMetadataRequest, opts ...grpc.CallOption) (*UpdateMetadataResponse, error)
-}
-
-type yorkieClient struct {
-	cc *grpc.ClientConn
+func (m *Operation) GetSelect() *Operation_Select {
+	if x, ok := m.GetBody().(*Operation_Select_); ok {
+		return x.Select
+	}
+	return nil
 }
 
-func NewYorkieClient(cc *grpc.ClientConn) YorkieClient {
-	return &yorkieClient{cc}
+func (m *Operation) GetRichEdit() *Operation_RichEdit {
+	if x, ok := m.GetBody().(*Operation_RichEdit_); ok {
+		return x.RichEdit
+	}
+	return nil
 }
 
-func (c *yorkieClient) ActivateClient(ctx context.Context, in *ActivateClientRequest, opts ...grpc.CallOption) (*ActivateClientResponse, error) {
-	out := new(ActivateClientResponse)
-	err := c.cc.Invoke(ctx, "/api.Yorkie/ActivateClient", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Operation) GetStyle() *Operation_Style {
+	if x, ok := m.GetBody().(*Operation_Style_); ok {
+		return x.Style
 	}
-	return out, nil
+	return nil
 }
 
-func (c *yorkieClient) DeactivateClient(ctx context.Context, in *DeactivateClientRequest, opts ...grpc.CallOption) (*DeactivateClientResponse, error) {
-	out := new(DeactivateClientResponse)
-	err := c.cc.Invoke(ctx, "/api.Yorkie/DeactivateClient", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Operation) GetIncrease() *Operation_Increase {
+	if x, ok := m.GetBody().(*Operation_Increase_); ok {
+		return x.Increase
 	}
-	return out, nil
+	return nil
 }
 
-func (c *yorkieClient) AttachDocument(ctx context.Context, in *AttachDocumentRequest, opts ...grpc.CallOption) (*AttachDocumentResponse, error) {
-	out := new(AttachDocumentResponse)
-	err := c.cc.Invoke(ctx, "/api.Yorkie/AttachDocument", in, out, opts...)
-	if err != nil {
-		return nil, err
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Operation) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Operation_Set_)(nil),
+		(*Operation_Add_)(nil),
+		(*Operation_Move_)(nil),
+		(*Operation_Remove_)(nil),
+		(*Operation_Edit_)(nil),
+		(*Operation_Select_)(nil),
+		(*Operation_RichEdit_)(nil),
+		(*Operation_Style_)(nil),
+		(*Operation_Increase_)(nil),
 	}
-	return out, nil
 }
 
-func (c *yorkieClient) DetachDocument(ctx context.Context, in *DetachDocumentRequest, opts ...grpc.CallOption) (*DetachDocumentResponse, error) {
-	out := new(DetachDocumentResponse)
-	err := c.cc.Invoke(ctx, "/api.Yorkie/DetachDocument", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type Operation_Set struct {
+	ParentCreatedAt      *TimeTicket        `protobuf:"bytes,1,opt,name=parent_created_at,json=parentCreatedAt,proto3" json:"parent_created_at,omitempty"`
+	Key                  string             `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value                *JSONElementSimple `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	ExecutedAt           *TimeTicket        `protobuf:"bytes,4,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-func (c *yorkieClient) WatchDocuments(ctx context.Context, in *WatchDocumentsRequest, opts ...grpc.CallOption) (Yorkie_WatchDocumentsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Yorkie_serviceDesc.Streams[0], "/api.Yorkie/WatchDocuments", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &yorkieWatchDocumentsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *Operation_Set) Reset()         { *m = Operation_Set{} }
+func (m *Operation_Set) String() string { return proto.CompactTextString(m) }
+func (*Operation_Set) ProtoMessage()    {}
+func (*Operation_Set) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{57, 0}
+}
+func (m *Operation_Set) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Operation_Set) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Operation_Set.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return x, nil
 }
-
-type Yorkie_WatchDocumentsClient interface {
-	Recv() (*WatchDocumentsResponse, error)
-	grpc.ClientStream
+func (m *Operation_Set) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Operation_Set.Merge(m, src)
 }
-
-type yorkieWatchDocumentsClient struct {
-	grpc.ClientStream
+func (m *Operation_Set) XXX_Size() int {
+	return m.Size()
+}
+func (m *Operation_Set) XXX_DiscardUnknown() {
+	xxx_messageInfo_Operation_Set.DiscardUnknown(m)
 }
 
-func (x *yorkieWatchDocumentsClient) Recv() (*WatchDocumentsResponse, error) {
-	m := new(WatchDocumentsResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_Operation_Set proto.InternalMessageInfo
+
+func (m *Operation_Set) GetParentCreatedAt() *TimeTicket {
+	if m != nil {
+		return m.ParentCreatedAt
 	}
-	return m, nil
+	return nil
 }
 
-func (c *yorkieClient) PushPull(ctx context.Context, in *PushPullRequest, opts ...grpc.CallOption) (*PushPullResponse, error) {
-	out := new(PushPullResponse)
-	err := c.cc.Invoke(ctx, "/api.Yorkie/PushPull", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Operation_Set) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return out, nil
+	return ""
 }
 
-func (c *yorkieClient) UpdateMetadata(ctx context.Context, in *UpdateMetadataRequest, opts ...grpc.CallOption) (*UpdateMetadataResponse, error) {
-	out := new(UpdateMetadataResponse)
-	err := c.cc.Invoke(ctx, "/api.Yorkie/UpdateMetadata", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Operation_Set) GetValue() *JSONElementSimple {
+	if m != nil {
+		return m.Value
 	}
-	return out, nil
+	return nil
 }
 
-// YorkieServer is the server API for Yorkie service.
-type YorkieServer interface {
-	ActivateClient(context.Context, *ActivateClientRequest) (*ActivateClientResponse, error)
-	DeactivateClient(context.Context, *DeactivateClientRequest) (*DeactivateClientResponse, error)
-	AttachDocument(context.Context, *AttachDocumentRequest) (*AttachDocumentResponse, error)
-	DetachDocument(context.Context, *DetachDocumentRequest) (*DetachDocumentResponse, error)
-	WatchDocuments(*WatchDocumentsRequest, Yorkie_WatchDocumentsServer) error
-	PushPull(context.Context, *PushPullRequest) (*PushPullResponse, error)
-	UpdateMetadata(context.Context, *UpdateMetadataRequest) (*UpdateMetadataResponse, error)
+func (m *Operation_Set) GetExecutedAt() *TimeTicket {
+	if m != nil {
+		return m.ExecutedAt
+	}
+	return nil
 }
 
-// UnimplementedYorkieServer can be embedded to have forward compatible implementations.
-type UnimplementedYorkieServer struct {
+type Operation_Add struct {
+	ParentCreatedAt      *TimeTicket        `protobuf:"bytes,1,opt,name=parent_created_at,json=parentCreatedAt,proto3" json:"parent_created_at,omitempty"`
+	PrevCreatedAt        *TimeTicket        `protobuf:"bytes,2,opt,name=prev_created_at,json=prevCreatedAt,proto3" json:"prev_created_at,omitempty"`
+	Value                *JSONElementSimple `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	ExecutedAt           *TimeTicket        `protobuf:"bytes,4,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-func (*UnimplementedYorkieServer) ActivateClient(ctx context.Context, req *ActivateClientRequest) (*ActivateClientResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ActivateClient not implemented")
-}
-func (*UnimplementedYorkieServer) DeactivateClient(ctx context.Context, req *DeactivateClientRequest) (*DeactivateClientResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeactivateClient not implemented")
+func (m *Operation_Add) Reset()         { *m = Operation_Add{} }
+func (m *Operation_Add) String() string { return proto.CompactTextString(m) }
+func (*Operation_Add) ProtoMessage()    {}
+func (*Operation_Add) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{57, 1}
 }
-func (*UnimplementedYorkieServer) AttachDocument(ctx context.Context, req *AttachDocumentRequest) (*AttachDocumentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AttachDocument not implemented")
+func (m *Operation_Add) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedYorkieServer) DetachDocument(ctx context.Context, req *DetachDocumentRequest) (*DetachDocumentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DetachDocument not implemented")
+func (m *Operation_Add) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Operation_Add.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedYorkieServer) WatchDocuments(req *WatchDocumentsRequest, srv Yorkie_WatchDocumentsServer) error {
-	return status.Errorf(codes.Unimplemented, "method WatchDocuments not implemented")
+func (m *Operation_Add) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Operation_Add.Merge(m, src)
 }
-func (*UnimplementedYorkieServer) PushPull(ctx context.Context, req *PushPullRequest) (*PushPullResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PushPull not implemented")
+func (m *Operation_Add) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedYorkieServer) UpdateMetadata(ctx context.Context, req *UpdateMetadataRequest) (*UpdateMetadataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateMetadata not implemented")
+func (m *Operation_Add) XXX_DiscardUnknown() {
+	xxx_messageInfo_Operation_Add.DiscardUnknown(m)
 }
 
-func RegisterYorkieServer(s *grpc.Server, srv YorkieServer) {
-	s.RegisterService(&_Yorkie_serviceDesc, srv)
-}
+var xxx_messageInfo_Operation_Add proto.InternalMessageInfo
 
-func _Yorkie_ActivateClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ActivateClientRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(YorkieServer).ActivateClient(ctx, in)
+func (m *Operation_Add) GetParentCreatedAt() *TimeTicket {
+	if m != nil {
+		return m.ParentCreatedAt
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Yorkie/ActivateClient",
+	return nil
+}
+
+func (m *Operation_Add) GetPrevCreatedAt() *TimeTicket {
+	if m != nil {
+		return m.PrevCreatedAt
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(YorkieServer).ActivateClient(ctx, req.(*ActivateClientRequest))
+	return nil
+}
+
+func (m *Operation_Add) GetValue() *JSONElementSimple {
+	if m != nil {
+		return m.Value
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Yorkie_DeactivateClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeactivateClientRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(YorkieServer).DeactivateClient(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Yorkie/DeactivateClient",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(YorkieServer).DeactivateClient(ctx, req.(*DeactivateClientRequest))
+func (m *Operation_Add) GetExecutedAt() *TimeTicket {
+	if m != nil {
+		return m.ExecutedAt
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Yorkie_AttachDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AttachDocumentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(YorkieServer).AttachDocument(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Yorkie/AttachDocument",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(YorkieServer).AttachDocument(ctx, req.(*AttachDocumentRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type Operation_Move struct {
+	ParentCreatedAt      *TimeTicket `protobuf:"bytes,1,opt,name=parent_created_at,json=parentCreatedAt,proto3" json:"parent_created_at,omitempty"`
+	PrevCreatedAt        *TimeTicket `protobuf:"bytes,2,opt,name=prev_created_at,json=prevCreatedAt,proto3" json:"prev_created_at,omitempty"`
+	CreatedAt            *TimeTicket `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExecutedAt           *TimeTicket `protobuf:"bytes,4,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func _Yorkie_DetachDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DetachDocumentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(YorkieServer).DetachDocument(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Yorkie/DetachDocument",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(YorkieServer).DetachDocument(ctx, req.(*DetachDocumentRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *Operation_Move) Reset()         { *m = Operation_Move{} }
+func (m *Operation_Move) String() string { return proto.CompactTextString(m) }
+func (*Operation_Move) ProtoMessage()    {}
+func (*Operation_Move) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{57, 2}
 }
-
-func _Yorkie_WatchDocuments_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(WatchDocumentsRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *Operation_Move) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Operation_Move) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Operation_Move.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return srv.(YorkieServer).WatchDocuments(m, &yorkieWatchDocumentsServer{stream})
 }
-
-type Yorkie_WatchDocumentsServer interface {
-	Send(*WatchDocumentsResponse) error
-	grpc.ServerStream
+func (m *Operation_Move) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Operation_Move.Merge(m, src)
 }
-
-type yorkieWatchDocumentsServer struct {
-	grpc.ServerStream
+func (m *Operation_Move) XXX_Size() int {
+	return m.Size()
 }
-
-func (x *yorkieWatchDocumentsServer) Send(m *WatchDocumentsResponse) error {
-	return x.ServerStream.SendMsg(m)
+func (m *Operation_Move) XXX_DiscardUnknown() {
+	xxx_messageInfo_Operation_Move.DiscardUnknown(m)
 }
 
-func _Yorkie_PushPull_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PushPullRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(YorkieServer).PushPull(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Yorkie/PushPull",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(YorkieServer).PushPull(ctx, req.(*PushPullRequest))
+var xxx_messageInfo_Operation_Move proto.InternalMessageInfo
+
+func (m *Operation_Move) GetParentCreatedAt() *TimeTicket {
+	if m != nil {
+		return m.ParentCreatedAt
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Yorkie_UpdateMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateMetadataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(YorkieServer).UpdateMetadata(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Yorkie/UpdateMetadata",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(YorkieServer).UpdateMetadata(ctx, req.(*UpdateMetadataRequest))
+func (m *Operation_Move) GetPrevCreatedAt() *TimeTicket {
+	if m != nil {
+		return m.PrevCreatedAt
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Yorkie_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "api.Yorkie",
-	HandlerType: (*YorkieServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ActivateClient",
-			Handler:    _Yorkie_ActivateClient_Handler,
-		},
-		{
-			MethodName: "DeactivateClient",
-			Handler:    _Yorkie_DeactivateClient_Handler,
-		},
-		{
-			MethodName: "AttachDocument",
-			Handler:    _Yorkie_AttachDocument_Handler,
-		},
-		{
-			MethodName: "DetachDocument",
-			Handler:    _Yorkie_DetachDocument_Handler,
-		},
-		{
-			MethodName: "PushPull",
-			Handler:    _Yorkie_PushPull_Handler,
-		},
-		{
-			MethodName: "UpdateMetadata",
-			Handler:    _Yorkie_UpdateMetadata_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "WatchDocuments",
-			Handler:       _Yorkie_WatchDocuments_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "api/yorkie.proto",
+func (m *Operation_Move) GetCreatedAt() *TimeTicket {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
 }
 
-// ClusterClient is the client API for Cluster service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ClusterClient interface {
-	BroadcastEvent(ctx context.Context, in *BroadcastEventRequest, opts ...grpc.CallOption) (*BroadcastEventResponse, error)
+func (m *Operation_Move) GetExecutedAt() *TimeTicket {
+	if m != nil {
+		return m.ExecutedAt
+	}
+	return nil
 }
 
-type clusterClient struct {
-	cc *grpc.ClientConn
+type Operation_Remove struct {
+	ParentCreatedAt      *TimeTicket `protobuf:"bytes,1,opt,name=parent_created_at,json=parentCreatedAt,proto3" json:"parent_created_at,omitempty"`
+	CreatedAt            *TimeTicket `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExecutedAt           *TimeTicket `protobuf:"bytes,3,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func NewClusterClient(cc *grpc.ClientConn) ClusterClient {
-	return &clusterClient{cc}
+func (m *Operation_Remove) Reset()         { *m = Operation_Remove{} }
+func (m *Operation_Remove) String() string { return proto.CompactTextString(m) }
+func (*Operation_Remove) ProtoMessage()    {}
+func (*Operation_Remove) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{57, 3}
 }
-
-func (c *clusterClient) BroadcastEvent(ctx context.Context, in *BroadcastEventRequest, opts ...grpc.CallOption) (*BroadcastEventResponse, error) {
-	out := new(BroadcastEventResponse)
-	err := c.cc.Invoke(ctx, "/api.Cluster/BroadcastEvent", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Operation_Remove) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Operation_Remove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Operation_Remove.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// ClusterServer is the server API for Cluster service.
-type ClusterServer interface {
-	BroadcastEvent(context.Context, *BroadcastEventRequest) (*BroadcastEventResponse, error)
+func (m *Operation_Remove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Operation_Remove.Merge(m, src)
 }
-
-// UnimplementedClusterServer can be embedded to have forward compatible implementations.
-type UnimplementedClusterServer struct {
+func (m *Operation_Remove) XXX_Size() int {
+	return m.Size()
+}
+func (m *Operation_Remove) XXX_DiscardUnknown() {
+	xxx_messageInfo_Operation_Remove.DiscardUnknown(m)
 }
 
-func (*UnimplementedClusterServer) BroadcastEvent(ctx context.Context, req *BroadcastEventRequest) (*BroadcastEventResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BroadcastEvent not implemented")
+var xxx_messageInfo_Operation_Remove proto.InternalMessageInfo
+
+func (m *Operation_Remove) GetParentCreatedAt() *TimeTicket {
+	if m != nil {
+		return m.ParentCreatedAt
+	}
+	return nil
 }
 
-func RegisterClusterServer(s *grpc.Server, srv ClusterServer) {
-	s.RegisterService(&_Cluster_serviceDesc, srv)
+func (m *Operation_Remove) GetCreatedAt() *TimeTicket {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
 }
 
-func _Cluster_BroadcastEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BroadcastEventRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ClusterServer).BroadcastEvent(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Cluster/BroadcastEvent",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterServer).BroadcastEvent(ctx, req.(*BroadcastEventRequest))
+func (m *Operation_Remove) GetExecutedAt() *TimeTicket {
+	if m != nil {
+		return m.ExecutedAt
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Cluster_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "api.Cluster",
-	HandlerType: (*ClusterServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "BroadcastEvent",
-			Handler:    _Cluster_BroadcastEvent_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "api/yorkie.proto",
+type Operation_Edit struct {
+	ParentCreatedAt      *TimeTicket            `protobuf:"bytes,1,opt,name=parent_created_at,json=parentCreatedAt,proto3" json:"parent_created_at,omitempty"`
+	From                 *TextNodePos           `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                   *TextNodePos           `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	CreatedAtMapByActor  map[string]*TimeTicket `protobuf:"bytes,4,rep,name=created_at_map_by_actor,json=createdAtMapByActor,proto3" json:"created_at_map_by_actor,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Content              string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	ExecutedAt           *TimeTicket            `protobuf:"bytes,6,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
-func (m *BroadcastEventRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Operation_Edit) Reset()         { *m = Operation_Edit{} }
+func (m *Operation_Edit) String() string { return proto.CompactTextString(m) }
+func (*Operation_Edit) ProtoMessage()    {}
+func (*Operation_Edit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{57, 4}
+}
+func (m *Operation_Edit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Operation_Edit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Operation_Edit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *BroadcastEventRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Operation_Edit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Operation_Edit.Merge(m, src)
+}
+func (m *Operation_Edit) XXX_Size() int {
+	return m.Size()
+}
+func (m *Operation_Edit) XXX_DiscardUnknown() {
+	xxx_messageInfo_Operation_Edit.DiscardUnknown(m)
 }
 
-func (m *BroadcastEventRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Event != nil {
-		{
-			size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_Operation_Edit proto.InternalMessageInfo
+
+func (m *Operation_Edit) GetParentCreatedAt() *TimeTicket {
+	if m != nil {
+		return m.ParentCreatedAt
 	}
-	if len(m.PublisherId) > 0 {
-		i -= len(m.PublisherId)
-		copy(dAtA[i:], m.PublisherId)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.PublisherId)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *Operation_Edit) GetFrom() *TextNodePos {
+	if m != nil {
+		return m.From
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *BroadcastEventResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Operation_Edit) GetTo() *TextNodePos {
+	if m != nil {
+		return m.To
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *BroadcastEventResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Operation_Edit) GetCreatedAtMapByActor() map[string]*TimeTicket {
+	if m != nil {
+		return m.CreatedAtMapByActor
+	}
+	return nil
 }
 
-func (m *BroadcastEventResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *Operation_Edit) GetContent() string {
+	if m != nil {
+		return m.Content
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ActivateClientRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Operation_Edit) GetExecutedAt() *TimeTicket {
+	if m != nil {
+		return m.ExecutedAt
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ActivateClientRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Operation_Select struct {
+	ParentCreatedAt      *TimeTicket  `protobuf:"bytes,1,opt,name=parent_created_at,json=parentCreatedAt,proto3" json:"parent_created_at,omitempty"`
+	From                 *TextNodePos `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                   *TextNodePos `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	ExecutedAt           *TimeTicket  `protobuf:"bytes,4,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *ActivateClientRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.ClientKey) > 0 {
-		i -= len(m.ClientKey)
-		copy(dAtA[i:], m.ClientKey)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientKey)))
-		i--
-		dAtA[i] = 0xa
+func (m *Operation_Select) Reset()         { *m = Operation_Select{} }
+func (m *Operation_Select) String() string { return proto.CompactTextString(m) }
+func (*Operation_Select) ProtoMessage()    {}
+func (*Operation_Select) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{57, 5}
+}
+func (m *Operation_Select) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Operation_Select) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Operation_Select.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *Operation_Select) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Operation_Select.Merge(m, src)
+}
+func (m *Operation_Select) XXX_Size() int {
+	return m.Size()
+}
+func (m *Operation_Select) XXX_DiscardUnknown() {
+	xxx_messageInfo_Operation_Select.DiscardUnknown(m)
 }
 
-func (m *ActivateClientResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Operation_Select proto.InternalMessageInfo
+
+func (m *Operation_Select) GetParentCreatedAt() *TimeTicket {
+	if m != nil {
+		return m.ParentCreatedAt
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ActivateClientResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Operation_Select) GetFrom() *TextNodePos {
+	if m != nil {
+		return m.From
+	}
+	return nil
 }
 
-func (m *ActivateClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *Operation_Select) GetTo() *TextNodePos {
+	if m != nil {
+		return m.To
 	}
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *Operation_Select) GetExecutedAt() *TimeTicket {
+	if m != nil {
+		return m.ExecutedAt
 	}
-	if len(m.ClientKey) > 0 {
-		i -= len(m.ClientKey)
-		copy(dAtA[i:], m.ClientKey)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientKey)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *DeactivateClientRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type Operation_RichEdit struct {
+	ParentCreatedAt      *TimeTicket            `protobuf:"bytes,1,opt,name=parent_created_at,json=parentCreatedAt,proto3" json:"parent_created_at,omitempty"`
+	From                 *TextNodePos           `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                   *TextNodePos           `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	CreatedAtMapByActor  map[string]*TimeTicket `protobuf:"bytes,4,rep,name=created_at_map_by_actor,json=createdAtMapByActor,proto3" json:"created_at_map_by_actor,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Content              string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	Attributes           map[string]string      `protobuf:"bytes,6,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ExecutedAt           *TimeTicket            `protobuf:"bytes,7,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
-func (m *DeactivateClientRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Operation_RichEdit) Reset()         { *m = Operation_RichEdit{} }
+func (m *Operation_RichEdit) String() string { return proto.CompactTextString(m) }
+func (*Operation_RichEdit) ProtoMessage()    {}
+func (*Operation_RichEdit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{57, 6}
 }
-
-func (m *DeactivateClientRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0xa
+func (m *Operation_RichEdit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Operation_RichEdit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Operation_RichEdit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *Operation_RichEdit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Operation_RichEdit.Merge(m, src)
+}
+func (m *Operation_RichEdit) XXX_Size() int {
+	return m.Size()
+}
+func (m *Operation_RichEdit) XXX_DiscardUnknown() {
+	xxx_messageInfo_Operation_RichEdit.DiscardUnknown(m)
 }
 
-func (m *DeactivateClientResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Operation_RichEdit proto.InternalMessageInfo
+
+func (m *Operation_RichEdit) GetParentCreatedAt() *TimeTicket {
+	if m != nil {
+		return m.ParentCreatedAt
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DeactivateClientResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Operation_RichEdit) GetFrom() *TextNodePos {
+	if m != nil {
+		return m.From
+	}
+	return nil
 }
 
-func (m *DeactivateClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0xa
+func (m *Operation_RichEdit) GetTo() *TextNodePos {
+	if m != nil {
+		return m.To
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *AttachDocumentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Operation_RichEdit) GetCreatedAtMapByActor() map[string]*TimeTicket {
+	if m != nil {
+		return m.CreatedAtMapByActor
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *AttachDocumentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Operation_RichEdit) GetContent() string {
+	if m != nil {
+		return m.Content
+	}
+	return ""
 }
 
-func (m *AttachDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ChangePack != nil {
-		{
-			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0xa
+func (m *Operation_RichEdit) GetAttributes() map[string]string {
+	if m != nil {
+		return m.Attributes
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *AttachDocumentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Operation_RichEdit) GetExecutedAt() *TimeTicket {
+	if m != nil {
+		return m.ExecutedAt
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *AttachDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Operation_Style struct {
+	ParentCreatedAt      *TimeTicket       `protobuf:"bytes,1,opt,name=parent_created_at,json=parentCreatedAt,proto3" json:"parent_created_at,omitempty"`
+	From                 *TextNodePos      `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                   *TextNodePos      `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Attributes           map[string]string `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ExecutedAt           *TimeTicket       `protobuf:"bytes,5,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *AttachDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ChangePack != nil {
-		{
-			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
+func (m *Operation_Style) Reset()         { *m = Operation_Style{} }
+func (m *Operation_Style) String() string { return proto.CompactTextString(m) }
+func (*Operation_Style) ProtoMessage()    {}
+func (*Operation_Style) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{57, 7}
+}
+func (m *Operation_Style) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Operation_Style) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Operation_Style.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *Operation_Style) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Operation_Style.Merge(m, src)
+}
+func (m *Operation_Style) XXX_Size() int {
+	return m.Size()
+}
+func (m *Operation_Style) XXX_DiscardUnknown() {
+	xxx_messageInfo_Operation_Style.DiscardUnknown(m)
 }
 
-func (m *DetachDocumentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Operation_Style proto.InternalMessageInfo
+
+func (m *Operation_Style) GetParentCreatedAt() *TimeTicket {
+	if m != nil {
+		return m.ParentCreatedAt
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DetachDocumentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DetachDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ChangePack != nil {
-		{
-			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0xa
+func (m *Operation_Style) GetFrom() *TextNodePos {
+	if m != nil {
+		return m.From
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *DetachDocumentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Operation_Style) GetTo() *TextNodePos {
+	if m != nil {
+		return m.To
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DetachDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return nil
 }
 
-func (m *DetachDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ChangePack != nil {
-		{
-			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ClientKey) > 0 {
-		i -= len(m.ClientKey)
-		copy(dAtA[i:], m.ClientKey)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientKey)))
-		i--
-		dAtA[i] = 0xa
+func (m *Operation_Style) GetAttributes() map[string]string {
+	if m != nil {
+		return m.Attributes
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *WatchDocumentsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Operation_Style) GetExecutedAt() *TimeTicket {
+	if m != nil {
+		return m.ExecutedAt
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *WatchDocumentsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Operation_Increase struct {
+	ParentCreatedAt      *TimeTicket        `protobuf:"bytes,1,opt,name=parent_created_at,json=parentCreatedAt,proto3" json:"parent_created_at,omitempty"`
+	Value                *JSONElementSimple `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	ExecutedAt           *TimeTicket        `protobuf:"bytes,3,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-func (m *WatchDocumentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.DocumentKeys) > 0 {
-		for iNdEx := len(m.DocumentKeys) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DocumentKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYorkie(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Client != nil {
-		{
-			size, err := m.Client.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
+func (m *Operation_Increase) Reset()         { *m = Operation_Increase{} }
+func (m *Operation_Increase) String() string { return proto.CompactTextString(m) }
+func (*Operation_Increase) ProtoMessage()    {}
+func (*Operation_Increase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{57, 8}
+}
+func (m *Operation_Increase) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Operation_Increase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Operation_Increase.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *Operation_Increase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Operation_Increase.Merge(m, src)
+}
+func (m *Operation_Increase) XXX_Size() int {
+	return m.Size()
+}
+func (m *Operation_Increase) XXX_DiscardUnknown() {
+	xxx_messageInfo_Operation_Increase.DiscardUnknown(m)
 }
 
-func (m *WatchDocumentsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Operation_Increase proto.InternalMessageInfo
+
+func (m *Operation_Increase) GetParentCreatedAt() *TimeTicket {
+	if m != nil {
+		return m.ParentCreatedAt
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *WatchDocumentsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Operation_Increase) GetValue() *JSONElementSimple {
+	if m != nil {
+		return m.Value
+	}
+	return nil
 }
 
-func (m *WatchDocumentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Body != nil {
-		{
-			size := m.Body.Size()
-			i -= size
-			if _, err := m.Body.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+func (m *Operation_Increase) GetExecutedAt() *TimeTicket {
+	if m != nil {
+		return m.ExecutedAt
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *WatchDocumentsResponse_Initialization_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type JSONElementSimple struct {
+	CreatedAt            *TimeTicket `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	MovedAt              *TimeTicket `protobuf:"bytes,2,opt,name=moved_at,json=movedAt,proto3" json:"moved_at,omitempty"`
+	RemovedAt            *TimeTicket `protobuf:"bytes,3,opt,name=removed_at,json=removedAt,proto3" json:"removed_at,omitempty"`
+	Type                 ValueType   `protobuf:"varint,4,opt,name=type,proto3,enum=api.ValueType" json:"type,omitempty"`
+	Value                []byte      `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *WatchDocumentsResponse_Initialization_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Initialization != nil {
-		{
-			size, err := m.Initialization.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
+func (m *JSONElementSimple) Reset()         { *m = JSONElementSimple{} }
+func (m *JSONElementSimple) String() string { return proto.CompactTextString(m) }
+func (*JSONElementSimple) ProtoMessage()    {}
+func (*JSONElementSimple) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{58}
+}
+func (m *JSONElementSimple) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JSONElementSimple) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JSONElementSimple.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *WatchDocumentsResponse_Event) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *JSONElementSimple) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JSONElementSimple.Merge(m, src)
 }
-
-func (m *WatchDocumentsResponse_Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Event != nil {
-		{
-			size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	return len(dAtA) - i, nil
+func (m *JSONElementSimple) XXX_Size() int {
+	return m.Size()
 }
-func (m *WatchDocumentsResponse_Initialization) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *JSONElementSimple) XXX_DiscardUnknown() {
+	xxx_messageInfo_JSONElementSimple.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JSONElementSimple proto.InternalMessageInfo
+
+func (m *JSONElementSimple) GetCreatedAt() *TimeTicket {
+	if m != nil {
+		return m.CreatedAt
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *WatchDocumentsResponse_Initialization) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *JSONElementSimple) GetMovedAt() *TimeTicket {
+	if m != nil {
+		return m.MovedAt
+	}
+	return nil
 }
 
-func (m *WatchDocumentsResponse_Initialization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *JSONElementSimple) GetRemovedAt() *TimeTicket {
+	if m != nil {
+		return m.RemovedAt
 	}
-	if len(m.PeersMapByDoc) > 0 {
-		for k := range m.PeersMapByDoc {
-			v := m.PeersMapByDoc[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintYorkie(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintYorkie(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintYorkie(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
+	return nil
+}
+
+func (m *JSONElementSimple) GetType() ValueType {
+	if m != nil {
+		return m.Type
 	}
-	return len(dAtA) - i, nil
+	return ValueType_NULL
 }
 
-func (m *PushPullRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *JSONElementSimple) GetValue() []byte {
+	if m != nil {
+		return m.Value
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *PushPullRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type JSONElement struct {
+	// Types that are valid to be assigned to Body:
+	//	*JSONElement_JsonObject
+	//	*JSONElement_JsonArray
+	//	*JSONElement_Primitive_
+	//	*JSONElement_Text_
+	//	*JSONElement_RichText_
+	//	*JSONElement_Counter_
+	Body                 isJSONElement_Body `protobuf_oneof:"Body"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-func (m *PushPullRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ChangePack != nil {
-		{
-			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
+func (m *JSONElement) Reset()         { *m = JSONElement{} }
+func (m *JSONElement) String() string { return proto.CompactTextString(m) }
+func (*JSONElement) ProtoMessage()    {}
+func (*JSONElement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{59}
+}
+func (m *JSONElement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JSONElement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JSONElement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *JSONElement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JSONElement.Merge(m, src)
+}
+func (m *JSONElement) XXX_Size() int {
+	return m.Size()
+}
+func (m *JSONElement) XXX_DiscardUnknown() {
+	xxx_messageInfo_JSONElement.DiscardUnknown(m)
 }
 
-func (m *PushPullResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_JSONElement proto.InternalMessageInfo
+
+type isJSONElement_Body interface {
+	isJSONElement_Body()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-func (m *PushPullResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type JSONElement_JsonObject struct {
+	JsonObject *JSONElement_JSONObject `protobuf:"bytes,1,opt,name=json_object,json=jsonObject,proto3,oneof" json:"json_object,omitempty"`
+}
+type JSONElement_JsonArray struct {
+	JsonArray *JSONElement_JSONArray `protobuf:"bytes,2,opt,name=json_array,json=jsonArray,proto3,oneof" json:"json_array,omitempty"`
+}
+type JSONElement_Primitive_ struct {
+	Primitive *JSONElement_Primitive `protobuf:"bytes,3,opt,name=primitive,proto3,oneof" json:"primitive,omitempty"`
+}
+type JSONElement_Text_ struct {
+	Text *JSONElement_Text `protobuf:"bytes,4,opt,name=text,proto3,oneof" json:"text,omitempty"`
+}
+type JSONElement_RichText_ struct {
+	RichText *JSONElement_RichText `protobuf:"bytes,5,opt,name=rich_text,json=richText,proto3,oneof" json:"rich_text,omitempty"`
+}
+type JSONElement_Counter_ struct {
+	Counter *JSONElement_Counter `protobuf:"bytes,6,opt,name=counter,proto3,oneof" json:"counter,omitempty"`
 }
 
-func (m *PushPullResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ChangePack != nil {
-		{
-			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (*JSONElement_JsonObject) isJSONElement_Body() {}
+func (*JSONElement_JsonArray) isJSONElement_Body()  {}
+func (*JSONElement_Primitive_) isJSONElement_Body() {}
+func (*JSONElement_Text_) isJSONElement_Body()      {}
+func (*JSONElement_RichText_) isJSONElement_Body()  {}
+func (*JSONElement_Counter_) isJSONElement_Body()   {}
+
+func (m *JSONElement) GetBody() isJSONElement_Body {
+	if m != nil {
+		return m.Body
 	}
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *JSONElement) GetJsonObject() *JSONElement_JSONObject {
+	if x, ok := m.GetBody().(*JSONElement_JsonObject); ok {
+		return x.JsonObject
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *UpdateMetadataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *JSONElement) GetJsonArray() *JSONElement_JSONArray {
+	if x, ok := m.GetBody().(*JSONElement_JsonArray); ok {
+		return x.JsonArray
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *UpdateMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *UpdateMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.DocumentKeys) > 0 {
-		for iNdEx := len(m.DocumentKeys) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DocumentKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYorkie(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Client != nil {
-		{
-			size, err := m.Client.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *JSONElement) GetPrimitive() *JSONElement_Primitive {
+	if x, ok := m.GetBody().(*JSONElement_Primitive_); ok {
+		return x.Primitive
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *UpdateMetadataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *JSONElement) GetText() *JSONElement_Text {
+	if x, ok := m.GetBody().(*JSONElement_Text_); ok {
+		return x.Text
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *UpdateMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *JSONElement) GetRichText() *JSONElement_RichText {
+	if x, ok := m.GetBody().(*JSONElement_RichText_); ok {
+		return x.RichText
+	}
+	return nil
 }
 
-func (m *UpdateMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *JSONElement) GetCounter() *JSONElement_Counter {
+	if x, ok := m.GetBody().(*JSONElement_Counter_); ok {
+		return x.Counter
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ChangePack) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*JSONElement) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*JSONElement_JsonObject)(nil),
+		(*JSONElement_JsonArray)(nil),
+		(*JSONElement_Primitive_)(nil),
+		(*JSONElement_Text_)(nil),
+		(*JSONElement_RichText_)(nil),
+		(*JSONElement_Counter_)(nil),
 	}
-	return dAtA[:n], nil
 }
 
-func (m *ChangePack) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type JSONElement_JSONObject struct {
+	Nodes                []*RHTNode  `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	CreatedAt            *TimeTicket `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	MovedAt              *TimeTicket `protobuf:"bytes,3,opt,name=moved_at,json=movedAt,proto3" json:"moved_at,omitempty"`
+	RemovedAt            *TimeTicket `protobuf:"bytes,4,opt,name=removed_at,json=removedAt,proto3" json:"removed_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *ChangePack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.MinSyncedTicket != nil {
-		{
-			size, err := m.MinSyncedTicket.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Changes) > 0 {
-		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYorkie(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+func (m *JSONElement_JSONObject) Reset()         { *m = JSONElement_JSONObject{} }
+func (m *JSONElement_JSONObject) String() string { return proto.CompactTextString(m) }
+func (*JSONElement_JSONObject) ProtoMessage()    {}
+func (*JSONElement_JSONObject) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{59, 0}
+}
+func (m *JSONElement_JSONObject) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JSONElement_JSONObject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JSONElement_JSONObject.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Snapshot) > 0 {
-		i -= len(m.Snapshot)
-		copy(dAtA[i:], m.Snapshot)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Snapshot)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *JSONElement_JSONObject) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JSONElement_JSONObject.Merge(m, src)
+}
+func (m *JSONElement_JSONObject) XXX_Size() int {
+	return m.Size()
+}
+func (m *JSONElement_JSONObject) XXX_DiscardUnknown() {
+	xxx_messageInfo_JSONElement_JSONObject.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JSONElement_JSONObject proto.InternalMessageInfo
+
+func (m *JSONElement_JSONObject) GetNodes() []*RHTNode {
+	if m != nil {
+		return m.Nodes
 	}
-	if m.Checkpoint != nil {
-		{
-			size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *JSONElement_JSONObject) GetCreatedAt() *TimeTicket {
+	if m != nil {
+		return m.CreatedAt
 	}
-	if m.DocumentKey != nil {
-		{
-			size, err := m.DocumentKey.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *JSONElement_JSONObject) GetMovedAt() *TimeTicket {
+	if m != nil {
+		return m.MovedAt
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Change) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *JSONElement_JSONObject) GetRemovedAt() *TimeTicket {
+	if m != nil {
+		return m.RemovedAt
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Change) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type JSONElement_JSONArray struct {
+	Nodes                []*RGANode  `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	CreatedAt            *TimeTicket `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	MovedAt              *TimeTicket `protobuf:"bytes,3,opt,name=moved_at,json=movedAt,proto3" json:"moved_at,omitempty"`
+	RemovedAt            *TimeTicket `protobuf:"bytes,4,opt,name=removed_at,json=removedAt,proto3" json:"removed_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *Change) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Operations) > 0 {
-		for iNdEx := len(m.Operations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Operations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYorkie(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Message)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != nil {
-		{
-			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
+func (m *JSONElement_JSONArray) Reset()         { *m = JSONElement_JSONArray{} }
+func (m *JSONElement_JSONArray) String() string { return proto.CompactTextString(m) }
+func (*JSONElement_JSONArray) ProtoMessage()    {}
+func (*JSONElement_JSONArray) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{59, 1}
+}
+func (m *JSONElement_JSONArray) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JSONElement_JSONArray) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JSONElement_JSONArray.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *ChangeID) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *JSONElement_JSONArray) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JSONElement_JSONArray.Merge(m, src)
 }
-
-func (m *ChangeID) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *JSONElement_JSONArray) XXX_Size() int {
+	return m.Size()
+}
+func (m *JSONElement_JSONArray) XXX_DiscardUnknown() {
+	xxx_messageInfo_JSONElement_JSONArray.DiscardUnknown(m)
 }
 
-func (m *ChangeID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.ActorId) > 0 {
-		i -= len(m.ActorId)
-		copy(dAtA[i:], m.ActorId)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ActorId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Lamport != 0 {
-		i = encodeVarintYorkie(dAtA, i, uint64(m.Lamport))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ClientSeq != 0 {
-		i = encodeVarintYorkie(dAtA, i, uint64(m.ClientSeq))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_JSONElement_JSONArray proto.InternalMessageInfo
+
+func (m *JSONElement_JSONArray) GetNodes() []*RGANode {
+	if m != nil {
+		return m.Nodes
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Operation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *JSONElement_JSONArray) GetCreatedAt() *TimeTicket {
+	if m != nil {
+		return m.CreatedAt
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Operation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *JSONElement_JSONArray) GetMovedAt() *TimeTicket {
+	if m != nil {
+		return m.MovedAt
+	}
+	return nil
 }
 
-func (m *Operation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Body != nil {
-		{
-			size := m.Body.Size()
-			i -= size
-			if _, err := m.Body.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+func (m *JSONElement_JSONArray) GetRemovedAt() *TimeTicket {
+	if m != nil {
+		return m.RemovedAt
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Operation_Set_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type JSONElement_Primitive struct {
+	Type                 ValueType   `protobuf:"varint,1,opt,name=type,proto3,enum=api.ValueType" json:"type,omitempty"`
+	Value                []byte      `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	CreatedAt            *TimeTicket `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	MovedAt              *TimeTicket `protobuf:"bytes,4,opt,name=moved_at,json=movedAt,proto3" json:"moved_at,omitempty"`
+	RemovedAt            *TimeTicket `protobuf:"bytes,5,opt,name=removed_at,json=removedAt,proto3" json:"removed_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *Operation_Set_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Set != nil {
-		{
-			size, err := m.Set.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *JSONElement_Primitive) Reset()         { *m = JSONElement_Primitive{} }
+func (m *JSONElement_Primitive) String() string { return proto.CompactTextString(m) }
+func (*JSONElement_Primitive) ProtoMessage()    {}
+func (*JSONElement_Primitive) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{59, 2}
 }
-func (m *Operation_Add_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *JSONElement_Primitive) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Operation_Add_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Add != nil {
-		{
-			size, err := m.Add.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
+func (m *JSONElement_Primitive) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JSONElement_Primitive.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *Operation_Move_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *JSONElement_Primitive) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JSONElement_Primitive.Merge(m, src)
+}
+func (m *JSONElement_Primitive) XXX_Size() int {
+	return m.Size()
+}
+func (m *JSONElement_Primitive) XXX_DiscardUnknown() {
+	xxx_messageInfo_JSONElement_Primitive.DiscardUnknown(m)
 }
 
-func (m *Operation_Move_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Move != nil {
-		{
-			size, err := m.Move.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+var xxx_messageInfo_JSONElement_Primitive proto.InternalMessageInfo
+
+func (m *JSONElement_Primitive) GetType() ValueType {
+	if m != nil {
+		return m.Type
 	}
-	return len(dAtA) - i, nil
+	return ValueType_NULL
 }
-func (m *Operation_Remove_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (m *JSONElement_Primitive) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
 }
 
-func (m *Operation_Remove_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Remove != nil {
-		{
-			size, err := m.Remove.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+func (m *JSONElement_Primitive) GetCreatedAt() *TimeTicket {
+	if m != nil {
+		return m.CreatedAt
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
-func (m *Operation_Edit_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (m *JSONElement_Primitive) GetMovedAt() *TimeTicket {
+	if m != nil {
+		return m.MovedAt
+	}
+	return nil
 }
 
-func (m *Operation_Edit_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Edit != nil {
-		{
-			size, err := m.Edit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+func (m *JSONElement_Primitive) GetRemovedAt() *TimeTicket {
+	if m != nil {
+		return m.RemovedAt
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
-func (m *Operation_Select_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+type JSONElement_Text struct {
+	Nodes                []*TextNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	CreatedAt            *TimeTicket `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	MovedAt              *TimeTicket `protobuf:"bytes,3,opt,name=moved_at,json=movedAt,proto3" json:"moved_at,omitempty"`
+	RemovedAt            *TimeTicket `protobuf:"bytes,4,opt,name=removed_at,json=removedAt,proto3" json:"removed_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *Operation_Select_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Select != nil {
-		{
-			size, err := m.Select.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	return len(dAtA) - i, nil
+func (m *JSONElement_Text) Reset()         { *m = JSONElement_Text{} }
+func (m *JSONElement_Text) String() string { return proto.CompactTextString(m) }
+func (*JSONElement_Text) ProtoMessage()    {}
+func (*JSONElement_Text) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{59, 3}
 }
-func (m *Operation_RichEdit_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *JSONElement_Text) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Operation_RichEdit_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.RichEdit != nil {
-		{
-			size, err := m.RichEdit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
+func (m *JSONElement_Text) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JSONElement_Text.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x3a
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *Operation_Style_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *JSONElement_Text) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JSONElement_Text.Merge(m, src)
+}
+func (m *JSONElement_Text) XXX_Size() int {
+	return m.Size()
+}
+func (m *JSONElement_Text) XXX_DiscardUnknown() {
+	xxx_messageInfo_JSONElement_Text.DiscardUnknown(m)
 }
 
-func (m *Operation_Style_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Style != nil {
-		{
-			size, err := m.Style.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
+var xxx_messageInfo_JSONElement_Text proto.InternalMessageInfo
+
+func (m *JSONElement_Text) GetNodes() []*TextNode {
+	if m != nil {
+		return m.Nodes
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
-func (m *Operation_Increase_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (m *JSONElement_Text) GetCreatedAt() *TimeTicket {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
 }
 
-func (m *Operation_Increase_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Increase != nil {
-		{
-			size, err := m.Increase.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
+func (m *JSONElement_Text) GetMovedAt() *TimeTicket {
+	if m != nil {
+		return m.MovedAt
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
-func (m *Operation_Set) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *JSONElement_Text) GetRemovedAt() *TimeTicket {
+	if m != nil {
+		return m.RemovedAt
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Operation_Set) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type JSONElement_RichText struct {
+	Nodes                []*RichTextNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	CreatedAt            *TimeTicket     `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	MovedAt              *TimeTicket     `protobuf:"bytes,3,opt,name=moved_at,json=movedAt,proto3" json:"moved_at,omitempty"`
+	RemovedAt            *TimeTicket     `protobuf:"bytes,4,opt,name=removed_at,json=removedAt,proto3" json:"removed_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *Operation_Set) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ExecutedAt != nil {
-		{
-			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Value != nil {
-		{
-			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ParentCreatedAt != nil {
-		{
-			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
+func (m *JSONElement_RichText) Reset()         { *m = JSONElement_RichText{} }
+func (m *JSONElement_RichText) String() string { return proto.CompactTextString(m) }
+func (*JSONElement_RichText) ProtoMessage()    {}
+func (*JSONElement_RichText) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{59, 4}
+}
+func (m *JSONElement_RichText) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JSONElement_RichText) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JSONElement_RichText.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *JSONElement_RichText) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JSONElement_RichText.Merge(m, src)
+}
+func (m *JSONElement_RichText) XXX_Size() int {
+	return m.Size()
+}
+func (m *JSONElement_RichText) XXX_DiscardUnknown() {
+	xxx_messageInfo_JSONElement_RichText.DiscardUnknown(m)
 }
 
-func (m *Operation_Add) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_JSONElement_RichText proto.InternalMessageInfo
+
+func (m *JSONElement_RichText) GetNodes() []*RichTextNode {
+	if m != nil {
+		return m.Nodes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Operation_Add) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *JSONElement_RichText) GetCreatedAt() *TimeTicket {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
 }
 
-func (m *Operation_Add) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ExecutedAt != nil {
-		{
-			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Value != nil {
-		{
-			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.PrevCreatedAt != nil {
-		{
-			size, err := m.PrevCreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ParentCreatedAt != nil {
-		{
-			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *JSONElement_RichText) GetMovedAt() *TimeTicket {
+	if m != nil {
+		return m.MovedAt
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Operation_Move) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *JSONElement_RichText) GetRemovedAt() *TimeTicket {
+	if m != nil {
+		return m.RemovedAt
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Operation_Move) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type JSONElement_Counter struct {
+	Type                 ValueType   `protobuf:"varint,1,opt,name=type,proto3,enum=api.ValueType" json:"type,omitempty"`
+	Value                []byte      `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	CreatedAt            *TimeTicket `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	MovedAt              *TimeTicket `protobuf:"bytes,4,opt,name=moved_at,json=movedAt,proto3" json:"moved_at,omitempty"`
+	RemovedAt            *TimeTicket `protobuf:"bytes,5,opt,name=removed_at,json=removedAt,proto3" json:"removed_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *Operation_Move) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ExecutedAt != nil {
-		{
-			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.CreatedAt != nil {
-		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.PrevCreatedAt != nil {
-		{
-			size, err := m.PrevCreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ParentCreatedAt != nil {
-		{
-			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
+func (m *JSONElement_Counter) Reset()         { *m = JSONElement_Counter{} }
+func (m *JSONElement_Counter) String() string { return proto.CompactTextString(m) }
+func (*JSONElement_Counter) ProtoMessage()    {}
+func (*JSONElement_Counter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{59, 5}
+}
+func (m *JSONElement_Counter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JSONElement_Counter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JSONElement_Counter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *JSONElement_Counter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JSONElement_Counter.Merge(m, src)
+}
+func (m *JSONElement_Counter) XXX_Size() int {
+	return m.Size()
+}
+func (m *JSONElement_Counter) XXX_DiscardUnknown() {
+	xxx_messageInfo_JSONElement_Counter.DiscardUnknown(m)
 }
 
-func (m *Operation_Remove) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_JSONElement_Counter proto.InternalMessageInfo
+
+func (m *JSONElement_Counter) GetType() ValueType {
+	if m != nil {
+		return m.Type
 	}
-	return dAtA[:n], nil
+	return ValueType_NULL
 }
 
-func (m *Operation_Remove) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *JSONElement_Counter) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
 }
 
-func (m *Operation_Remove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ExecutedAt != nil {
-		{
-			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.CreatedAt != nil {
-		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ParentCreatedAt != nil {
-		{
-			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *JSONElement_Counter) GetCreatedAt() *TimeTicket {
+	if m != nil {
+		return m.CreatedAt
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Operation_Edit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *JSONElement_Counter) GetMovedAt() *TimeTicket {
+	if m != nil {
+		return m.MovedAt
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Operation_Edit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *JSONElement_Counter) GetRemovedAt() *TimeTicket {
+	if m != nil {
+		return m.RemovedAt
+	}
+	return nil
 }
 
-func (m *Operation_Edit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ExecutedAt != nil {
-		{
-			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Content) > 0 {
-		i -= len(m.Content)
-		copy(dAtA[i:], m.Content)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Content)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.CreatedAtMapByActor) > 0 {
-		for k := range m.CreatedAtMapByActor {
-			v := m.CreatedAtMapByActor[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintYorkie(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintYorkie(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintYorkie(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.To != nil {
-		{
-			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.From != nil {
-		{
-			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
+type RHTNode struct {
+	Key                  string       `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Element              *JSONElement `protobuf:"bytes,2,opt,name=element,proto3" json:"element,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *RHTNode) Reset()         { *m = RHTNode{} }
+func (m *RHTNode) String() string { return proto.CompactTextString(m) }
+func (*RHTNode) ProtoMessage()    {}
+func (*RHTNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{60}
+}
+func (m *RHTNode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RHTNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RHTNode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.ParentCreatedAt != nil {
-		{
-			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *RHTNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RHTNode.Merge(m, src)
+}
+func (m *RHTNode) XXX_Size() int {
+	return m.Size()
+}
+func (m *RHTNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_RHTNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RHTNode proto.InternalMessageInfo
+
+func (m *RHTNode) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Operation_Select) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RHTNode) GetElement() *JSONElement {
+	if m != nil {
+		return m.Element
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Operation_Select) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type RGANode struct {
+	Next                 *RGANode     `protobuf:"bytes,1,opt,name=next,proto3" json:"next,omitempty"`
+	Element              *JSONElement `protobuf:"bytes,2,opt,name=element,proto3" json:"element,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *Operation_Select) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ExecutedAt != nil {
-		{
-			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.To != nil {
-		{
-			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.From != nil {
-		{
-			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
+func (m *RGANode) Reset()         { *m = RGANode{} }
+func (m *RGANode) String() string { return proto.CompactTextString(m) }
+func (*RGANode) ProtoMessage()    {}
+func (*RGANode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{61}
+}
+func (m *RGANode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RGANode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RGANode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.ParentCreatedAt != nil {
-		{
-			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *RGANode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RGANode.Merge(m, src)
+}
+func (m *RGANode) XXX_Size() int {
+	return m.Size()
+}
+func (m *RGANode) XXX_DiscardUnknown() {
+	xxx_messageInfo_RGANode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RGANode proto.InternalMessageInfo
+
+func (m *RGANode) GetNext() *RGANode {
+	if m != nil {
+		return m.Next
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Operation_RichEdit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RGANode) GetElement() *JSONElement {
+	if m != nil {
+		return m.Element
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Operation_RichEdit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type TextNode struct {
+	Id                   *TextNodeID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Value                string      `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	RemovedAt            *TimeTicket `protobuf:"bytes,3,opt,name=removed_at,json=removedAt,proto3" json:"removed_at,omitempty"`
+	InsPrevId            *TextNodeID `protobuf:"bytes,4,opt,name=ins_prev_id,json=insPrevId,proto3" json:"ins_prev_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *Operation_RichEdit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ExecutedAt != nil {
-		{
-			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
+func (m *TextNode) Reset()         { *m = TextNode{} }
+func (m *TextNode) String() string { return proto.CompactTextString(m) }
+func (*TextNode) ProtoMessage()    {}
+func (*TextNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{62}
+}
+func (m *TextNode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TextNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TextNode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x3a
+		return b[:n], nil
 	}
-	if len(m.Attributes) > 0 {
-		for k := range m.Attributes {
-			v := m.Attributes[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintYorkie(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintYorkie(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintYorkie(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x32
-		}
+}
+func (m *TextNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TextNode.Merge(m, src)
+}
+func (m *TextNode) XXX_Size() int {
+	return m.Size()
+}
+func (m *TextNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_TextNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TextNode proto.InternalMessageInfo
+
+func (m *TextNode) GetId() *TextNodeID {
+	if m != nil {
+		return m.Id
 	}
-	if len(m.Content) > 0 {
-		i -= len(m.Content)
-		copy(dAtA[i:], m.Content)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Content)))
-		i--
-		dAtA[i] = 0x2a
+	return nil
+}
+
+func (m *TextNode) GetValue() string {
+	if m != nil {
+		return m.Value
 	}
-	if len(m.CreatedAtMapByActor) > 0 {
-		for k := range m.CreatedAtMapByActor {
-			v := m.CreatedAtMapByActor[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintYorkie(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintYorkie(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintYorkie(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x22
-		}
+	return ""
+}
+
+func (m *TextNode) GetRemovedAt() *TimeTicket {
+	if m != nil {
+		return m.RemovedAt
 	}
-	if m.To != nil {
-		{
-			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+func (m *TextNode) GetInsPrevId() *TextNodeID {
+	if m != nil {
+		return m.InsPrevId
 	}
-	if m.From != nil {
-		{
-			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
+	return nil
+}
+
+type RichTextNodeAttr struct {
+	Key                  string      `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value                string      `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	UpdatedAt            *TimeTicket `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *RichTextNodeAttr) Reset()         { *m = RichTextNodeAttr{} }
+func (m *RichTextNodeAttr) String() string { return proto.CompactTextString(m) }
+func (*RichTextNodeAttr) ProtoMessage()    {}
+func (*RichTextNodeAttr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{63}
+}
+func (m *RichTextNodeAttr) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RichTextNodeAttr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RichTextNodeAttr.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.ParentCreatedAt != nil {
-		{
-			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *RichTextNodeAttr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RichTextNodeAttr.Merge(m, src)
+}
+func (m *RichTextNodeAttr) XXX_Size() int {
+	return m.Size()
+}
+func (m *RichTextNodeAttr) XXX_DiscardUnknown() {
+	xxx_messageInfo_RichTextNodeAttr.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RichTextNodeAttr proto.InternalMessageInfo
+
+func (m *RichTextNodeAttr) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Operation_Style) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RichTextNodeAttr) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *RichTextNodeAttr) GetUpdatedAt() *TimeTicket {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+type RichTextNode struct {
+	Id                   *TextNodeID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Attributes           map[string]*RichTextNodeAttr `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Value                string                       `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	RemovedAt            *TimeTicket                  `protobuf:"bytes,4,opt,name=removed_at,json=removedAt,proto3" json:"removed_at,omitempty"`
+	InsPrevId            *TextNodeID                  `protobuf:"bytes,5,opt,name=ins_prev_id,json=insPrevId,proto3" json:"ins_prev_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *RichTextNode) Reset()         { *m = RichTextNode{} }
+func (m *RichTextNode) String() string { return proto.CompactTextString(m) }
+func (*RichTextNode) ProtoMessage()    {}
+func (*RichTextNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{64}
+}
+func (m *RichTextNode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RichTextNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return b[:n], nil
+}
+func (m *RichTextNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RichTextNode.Merge(m, src)
+}
+func (m *RichTextNode) XXX_Size() int {
+	return m.Size()
+}
+func (m *RichTextNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_RichTextNode.DiscardUnknown(m)
 }
 
-func (m *Operation_Style) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_RichTextNode proto.InternalMessageInfo
+
+func (m *RichTextNode) GetId() *TextNodeID {
+	if m != nil {
+		return m.Id
+	}
+	return nil
 }
 
-func (m *Operation_Style) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *RichTextNode) GetAttributes() map[string]*RichTextNodeAttr {
+	if m != nil {
+		return m.Attributes
 	}
-	if m.ExecutedAt != nil {
-		{
-			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+	return nil
+}
+
+func (m *RichTextNode) GetValue() string {
+	if m != nil {
+		return m.Value
 	}
-	if len(m.Attributes) > 0 {
-		for k := range m.Attributes {
-			v := m.Attributes[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintYorkie(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintYorkie(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintYorkie(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x22
-		}
+	return ""
+}
+
+func (m *RichTextNode) GetRemovedAt() *TimeTicket {
+	if m != nil {
+		return m.RemovedAt
 	}
-	if m.To != nil {
-		{
-			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+func (m *RichTextNode) GetInsPrevId() *TextNodeID {
+	if m != nil {
+		return m.InsPrevId
 	}
-	if m.From != nil {
-		{
-			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
+	return nil
+}
+
+type TextNodeID struct {
+	CreatedAt            *TimeTicket `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Offset               int32       `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *TextNodeID) Reset()         { *m = TextNodeID{} }
+func (m *TextNodeID) String() string { return proto.CompactTextString(m) }
+func (*TextNodeID) ProtoMessage()    {}
+func (*TextNodeID) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{65}
+}
+func (m *TextNodeID) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TextNodeID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TextNodeID.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.ParentCreatedAt != nil {
-		{
-			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *TextNodeID) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TextNodeID.Merge(m, src)
+}
+func (m *TextNodeID) XXX_Size() int {
+	return m.Size()
+}
+func (m *TextNodeID) XXX_DiscardUnknown() {
+	xxx_messageInfo_TextNodeID.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TextNodeID proto.InternalMessageInfo
+
+func (m *TextNodeID) GetCreatedAt() *TimeTicket {
+	if m != nil {
+		return m.CreatedAt
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Operation_Increase) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TextNodeID) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Operation_Increase) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Metadata struct {
+	Clock                int32             `protobuf:"varint,1,opt,name=clock,proto3" json:"clock,omitempty"`
+	Data                 map[string]string `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *Operation_Increase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ExecutedAt != nil {
-		{
-			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Value != nil {
-		{
-			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
+func (m *Metadata) Reset()         { *m = Metadata{} }
+func (m *Metadata) String() string { return proto.CompactTextString(m) }
+func (*Metadata) ProtoMessage()    {}
+func (*Metadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{66}
+}
+func (m *Metadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Metadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Metadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.ParentCreatedAt != nil {
-		{
-			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *Metadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Metadata.Merge(m, src)
+}
+func (m *Metadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *Metadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_Metadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Metadata proto.InternalMessageInfo
+
+func (m *Metadata) GetClock() int32 {
+	if m != nil {
+		return m.Clock
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *JSONElementSimple) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Metadata) GetData() map[string]string {
+	if m != nil {
+		return m.Data
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *JSONElementSimple) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Client struct {
+	Id                   []byte    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Metadata             *Metadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func (m *JSONElementSimple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Type != 0 {
-		i = encodeVarintYorkie(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.RemovedAt != nil {
-		{
-			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.MovedAt != nil {
-		{
-			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.CreatedAt != nil {
-		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
+func (m *Client) Reset()         { *m = Client{} }
+func (m *Client) String() string { return proto.CompactTextString(m) }
+func (*Client) ProtoMessage()    {}
+func (*Client) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{67}
+}
+func (m *Client) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Client) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Client.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *JSONElement) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *Client) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Client.Merge(m, src)
 }
-
-func (m *JSONElement) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Client) XXX_Size() int {
+	return m.Size()
+}
+func (m *Client) XXX_DiscardUnknown() {
+	xxx_messageInfo_Client.DiscardUnknown(m)
 }
 
-func (m *JSONElement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+var xxx_messageInfo_Client proto.InternalMessageInfo
+
+func (m *Client) GetId() []byte {
+	if m != nil {
+		return m.Id
 	}
-	if m.Body != nil {
-		{
-			size := m.Body.Size()
-			i -= size
-			if _, err := m.Body.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+	return nil
+}
+
+func (m *Client) GetMetadata() *Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *JSONElement_JsonObject) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Clients struct {
+	Clients              []*Client `protobuf:"bytes,1,rep,name=clients,proto3" json:"clients,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func (m *JSONElement_JsonObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.JsonObject != nil {
-		{
-			size, err := m.JsonObject.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *Clients) Reset()         { *m = Clients{} }
+func (m *Clients) String() string { return proto.CompactTextString(m) }
+func (*Clients) ProtoMessage()    {}
+func (*Clients) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{68}
 }
-func (m *JSONElement_JsonArray) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Clients) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *JSONElement_JsonArray) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.JsonArray != nil {
-		{
-			size, err := m.JsonArray.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
+func (m *Clients) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Clients.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *JSONElement_Primitive_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Clients) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Clients.Merge(m, src)
+}
+func (m *Clients) XXX_Size() int {
+	return m.Size()
+}
+func (m *Clients) XXX_DiscardUnknown() {
+	xxx_messageInfo_Clients.DiscardUnknown(m)
 }
 
-func (m *JSONElement_Primitive_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Primitive != nil {
-		{
-			size, err := m.Primitive.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+var xxx_messageInfo_Clients proto.InternalMessageInfo
+
+func (m *Clients) GetClients() []*Client {
+	if m != nil {
+		return m.Clients
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
-func (m *JSONElement_Text_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+type DocumentKey struct {
+	Collection           string   `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Document             string   `protobuf:"bytes,2,opt,name=document,proto3" json:"document,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *JSONElement_Text_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Text != nil {
-		{
-			size, err := m.Text.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
+func (m *DocumentKey) Reset()         { *m = DocumentKey{} }
+func (m *DocumentKey) String() string { return proto.CompactTextString(m) }
+func (*DocumentKey) ProtoMessage()    {}
+func (*DocumentKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{69}
+}
+func (m *DocumentKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DocumentKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DocumentKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *JSONElement_RichText_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DocumentKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DocumentKey.Merge(m, src)
+}
+func (m *DocumentKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *DocumentKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_DocumentKey.DiscardUnknown(m)
 }
 
-func (m *JSONElement_RichText_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.RichText != nil {
-		{
-			size, err := m.RichText.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+var xxx_messageInfo_DocumentKey proto.InternalMessageInfo
+
+func (m *DocumentKey) GetCollection() string {
+	if m != nil {
+		return m.Collection
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
-func (m *JSONElement_Counter_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (m *DocumentKey) GetDocument() string {
+	if m != nil {
+		return m.Document
+	}
+	return ""
 }
 
-func (m *JSONElement_Counter_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Counter != nil {
-		{
-			size, err := m.Counter.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
+type Checkpoint struct {
+	ServerSeq            uint64   `protobuf:"varint,1,opt,name=server_seq,json=serverSeq,proto3" json:"server_seq,omitempty"`
+	ClientSeq            uint32   `protobuf:"varint,2,opt,name=client_seq,json=clientSeq,proto3" json:"client_seq,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Checkpoint) Reset()         { *m = Checkpoint{} }
+func (m *Checkpoint) String() string { return proto.CompactTextString(m) }
+func (*Checkpoint) ProtoMessage()    {}
+func (*Checkpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{70}
+}
+func (m *Checkpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Checkpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Checkpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x32
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *JSONElement_JSONObject) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *Checkpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Checkpoint.Merge(m, src)
 }
-
-func (m *JSONElement_JSONObject) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Checkpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *Checkpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_Checkpoint.DiscardUnknown(m)
 }
 
-func (m *JSONElement_JSONObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+var xxx_messageInfo_Checkpoint proto.InternalMessageInfo
+
+func (m *Checkpoint) GetServerSeq() uint64 {
+	if m != nil {
+		return m.ServerSeq
 	}
-	if m.RemovedAt != nil {
-		{
-			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	return 0
+}
+
+func (m *Checkpoint) GetClientSeq() uint32 {
+	if m != nil {
+		return m.ClientSeq
 	}
-	if m.MovedAt != nil {
-		{
-			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
+	return 0
+}
+
+type TextNodePos struct {
+	CreatedAt            *TimeTicket `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Offset               int32       `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	RelativeOffset       int32       `protobuf:"varint,3,opt,name=relative_offset,json=relativeOffset,proto3" json:"relative_offset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *TextNodePos) Reset()         { *m = TextNodePos{} }
+func (m *TextNodePos) String() string { return proto.CompactTextString(m) }
+func (*TextNodePos) ProtoMessage()    {}
+func (*TextNodePos) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{71}
+}
+func (m *TextNodePos) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TextNodePos) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TextNodePos.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if m.CreatedAt != nil {
-		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+}
+func (m *TextNodePos) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TextNodePos.Merge(m, src)
+}
+func (m *TextNodePos) XXX_Size() int {
+	return m.Size()
+}
+func (m *TextNodePos) XXX_DiscardUnknown() {
+	xxx_messageInfo_TextNodePos.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TextNodePos proto.InternalMessageInfo
+
+func (m *TextNodePos) GetCreatedAt() *TimeTicket {
+	if m != nil {
+		return m.CreatedAt
 	}
-	if len(m.Nodes) > 0 {
-		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYorkie(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return nil
+}
+
+func (m *TextNodePos) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *JSONElement_JSONArray) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TextNodePos) GetRelativeOffset() int32 {
+	if m != nil {
+		return m.RelativeOffset
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *JSONElement_JSONArray) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type TimeTicket struct {
+	Lamport              uint64   `protobuf:"varint,1,opt,name=lamport,proto3" json:"lamport,omitempty"`
+	Delimiter            uint32   `protobuf:"varint,2,opt,name=delimiter,proto3" json:"delimiter,omitempty"`
+	ActorId              []byte   `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *JSONElement_JSONArray) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *TimeTicket) Reset()         { *m = TimeTicket{} }
+func (m *TimeTicket) String() string { return proto.CompactTextString(m) }
+func (*TimeTicket) ProtoMessage()    {}
+func (*TimeTicket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{72}
+}
+func (m *TimeTicket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimeTicket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimeTicket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.RemovedAt != nil {
-		{
-			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.MovedAt != nil {
-		{
-			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.CreatedAt != nil {
-		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+}
+func (m *TimeTicket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeTicket.Merge(m, src)
+}
+func (m *TimeTicket) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimeTicket) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeTicket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeTicket proto.InternalMessageInfo
+
+func (m *TimeTicket) GetLamport() uint64 {
+	if m != nil {
+		return m.Lamport
 	}
-	if len(m.Nodes) > 0 {
-		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYorkie(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return 0
+}
+
+func (m *TimeTicket) GetDelimiter() uint32 {
+	if m != nil {
+		return m.Delimiter
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *JSONElement_Primitive) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TimeTicket) GetActorId() []byte {
+	if m != nil {
+		return m.ActorId
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *JSONElement_Primitive) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DocEvent struct {
+	Type                 DocEventType   `protobuf:"varint,1,opt,name=type,proto3,enum=api.DocEventType" json:"type,omitempty"`
+	Publisher            *Client        `protobuf:"bytes,2,opt,name=publisher,proto3" json:"publisher,omitempty"`
+	DocumentKeys         []*DocumentKey `protobuf:"bytes,3,rep,name=document_keys,json=documentKeys,proto3" json:"document_keys,omitempty"`
+	ServerSeq            uint64         `protobuf:"varint,4,opt,name=server_seq,json=serverSeq,proto3" json:"server_seq,omitempty"`
+	FromServerSeq        uint64         `protobuf:"varint,5,opt,name=from_server_seq,json=fromServerSeq,proto3" json:"from_server_seq,omitempty"`
+	Peers                []*Client      `protobuf:"bytes,6,rep,name=peers,proto3" json:"peers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *JSONElement_Primitive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.RemovedAt != nil {
-		{
-			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.MovedAt != nil {
-		{
-			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.CreatedAt != nil {
-		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
+func (m *DocEvent) Reset()         { *m = DocEvent{} }
+func (m *DocEvent) String() string { return proto.CompactTextString(m) }
+func (*DocEvent) ProtoMessage()    {}
+func (*DocEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9df40050e88fbc16, []int{73}
+}
+func (m *DocEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DocEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DocEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Type != 0 {
-		i = encodeVarintYorkie(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *DocEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DocEvent.Merge(m, src)
+}
+func (m *DocEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *DocEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_DocEvent.DiscardUnknown(m)
 }
 
-func (m *JSONElement_Text) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DocEvent proto.InternalMessageInfo
+
+func (m *DocEvent) GetType() DocEventType {
+	if m != nil {
+		return m.Type
 	}
-	return dAtA[:n], nil
+	return DocEventType_DOCUMENTS_CHANGED
 }
 
-func (m *JSONElement_Text) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DocEvent) GetPublisher() *Client {
+	if m != nil {
+		return m.Publisher
+	}
+	return nil
 }
 
-func (m *JSONElement_Text) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *DocEvent) GetDocumentKeys() []*DocumentKey {
+	if m != nil {
+		return m.DocumentKeys
 	}
-	if m.RemovedAt != nil {
-		{
-			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	return nil
+}
+
+func (m *DocEvent) GetServerSeq() uint64 {
+	if m != nil {
+		return m.ServerSeq
 	}
-	if m.MovedAt != nil {
-		{
-			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+func (m *DocEvent) GetFromServerSeq() uint64 {
+	if m != nil {
+		return m.FromServerSeq
 	}
-	if m.CreatedAt != nil {
-		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *DocEvent) GetPeers() []*Client {
+	if m != nil {
+		return m.Peers
 	}
-	if len(m.Nodes) > 0 {
-		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYorkie(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("api.ValueType", ValueType_name, ValueType_value)
+	proto.RegisterEnum("api.DocEventType", DocEventType_name, DocEventType_value)
+	proto.RegisterType((*BroadcastEventRequest)(nil), "api.BroadcastEventRequest")
+	proto.RegisterType((*BroadcastEventResponse)(nil), "api.BroadcastEventResponse")
+	proto.RegisterType((*CreateSnapshotRequest)(nil), "api.CreateSnapshotRequest")
+	proto.RegisterType((*CreateSnapshotResponse)(nil), "api.CreateSnapshotResponse")
+	proto.RegisterType((*SetDocumentGCDisabledRequest)(nil), "api.SetDocumentGCDisabledRequest")
+	proto.RegisterType((*SetDocumentGCDisabledResponse)(nil), "api.SetDocumentGCDisabledResponse")
+	proto.RegisterType((*GetServerInfoRequest)(nil), "api.GetServerInfoRequest")
+	proto.RegisterType((*GetServerInfoResponse)(nil), "api.GetServerInfoResponse")
+	proto.RegisterType((*CompactChangesRequest)(nil), "api.CompactChangesRequest")
+	proto.RegisterType((*CompactChangesResponse)(nil), "api.CompactChangesResponse")
+	proto.RegisterType((*GetDocumentStatsRequest)(nil), "api.GetDocumentStatsRequest")
+	proto.RegisterType((*GetDocumentStatsResponse)(nil), "api.GetDocumentStatsResponse")
+	proto.RegisterType((*DryRunGarbageCollectionRequest)(nil), "api.DryRunGarbageCollectionRequest")
+	proto.RegisterType((*DryRunGarbageCollectionResponse)(nil), "api.DryRunGarbageCollectionResponse")
+	proto.RegisterType((*Garbage)(nil), "api.Garbage")
+	proto.RegisterType((*GetClientInfoRequest)(nil), "api.GetClientInfoRequest")
+	proto.RegisterType((*GetClientInfoResponse)(nil), "api.GetClientInfoResponse")
+	proto.RegisterMapType((map[string]string)(nil), "api.GetClientInfoResponse.MetadataEntry")
+	proto.RegisterType((*ClientDocInfo)(nil), "api.ClientDocInfo")
+	proto.RegisterType((*GetSnapshotMetaRequest)(nil), "api.GetSnapshotMetaRequest")
+	proto.RegisterType((*GetSnapshotMetaResponse)(nil), "api.GetSnapshotMetaResponse")
+	proto.RegisterType((*StreamLogsRequest)(nil), "api.StreamLogsRequest")
+	proto.RegisterType((*StreamLogsResponse)(nil), "api.StreamLogsResponse")
+	proto.RegisterType((*QuarantineDocumentRequest)(nil), "api.QuarantineDocumentRequest")
+	proto.RegisterType((*QuarantineDocumentResponse)(nil), "api.QuarantineDocumentResponse")
+	proto.RegisterType((*ReleaseDocumentRequest)(nil), "api.ReleaseDocumentRequest")
+	proto.RegisterType((*ReleaseDocumentResponse)(nil), "api.ReleaseDocumentResponse")
+	proto.RegisterType((*DocumentSettings)(nil), "api.DocumentSettings")
+	proto.RegisterType((*UpdateDocumentSettingsRequest)(nil), "api.UpdateDocumentSettingsRequest")
+	proto.RegisterType((*UpdateDocumentSettingsResponse)(nil), "api.UpdateDocumentSettingsResponse")
+	proto.RegisterType((*ExportDocumentHistoryRequest)(nil), "api.ExportDocumentHistoryRequest")
+	proto.RegisterType((*ExportDocumentHistoryResponse)(nil), "api.ExportDocumentHistoryResponse")
+	proto.RegisterType((*CheckAccessRequest)(nil), "api.CheckAccessRequest")
+	proto.RegisterType((*CheckAccessRequest_Attribute)(nil), "api.CheckAccessRequest.Attribute")
+	proto.RegisterType((*CheckAccessResponse)(nil), "api.CheckAccessResponse")
+	proto.RegisterType((*ActivateClientRequest)(nil), "api.ActivateClientRequest")
+	proto.RegisterMapType((map[string]string)(nil), "api.ActivateClientRequest.MetadataEntry")
+	proto.RegisterType((*ActivateClientResponse)(nil), "api.ActivateClientResponse")
+	proto.RegisterType((*DeactivateClientRequest)(nil), "api.DeactivateClientRequest")
+	proto.RegisterType((*DeactivateClientResponse)(nil), "api.DeactivateClientResponse")
+	proto.RegisterType((*AttachDocumentRequest)(nil), "api.AttachDocumentRequest")
+	proto.RegisterType((*AttachDocumentResponse)(nil), "api.AttachDocumentResponse")
+	proto.RegisterType((*DetachDocumentRequest)(nil), "api.DetachDocumentRequest")
+	proto.RegisterType((*DetachDocumentResponse)(nil), "api.DetachDocumentResponse")
+	proto.RegisterType((*WatchDocumentsRequest)(nil), "api.WatchDocumentsRequest")
+	proto.RegisterType((*WatchDocumentsResponse)(nil), "api.WatchDocumentsResponse")
+	proto.RegisterType((*WatchDocumentsResponse_Initialization)(nil), "api.WatchDocumentsResponse.Initialization")
+	proto.RegisterMapType((map[string]*Clients)(nil), "api.WatchDocumentsResponse.Initialization.PeersMapByDocEntry")
+	proto.RegisterMapType((map[string]uint64)(nil), "api.WatchDocumentsResponse.Initialization.ServerSeqsEntry")
+	proto.RegisterType((*WatchDocumentsResponse_Keepalive)(nil), "api.WatchDocumentsResponse.Keepalive")
+	proto.RegisterType((*PushPullRequest)(nil), "api.PushPullRequest")
+	proto.RegisterType((*PushPullResponse)(nil), "api.PushPullResponse")
+	proto.RegisterType((*UpdateMetadataRequest)(nil), "api.UpdateMetadataRequest")
+	proto.RegisterType((*UpdateMetadataResponse)(nil), "api.UpdateMetadataResponse")
+	proto.RegisterType((*UpdateClientMetadataRequest)(nil), "api.UpdateClientMetadataRequest")
+	proto.RegisterMapType((map[string]string)(nil), "api.UpdateClientMetadataRequest.MetadataEntry")
+	proto.RegisterType((*UpdateClientMetadataResponse)(nil), "api.UpdateClientMetadataResponse")
+	proto.RegisterType((*PullJSONPatchesRequest)(nil), "api.PullJSONPatchesRequest")
+	proto.RegisterType((*PullJSONPatchesResponse)(nil), "api.PullJSONPatchesResponse")
+	proto.RegisterType((*FetchSnapshotRequest)(nil), "api.FetchSnapshotRequest")
+	proto.RegisterType((*FetchSnapshotResponse)(nil), "api.FetchSnapshotResponse")
+	proto.RegisterType((*JSONPatch)(nil), "api.JSONPatch")
+	proto.RegisterType((*ChangePack)(nil), "api.ChangePack")
+	proto.RegisterType((*Change)(nil), "api.Change")
+	proto.RegisterType((*ChangeID)(nil), "api.ChangeID")
+	proto.RegisterMapType((map[string]uint64)(nil), "api.ChangeID.VectorEntry")
+	proto.RegisterType((*Operation)(nil), "api.Operation")
+	proto.RegisterType((*Operation_Set)(nil), "api.Operation.Set")
+	proto.RegisterType((*Operation_Add)(nil), "api.Operation.Add")
+	proto.RegisterType((*Operation_Move)(nil), "api.Operation.Move")
+	proto.RegisterType((*Operation_Remove)(nil), "api.Operation.Remove")
+	proto.RegisterType((*Operation_Edit)(nil), "api.Operation.Edit")
+	proto.RegisterMapType((map[string]*TimeTicket)(nil), "api.Operation.Edit.CreatedAtMapByActorEntry")
+	proto.RegisterType((*Operation_Select)(nil), "api.Operation.Select")
+	proto.RegisterType((*Operation_RichEdit)(nil), "api.Operation.RichEdit")
+	proto.RegisterMapType((map[string]string)(nil), "api.Operation.RichEdit.AttributesEntry")
+	proto.RegisterMapType((map[string]*TimeTicket)(nil), "api.Operation.RichEdit.CreatedAtMapByActorEntry")
+	proto.RegisterType((*Operation_Style)(nil), "api.Operation.Style")
+	proto.RegisterMapType((map[string]string)(nil), "api.Operation.Style.AttributesEntry")
+	proto.RegisterType((*Operation_Increase)(nil), "api.Operation.Increase")
+	proto.RegisterType((*JSONElementSimple)(nil), "api.JSONElementSimple")
+	proto.RegisterType((*JSONElement)(nil), "api.JSONElement")
+	proto.RegisterType((*JSONElement_JSONObject)(nil), "api.JSONElement.JSONObject")
+	proto.RegisterType((*JSONElement_JSONArray)(nil), "api.JSONElement.JSONArray")
+	proto.RegisterType((*JSONElement_Primitive)(nil), "api.JSONElement.Primitive")
+	proto.RegisterType((*JSONElement_Text)(nil), "api.JSONElement.Text")
+	proto.RegisterType((*JSONElement_RichText)(nil), "api.JSONElement.RichText")
+	proto.RegisterType((*JSONElement_Counter)(nil), "api.JSONElement.Counter")
+	proto.RegisterType((*RHTNode)(nil), "api.RHTNode")
+	proto.RegisterType((*RGANode)(nil), "api.RGANode")
+	proto.RegisterType((*TextNode)(nil), "api.TextNode")
+	proto.RegisterType((*RichTextNodeAttr)(nil), "api.RichTextNodeAttr")
+	proto.RegisterType((*RichTextNode)(nil), "api.RichTextNode")
+	proto.RegisterMapType((map[string]*RichTextNodeAttr)(nil), "api.RichTextNode.AttributesEntry")
+	proto.RegisterType((*TextNodeID)(nil), "api.TextNodeID")
+	proto.RegisterType((*Metadata)(nil), "api.Metadata")
+	proto.RegisterMapType((map[string]string)(nil), "api.Metadata.DataEntry")
+	proto.RegisterType((*Client)(nil), "api.Client")
+	proto.RegisterType((*Clients)(nil), "api.Clients")
+	proto.RegisterType((*DocumentKey)(nil), "api.DocumentKey")
+	proto.RegisterType((*Checkpoint)(nil), "api.Checkpoint")
+	proto.RegisterType((*TextNodePos)(nil), "api.TextNodePos")
+	proto.RegisterType((*TimeTicket)(nil), "api.TimeTicket")
+	proto.RegisterType((*DocEvent)(nil), "api.DocEvent")
+}
+
+func init() { proto.RegisterFile("api/yorkie.proto", fileDescriptor_9df40050e88fbc16) }
+
+var fileDescriptor_9df40050e88fbc16 = []byte{
+	// 4268 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3b, 0x5d, 0x6f, 0x23, 0x47,
+	0x72, 0x1a, 0x7e, 0xb3, 0xa8, 0x0f, 0xaa, 0x57, 0x94, 0xb8, 0x23, 0xed, 0xd7, 0xac, 0xd7, 0x5e,
+	0xaf, 0x7d, 0xdc, 0xbd, 0xf5, 0xf9, 0x7c, 0xb6, 0xe3, 0x03, 0x28, 0x91, 0x27, 0xc9, 0xbb, 0x2b,
+	0xc9, 0x43, 0xee, 0x6d, 0x8c, 0x20, 0x98, 0x1b, 0xcd, 0xb4, 0xc8, 0xb1, 0x48, 0x0e, 0x77, 0x66,
+	0xa8, 0xac, 0x8c, 0x20, 0x0f, 0x09, 0x90, 0x20, 0x01, 0x82, 0x00, 0xc1, 0x3d, 0xdc, 0xe5, 0x2d,
+	0x41, 0x90, 0x7b, 0xcb, 0x53, 0x80, 0x04, 0x48, 0x90, 0x7b, 0xb8, 0x04, 0xb8, 0xb7, 0xbb, 0x3c,
+	0xe5, 0x02, 0x03, 0x41, 0xe0, 0x04, 0xc8, 0xaf, 0x08, 0x10, 0xf4, 0xd7, 0x7c, 0x71, 0x48, 0x8a,
+	0x96, 0x7d, 0xb7, 0xb8, 0xb7, 0x99, 0xae, 0xea, 0xaa, 0xea, 0xea, 0xea, 0xaa, 0xea, 0xea, 0x6e,
+	0x28, 0xeb, 0x43, 0xeb, 0xfe, 0xb9, 0xed, 0x9c, 0x5a, 0xb8, 0x36, 0x74, 0x6c, 0xcf, 0x46, 0x69,
+	0x7d, 0x68, 0xc9, 0x5f, 0xeb, 0x58, 0x5e, 0x77, 0x74, 0x5c, 0x33, 0xec, 0xfe, 0xfd, 0x8e, 0xdd,
+	0xb1, 0xef, 0x53, 0xd8, 0xf1, 0xe8, 0x84, 0xfe, 0xd1, 0x1f, 0xfa, 0xc5, 0xfa, 0xc8, 0x37, 0x3a,
+	0xb6, 0xdd, 0xe9, 0xe1, 0x00, 0xcb, 0xb3, 0xfa, 0xd8, 0xf5, 0xf4, 0xfe, 0x90, 0x23, 0xdc, 0x8c,
+	0x23, 0x9c, 0x58, 0xb8, 0x67, 0x6a, 0x7d, 0xdd, 0x3d, 0x65, 0x18, 0x8a, 0x06, 0x95, 0x6d, 0xc7,
+	0xd6, 0x4d, 0x43, 0x77, 0xbd, 0xe6, 0x19, 0x1e, 0x78, 0x2a, 0x7e, 0x3e, 0xc2, 0xae, 0x87, 0x6e,
+	0xc1, 0xe2, 0x70, 0x74, 0xdc, 0xb3, 0xdc, 0x2e, 0x76, 0x34, 0xcb, 0xac, 0x4a, 0x37, 0xa5, 0xbb,
+	0x8b, 0x6a, 0xc9, 0x6f, 0xdb, 0x37, 0xd1, 0x6d, 0xc8, 0x62, 0xd2, 0xa5, 0x9a, 0xba, 0x29, 0xdd,
+	0x2d, 0x3d, 0x5c, 0xaa, 0xe9, 0x43, 0xab, 0xd6, 0xb0, 0x0d, 0x46, 0x87, 0xc1, 0x94, 0x2a, 0xac,
+	0xc7, 0x19, 0xb8, 0x43, 0x7b, 0xe0, 0x62, 0xe5, 0x31, 0x54, 0x76, 0x1c, 0xac, 0x7b, 0xb8, 0x35,
+	0xd0, 0x87, 0x6e, 0xd7, 0xf6, 0x59, 0xbf, 0x05, 0x8b, 0xa6, 0x6d, 0x8c, 0xfa, 0x78, 0xe0, 0x69,
+	0xa7, 0xf8, 0x9c, 0xb2, 0x2e, 0x3d, 0x2c, 0x0b, 0xf2, 0x14, 0xf0, 0x08, 0x9f, 0xab, 0x25, 0x33,
+	0xf8, 0x51, 0xde, 0x81, 0xf5, 0x38, 0x35, 0xc6, 0x07, 0x5d, 0x03, 0x70, 0xb1, 0x73, 0x86, 0x1d,
+	0xcd, 0xc5, 0xcf, 0x29, 0xb1, 0x8c, 0x5a, 0x64, 0x2d, 0x2d, 0xfc, 0x5c, 0xf1, 0x60, 0xab, 0x85,
+	0x3d, 0x41, 0x77, 0x77, 0xa7, 0x61, 0xb9, 0xfa, 0x71, 0x0f, 0x9b, 0x97, 0x91, 0x06, 0xdd, 0x80,
+	0x52, 0xc7, 0xd0, 0x4c, 0x4e, 0x8a, 0x2a, 0xa8, 0xa0, 0x42, 0xc7, 0x10, 0xc4, 0x95, 0x1b, 0x70,
+	0x6d, 0x02, 0x57, 0xae, 0x9d, 0x75, 0x58, 0xdb, 0xc5, 0x5e, 0x8b, 0x8a, 0xb9, 0x3f, 0x38, 0xb1,
+	0xb9, 0x38, 0x8a, 0x0d, 0x95, 0x58, 0x3b, 0x1f, 0x66, 0x15, 0xf2, 0x67, 0xd8, 0x71, 0x2d, 0x7b,
+	0x40, 0x45, 0x2c, 0xaa, 0xe2, 0x97, 0x28, 0xa0, 0x63, 0x79, 0x9a, 0x61, 0xf7, 0xfb, 0x16, 0x9b,
+	0xac, 0xa2, 0x5a, 0xec, 0x58, 0xde, 0x0e, 0x6d, 0x20, 0xe0, 0xe3, 0x91, 0xd5, 0x33, 0x35, 0x53,
+	0xf7, 0x70, 0x35, 0xcd, 0xc0, 0xb4, 0xa5, 0xa1, 0x7b, 0x58, 0xf9, 0x14, 0x2a, 0x3b, 0x76, 0x7f,
+	0xa8, 0x1b, 0xde, 0x4e, 0x57, 0x1f, 0x74, 0xb0, 0x2b, 0x14, 0xf3, 0x36, 0x2c, 0x85, 0x15, 0xe3,
+	0x56, 0xa5, 0x9b, 0xe9, 0x44, 0xcd, 0x2c, 0x86, 0x34, 0xe3, 0xa2, 0x7b, 0xb0, 0x7a, 0x8c, 0x4f,
+	0x6c, 0x07, 0x6b, 0xa1, 0x59, 0x49, 0xd1, 0x59, 0x59, 0x61, 0x80, 0x96, 0x3f, 0x37, 0x67, 0xb0,
+	0x1e, 0xe7, 0xcd, 0x47, 0x7b, 0x1f, 0xae, 0x18, 0x0c, 0x82, 0x4d, 0x4d, 0xd0, 0x77, 0xf9, 0xec,
+	0x22, 0x1f, 0x24, 0xc4, 0x70, 0xd1, 0x1b, 0xb0, 0x1a, 0x74, 0x30, 0x18, 0x35, 0xce, 0xb6, 0xec,
+	0x03, 0x38, 0x17, 0xe5, 0x00, 0x36, 0x76, 0x83, 0xd9, 0x69, 0x79, 0xba, 0xe7, 0x5e, 0xca, 0x38,
+	0xff, 0x57, 0x82, 0xea, 0x38, 0xc1, 0x0b, 0xd9, 0x27, 0xaa, 0xc1, 0x15, 0x97, 0x9b, 0xf4, 0xb8,
+	0xc6, 0x56, 0x05, 0xc8, 0xd7, 0x19, 0xfa, 0x06, 0xac, 0xf3, 0xe1, 0x69, 0xae, 0x35, 0x30, 0xb0,
+	0x26, 0x50, 0xe8, 0xd4, 0x66, 0xd4, 0x35, 0x0e, 0x6d, 0x11, 0xa0, 0x58, 0x2c, 0xe8, 0x0e, 0x2c,
+	0xfb, 0x5c, 0x8e, 0xcf, 0x3d, 0xec, 0x56, 0x33, 0x14, 0x7b, 0x49, 0xb4, 0x6e, 0x93, 0x46, 0x62,
+	0xd7, 0xba, 0xe1, 0xd9, 0x8e, 0x66, 0xd8, 0xa3, 0x81, 0x57, 0xcd, 0x52, 0x1c, 0xa0, 0x4d, 0x3b,
+	0xa4, 0x45, 0xf9, 0x73, 0x09, 0xae, 0x37, 0x9c, 0x73, 0x75, 0x34, 0xd8, 0xd5, 0x9d, 0x63, 0xbd,
+	0x83, 0x77, 0xec, 0x5e, 0x0f, 0x1b, 0x9e, 0x65, 0x0f, 0x2e, 0xb5, 0xa0, 0xde, 0x87, 0xd5, 0xbe,
+	0x35, 0xd0, 0xdc, 0xf3, 0x81, 0x81, 0x4d, 0xcd, 0xb3, 0x8c, 0x53, 0x2c, 0xfc, 0xce, 0x0a, 0xed,
+	0xd9, 0xb6, 0xfa, 0xb8, 0x4d, 0x9b, 0xd5, 0x95, 0xbe, 0x35, 0x68, 0x51, 0x44, 0xd6, 0xa0, 0xfc,
+	0xb1, 0x04, 0x37, 0x26, 0x0a, 0x75, 0xb1, 0x59, 0x98, 0xb5, 0xa0, 0xd1, 0xab, 0x90, 0xef, 0x30,
+	0xe2, 0xd5, 0x34, 0x5d, 0x07, 0x8b, 0x54, 0x2c, 0xce, 0x50, 0x15, 0x40, 0xe5, 0x77, 0x21, 0xcf,
+	0xdb, 0xd0, 0x32, 0xa4, 0xb8, 0x63, 0x2d, 0xaa, 0x29, 0xcb, 0x44, 0x9b, 0x50, 0x1c, 0xea, 0x0e,
+	0x51, 0x8b, 0x65, 0xf2, 0x65, 0x5a, 0x60, 0x0d, 0xfb, 0x26, 0xaa, 0x01, 0x38, 0xb8, 0x6f, 0x9f,
+	0x61, 0x53, 0xd3, 0xd9, 0x54, 0x26, 0x8c, 0xbc, 0xc8, 0x51, 0xea, 0x1e, 0x5a, 0x83, 0x2c, 0x9b,
+	0x23, 0x32, 0x8f, 0x4b, 0x2a, 0xfb, 0x51, 0xde, 0xa2, 0x5e, 0x65, 0xa7, 0x67, 0x11, 0xa2, 0x81,
+	0x57, 0x21, 0xac, 0x8d, 0x9e, 0xc5, 0x59, 0x33, 0x57, 0x5f, 0x60, 0x0d, 0xfb, 0xa6, 0xf2, 0x59,
+	0x0a, 0x2a, 0xb1, 0x5e, 0x5c, 0x69, 0xd3, 0xba, 0x11, 0x8d, 0x72, 0x20, 0x99, 0x65, 0xee, 0x76,
+	0x58, 0x0b, 0x99, 0xd1, 0x75, 0xc8, 0xb9, 0x9e, 0xee, 0x8d, 0x5c, 0xee, 0x72, 0xf8, 0x1f, 0x6a,
+	0x40, 0xa1, 0x8f, 0x3d, 0xdd, 0xd4, 0x3d, 0xbd, 0x9a, 0xa1, 0x9a, 0xbc, 0xcb, 0x34, 0x99, 0x24,
+	0x41, 0xed, 0x09, 0x47, 0x6d, 0x0e, 0x3c, 0xe7, 0x5c, 0xf5, 0x7b, 0xa2, 0x07, 0x50, 0x0c, 0xbc,
+	0x42, 0x96, 0x92, 0x41, 0x94, 0x0c, 0xa3, 0xd1, 0xb0, 0x0d, 0x4a, 0x26, 0x40, 0x42, 0xef, 0x02,
+	0x8c, 0x86, 0xc4, 0x05, 0x52, 0x05, 0xe7, 0xa8, 0x82, 0xe5, 0x1a, 0x0b, 0xa0, 0x35, 0x11, 0x40,
+	0x6b, 0x6d, 0x11, 0x61, 0xd5, 0x22, 0xc7, 0xae, 0x7b, 0xf2, 0xfb, 0xb0, 0x14, 0x91, 0x03, 0x95,
+	0x21, 0x2d, 0x2c, 0xbb, 0xa8, 0x92, 0x4f, 0x32, 0x1d, 0x67, 0x7a, 0x6f, 0x84, 0xb9, 0x1e, 0xd8,
+	0xcf, 0x7b, 0xa9, 0x6f, 0x49, 0xca, 0xdf, 0x4a, 0xb0, 0x14, 0x11, 0x8a, 0xd8, 0x9a, 0xbf, 0x40,
+	0x7c, 0xbd, 0x82, 0x68, 0xda, 0x37, 0xc7, 0x56, 0x50, 0xea, 0x22, 0x2b, 0x68, 0x92, 0xbe, 0xef,
+	0x03, 0x18, 0x5d, 0x6c, 0x9c, 0x0e, 0x6d, 0x8b, 0x5b, 0x8b, 0x30, 0xac, 0x1d, 0xbf, 0x59, 0x0d,
+	0xa1, 0x28, 0x4f, 0x60, 0x9d, 0x44, 0x20, 0xee, 0x17, 0xc8, 0xc0, 0x2f, 0xe5, 0x1b, 0xff, 0x48,
+	0x82, 0x8d, 0x31, 0x7a, 0x17, 0x5b, 0x94, 0x08, 0x32, 0x5d, 0xdd, 0xed, 0x72, 0x9d, 0xd2, 0x6f,
+	0x32, 0x8d, 0x86, 0x83, 0xc5, 0x34, 0xa6, 0x67, 0x4f, 0x23, 0xc7, 0xae, 0x7b, 0x8a, 0x0a, 0xab,
+	0x2d, 0xcf, 0xc1, 0x7a, 0xff, 0xb1, 0xdd, 0xf1, 0xfd, 0xfd, 0x1a, 0x64, 0x7b, 0xf8, 0x0c, 0xf7,
+	0xf8, 0x64, 0xb2, 0x1f, 0xf4, 0x1a, 0xac, 0xf4, 0xec, 0x4e, 0x07, 0x3b, 0xda, 0xd0, 0xc1, 0x27,
+	0xd6, 0x0b, 0x1a, 0x4b, 0xd2, 0x77, 0x8b, 0xea, 0x32, 0x6b, 0x3e, 0xe2, 0xad, 0xca, 0xdf, 0x48,
+	0x80, 0xc2, 0x44, 0xf9, 0xc0, 0x6a, 0x90, 0x21, 0xb9, 0x5a, 0x55, 0x9a, 0x29, 0x1f, 0xc5, 0x0b,
+	0xa4, 0x48, 0x85, 0xa5, 0x58, 0x87, 0x1c, 0x63, 0x27, 0xa6, 0x94, 0xfd, 0x91, 0x54, 0xa0, 0x8f,
+	0x5d, 0x97, 0xf8, 0xa2, 0x0c, 0x4b, 0x05, 0xf8, 0x2f, 0x81, 0x98, 0x8e, 0x3d, 0x1c, 0x62, 0x93,
+	0xfb, 0x6e, 0xf1, 0xab, 0x1c, 0xc1, 0xd5, 0x8f, 0x46, 0xba, 0xa3, 0x0f, 0x3c, 0x6b, 0x80, 0xc5,
+	0x64, 0x5d, 0x6a, 0x62, 0xbf, 0x0d, 0x72, 0x12, 0x45, 0xae, 0x81, 0x9b, 0x50, 0x7a, 0xee, 0x43,
+	0x99, 0x91, 0x17, 0xd4, 0x70, 0x13, 0xb1, 0x33, 0x15, 0xf7, 0xb0, 0xee, 0x7e, 0x39, 0xe2, 0xbc,
+	0x0d, 0x1b, 0x63, 0xe4, 0xb8, 0x2c, 0x32, 0x14, 0x1c, 0x06, 0x12, 0x82, 0xf8, 0xff, 0xca, 0x3f,
+	0xa7, 0xa0, 0xec, 0xc7, 0x6d, 0xec, 0x79, 0xd6, 0xa0, 0xe3, 0xa2, 0xaf, 0x01, 0xf2, 0xa3, 0xa5,
+	0xd7, 0x75, 0xb0, 0xdb, 0xb5, 0x7b, 0x66, 0x55, 0x8a, 0x86, 0xe4, 0xb6, 0x00, 0x90, 0xdc, 0xc3,
+	0x47, 0xb7, 0x06, 0x1e, 0x76, 0xce, 0xf4, 0x9e, 0xc8, 0x3d, 0x04, 0x60, 0x9f, 0xb7, 0xa3, 0xfb,
+	0xb0, 0xd6, 0xd7, 0x5f, 0x88, 0x14, 0x45, 0x1b, 0x12, 0x1b, 0x1b, 0xf5, 0x7a, 0x3c, 0x7a, 0xaf,
+	0xf6, 0xf5, 0x17, 0x3c, 0x4b, 0x39, 0xc2, 0xce, 0xd1, 0xa8, 0xd7, 0x43, 0xbf, 0x01, 0x32, 0x8f,
+	0x4b, 0x1a, 0x0f, 0x32, 0x9a, 0xe1, 0xc7, 0x37, 0x6a, 0x00, 0x05, 0xb5, 0xca, 0x31, 0xc6, 0xe2,
+	0x1f, 0x7a, 0x1d, 0xca, 0xc4, 0x84, 0xb1, 0xe3, 0x60, 0x53, 0x73, 0x70, 0x87, 0xf4, 0xc9, 0x52,
+	0xa3, 0x59, 0xf1, 0xdb, 0x55, 0xda, 0x4c, 0x32, 0x0b, 0x07, 0x3f, 0x1f, 0x59, 0x24, 0x75, 0xb3,
+	0x3a, 0x83, 0x50, 0x1e, 0x95, 0xa3, 0x4c, 0xd6, 0x38, 0xb4, 0x45, 0x81, 0x22, 0x97, 0xfa, 0x17,
+	0x09, 0xae, 0x3d, 0xa5, 0xae, 0x32, 0xae, 0xc6, 0x4b, 0x25, 0x04, 0x5f, 0x87, 0x82, 0xcb, 0xe9,
+	0x70, 0xff, 0x57, 0x89, 0x74, 0xf0, 0x99, 0xf8, 0x68, 0xe8, 0x7d, 0x28, 0x31, 0x9f, 0x4d, 0x37,
+	0x40, 0x13, 0x7d, 0xc3, 0x77, 0xc8, 0x1e, 0xe9, 0x89, 0xee, 0x9e, 0xaa, 0x3c, 0x20, 0x90, 0x6f,
+	0xa5, 0x05, 0xd7, 0x27, 0x8d, 0x82, 0x5b, 0x51, 0x58, 0x22, 0xe9, 0x42, 0x12, 0x29, 0x2d, 0xd8,
+	0x6a, 0xbe, 0x18, 0xda, 0x8e, 0x9f, 0x19, 0xee, 0x59, 0xae, 0x67, 0x3b, 0xe7, 0x97, 0x34, 0xf4,
+	0x6b, 0x13, 0x88, 0x72, 0x41, 0x49, 0x6a, 0xd0, 0x1d, 0x0d, 0x4e, 0x79, 0x64, 0x61, 0x3f, 0xca,
+	0x67, 0x12, 0x20, 0xea, 0xf1, 0xeb, 0x86, 0x81, 0xdd, 0xb0, 0xff, 0xf3, 0xec, 0x53, 0x2c, 0x36,
+	0x15, 0xec, 0x87, 0x78, 0x9e, 0x3e, 0xf6, 0xba, 0xb6, 0xc8, 0x53, 0xf8, 0x1f, 0xaa, 0x03, 0xe8,
+	0x9e, 0xe7, 0x58, 0xc7, 0x23, 0x92, 0x42, 0xb2, 0x44, 0xe8, 0x56, 0x10, 0x4c, 0x22, 0xa4, 0x6b,
+	0x75, 0x81, 0xa9, 0x86, 0x3a, 0xc9, 0x6d, 0x28, 0xfa, 0x80, 0x2f, 0x66, 0x1a, 0x08, 0x32, 0x67,
+	0xd8, 0x39, 0x16, 0x61, 0x81, 0x7c, 0x2b, 0xbb, 0x70, 0x25, 0x22, 0x41, 0xb0, 0x69, 0xd2, 0x7b,
+	0x3d, 0xfb, 0x77, 0xfc, 0x85, 0x2f, 0x7e, 0xc9, 0x08, 0x1d, 0xac, 0xbb, 0xf6, 0x40, 0x8c, 0x90,
+	0xfd, 0x29, 0xbf, 0x90, 0xa0, 0x52, 0x37, 0x3c, 0xeb, 0x4c, 0xf7, 0x30, 0x0b, 0xdb, 0x42, 0x53,
+	0xd1, 0x7c, 0x47, 0x8a, 0xe7, 0x3b, 0xe1, 0xbc, 0x26, 0x15, 0xca, 0x6b, 0x12, 0x89, 0x4d, 0xcc,
+	0x6b, 0xae, 0x01, 0xd0, 0x2d, 0xb8, 0x41, 0x99, 0xa4, 0xe9, 0x04, 0x16, 0x59, 0xcb, 0x23, 0x7c,
+	0x7e, 0xb9, 0x4c, 0xa4, 0x0d, 0xeb, 0x71, 0x61, 0x82, 0x38, 0x3c, 0x6d, 0x68, 0x91, 0x34, 0x30,
+	0x15, 0xcb, 0x1e, 0xbf, 0x09, 0x1b, 0x0d, 0xac, 0x27, 0x6a, 0x6c, 0x6a, 0xd6, 0xf9, 0x0e, 0x54,
+	0xc7, 0xfb, 0x5d, 0x20, 0xef, 0x54, 0x4e, 0xa0, 0x52, 0xf7, 0x3c, 0xdd, 0xe8, 0xc6, 0xc3, 0xc6,
+	0xb4, 0x5e, 0xe8, 0x01, 0x94, 0x98, 0x37, 0xd3, 0x86, 0xba, 0x71, 0x1a, 0xd9, 0x5a, 0x30, 0x4f,
+	0x76, 0xa4, 0x1b, 0xa7, 0x24, 0x0f, 0x12, 0xdf, 0x4a, 0x07, 0xd6, 0xe3, 0x7c, 0x2e, 0x92, 0x16,
+	0xcf, 0xcf, 0xe8, 0x04, 0x2a, 0x0d, 0xfc, 0x4b, 0x18, 0x90, 0x05, 0xeb, 0x0d, 0x9c, 0x38, 0xa0,
+	0x19, 0xf3, 0x3f, 0x3f, 0xab, 0x3f, 0x95, 0xa0, 0xf2, 0x4c, 0xf7, 0x02, 0x56, 0xbe, 0xbf, 0xb9,
+	0x0d, 0x39, 0x46, 0x98, 0xaf, 0xf5, 0x52, 0x28, 0x6b, 0x57, 0x39, 0x68, 0xbc, 0xf4, 0x90, 0xba,
+	0x50, 0xe9, 0xa1, 0x0a, 0x79, 0x83, 0x30, 0x1d, 0x0d, 0xe9, 0xca, 0x29, 0xa8, 0xe2, 0x57, 0xf9,
+	0x45, 0x06, 0xd6, 0xe3, 0xf2, 0xf0, 0xb1, 0xb7, 0x61, 0xd9, 0x1a, 0x58, 0x9e, 0xa5, 0xf7, 0xac,
+	0x4f, 0x75, 0x4f, 0x94, 0x57, 0x4a, 0x0f, 0xef, 0x51, 0x66, 0xc9, 0x9d, 0x6a, 0xfb, 0x91, 0x1e,
+	0x7b, 0x0b, 0x6a, 0x8c, 0x06, 0xba, 0x33, 0xad, 0x76, 0xb6, 0xb7, 0xc0, 0xab, 0x67, 0xa8, 0x09,
+	0xc5, 0x53, 0x8c, 0x87, 0x7a, 0xcf, 0x3a, 0xc3, 0x3c, 0x60, 0xdd, 0x99, 0xc6, 0xf7, 0x91, 0x40,
+	0xde, 0x5b, 0x50, 0x83, 0x9e, 0xf2, 0xff, 0xa4, 0x60, 0x39, 0x2a, 0x12, 0x3a, 0x81, 0xf2, 0x10,
+	0x63, 0xc7, 0xd5, 0xfa, 0xfa, 0x50, 0x3b, 0x3e, 0x27, 0x35, 0x14, 0x5e, 0xc0, 0xf9, 0xe0, 0xe2,
+	0x03, 0xab, 0x1d, 0x11, 0x12, 0x4f, 0xf4, 0xe1, 0xf6, 0x39, 0x91, 0x9d, 0xfa, 0xaa, 0xa5, 0x61,
+	0xb8, 0x0d, 0xfd, 0x16, 0x94, 0x82, 0x14, 0x5e, 0x4c, 0xd4, 0x7b, 0x73, 0xb0, 0xf0, 0x2b, 0x1b,
+	0x2e, 0xa3, 0x0f, 0x7e, 0xfe, 0xef, 0xca, 0x07, 0x80, 0xc6, 0x25, 0x48, 0xf0, 0x79, 0x4a, 0xd8,
+	0xe7, 0x89, 0xad, 0x39, 0xb3, 0x29, 0x37, 0xe4, 0x01, 0xe5, 0x0f, 0x60, 0x25, 0xc6, 0x6e, 0x96,
+	0x03, 0xcd, 0x84, 0xbb, 0x97, 0xa0, 0xe8, 0x4f, 0xc0, 0x76, 0x0e, 0x32, 0xc7, 0xb6, 0x79, 0xae,
+	0x7c, 0x0f, 0x56, 0x8e, 0x46, 0x6e, 0x97, 0xa4, 0x6a, 0x5f, 0xd1, 0xba, 0xd5, 0xa1, 0x1c, 0x70,
+	0xf8, 0x6a, 0x5c, 0x90, 0x0b, 0x15, 0x96, 0xfd, 0x88, 0xe8, 0xf2, 0x4b, 0x58, 0xae, 0xa4, 0x74,
+	0x1c, 0x67, 0xca, 0x8b, 0xa3, 0x3f, 0x91, 0x60, 0x93, 0x81, 0x18, 0xa7, 0xb8, 0x54, 0x53, 0x47,
+	0xff, 0xe1, 0x58, 0x20, 0xae, 0x51, 0x41, 0xa6, 0x10, 0x9c, 0x14, 0x8e, 0x2f, 0x17, 0x6f, 0xaf,
+	0xc3, 0x56, 0x32, 0x4f, 0x3e, 0xca, 0x1e, 0xac, 0x93, 0x39, 0xfd, 0xb0, 0x75, 0x78, 0x70, 0x44,
+	0x96, 0x0a, 0xbe, 0x5c, 0xc6, 0x1c, 0xdd, 0x4c, 0xa7, 0xe2, 0x75, 0xf0, 0x1f, 0x4a, 0xb0, 0x31,
+	0xc6, 0xee, 0x62, 0xfb, 0xf0, 0xbb, 0x90, 0x1f, 0xb2, 0x1e, 0x5c, 0xa1, 0xcb, 0x54, 0x12, 0x9f,
+	0x92, 0x2a, 0xc0, 0x64, 0xa7, 0x25, 0x44, 0xe2, 0x7b, 0x56, 0xff, 0x1f, 0x5d, 0x85, 0x42, 0x57,
+	0x77, 0xb5, 0xbe, 0xed, 0x60, 0xbe, 0x6b, 0xc9, 0x77, 0x75, 0xf7, 0x89, 0xed, 0x60, 0xe5, 0xf7,
+	0x25, 0x58, 0xfb, 0x0e, 0xf6, 0x8c, 0xee, 0x97, 0x71, 0x54, 0x30, 0x43, 0x11, 0x24, 0xf3, 0xb3,
+	0x4f, 0x4e, 0x5c, 0x2c, 0x0a, 0xa6, 0xfc, 0x4f, 0xf9, 0x03, 0x09, 0x2a, 0x31, 0x21, 0x2e, 0xa6,
+	0x9e, 0x6b, 0x00, 0x9e, 0xed, 0xe9, 0x3d, 0xcd, 0xb5, 0x3e, 0x15, 0x5e, 0xa3, 0x48, 0x5b, 0x5a,
+	0xd6, 0xa7, 0x78, 0x12, 0xbf, 0x20, 0x4d, 0xcf, 0x84, 0xd3, 0xf4, 0x26, 0x14, 0x7d, 0xbd, 0x92,
+	0x0a, 0xa2, 0x3d, 0x14, 0x15, 0x44, 0x7b, 0x48, 0x32, 0xdf, 0xa1, 0xee, 0xf9, 0x05, 0x11, 0xf2,
+	0x1d, 0xd8, 0x5f, 0x3a, 0x64, 0x7f, 0xca, 0x3f, 0xa4, 0x00, 0x82, 0xb5, 0xfe, 0xc5, 0xf4, 0x18,
+	0xad, 0x1c, 0xa5, 0x66, 0x56, 0x8e, 0xc8, 0xec, 0x47, 0x8a, 0xd1, 0x8b, 0xaa, 0xff, 0x8f, 0xee,
+	0x40, 0x5e, 0xec, 0x26, 0x59, 0xd5, 0xaf, 0x14, 0xf2, 0x47, 0xaa, 0x80, 0x25, 0xd7, 0x81, 0xb3,
+	0x17, 0xab, 0x03, 0x47, 0x2c, 0x2c, 0x17, 0xb1, 0xb0, 0xc4, 0x6d, 0x70, 0x3e, 0x71, 0x1b, 0xac,
+	0xfc, 0x99, 0x04, 0x39, 0x26, 0x16, 0xba, 0xe6, 0x57, 0x70, 0x45, 0x08, 0x67, 0x80, 0xfd, 0x06,
+	0x2d, 0xe8, 0x86, 0xea, 0x30, 0xa9, 0x68, 0x1d, 0xa6, 0x06, 0x60, 0x0f, 0xb1, 0x43, 0x23, 0x9c,
+	0xd8, 0x27, 0xb1, 0x45, 0x73, 0x28, 0x9a, 0xd5, 0x10, 0x06, 0xda, 0x82, 0x22, 0xd9, 0x72, 0xeb,
+	0xde, 0x88, 0x2f, 0x8e, 0x45, 0x35, 0x68, 0x50, 0x7e, 0x2e, 0x41, 0x41, 0x30, 0x0e, 0xe5, 0x6a,
+	0xc2, 0x18, 0x97, 0x44, 0xae, 0x46, 0x8c, 0x71, 0x0b, 0xf2, 0x3d, 0xbd, 0x4f, 0xb6, 0x87, 0xcc,
+	0x12, 0xb7, 0x53, 0x0f, 0x24, 0x55, 0x34, 0x11, 0x0d, 0xb1, 0xfa, 0xbe, 0x65, 0xf2, 0x19, 0xca,
+	0xd3, 0xff, 0x7d, 0x13, 0x7d, 0x1d, 0x72, 0x67, 0x98, 0x7c, 0xf3, 0xf9, 0xb9, 0x1a, 0x19, 0x6f,
+	0xed, 0xbb, 0x14, 0xc6, 0xfc, 0x23, 0x47, 0x94, 0xdf, 0x85, 0x52, 0xa8, 0x79, 0x9e, 0x50, 0xaa,
+	0xfc, 0x74, 0x1d, 0x8a, 0xbe, 0x2a, 0xd0, 0xab, 0x90, 0x26, 0xeb, 0x83, 0x29, 0x1a, 0x45, 0xf5,
+	0x54, 0x6b, 0x61, 0x92, 0x30, 0x11, 0x04, 0x82, 0xa7, 0x9b, 0x66, 0x35, 0x95, 0x88, 0x57, 0x37,
+	0x4d, 0x82, 0xa7, 0x9b, 0x26, 0x7a, 0x1d, 0x32, 0xa4, 0x4e, 0xce, 0x33, 0xaa, 0x2b, 0x31, 0xc4,
+	0x27, 0x36, 0xcd, 0x9f, 0x28, 0x0a, 0xba, 0x4f, 0xf6, 0x81, 0x14, 0x39, 0x13, 0xda, 0xd3, 0x07,
+	0xc8, 0x2a, 0x05, 0xee, 0x2d, 0xa8, 0x1c, 0x8d, 0xd0, 0xc6, 0xa6, 0x25, 0x8c, 0x32, 0x4e, 0xbb,
+	0x69, 0x5a, 0x44, 0x5a, 0x8a, 0x42, 0x68, 0xbb, 0xb8, 0x87, 0x0d, 0x51, 0x6e, 0xae, 0x8c, 0x8d,
+	0x8c, 0x00, 0x09, 0x6d, 0x86, 0x86, 0xbe, 0x09, 0x45, 0xc7, 0x32, 0xba, 0x1a, 0x65, 0x90, 0xa7,
+	0x7d, 0x36, 0xe2, 0xf2, 0x58, 0x46, 0x97, 0x33, 0x29, 0x38, 0xfc, 0x1b, 0xbd, 0x09, 0x59, 0xd7,
+	0x3b, 0xef, 0xe1, 0x6a, 0x81, 0xf6, 0x59, 0x8b, 0xf3, 0x21, 0x30, 0x92, 0x74, 0x52, 0x24, 0xf4,
+	0x36, 0x14, 0xac, 0x81, 0xe1, 0x60, 0xdd, 0xc5, 0xd5, 0x62, 0x22, 0x93, 0x7d, 0x0e, 0x26, 0x4c,
+	0x04, 0xaa, 0xfc, 0x77, 0x12, 0xa4, 0x5b, 0xd8, 0x23, 0x4b, 0x94, 0x1f, 0x63, 0x84, 0x0a, 0xb1,
+	0xd2, 0x84, 0x25, 0xca, 0x30, 0x77, 0x44, 0x0d, 0x56, 0xd8, 0x48, 0x2a, 0xb0, 0x91, 0x37, 0xc3,
+	0xfe, 0xab, 0xf4, 0x70, 0xdd, 0x0f, 0x2d, 0xcd, 0x1e, 0xa6, 0x65, 0x15, 0xab, 0x3f, 0xec, 0x61,
+	0x6e, 0x3b, 0x24, 0xb5, 0xc1, 0x2f, 0xb0, 0x31, 0xe2, 0x6c, 0x33, 0xc9, 0x6c, 0x41, 0xe0, 0xd4,
+	0x3d, 0xf9, 0x33, 0x09, 0xd2, 0x75, 0xd3, 0xbc, 0x9c, 0xd8, 0xef, 0x00, 0x71, 0x13, 0x67, 0xe1,
+	0xae, 0x13, 0x0e, 0xa7, 0x96, 0x08, 0x5e, 0xd0, 0xf1, 0xab, 0x1e, 0xdd, 0x7f, 0x4a, 0x90, 0x21,
+	0xf6, 0xfc, 0x2b, 0x1a, 0x5e, 0x2d, 0xa1, 0x1a, 0x3f, 0xd6, 0x27, 0x28, 0xc1, 0x7f, 0x81, 0x01,
+	0xfe, 0x48, 0x82, 0x1c, 0x5b, 0x83, 0x97, 0x1b, 0x62, 0x54, 0xd2, 0xd4, 0xbc, 0x92, 0xa6, 0x67,
+	0x4b, 0xfa, 0xfd, 0x34, 0x64, 0xe8, 0x6a, 0xbc, 0x94, 0x9c, 0xaf, 0x40, 0xe6, 0xc4, 0xb1, 0xfb,
+	0x91, 0x33, 0x9f, 0x36, 0x7e, 0xe1, 0x1d, 0xd8, 0x26, 0x3e, 0xb2, 0x5d, 0x95, 0x42, 0xd1, 0x4d,
+	0x48, 0x79, 0x76, 0x35, 0x3d, 0x01, 0x27, 0xe5, 0xd9, 0xe8, 0x18, 0x36, 0x02, 0xee, 0x62, 0x13,
+	0xa8, 0x87, 0xfc, 0xfb, 0x9b, 0x09, 0x9e, 0xab, 0xe6, 0xcb, 0x41, 0x77, 0x5c, 0xf5, 0xc0, 0xe5,
+	0x5f, 0x31, 0xc6, 0x21, 0x74, 0xbf, 0x6d, 0x0f, 0x3c, 0xcc, 0x4f, 0x8a, 0x8b, 0xaa, 0xf8, 0x8d,
+	0x6b, 0x2f, 0x37, 0x5b, 0x7b, 0xcf, 0xa0, 0x3a, 0x89, 0x79, 0x42, 0x60, 0xb9, 0x13, 0xdd, 0xf0,
+	0x8d, 0x51, 0x0e, 0x6d, 0xda, 0x7e, 0x2c, 0x41, 0x8e, 0x39, 0xda, 0x97, 0x63, 0x62, 0xe6, 0x5f,
+	0x02, 0x7f, 0x9d, 0x81, 0x82, 0x70, 0xfb, 0x2f, 0xc7, 0x18, 0x4e, 0x66, 0x19, 0xd7, 0x83, 0x09,
+	0x51, 0xeb, 0x4b, 0x33, 0xb0, 0xdd, 0x48, 0x21, 0x3a, 0x47, 0x99, 0xbe, 0x36, 0x89, 0xa9, 0x5f,
+	0x6f, 0x16, 0x25, 0x86, 0xa0, 0x6b, 0x7c, 0x3a, 0xf2, 0xbf, 0x42, 0x4b, 0xfd, 0x00, 0x56, 0x62,
+	0x92, 0xce, 0xb3, 0xdd, 0x94, 0x7f, 0x92, 0x82, 0x2c, 0x8d, 0xf4, 0x2f, 0x87, 0x8d, 0x34, 0x22,
+	0x33, 0xc4, 0xcc, 0xe2, 0x95, 0xa4, 0xc4, 0x64, 0x9e, 0xe9, 0xc9, 0xce, 0x9e, 0x9e, 0x4b, 0x6a,
+	0xf1, 0x47, 0x12, 0x14, 0x44, 0xfa, 0x73, 0x39, 0x45, 0xbe, 0x19, 0x9d, 0xf9, 0xf9, 0x42, 0xff,
+	0xec, 0x78, 0xe3, 0x17, 0xa0, 0xfe, 0x43, 0x82, 0xd5, 0x31, 0xb2, 0xb1, 0x78, 0x27, 0xcd, 0x8c,
+	0x77, 0xf7, 0xa0, 0xe0, 0xdf, 0x3e, 0x99, 0x60, 0xaa, 0x79, 0x71, 0xf7, 0x64, 0xde, 0xbb, 0x2a,
+	0x0a, 0x64, 0xbc, 0xf3, 0x21, 0xcb, 0xb0, 0x97, 0xf9, 0x3e, 0xe8, 0xbb, 0x64, 0xd4, 0xed, 0xf3,
+	0x21, 0x56, 0x29, 0x2c, 0x98, 0x91, 0x2c, 0xdb, 0x0d, 0xd3, 0x1f, 0xe5, 0x4f, 0x16, 0xa1, 0x14,
+	0x1a, 0x1b, 0xfa, 0x36, 0x94, 0x3e, 0x71, 0xed, 0x81, 0x66, 0x1f, 0x7f, 0x82, 0x0d, 0x31, 0xac,
+	0xcd, 0xb8, 0x66, 0xe9, 0xf7, 0x21, 0x45, 0xd9, 0x5b, 0x50, 0x81, 0xf4, 0x60, 0x7f, 0xe8, 0x7d,
+	0xa0, 0x7f, 0x9a, 0xee, 0x38, 0xba, 0xb8, 0x57, 0x21, 0x27, 0x76, 0xaf, 0x13, 0x0c, 0x52, 0x65,
+	0x25, 0xf8, 0xf4, 0x07, 0xbd, 0x07, 0xc5, 0xa1, 0x63, 0xf5, 0x2d, 0x2f, 0x28, 0xd6, 0x8e, 0xf7,
+	0x3d, 0x12, 0x18, 0xa4, 0xaf, 0x8f, 0x8e, 0xde, 0x80, 0x8c, 0x87, 0x5f, 0x78, 0x91, 0x4d, 0x46,
+	0xb8, 0x1b, 0x59, 0x3d, 0x64, 0xdf, 0x40, 0x90, 0xd0, 0xb7, 0xf8, 0x36, 0x80, 0xf6, 0x60, 0x26,
+	0x7f, 0x75, 0xac, 0x07, 0xf1, 0x6e, 0xbc, 0x57, 0xc1, 0xe1, 0xdf, 0xe8, 0x1b, 0xc4, 0x61, 0x8e,
+	0x06, 0x1e, 0x76, 0x78, 0xcc, 0xad, 0x8e, 0xf5, 0xdb, 0x61, 0xf0, 0xbd, 0x05, 0x55, 0xa0, 0xca,
+	0xff, 0x24, 0x01, 0x04, 0x2a, 0x23, 0xd5, 0xd4, 0x81, 0x6d, 0x62, 0x71, 0xe1, 0x8f, 0x55, 0x53,
+	0xd5, 0xbd, 0x36, 0x59, 0xdd, 0x2a, 0x03, 0xcd, 0x9d, 0x4e, 0x85, 0xcd, 0x2b, 0x3d, 0x97, 0x79,
+	0x65, 0x66, 0x99, 0x97, 0xfc, 0x8f, 0x12, 0xab, 0x99, 0xb0, 0x59, 0x4a, 0x96, 0x7e, 0xb7, 0xfe,
+	0xb2, 0x4a, 0xff, 0x6f, 0x12, 0x14, 0x7d, 0xa3, 0xf1, 0x97, 0x8a, 0x74, 0x91, 0xa5, 0x92, 0x0a,
+	0x2d, 0x95, 0xb9, 0x53, 0xf1, 0xf0, 0x98, 0x32, 0x73, 0x8d, 0x29, 0x3b, 0x73, 0x4c, 0x7f, 0x2f,
+	0x41, 0x86, 0xda, 0xe3, 0xed, 0xe8, 0x64, 0x2c, 0x45, 0x22, 0xc5, 0xcb, 0x38, 0x1b, 0x3f, 0x96,
+	0x58, 0xae, 0x45, 0xa5, 0x7f, 0x2d, 0x2a, 0xfd, 0x2a, 0x33, 0x25, 0x0e, 0x7d, 0x59, 0x47, 0xf0,
+	0x33, 0x09, 0xf2, 0x7c, 0x8d, 0xff, 0x7a, 0x58, 0x13, 0x09, 0x74, 0xdb, 0x24, 0xd0, 0xed, 0x42,
+	0x9e, 0x7b, 0xa1, 0x84, 0x88, 0x7e, 0x0f, 0xf2, 0x98, 0x79, 0xb8, 0x48, 0xe6, 0x12, 0xf2, 0x7c,
+	0xaa, 0x40, 0x50, 0x9e, 0x41, 0x9e, 0x3b, 0x04, 0x74, 0x13, 0x32, 0x03, 0xe2, 0x65, 0xa5, 0xd0,
+	0xc1, 0x11, 0x87, 0xa9, 0x14, 0x32, 0x17, 0xe1, 0xbf, 0x92, 0xa0, 0x20, 0x6c, 0x03, 0xdd, 0x08,
+	0x15, 0x0f, 0x57, 0x22, 0x86, 0xcf, 0xcb, 0x87, 0x89, 0x49, 0xc8, 0xdc, 0xc1, 0xf5, 0x3e, 0x94,
+	0xac, 0x81, 0xab, 0xd1, 0xfd, 0xbb, 0x65, 0x56, 0x33, 0xc9, 0xfc, 0x8a, 0xd6, 0xc0, 0x3d, 0x72,
+	0xf0, 0xd9, 0xbe, 0xa9, 0x7c, 0x02, 0xe5, 0xb0, 0x0d, 0x93, 0x64, 0xe9, 0xa2, 0x19, 0x12, 0x11,
+	0x2e, 0x74, 0x89, 0x72, 0x92, 0x70, 0xfe, 0xcd, 0x49, 0xe5, 0x5f, 0x53, 0xb0, 0x18, 0x66, 0x36,
+	0x5b, 0x29, 0xd1, 0x1b, 0x26, 0xa9, 0xd0, 0x0d, 0x93, 0x30, 0x9d, 0xa9, 0x39, 0x63, 0x62, 0x45,
+	0x7c, 0xde, 0x75, 0x14, 0xd7, 0x6b, 0x76, 0x96, 0x5e, 0xe5, 0xf6, 0x45, 0x12, 0xcf, 0x37, 0xa2,
+	0x49, 0x61, 0x65, 0x6c, 0x64, 0x84, 0x44, 0x28, 0x1f, 0x7d, 0x2f, 0xf3, 0x83, 0xbf, 0xbc, 0x41,
+	0xae, 0x6e, 0x40, 0xc0, 0x74, 0xee, 0xdc, 0x2e, 0x38, 0x81, 0x20, 0x5c, 0xb3, 0xfe, 0x89, 0xc7,
+	0x1f, 0x4a, 0x50, 0x10, 0xa7, 0x52, 0xf4, 0x38, 0xa2, 0x67, 0x1b, 0xec, 0xd6, 0x50, 0x56, 0x65,
+	0x3f, 0x24, 0x6f, 0x09, 0x1d, 0xa4, 0xb1, 0x3a, 0xa1, 0xe8, 0x52, 0x6b, 0xf8, 0x27, 0x66, 0x14,
+	0x49, 0x7e, 0x07, 0x8a, 0x8d, 0x2f, 0x74, 0x52, 0xb6, 0x03, 0x39, 0x76, 0x46, 0x16, 0xba, 0x33,
+	0xbd, 0x48, 0xcd, 0xe1, 0xf5, 0xc8, 0x61, 0x5e, 0x50, 0x87, 0x17, 0x32, 0x04, 0x67, 0x75, 0xca,
+	0x03, 0xc8, 0x33, 0x22, 0x2e, 0x3d, 0x6c, 0x60, 0x9f, 0x55, 0x29, 0x7c, 0xd8, 0x40, 0xdb, 0x54,
+	0x01, 0x53, 0xf6, 0xa1, 0x14, 0x3a, 0xfc, 0x40, 0xd7, 0x01, 0x42, 0x17, 0xeb, 0x98, 0xe0, 0xa1,
+	0x96, 0xc8, 0xe1, 0x56, 0x2a, 0x7a, 0xb8, 0xa5, 0x1c, 0x90, 0xe3, 0x16, 0xff, 0x20, 0xe4, 0xd6,
+	0xf8, 0x81, 0x11, 0xad, 0xc3, 0x47, 0x0f, 0x8d, 0x42, 0x65, 0xfc, 0x54, 0xac, 0x8c, 0xaf, 0xfc,
+	0x1e, 0x94, 0x42, 0x1b, 0xaa, 0x2f, 0x6b, 0xc6, 0xc9, 0xbd, 0x56, 0x07, 0xf7, 0x74, 0x92, 0x6a,
+	0x68, 0xa1, 0x43, 0xa9, 0xac, 0xba, 0x2c, 0x9a, 0x0f, 0x99, 0x69, 0x18, 0x00, 0x01, 0xe5, 0xf0,
+	0xa1, 0x82, 0x34, 0x7e, 0xa8, 0xb0, 0x05, 0x45, 0x13, 0xf7, 0x48, 0x06, 0x83, 0x1d, 0x31, 0x12,
+	0xbf, 0x61, 0xca, 0x91, 0x83, 0xf2, 0x7f, 0x12, 0x14, 0xc4, 0x9d, 0x08, 0x74, 0x27, 0x12, 0xab,
+	0x56, 0x23, 0x17, 0x26, 0x42, 0xe1, 0xea, 0x75, 0x28, 0xfa, 0x6f, 0x94, 0xb8, 0x45, 0x44, 0x26,
+	0x37, 0x80, 0x8e, 0x1f, 0x4b, 0xa7, 0x2f, 0x74, 0x8b, 0x24, 0x7a, 0xda, 0x97, 0x89, 0x9f, 0xf6,
+	0xbd, 0x0a, 0x2b, 0x64, 0x07, 0x1c, 0x7e, 0xab, 0xc1, 0xae, 0xda, 0x2e, 0x91, 0xe6, 0xe0, 0x9d,
+	0xc6, 0x2d, 0xc8, 0xd2, 0x9b, 0x12, 0xbc, 0x38, 0x11, 0x11, 0x92, 0x41, 0xee, 0xfd, 0x4c, 0x82,
+	0xa2, 0x1f, 0x8e, 0x51, 0x01, 0x32, 0x07, 0x4f, 0x1f, 0x3f, 0x2e, 0x2f, 0xa0, 0x12, 0xe4, 0xb7,
+	0x0f, 0x0f, 0x1f, 0x37, 0xeb, 0x07, 0x65, 0x89, 0xfc, 0xec, 0x1f, 0xb4, 0x9b, 0xbb, 0x4d, 0xb5,
+	0x9c, 0x22, 0x38, 0x8f, 0x0f, 0x0f, 0x76, 0xcb, 0x69, 0x04, 0x90, 0x6b, 0x1c, 0x3e, 0xdd, 0x7e,
+	0xdc, 0x2c, 0x67, 0xc8, 0x77, 0xab, 0xad, 0xee, 0x1f, 0xec, 0x96, 0xb3, 0xa8, 0x08, 0xd9, 0xed,
+	0x8f, 0xdb, 0xcd, 0x56, 0x39, 0x47, 0x90, 0x1b, 0xf5, 0x76, 0xb3, 0x9c, 0x47, 0x2b, 0x6c, 0x17,
+	0xa5, 0x1d, 0x6e, 0x7f, 0xd8, 0xdc, 0x69, 0x97, 0x0b, 0x68, 0x99, 0x25, 0xfc, 0x5a, 0x5d, 0x55,
+	0xeb, 0x1f, 0x97, 0x8b, 0x04, 0xb5, 0xdd, 0xfc, 0xcd, 0x76, 0x19, 0xd0, 0x12, 0x14, 0xd5, 0xfd,
+	0x9d, 0x3d, 0x8d, 0xfe, 0x96, 0x48, 0x4f, 0xce, 0x5d, 0xdb, 0x39, 0x68, 0x97, 0x17, 0xd1, 0x22,
+	0x14, 0x88, 0x04, 0xf4, 0x6f, 0x89, 0xd0, 0x61, 0x52, 0xd0, 0xff, 0xe5, 0x7b, 0x3f, 0x94, 0x60,
+	0x31, 0x3c, 0x69, 0xa8, 0x02, 0xab, 0x8d, 0xc3, 0x9d, 0xa7, 0x4f, 0x9a, 0x07, 0xed, 0x96, 0xb6,
+	0xb3, 0x57, 0x3f, 0xd8, 0x6d, 0x36, 0xca, 0x0b, 0xd1, 0xe6, 0x67, 0xf5, 0xf6, 0xce, 0x5e, 0xb3,
+	0x51, 0x96, 0xd0, 0x06, 0x5c, 0x09, 0x9a, 0x9f, 0x1e, 0x08, 0x40, 0x0a, 0xad, 0x41, 0xf9, 0x49,
+	0xb3, 0x5d, 0x6f, 0xd4, 0xdb, 0x75, 0x9f, 0x4a, 0x1a, 0x5d, 0x85, 0x4a, 0x80, 0xfe, 0xd1, 0xd3,
+	0xba, 0x5a, 0x3f, 0x68, 0xef, 0x1f, 0x34, 0x1b, 0xe5, 0x0c, 0x5a, 0x85, 0xa5, 0xa3, 0x66, 0x53,
+	0x0d, 0x78, 0x66, 0x1f, 0x7e, 0x3f, 0x07, 0xb9, 0x8f, 0xe9, 0x93, 0x3c, 0xf4, 0x08, 0x96, 0xa3,
+	0x37, 0xe1, 0x90, 0x3c, 0xf9, 0xae, 0x9e, 0xbc, 0x99, 0x08, 0xe3, 0x87, 0xf8, 0x0b, 0xe8, 0x23,
+	0x28, 0xc7, 0x2f, 0xb2, 0xa1, 0x2d, 0x66, 0x63, 0xc9, 0xf7, 0xe2, 0xe4, 0x6b, 0x13, 0xa0, 0x3e,
+	0x49, 0x22, 0x5f, 0xe4, 0xea, 0x99, 0x90, 0x2f, 0xe9, 0xde, 0x9b, 0xbc, 0x99, 0x08, 0x0b, 0x13,
+	0x6b, 0xe0, 0x04, 0x62, 0x0d, 0x3c, 0x99, 0x58, 0xf2, 0x3d, 0x31, 0x65, 0x01, 0x3d, 0x81, 0xe5,
+	0xe8, 0xb5, 0x1e, 0x4e, 0x2c, 0xf1, 0xb2, 0x97, 0xbc, 0x99, 0x08, 0x13, 0xc4, 0x1e, 0x48, 0xe8,
+	0x5d, 0x28, 0x88, 0xab, 0x2d, 0x88, 0x9d, 0x5a, 0xc5, 0xee, 0xd2, 0xc8, 0x95, 0x58, 0x6b, 0x78,
+	0x58, 0xd1, 0xdb, 0x23, 0x5c, 0x92, 0xc4, 0x7b, 0x2c, 0xf2, 0x66, 0x22, 0xcc, 0x27, 0xf6, 0xdb,
+	0xb0, 0x96, 0x74, 0x55, 0x03, 0xdd, 0x9c, 0x75, 0x73, 0x44, 0xbe, 0x35, 0x05, 0xc3, 0x27, 0x7f,
+	0x00, 0x2b, 0xb1, 0xab, 0x17, 0x68, 0x93, 0x8f, 0x2b, 0xe9, 0xfe, 0x87, 0xbc, 0x95, 0x0c, 0xf4,
+	0xe9, 0x7d, 0x08, 0x4b, 0x91, 0x9b, 0x0a, 0x88, 0x95, 0x07, 0x92, 0xae, 0x50, 0xc8, 0x72, 0x12,
+	0x28, 0x98, 0x82, 0x87, 0xff, 0x9e, 0x21, 0x71, 0x73, 0xe4, 0x12, 0x5f, 0xfd, 0x08, 0x96, 0xa3,
+	0x8f, 0x39, 0xb9, 0x4e, 0x13, 0x9f, 0x90, 0xca, 0x9b, 0x89, 0xb0, 0xf0, 0x04, 0x45, 0x5f, 0x6c,
+	0x72, 0x62, 0x89, 0x8f, 0x42, 0xe5, 0xcd, 0x44, 0x98, 0x4f, 0xec, 0x7b, 0x50, 0x49, 0x7c, 0x4f,
+	0x89, 0x98, 0xfe, 0xa7, 0xbd, 0xf0, 0x94, 0x95, 0x69, 0x28, 0x3e, 0x87, 0x3d, 0x58, 0x8a, 0x3c,
+	0xbc, 0xe4, 0x3a, 0x4d, 0x7a, 0xa4, 0x29, 0xcb, 0x49, 0xa0, 0xc8, 0xc0, 0x23, 0xaf, 0x1a, 0xc5,
+	0xc0, 0x93, 0x9e, 0x59, 0xca, 0x9b, 0x89, 0xb0, 0xb0, 0x77, 0x89, 0xbf, 0x2c, 0xe4, 0xde, 0x65,
+	0xc2, 0x0b, 0x46, 0xf9, 0xda, 0x04, 0xa8, 0x4f, 0xf2, 0x04, 0x36, 0x26, 0xbc, 0x96, 0x43, 0xb7,
+	0xd9, 0xea, 0x9f, 0xfa, 0xc0, 0x4f, 0x7e, 0x65, 0x3a, 0x92, 0xe0, 0xf3, 0xf0, 0x2f, 0xb2, 0x90,
+	0xad, 0x9b, 0x7d, 0x6b, 0xc0, 0x75, 0x1b, 0x3c, 0xef, 0x0a, 0x74, 0x3b, 0xf6, 0x54, 0x4d, 0x96,
+	0x93, 0x40, 0xe1, 0x95, 0x14, 0x7b, 0x4c, 0xc4, 0x57, 0x52, 0xf2, 0x93, 0x25, 0x79, 0x2b, 0x19,
+	0xe8, 0xd3, 0xab, 0x03, 0x04, 0xcf, 0x77, 0x10, 0xab, 0xd1, 0x8e, 0x3d, 0x12, 0x92, 0x37, 0xc6,
+	0xda, 0x43, 0x3e, 0xec, 0x19, 0xa0, 0xf1, 0x77, 0x30, 0xe8, 0x3a, 0xed, 0x32, 0xf1, 0xc9, 0x8d,
+	0x7c, 0x63, 0x22, 0x3c, 0x3c, 0xd6, 0xd8, 0x8b, 0x16, 0x3e, 0xd6, 0xe4, 0x67, 0x33, 0xf2, 0x56,
+	0x32, 0xd0, 0xa7, 0x67, 0x88, 0xfb, 0x76, 0x63, 0xef, 0x5d, 0x94, 0x90, 0x13, 0x9b, 0xf0, 0x8a,
+	0x43, 0xbe, 0x3d, 0x15, 0xc7, 0x67, 0x72, 0x0c, 0x95, 0xc4, 0xd7, 0x09, 0x7c, 0xa1, 0x4e, 0x7b,
+	0x0e, 0x21, 0x2b, 0xd3, 0x50, 0x42, 0x1a, 0xdf, 0x86, 0x52, 0xe8, 0xb2, 0x3f, 0xda, 0x98, 0xf0,
+	0x00, 0x41, 0xae, 0x8e, 0x03, 0x04, 0x95, 0xed, 0xf2, 0x4f, 0x3f, 0xbf, 0x2e, 0xfd, 0xfc, 0xf3,
+	0xeb, 0xd2, 0x7f, 0x7d, 0x7e, 0x5d, 0xfa, 0xc1, 0x7f, 0x5f, 0x5f, 0x38, 0xce, 0xd1, 0x17, 0x22,
+	0x6f, 0xfd, 0xff, 0x00, 0x56, 0xfa, 0xb8, 0xca, 0xbc, 0x3f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// YorkieClient is the client API for Yorkie service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type YorkieClient interface {
+	ActivateClient(ctx context.Context, in *ActivateClientRequest, opts ...grpc.CallOption) (*ActivateClientResponse, error)
+	DeactivateClient(ctx context.Context, in *DeactivateClientRequest, opts ...grpc.CallOption) (*DeactivateClientResponse, error)
+	AttachDocument(ctx context.Context, in *AttachDocumentRequest, opts ...grpc.CallOption) (*AttachDocumentResponse, error)
+	DetachDocument(ctx context.Context, in *DetachDocumentRequest, opts ...grpc.CallOption) (*DetachDocumentResponse, error)
+	WatchDocuments(ctx context.Context, in *WatchDocumentsRequest, opts ...grpc.CallOption) (Yorkie_WatchDocumentsClient, error)
+	PushPull(ctx context.Context, in *PushPullRequest, opts ...grpc.CallOption) (*PushPullResponse, error)
+	UpdateMetadata(ctx context.Context, in *UpdateMetadataRequest, opts ...grpc.CallOption) (*UpdateMetadataResponse, error)
+	UpdateClientMetadata(ctx context.Context, in *UpdateClientMetadataRequest, opts ...grpc.CallOption) (*UpdateClientMetadataResponse, error)
+	PullJSONPatches(ctx context.Context, in *PullJSONPatchesRequest, opts ...grpc.CallOption) (*PullJSONPatchesResponse, error)
+	FetchSnapshot(ctx context.Context, in *FetchSnapshotRequest, opts ...grpc.CallOption) (Yorkie_FetchSnapshotClient, error)
+}
+
+type yorkieClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewYorkieClient(cc *grpc.ClientConn) YorkieClient {
+	return &yorkieClient{cc}
+}
+
+func (c *yorkieClient) ActivateClient(ctx context.Context, in *ActivateClientRequest, opts ...grpc.CallOption) (*ActivateClientResponse, error) {
+	out := new(ActivateClientResponse)
+	err := c.cc.Invoke(ctx, "/api.Yorkie/ActivateClient", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *JSONElement_RichText) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *yorkieClient) DeactivateClient(ctx context.Context, in *DeactivateClientRequest, opts ...grpc.CallOption) (*DeactivateClientResponse, error) {
+	out := new(DeactivateClientResponse)
+	err := c.cc.Invoke(ctx, "/api.Yorkie/DeactivateClient", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *JSONElement_RichText) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *yorkieClient) AttachDocument(ctx context.Context, in *AttachDocumentRequest, opts ...grpc.CallOption) (*AttachDocumentResponse, error) {
+	out := new(AttachDocumentResponse)
+	err := c.cc.Invoke(ctx, "/api.Yorkie/AttachDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *JSONElement_RichText) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (c *yorkieClient) DetachDocument(ctx context.Context, in *DetachDocumentRequest, opts ...grpc.CallOption) (*DetachDocumentResponse, error) {
+	out := new(DetachDocumentResponse)
+	err := c.cc.Invoke(ctx, "/api.Yorkie/DetachDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.RemovedAt != nil {
-		{
-			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	return out, nil
+}
+
+func (c *yorkieClient) WatchDocuments(ctx context.Context, in *WatchDocumentsRequest, opts ...grpc.CallOption) (Yorkie_WatchDocumentsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Yorkie_serviceDesc.Streams[0], "/api.Yorkie/WatchDocuments", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.MovedAt != nil {
-		{
-			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	x := &yorkieWatchDocumentsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if m.CreatedAt != nil {
-		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	if len(m.Nodes) > 0 {
-		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYorkie(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return x, nil
+}
+
+type Yorkie_WatchDocumentsClient interface {
+	Recv() (*WatchDocumentsResponse, error)
+	grpc.ClientStream
+}
+
+type yorkieWatchDocumentsClient struct {
+	grpc.ClientStream
+}
+
+func (x *yorkieWatchDocumentsClient) Recv() (*WatchDocumentsResponse, error) {
+	m := new(WatchDocumentsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return m, nil
 }
 
-func (m *JSONElement_Counter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *yorkieClient) PushPull(ctx context.Context, in *PushPullRequest, opts ...grpc.CallOption) (*PushPullResponse, error) {
+	out := new(PushPullResponse)
+	err := c.cc.Invoke(ctx, "/api.Yorkie/PushPull", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *JSONElement_Counter) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *yorkieClient) UpdateMetadata(ctx context.Context, in *UpdateMetadataRequest, opts ...grpc.CallOption) (*UpdateMetadataResponse, error) {
+	out := new(UpdateMetadataResponse)
+	err := c.cc.Invoke(ctx, "/api.Yorkie/UpdateMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *JSONElement_Counter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (c *yorkieClient) UpdateClientMetadata(ctx context.Context, in *UpdateClientMetadataRequest, opts ...grpc.CallOption) (*UpdateClientMetadataResponse, error) {
+	out := new(UpdateClientMetadataResponse)
+	err := c.cc.Invoke(ctx, "/api.Yorkie/UpdateClientMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.RemovedAt != nil {
-		{
-			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.MovedAt != nil {
-		{
-			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	return out, nil
+}
+
+func (c *yorkieClient) PullJSONPatches(ctx context.Context, in *PullJSONPatchesRequest, opts ...grpc.CallOption) (*PullJSONPatchesResponse, error) {
+	out := new(PullJSONPatchesResponse)
+	err := c.cc.Invoke(ctx, "/api.Yorkie/PullJSONPatches", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.CreatedAt != nil {
-		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	return out, nil
+}
+
+func (c *yorkieClient) FetchSnapshot(ctx context.Context, in *FetchSnapshotRequest, opts ...grpc.CallOption) (Yorkie_FetchSnapshotClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Yorkie_serviceDesc.Streams[1], "/api.Yorkie/FetchSnapshot", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
+	x := &yorkieFetchSnapshotClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if m.Type != 0 {
-		i = encodeVarintYorkie(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return x, nil
 }
 
-func (m *RHTNode) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+type Yorkie_FetchSnapshotClient interface {
+	Recv() (*FetchSnapshotResponse, error)
+	grpc.ClientStream
+}
+
+type yorkieFetchSnapshotClient struct {
+	grpc.ClientStream
+}
+
+func (x *yorkieFetchSnapshotClient) Recv() (*FetchSnapshotResponse, error) {
+	m := new(FetchSnapshotResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *RHTNode) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// YorkieServer is the server API for Yorkie service.
+type YorkieServer interface {
+	ActivateClient(context.Context, *ActivateClientRequest) (*ActivateClientResponse, error)
+	DeactivateClient(context.Context, *DeactivateClientRequest) (*DeactivateClientResponse, error)
+	AttachDocument(context.Context, *AttachDocumentRequest) (*AttachDocumentResponse, error)
+	DetachDocument(context.Context, *DetachDocumentRequest) (*DetachDocumentResponse, error)
+	WatchDocuments(*WatchDocumentsRequest, Yorkie_WatchDocumentsServer) error
+	PushPull(context.Context, *PushPullRequest) (*PushPullResponse, error)
+	UpdateMetadata(context.Context, *UpdateMetadataRequest) (*UpdateMetadataResponse, error)
+	UpdateClientMetadata(context.Context, *UpdateClientMetadataRequest) (*UpdateClientMetadataResponse, error)
+	PullJSONPatches(context.Context, *PullJSONPatchesRequest) (*PullJSONPatchesResponse, error)
+	FetchSnapshot(*FetchSnapshotRequest, Yorkie_FetchSnapshotServer) error
 }
 
-func (m *RHTNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+// UnimplementedYorkieServer can be embedded to have forward compatible implementations.
+type UnimplementedYorkieServer struct {
+}
+
+func (*UnimplementedYorkieServer) ActivateClient(ctx context.Context, req *ActivateClientRequest) (*ActivateClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateClient not implemented")
+}
+func (*UnimplementedYorkieServer) DeactivateClient(ctx context.Context, req *DeactivateClientRequest) (*DeactivateClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivateClient not implemented")
+}
+func (*UnimplementedYorkieServer) AttachDocument(ctx context.Context, req *AttachDocumentRequest) (*AttachDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttachDocument not implemented")
+}
+func (*UnimplementedYorkieServer) DetachDocument(ctx context.Context, req *DetachDocumentRequest) (*DetachDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetachDocument not implemented")
+}
+func (*UnimplementedYorkieServer) WatchDocuments(req *WatchDocumentsRequest, srv Yorkie_WatchDocumentsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchDocuments not implemented")
+}
+func (*UnimplementedYorkieServer) PushPull(ctx context.Context, req *PushPullRequest) (*PushPullResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushPull not implemented")
+}
+func (*UnimplementedYorkieServer) UpdateMetadata(ctx context.Context, req *UpdateMetadataRequest) (*UpdateMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMetadata not implemented")
+}
+func (*UnimplementedYorkieServer) UpdateClientMetadata(ctx context.Context, req *UpdateClientMetadataRequest) (*UpdateClientMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClientMetadata not implemented")
+}
+func (*UnimplementedYorkieServer) PullJSONPatches(ctx context.Context, req *PullJSONPatchesRequest) (*PullJSONPatchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PullJSONPatches not implemented")
+}
+func (*UnimplementedYorkieServer) FetchSnapshot(req *FetchSnapshotRequest, srv Yorkie_FetchSnapshotServer) error {
+	return status.Errorf(codes.Unimplemented, "method FetchSnapshot not implemented")
+}
+
+func RegisterYorkieServer(s *grpc.Server, srv YorkieServer) {
+	s.RegisterService(&_Yorkie_serviceDesc, srv)
+}
+
+func _Yorkie_ActivateClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivateClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Element != nil {
-		{
-			size, err := m.Element.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(YorkieServer).ActivateClient(ctx, in)
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Yorkie/ActivateClient",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YorkieServer).ActivateClient(ctx, req.(*ActivateClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RGANode) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Yorkie_DeactivateClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivateClientRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(YorkieServer).DeactivateClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Yorkie/DeactivateClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YorkieServer).DeactivateClient(ctx, req.(*DeactivateClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RGANode) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Yorkie_AttachDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YorkieServer).AttachDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Yorkie/AttachDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YorkieServer).AttachDocument(ctx, req.(*AttachDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RGANode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _Yorkie_DetachDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetachDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Element != nil {
-		{
-			size, err := m.Element.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(YorkieServer).DetachDocument(ctx, in)
 	}
-	if m.Next != nil {
-		{
-			size, err := m.Next.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Yorkie/DetachDocument",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YorkieServer).DetachDocument(ctx, req.(*DetachDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TextNode) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func _Yorkie_WatchDocuments_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchDocumentsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(YorkieServer).WatchDocuments(m, &yorkieWatchDocumentsServer{stream})
 }
 
-func (m *TextNode) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Yorkie_WatchDocumentsServer interface {
+	Send(*WatchDocumentsResponse) error
+	grpc.ServerStream
 }
 
-func (m *TextNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.InsPrevId != nil {
-		{
-			size, err := m.InsPrevId.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+type yorkieWatchDocumentsServer struct {
+	grpc.ServerStream
+}
+
+func (x *yorkieWatchDocumentsServer) Send(m *WatchDocumentsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Yorkie_PushPull_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushPullRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.RemovedAt != nil {
-		{
-			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(YorkieServer).PushPull(ctx, in)
 	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Yorkie/PushPull",
 	}
-	if m.Id != nil {
-		{
-			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YorkieServer).PushPull(ctx, req.(*PushPullRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RichTextNodeAttr) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Yorkie_UpdateMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMetadataRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RichTextNodeAttr) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RichTextNodeAttr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.UpdatedAt != nil {
-		{
-			size, err := m.UpdatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(YorkieServer).UpdateMetadata(ctx, in)
 	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Yorkie/UpdateMetadata",
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YorkieServer).UpdateMetadata(ctx, req.(*UpdateMetadataRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *RichTextNode) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Yorkie_UpdateClientMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateClientMetadataRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *RichTextNode) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RichTextNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(YorkieServer).UpdateClientMetadata(ctx, in)
 	}
-	if m.InsPrevId != nil {
-		{
-			size, err := m.InsPrevId.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Yorkie/UpdateClientMetadata",
 	}
-	if m.RemovedAt != nil {
-		{
-			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YorkieServer).UpdateClientMetadata(ctx, req.(*UpdateClientMetadataRequest))
 	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x1a
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Yorkie_PullJSONPatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullJSONPatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Attributes) > 0 {
-		for k := range m.Attributes {
-			v := m.Attributes[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintYorkie(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintYorkie(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintYorkie(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
-		}
+	if interceptor == nil {
+		return srv.(YorkieServer).PullJSONPatches(ctx, in)
 	}
-	if m.Id != nil {
-		{
-			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Yorkie/PullJSONPatches",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YorkieServer).PullJSONPatches(ctx, req.(*PullJSONPatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TextNodeID) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func _Yorkie_FetchSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FetchSnapshotRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(YorkieServer).FetchSnapshot(m, &yorkieFetchSnapshotServer{stream})
 }
 
-func (m *TextNodeID) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Yorkie_FetchSnapshotServer interface {
+	Send(*FetchSnapshotResponse) error
+	grpc.ServerStream
 }
 
-func (m *TextNodeID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Offset != 0 {
-		i = encodeVarintYorkie(dAtA, i, uint64(m.Offset))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.CreatedAt != nil {
-		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type yorkieFetchSnapshotServer struct {
+	grpc.ServerStream
 }
 
-func (m *Metadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (x *yorkieFetchSnapshotServer) Send(m *FetchSnapshotResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func (m *Metadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var _Yorkie_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.Yorkie",
+	HandlerType: (*YorkieServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ActivateClient",
+			Handler:    _Yorkie_ActivateClient_Handler,
+		},
+		{
+			MethodName: "DeactivateClient",
+			Handler:    _Yorkie_DeactivateClient_Handler,
+		},
+		{
+			MethodName: "AttachDocument",
+			Handler:    _Yorkie_AttachDocument_Handler,
+		},
+		{
+			MethodName: "DetachDocument",
+			Handler:    _Yorkie_DetachDocument_Handler,
+		},
+		{
+			MethodName: "PushPull",
+			Handler:    _Yorkie_PushPull_Handler,
+		},
+		{
+			MethodName: "UpdateMetadata",
+			Handler:    _Yorkie_UpdateMetadata_Handler,
+		},
+		{
+			MethodName: "UpdateClientMetadata",
+			Handler:    _Yorkie_UpdateClientMetadata_Handler,
+		},
+		{
+			MethodName: "PullJSONPatches",
+			Handler:    _Yorkie_PullJSONPatches_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchDocuments",
+			Handler:       _Yorkie_WatchDocuments_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "FetchSnapshot",
+			Handler:       _Yorkie_FetchSnapshot_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/yorkie.proto",
 }
 
-func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Data) > 0 {
-		for k := range m.Data {
-			v := m.Data[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintYorkie(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintYorkie(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintYorkie(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Clock != 0 {
-		i = encodeVarintYorkie(dAtA, i, uint64(m.Clock))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// ClusterClient is the client API for Cluster service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ClusterClient interface {
+	BroadcastEvent(ctx context.Context, in *BroadcastEventRequest, opts ...grpc.CallOption) (*BroadcastEventResponse, error)
+	CreateSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*CreateSnapshotResponse, error)
+	SetDocumentGCDisabled(ctx context.Context, in *SetDocumentGCDisabledRequest, opts ...grpc.CallOption) (*SetDocumentGCDisabledResponse, error)
+	GetServerInfo(ctx context.Context, in *GetServerInfoRequest, opts ...grpc.CallOption) (*GetServerInfoResponse, error)
+	CompactChanges(ctx context.Context, in *CompactChangesRequest, opts ...grpc.CallOption) (*CompactChangesResponse, error)
+	GetDocumentStats(ctx context.Context, in *GetDocumentStatsRequest, opts ...grpc.CallOption) (*GetDocumentStatsResponse, error)
+	DryRunGarbageCollection(ctx context.Context, in *DryRunGarbageCollectionRequest, opts ...grpc.CallOption) (*DryRunGarbageCollectionResponse, error)
 }
 
-func (m *Client) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type clusterClient struct {
+	cc *grpc.ClientConn
 }
 
-func (m *Client) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func NewClusterClient(cc *grpc.ClientConn) ClusterClient {
+	return &clusterClient{cc}
 }
 
-func (m *Client) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Metadata != nil {
-		{
-			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+func (c *clusterClient) BroadcastEvent(ctx context.Context, in *BroadcastEventRequest, opts ...grpc.CallOption) (*BroadcastEventResponse, error) {
+	out := new(BroadcastEventResponse)
+	err := c.cc.Invoke(ctx, "/api.Cluster/BroadcastEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *Clients) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *clusterClient) CreateSnapshot(ctx context.Context, in *CreateSnapshotRequest, opts ...grpc.CallOption) (*CreateSnapshotResponse, error) {
+	out := new(CreateSnapshotResponse)
+	err := c.cc.Invoke(ctx, "/api.Cluster/CreateSnapshot", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Clients) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *Clients) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Clients) > 0 {
-		for iNdEx := len(m.Clients) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Clients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYorkie(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *clusterClient) SetDocumentGCDisabled(ctx context.Context, in *SetDocumentGCDisabledRequest, opts ...grpc.CallOption) (*SetDocumentGCDisabledResponse, error) {
+	out := new(SetDocumentGCDisabledResponse)
+	err := c.cc.Invoke(ctx, "/api.Cluster/SetDocumentGCDisabled", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *DocumentKey) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *clusterClient) GetServerInfo(ctx context.Context, in *GetServerInfoRequest, opts ...grpc.CallOption) (*GetServerInfoResponse, error) {
+	out := new(GetServerInfoResponse)
+	err := c.cc.Invoke(ctx, "/api.Cluster/GetServerInfo", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *DocumentKey) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *clusterClient) CompactChanges(ctx context.Context, in *CompactChangesRequest, opts ...grpc.CallOption) (*CompactChangesResponse, error) {
+	out := new(CompactChangesResponse)
+	err := c.cc.Invoke(ctx, "/api.Cluster/CompactChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *DocumentKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Document) > 0 {
-		i -= len(m.Document)
-		copy(dAtA[i:], m.Document)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Document)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Collection) > 0 {
-		i -= len(m.Collection)
-		copy(dAtA[i:], m.Collection)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Collection)))
-		i--
-		dAtA[i] = 0xa
+func (c *clusterClient) GetDocumentStats(ctx context.Context, in *GetDocumentStatsRequest, opts ...grpc.CallOption) (*GetDocumentStatsResponse, error) {
+	out := new(GetDocumentStatsResponse)
+	err := c.cc.Invoke(ctx, "/api.Cluster/GetDocumentStats", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *Checkpoint) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *clusterClient) DryRunGarbageCollection(ctx context.Context, in *DryRunGarbageCollectionRequest, opts ...grpc.CallOption) (*DryRunGarbageCollectionResponse, error) {
+	out := new(DryRunGarbageCollectionResponse)
+	err := c.cc.Invoke(ctx, "/api.Cluster/DryRunGarbageCollection", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *Checkpoint) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ClusterServer is the server API for Cluster service.
+type ClusterServer interface {
+	BroadcastEvent(context.Context, *BroadcastEventRequest) (*BroadcastEventResponse, error)
+	CreateSnapshot(context.Context, *CreateSnapshotRequest) (*CreateSnapshotResponse, error)
+	SetDocumentGCDisabled(context.Context, *SetDocumentGCDisabledRequest) (*SetDocumentGCDisabledResponse, error)
+	GetServerInfo(context.Context, *GetServerInfoRequest) (*GetServerInfoResponse, error)
+	CompactChanges(context.Context, *CompactChangesRequest) (*CompactChangesResponse, error)
+	GetDocumentStats(context.Context, *GetDocumentStatsRequest) (*GetDocumentStatsResponse, error)
+	DryRunGarbageCollection(context.Context, *DryRunGarbageCollectionRequest) (*DryRunGarbageCollectionResponse, error)
 }
 
-func (m *Checkpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ClientSeq != 0 {
-		i = encodeVarintYorkie(dAtA, i, uint64(m.ClientSeq))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ServerSeq != 0 {
-		i = encodeVarintYorkie(dAtA, i, uint64(m.ServerSeq))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// UnimplementedClusterServer can be embedded to have forward compatible implementations.
+type UnimplementedClusterServer struct {
 }
 
-func (m *TextNodePos) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedClusterServer) BroadcastEvent(ctx context.Context, req *BroadcastEventRequest) (*BroadcastEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BroadcastEvent not implemented")
+}
+func (*UnimplementedClusterServer) CreateSnapshot(ctx context.Context, req *CreateSnapshotRequest) (*CreateSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSnapshot not implemented")
+}
+func (*UnimplementedClusterServer) SetDocumentGCDisabled(ctx context.Context, req *SetDocumentGCDisabledRequest) (*SetDocumentGCDisabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDocumentGCDisabled not implemented")
+}
+func (*UnimplementedClusterServer) GetServerInfo(ctx context.Context, req *GetServerInfoRequest) (*GetServerInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServerInfo not implemented")
+}
+func (*UnimplementedClusterServer) CompactChanges(ctx context.Context, req *CompactChangesRequest) (*CompactChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompactChanges not implemented")
+}
+func (*UnimplementedClusterServer) GetDocumentStats(ctx context.Context, req *GetDocumentStatsRequest) (*GetDocumentStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDocumentStats not implemented")
+}
+func (*UnimplementedClusterServer) DryRunGarbageCollection(ctx context.Context, req *DryRunGarbageCollectionRequest) (*DryRunGarbageCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DryRunGarbageCollection not implemented")
 }
 
-func (m *TextNodePos) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterClusterServer(s *grpc.Server, srv ClusterServer) {
+	s.RegisterService(&_Cluster_serviceDesc, srv)
 }
 
-func (m *TextNodePos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func _Cluster_BroadcastEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BroadcastEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.RelativeOffset != 0 {
-		i = encodeVarintYorkie(dAtA, i, uint64(m.RelativeOffset))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(ClusterServer).BroadcastEvent(ctx, in)
 	}
-	if m.Offset != 0 {
-		i = encodeVarintYorkie(dAtA, i, uint64(m.Offset))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Cluster/BroadcastEvent",
 	}
-	if m.CreatedAt != nil {
-		{
-			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).BroadcastEvent(ctx, req.(*BroadcastEventRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *TimeTicket) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Cluster_CreateSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSnapshotRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *TimeTicket) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *TimeTicket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.ActorId) > 0 {
-		i -= len(m.ActorId)
-		copy(dAtA[i:], m.ActorId)
-		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ActorId)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(ClusterServer).CreateSnapshot(ctx, in)
 	}
-	if m.Delimiter != 0 {
-		i = encodeVarintYorkie(dAtA, i, uint64(m.Delimiter))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Cluster/CreateSnapshot",
 	}
-	if m.Lamport != 0 {
-		i = encodeVarintYorkie(dAtA, i, uint64(m.Lamport))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).CreateSnapshot(ctx, req.(*CreateSnapshotRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DocEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Cluster_SetDocumentGCDisabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDocumentGCDisabledRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DocEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *DocEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.DocumentKeys) > 0 {
-		for iNdEx := len(m.DocumentKeys) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DocumentKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintYorkie(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	if interceptor == nil {
+		return srv.(ClusterServer).SetDocumentGCDisabled(ctx, in)
 	}
-	if m.Publisher != nil {
-		{
-			size, err := m.Publisher.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintYorkie(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Cluster/SetDocumentGCDisabled",
 	}
-	if m.Type != 0 {
-		i = encodeVarintYorkie(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).SetDocumentGCDisabled(ctx, req.(*SetDocumentGCDisabledRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func encodeVarintYorkie(dAtA []byte, offset int, v uint64) int {
-	offset -= sovYorkie(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *BroadcastEventRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Cluster_GetServerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServerInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.PublisherId)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
+	if interceptor == nil {
+		return srv.(ClusterServer).GetServerInfo(ctx, in)
 	}
-	if m.Event != nil {
-		l = m.Event.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Cluster/GetServerInfo",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).GetServerInfo(ctx, req.(*GetServerInfoRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BroadcastEventResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func _Cluster_CompactChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompactChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
-}
-
-func (m *ActivateClientRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(ClusterServer).CompactChanges(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.ClientKey)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Cluster/CompactChanges",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).CompactChanges(ctx, req.(*CompactChangesRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ActivateClientResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Cluster_GetDocumentStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDocumentStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ClientKey)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
+	if interceptor == nil {
+		return srv.(ClusterServer).GetDocumentStats(ctx, in)
 	}
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Cluster/GetDocumentStats",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).GetDocumentStats(ctx, req.(*GetDocumentStatsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DeactivateClientRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Cluster_DryRunGarbageCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DryRunGarbageCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
+	if interceptor == nil {
+		return srv.(ClusterServer).DryRunGarbageCollection(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Cluster/DryRunGarbageCollection",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServer).DryRunGarbageCollection(ctx, req.(*DryRunGarbageCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DeactivateClientResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+var _Cluster_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.Cluster",
+	HandlerType: (*ClusterServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BroadcastEvent",
+			Handler:    _Cluster_BroadcastEvent_Handler,
+		},
+		{
+			MethodName: "CreateSnapshot",
+			Handler:    _Cluster_CreateSnapshot_Handler,
+		},
+		{
+			MethodName: "SetDocumentGCDisabled",
+			Handler:    _Cluster_SetDocumentGCDisabled_Handler,
+		},
+		{
+			MethodName: "GetServerInfo",
+			Handler:    _Cluster_GetServerInfo_Handler,
+		},
+		{
+			MethodName: "CompactChanges",
+			Handler:    _Cluster_CompactChanges_Handler,
+		},
+		{
+			MethodName: "GetDocumentStats",
+			Handler:    _Cluster_GetDocumentStats_Handler,
+		},
+		{
+			MethodName: "DryRunGarbageCollection",
+			Handler:    _Cluster_DryRunGarbageCollection_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/yorkie.proto",
+}
+
+// AdminClient is the client API for Admin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AdminClient interface {
+	GetClientInfo(ctx context.Context, in *GetClientInfoRequest, opts ...grpc.CallOption) (*GetClientInfoResponse, error)
+	GetSnapshotMeta(ctx context.Context, in *GetSnapshotMetaRequest, opts ...grpc.CallOption) (*GetSnapshotMetaResponse, error)
+	StreamLogs(ctx context.Context, in *StreamLogsRequest, opts ...grpc.CallOption) (Admin_StreamLogsClient, error)
+	QuarantineDocument(ctx context.Context, in *QuarantineDocumentRequest, opts ...grpc.CallOption) (*QuarantineDocumentResponse, error)
+	ReleaseDocument(ctx context.Context, in *ReleaseDocumentRequest, opts ...grpc.CallOption) (*ReleaseDocumentResponse, error)
+	UpdateDocumentSettings(ctx context.Context, in *UpdateDocumentSettingsRequest, opts ...grpc.CallOption) (*UpdateDocumentSettingsResponse, error)
+	ExportDocumentHistory(ctx context.Context, in *ExportDocumentHistoryRequest, opts ...grpc.CallOption) (Admin_ExportDocumentHistoryClient, error)
+	CheckAccess(ctx context.Context, in *CheckAccessRequest, opts ...grpc.CallOption) (*CheckAccessResponse, error)
+}
+
+type adminClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAdminClient(cc *grpc.ClientConn) AdminClient {
+	return &adminClient{cc}
+}
+
+func (c *adminClient) GetClientInfo(ctx context.Context, in *GetClientInfoRequest, opts ...grpc.CallOption) (*GetClientInfoResponse, error) {
+	out := new(GetClientInfoResponse)
+	err := c.cc.Invoke(ctx, "/api.Admin/GetClientInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *AttachDocumentRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *adminClient) GetSnapshotMeta(ctx context.Context, in *GetSnapshotMetaRequest, opts ...grpc.CallOption) (*GetSnapshotMetaResponse, error) {
+	out := new(GetSnapshotMetaResponse)
+	err := c.cc.Invoke(ctx, "/api.Admin/GetSnapshotMeta", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
+	return out, nil
+}
+
+func (c *adminClient) StreamLogs(ctx context.Context, in *StreamLogsRequest, opts ...grpc.CallOption) (Admin_StreamLogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Admin_serviceDesc.Streams[0], "/api.Admin/StreamLogs", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.ChangePack != nil {
-		l = m.ChangePack.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	x := &adminStreamLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return n
+	return x, nil
 }
 
-func (m *AttachDocumentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type Admin_StreamLogsClient interface {
+	Recv() (*StreamLogsResponse, error)
+	grpc.ClientStream
+}
+
+type adminStreamLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *adminStreamLogsClient) Recv() (*StreamLogsResponse, error) {
+	m := new(StreamLogsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
+	return m, nil
+}
+
+func (c *adminClient) QuarantineDocument(ctx context.Context, in *QuarantineDocumentRequest, opts ...grpc.CallOption) (*QuarantineDocumentResponse, error) {
+	out := new(QuarantineDocumentResponse)
+	err := c.cc.Invoke(ctx, "/api.Admin/QuarantineDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.ChangePack != nil {
-		l = m.ChangePack.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	return out, nil
+}
+
+func (c *adminClient) ReleaseDocument(ctx context.Context, in *ReleaseDocumentRequest, opts ...grpc.CallOption) (*ReleaseDocumentResponse, error) {
+	out := new(ReleaseDocumentResponse)
+	err := c.cc.Invoke(ctx, "/api.Admin/ReleaseDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return out, nil
+}
+
+func (c *adminClient) UpdateDocumentSettings(ctx context.Context, in *UpdateDocumentSettingsRequest, opts ...grpc.CallOption) (*UpdateDocumentSettingsResponse, error) {
+	out := new(UpdateDocumentSettingsResponse)
+	err := c.cc.Invoke(ctx, "/api.Admin/UpdateDocumentSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *DetachDocumentRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *adminClient) ExportDocumentHistory(ctx context.Context, in *ExportDocumentHistoryRequest, opts ...grpc.CallOption) (Admin_ExportDocumentHistoryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Admin_serviceDesc.Streams[1], "/api.Admin/ExportDocumentHistory", opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
+	x := &adminExportDocumentHistoryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if m.ChangePack != nil {
-		l = m.ChangePack.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return x, nil
+}
+
+type Admin_ExportDocumentHistoryClient interface {
+	Recv() (*ExportDocumentHistoryResponse, error)
+	grpc.ClientStream
+}
+
+type adminExportDocumentHistoryClient struct {
+	grpc.ClientStream
+}
+
+func (x *adminExportDocumentHistoryClient) Recv() (*ExportDocumentHistoryResponse, error) {
+	m := new(ExportDocumentHistoryResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return n
+	return m, nil
 }
 
-func (m *DetachDocumentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *adminClient) CheckAccess(ctx context.Context, in *CheckAccessRequest, opts ...grpc.CallOption) (*CheckAccessResponse, error) {
+	out := new(CheckAccessResponse)
+	err := c.cc.Invoke(ctx, "/api.Admin/CheckAccess", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ClientKey)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
+	return out, nil
+}
+
+// AdminServer is the server API for Admin service.
+type AdminServer interface {
+	GetClientInfo(context.Context, *GetClientInfoRequest) (*GetClientInfoResponse, error)
+	GetSnapshotMeta(context.Context, *GetSnapshotMetaRequest) (*GetSnapshotMetaResponse, error)
+	StreamLogs(*StreamLogsRequest, Admin_StreamLogsServer) error
+	QuarantineDocument(context.Context, *QuarantineDocumentRequest) (*QuarantineDocumentResponse, error)
+	ReleaseDocument(context.Context, *ReleaseDocumentRequest) (*ReleaseDocumentResponse, error)
+	UpdateDocumentSettings(context.Context, *UpdateDocumentSettingsRequest) (*UpdateDocumentSettingsResponse, error)
+	ExportDocumentHistory(*ExportDocumentHistoryRequest, Admin_ExportDocumentHistoryServer) error
+	CheckAccess(context.Context, *CheckAccessRequest) (*CheckAccessResponse, error)
+}
+
+// UnimplementedAdminServer can be embedded to have forward compatible implementations.
+type UnimplementedAdminServer struct {
+}
+
+func (*UnimplementedAdminServer) GetClientInfo(ctx context.Context, req *GetClientInfoRequest) (*GetClientInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClientInfo not implemented")
+}
+func (*UnimplementedAdminServer) GetSnapshotMeta(ctx context.Context, req *GetSnapshotMetaRequest) (*GetSnapshotMetaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSnapshotMeta not implemented")
+}
+func (*UnimplementedAdminServer) StreamLogs(req *StreamLogsRequest, srv Admin_StreamLogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamLogs not implemented")
+}
+func (*UnimplementedAdminServer) QuarantineDocument(ctx context.Context, req *QuarantineDocumentRequest) (*QuarantineDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuarantineDocument not implemented")
+}
+func (*UnimplementedAdminServer) ReleaseDocument(ctx context.Context, req *ReleaseDocumentRequest) (*ReleaseDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseDocument not implemented")
+}
+func (*UnimplementedAdminServer) UpdateDocumentSettings(ctx context.Context, req *UpdateDocumentSettingsRequest) (*UpdateDocumentSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDocumentSettings not implemented")
+}
+func (*UnimplementedAdminServer) ExportDocumentHistory(req *ExportDocumentHistoryRequest, srv Admin_ExportDocumentHistoryServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportDocumentHistory not implemented")
+}
+func (*UnimplementedAdminServer) CheckAccess(ctx context.Context, req *CheckAccessRequest) (*CheckAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAccess not implemented")
+}
+
+func RegisterAdminServer(s *grpc.Server, srv AdminServer) {
+	s.RegisterService(&_Admin_serviceDesc, srv)
+}
+
+func _Admin_GetClientInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClientInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ChangePack != nil {
-		l = m.ChangePack.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if interceptor == nil {
+		return srv.(AdminServer).GetClientInfo(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Admin/GetClientInfo",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetClientInfo(ctx, req.(*GetClientInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *WatchDocumentsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Admin_GetSnapshotMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSnapshotMetaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Client != nil {
-		l = m.Client.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if interceptor == nil {
+		return srv.(AdminServer).GetSnapshotMeta(ctx, in)
 	}
-	if len(m.DocumentKeys) > 0 {
-		for _, e := range m.DocumentKeys {
-			l = e.Size()
-			n += 1 + l + sovYorkie(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Admin/GetSnapshotMeta",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetSnapshotMeta(ctx, req.(*GetSnapshotMetaRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *WatchDocumentsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Body != nil {
-		n += m.Body.Size()
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func _Admin_StreamLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return n
+	return srv.(AdminServer).StreamLogs(m, &adminStreamLogsServer{stream})
 }
 
-func (m *WatchDocumentsResponse_Initialization_) Size() (n int) {
-	if m == nil {
-		return 0
+type Admin_StreamLogsServer interface {
+	Send(*StreamLogsResponse) error
+	grpc.ServerStream
+}
+
+type adminStreamLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminStreamLogsServer) Send(m *StreamLogsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Admin_QuarantineDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuarantineDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Initialization != nil {
-		l = m.Initialization.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if interceptor == nil {
+		return srv.(AdminServer).QuarantineDocument(ctx, in)
 	}
-	return n
-}
-func (m *WatchDocumentsResponse_Event) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Admin/QuarantineDocument",
 	}
-	var l int
-	_ = l
-	if m.Event != nil {
-		l = m.Event.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).QuarantineDocument(ctx, req.(*QuarantineDocumentRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
-func (m *WatchDocumentsResponse_Initialization) Size() (n int) {
-	if m == nil {
-		return 0
+
+func _Admin_ReleaseDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.PeersMapByDoc) > 0 {
-		for k, v := range m.PeersMapByDoc {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovYorkie(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovYorkie(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovYorkie(uint64(mapEntrySize))
-		}
+	if interceptor == nil {
+		return srv.(AdminServer).ReleaseDocument(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Admin/ReleaseDocument",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ReleaseDocument(ctx, req.(*ReleaseDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PushPullRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Admin_UpdateDocumentSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDocumentSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
+	if interceptor == nil {
+		return srv.(AdminServer).UpdateDocumentSettings(ctx, in)
 	}
-	if m.ChangePack != nil {
-		l = m.ChangePack.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Admin/UpdateDocumentSettings",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).UpdateDocumentSettings(ctx, req.(*UpdateDocumentSettingsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *PushPullResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Admin_ExportDocumentHistory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportDocumentHistoryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	var l int
-	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
+	return srv.(AdminServer).ExportDocumentHistory(m, &adminExportDocumentHistoryServer{stream})
+}
+
+type Admin_ExportDocumentHistoryServer interface {
+	Send(*ExportDocumentHistoryResponse) error
+	grpc.ServerStream
+}
+
+type adminExportDocumentHistoryServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminExportDocumentHistoryServer) Send(m *ExportDocumentHistoryResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Admin_CheckAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ChangePack != nil {
-		l = m.ChangePack.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if interceptor == nil {
+		return srv.(AdminServer).CheckAccess(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Admin/CheckAccess",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).CheckAccess(ctx, req.(*CheckAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UpdateMetadataRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var _Admin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.Admin",
+	HandlerType: (*AdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetClientInfo",
+			Handler:    _Admin_GetClientInfo_Handler,
+		},
+		{
+			MethodName: "GetSnapshotMeta",
+			Handler:    _Admin_GetSnapshotMeta_Handler,
+		},
+		{
+			MethodName: "QuarantineDocument",
+			Handler:    _Admin_QuarantineDocument_Handler,
+		},
+		{
+			MethodName: "ReleaseDocument",
+			Handler:    _Admin_ReleaseDocument_Handler,
+		},
+		{
+			MethodName: "UpdateDocumentSettings",
+			Handler:    _Admin_UpdateDocumentSettings_Handler,
+		},
+		{
+			MethodName: "CheckAccess",
+			Handler:    _Admin_CheckAccess_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamLogs",
+			Handler:       _Admin_StreamLogs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportDocumentHistory",
+			Handler:       _Admin_ExportDocumentHistory_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/yorkie.proto",
+}
+
+func (m *BroadcastEventRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BroadcastEventRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BroadcastEventRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Client != nil {
-		l = m.Client.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.DocumentKeys) > 0 {
-		for _, e := range m.DocumentKeys {
-			l = e.Size()
-			n += 1 + l + sovYorkie(uint64(l))
+	if m.Event != nil {
+		{
+			size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.PublisherId) > 0 {
+		i -= len(m.PublisherId)
+		copy(dAtA[i:], m.PublisherId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.PublisherId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UpdateMetadataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BroadcastEventResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BroadcastEventResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BroadcastEventResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ChangePack) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CreateSnapshotRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateSnapshotRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateSnapshotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.DocumentKey != nil {
-		l = m.DocumentKey.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.Checkpoint != nil {
-		l = m.Checkpoint.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	l = len(m.Snapshot)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Changes) > 0 {
-		for _, e := range m.Changes {
-			l = e.Size()
-			n += 1 + l + sovYorkie(uint64(l))
+	if m.DocumentKey != nil {
+		{
+			size, err := m.DocumentKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.MinSyncedTicket != nil {
-		l = m.MinSyncedTicket.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Change) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CreateSnapshotResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateSnapshotResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateSnapshotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if len(m.Operations) > 0 {
-		for _, e := range m.Operations {
-			l = e.Size()
-			n += 1 + l + sovYorkie(uint64(l))
-		}
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.ServerSeq != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.ServerSeq))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ChangeID) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SetDocumentGCDisabledRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SetDocumentGCDisabledRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetDocumentGCDisabledRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ClientSeq != 0 {
-		n += 1 + sovYorkie(uint64(m.ClientSeq))
-	}
-	if m.Lamport != 0 {
-		n += 1 + sovYorkie(uint64(m.Lamport))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.ActorId)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
+	if m.GcDisabled {
+		i--
+		if m.GcDisabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.DocumentKey != nil {
+		{
+			size, err := m.DocumentKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Operation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SetDocumentGCDisabledResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SetDocumentGCDisabledResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetDocumentGCDisabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Body != nil {
-		n += m.Body.Size()
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Operation_Set_) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetServerInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetServerInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetServerInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Set != nil {
-		l = m.Set.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Operation_Add_) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *GetServerInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetServerInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetServerInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Add != nil {
-		l = m.Add.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-func (m *Operation_Move_) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.BuildDate) > 0 {
+		i -= len(m.BuildDate)
+		copy(dAtA[i:], m.BuildDate)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.BuildDate)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	if m.Move != nil {
-		l = m.Move.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if len(m.GitCommit) > 0 {
+		i -= len(m.GitCommit)
+		copy(dAtA[i:], m.GitCommit)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.GitCommit)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func (m *Operation_Remove_) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *CompactChangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CompactChangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompactChangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Remove != nil {
-		l = m.Remove.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-func (m *Operation_Edit_) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.BeforeServerSeq != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.BeforeServerSeq))
+		i--
+		dAtA[i] = 0x10
 	}
-	var l int
-	_ = l
-	if m.Edit != nil {
-		l = m.Edit.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if len(m.DocumentKeys) > 0 {
+		for iNdEx := len(m.DocumentKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DocumentKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYorkie(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Operation_Select_) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *CompactChangesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CompactChangesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CompactChangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Select != nil {
-		l = m.Select.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-func (m *Operation_RichEdit_) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.CompactedChanges != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.CompactedChanges))
+		i--
+		dAtA[i] = 0x10
 	}
-	var l int
-	_ = l
-	if m.RichEdit != nil {
-		l = m.RichEdit.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if m.CompactedDocuments != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.CompactedDocuments))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Operation_Style_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Style != nil {
-		l = m.Style.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+
+func (m *GetDocumentStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *Operation_Increase_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *GetDocumentStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDocumentStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Increase != nil {
-		l = m.Increase.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.DocumentKey != nil {
+		{
+			size, err := m.DocumentKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func (m *Operation_Set) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *GetDocumentStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDocumentStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDocumentStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ParentCreatedAt != nil {
-		l = m.ParentCreatedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
+	if m.ActorCount != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.ActorCount))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.Value != nil {
-		l = m.Value.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if m.SnapshotBytes != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.SnapshotBytes))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.ExecutedAt != nil {
-		l = m.ExecutedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if m.ChangesSinceSnapshot != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.ChangesSinceSnapshot))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.SnapshotServerSeq != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.SnapshotServerSeq))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.ServerSeq != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.ServerSeq))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Operation_Add) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DryRunGarbageCollectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DryRunGarbageCollectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DryRunGarbageCollectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ParentCreatedAt != nil {
-		l = m.ParentCreatedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.PrevCreatedAt != nil {
-		l = m.PrevCreatedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.Value != nil {
-		l = m.Value.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ExecutedAt != nil {
-		l = m.ExecutedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if m.MinSyncedTicket != nil {
+		{
+			size, err := m.MinSyncedTicket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.DocumentKey != nil {
+		{
+			size, err := m.DocumentKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Operation_Move) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DryRunGarbageCollectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DryRunGarbageCollectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DryRunGarbageCollectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ParentCreatedAt != nil {
-		l = m.ParentCreatedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.PrevCreatedAt != nil {
-		l = m.PrevCreatedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if len(m.Garbage) > 0 {
+		for iNdEx := len(m.Garbage) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Garbage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYorkie(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.ExecutedAt != nil {
-		l = m.ExecutedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if m.GcDisabled {
+		i--
+		if m.GcDisabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ServerSeq != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.ServerSeq))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Operation_Remove) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Garbage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Garbage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Garbage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ParentCreatedAt != nil {
-		l = m.ParentCreatedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if m.Count != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.ExecutedAt != nil {
-		l = m.ExecutedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *Operation_Edit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ParentCreatedAt != nil {
-		l = m.ParentCreatedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.From != nil {
-		l = m.From.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.To != nil {
-		l = m.To.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if len(m.CreatedAtMapByActor) > 0 {
-		for k, v := range m.CreatedAtMapByActor {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovYorkie(uint64(l))
+	if m.RemovedAt != nil {
+		{
+			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			mapEntrySize := 1 + len(k) + sovYorkie(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovYorkie(uint64(mapEntrySize))
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Content)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.ExecutedAt != nil {
-		l = m.ExecutedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if len(m.ParentId) > 0 {
+		i -= len(m.ParentId)
+		copy(dAtA[i:], m.ParentId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ParentId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Operation_Select) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetClientInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetClientInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetClientInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ParentCreatedAt != nil {
-		l = m.ParentCreatedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.From != nil {
-		l = m.From.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.To != nil {
-		l = m.To.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.ExecutedAt != nil {
-		l = m.ExecutedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Operation_RichEdit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetClientInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetClientInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetClientInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ParentCreatedAt != nil {
-		l = m.ParentCreatedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.From != nil {
-		l = m.From.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.To != nil {
-		l = m.To.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.CreatedAtMapByActor) > 0 {
-		for k, v := range m.CreatedAtMapByActor {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovYorkie(uint64(l))
+	if m.UpdatedAt != nil {
+		{
+			size, err := m.UpdatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			mapEntrySize := 1 + len(k) + sovYorkie(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovYorkie(uint64(mapEntrySize))
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.Content)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
+	if len(m.Documents) > 0 {
+		for iNdEx := len(m.Documents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Documents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYorkie(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	if len(m.Attributes) > 0 {
-		for k, v := range m.Attributes {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovYorkie(uint64(len(k))) + 1 + len(v) + sovYorkie(uint64(len(v)))
-			n += mapEntrySize + 1 + sovYorkie(uint64(mapEntrySize))
+	if len(m.Metadata) > 0 {
+		for k := range m.Metadata {
+			v := m.Metadata[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintYorkie(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintYorkie(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintYorkie(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	if m.ExecutedAt != nil {
-		l = m.ExecutedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.ClientKey) > 0 {
+		i -= len(m.ClientKey)
+		copy(dAtA[i:], m.ClientKey)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientKey)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Operation_Style) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ClientDocInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientDocInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientDocInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ParentCreatedAt != nil {
-		l = m.ParentCreatedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.From != nil {
-		l = m.From.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if m.Checkpoint != nil {
+		{
+			size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.To != nil {
-		l = m.To.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Attributes) > 0 {
-		for k, v := range m.Attributes {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovYorkie(uint64(len(k))) + 1 + len(v) + sovYorkie(uint64(len(v)))
-			n += mapEntrySize + 1 + sovYorkie(uint64(mapEntrySize))
+	if m.DocumentKey != nil {
+		{
+			size, err := m.DocumentKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ExecutedAt != nil {
-		l = m.ExecutedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.DocumentId) > 0 {
+		i -= len(m.DocumentId)
+		copy(dAtA[i:], m.DocumentId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.DocumentId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Operation_Increase) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetSnapshotMetaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSnapshotMetaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSnapshotMetaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ParentCreatedAt != nil {
-		l = m.ParentCreatedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.Value != nil {
-		l = m.Value.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.ExecutedAt != nil {
-		l = m.ExecutedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.DocumentKey != nil {
+		{
+			size, err := m.DocumentKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *JSONElementSimple) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetSnapshotMetaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSnapshotMetaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSnapshotMetaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.MovedAt != nil {
-		l = m.MovedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.RemovedAt != nil {
-		l = m.RemovedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Type != 0 {
-		n += 1 + sovYorkie(uint64(m.Type))
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ServerSeq != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.ServerSeq))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JSONElement) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StreamLogsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamLogsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamLogsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Body != nil {
-		n += m.Body.Size()
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *JSONElement_JsonObject) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.LoggerPrefixes) > 0 {
+		for iNdEx := len(m.LoggerPrefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LoggerPrefixes[iNdEx])
+			copy(dAtA[i:], m.LoggerPrefixes[iNdEx])
+			i = encodeVarintYorkie(dAtA, i, uint64(len(m.LoggerPrefixes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	var l int
-	_ = l
-	if m.JsonObject != nil {
-		l = m.JsonObject.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if len(m.Level) > 0 {
+		i -= len(m.Level)
+		copy(dAtA[i:], m.Level)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Level)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *JSONElement_JsonArray) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *StreamLogsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamLogsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamLogsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.JsonArray != nil {
-		l = m.JsonArray.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-func (m *JSONElement_Primitive_) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Dropped != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.Dropped))
+		i--
+		dAtA[i] = 0x28
 	}
-	var l int
-	_ = l
-	if m.Primitive != nil {
-		l = m.Primitive.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
-}
-func (m *JSONElement_Text_) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Logger) > 0 {
+		i -= len(m.Logger)
+		copy(dAtA[i:], m.Logger)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Logger)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	if m.Text != nil {
-		l = m.Text.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if len(m.Level) > 0 {
+		i -= len(m.Level)
+		copy(dAtA[i:], m.Level)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Level)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.Time != nil {
+		{
+			size, err := m.Time.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func (m *JSONElement_RichText_) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QuarantineDocumentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuarantineDocumentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuarantineDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.RichText != nil {
-		l = m.RichText.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	return n
-}
-func (m *JSONElement_Counter_) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	var l int
-	_ = l
-	if m.Counter != nil {
-		l = m.Counter.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if m.DocumentKey != nil {
+		{
+			size, err := m.DocumentKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *JSONElement_JSONObject) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QuarantineDocumentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuarantineDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuarantineDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Nodes) > 0 {
-		for _, e := range m.Nodes {
-			l = e.Size()
-			n += 1 + l + sovYorkie(uint64(l))
-		}
-	}
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.MovedAt != nil {
-		l = m.MovedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.RemovedAt != nil {
-		l = m.RemovedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Quarantined {
+		i--
+		if m.Quarantined {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *JSONElement_JSONArray) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ReleaseDocumentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleaseDocumentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReleaseDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Nodes) > 0 {
-		for _, e := range m.Nodes {
-			l = e.Size()
-			n += 1 + l + sovYorkie(uint64(l))
-		}
-	}
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.MovedAt != nil {
-		l = m.MovedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.RemovedAt != nil {
-		l = m.RemovedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.DocumentKey != nil {
+		{
+			size, err := m.DocumentKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *JSONElement_Primitive) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ReleaseDocumentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleaseDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReleaseDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovYorkie(uint64(m.Type))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.MovedAt != nil {
-		l = m.MovedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.RemovedAt != nil {
-		l = m.RemovedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Released {
+		i--
+		if m.Released {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *JSONElement_Text) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DocumentSettings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DocumentSettings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DocumentSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Nodes) > 0 {
-		for _, e := range m.Nodes {
-			l = e.Size()
-			n += 1 + l + sovYorkie(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.RequireSignedChanges {
+		i--
+		if m.RequireSignedChanges {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if len(m.PreferredRegion) > 0 {
+		i -= len(m.PreferredRegion)
+		copy(dAtA[i:], m.PreferredRegion)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.PreferredRegion)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.MovedAt != nil {
-		l = m.MovedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if m.DisableGarbageCollection {
+		i--
+		if m.DisableGarbageCollection {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.RemovedAt != nil {
-		l = m.RemovedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if m.MaxChangesPerPull != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.MaxChangesPerPull))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.SnapshotInterval != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.SnapshotInterval))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.SnapshotThreshold != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.SnapshotThreshold))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *JSONElement_RichText) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpdateDocumentSettingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateDocumentSettingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateDocumentSettingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Nodes) > 0 {
-		for _, e := range m.Nodes {
-			l = e.Size()
-			n += 1 + l + sovYorkie(uint64(l))
-		}
-	}
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.MovedAt != nil {
-		l = m.MovedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if m.UpdateMask != nil {
+		{
+			size, err := m.UpdateMask.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.RemovedAt != nil {
-		l = m.RemovedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if m.Settings != nil {
+		{
+			size, err := m.Settings.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.DocumentKey != nil {
+		{
+			size, err := m.DocumentKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JSONElement_Counter) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpdateDocumentSettingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateDocumentSettingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateDocumentSettingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovYorkie(uint64(m.Type))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.MovedAt != nil {
-		l = m.MovedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.RemovedAt != nil {
-		l = m.RemovedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Settings != nil {
+		{
+			size, err := m.Settings.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RHTNode) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExportDocumentHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportDocumentHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportDocumentHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.Element != nil {
-		l = m.Element.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.DocumentKey != nil {
+		{
+			size, err := m.DocumentKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RGANode) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExportDocumentHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportDocumentHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportDocumentHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Next != nil {
-		l = m.Next.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.Element != nil {
-		l = m.Element.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Chunk) > 0 {
+		i -= len(m.Chunk)
+		copy(dAtA[i:], m.Chunk)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Chunk)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *TextNode) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CheckAccessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckAccessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckAccessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.RemovedAt != nil {
-		l = m.RemovedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if len(m.Attributes) > 0 {
+		for iNdEx := len(m.Attributes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attributes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYorkie(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.InsPrevId != nil {
-		l = m.InsPrevId.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RichTextNodeAttr) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CheckAccessRequest_Attribute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckAccessRequest_Attribute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckAccessRequest_Attribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.UpdatedAt != nil {
-		l = m.UpdatedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *RichTextNode) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Verb) > 0 {
+		i -= len(m.Verb)
+		copy(dAtA[i:], m.Verb)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Verb)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if len(m.Attributes) > 0 {
-		for k, v := range m.Attributes {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovYorkie(uint64(l))
+	if m.DocumentKey != nil {
+		{
+			size, err := m.DocumentKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			mapEntrySize := 1 + len(k) + sovYorkie(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovYorkie(uint64(mapEntrySize))
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.RemovedAt != nil {
-		l = m.RemovedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.InsPrevId != nil {
-		l = m.InsPrevId.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *TextNodeID) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.Offset != 0 {
-		n += 1 + sovYorkie(uint64(m.Offset))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *CheckAccessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Metadata) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *CheckAccessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckAccessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Clock != 0 {
-		n += 1 + sovYorkie(uint64(m.Clock))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Data) > 0 {
-		for k, v := range m.Data {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovYorkie(uint64(len(k))) + 1 + len(v) + sovYorkie(uint64(len(v)))
-			n += mapEntrySize + 1 + sovYorkie(uint64(mapEntrySize))
-		}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Allowed {
+		i--
+		if m.Allowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Client) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ActivateClientRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ActivateClientRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActivateClientRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
-	}
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovYorkie(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *Clients) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	if len(m.Clients) > 0 {
-		for _, e := range m.Clients {
-			l = e.Size()
-			n += 1 + l + sovYorkie(uint64(l))
+	if len(m.Metadata) > 0 {
+		for k := range m.Metadata {
+			v := m.Metadata[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintYorkie(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintYorkie(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintYorkie(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.ClientKey) > 0 {
+		i -= len(m.ClientKey)
+		copy(dAtA[i:], m.ClientKey)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientKey)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DocumentKey) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ActivateClientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ActivateClientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActivateClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Collection)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Document)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.ClientKey) > 0 {
+		i -= len(m.ClientKey)
+		copy(dAtA[i:], m.ClientKey)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientKey)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Checkpoint) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DeactivateClientRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DeactivateClientRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeactivateClientRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ServerSeq != 0 {
-		n += 1 + sovYorkie(uint64(m.ServerSeq))
-	}
-	if m.ClientSeq != 0 {
-		n += 1 + sovYorkie(uint64(m.ClientSeq))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *TextNodePos) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DeactivateClientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DeactivateClientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeactivateClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Offset != 0 {
-		n += 1 + sovYorkie(uint64(m.Offset))
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.RelativeOffset != 0 {
-		n += 1 + sovYorkie(uint64(m.RelativeOffset))
+	return len(dAtA) - i, nil
+}
+
+func (m *AttachDocumentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AttachDocumentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttachDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.ChangePack != nil {
+		{
+			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *TimeTicket) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AttachDocumentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AttachDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttachDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Lamport != 0 {
-		n += 1 + sovYorkie(uint64(m.Lamport))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Delimiter != 0 {
-		n += 1 + sovYorkie(uint64(m.Delimiter))
+	if m.ChangePack != nil {
+		{
+			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ActorId)
-	if l > 0 {
-		n += 1 + l + sovYorkie(uint64(l))
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DetachDocumentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DetachDocumentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DetachDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.ChangePack != nil {
+		{
+			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DocEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DetachDocumentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DetachDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DetachDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovYorkie(uint64(m.Type))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Publisher != nil {
-		l = m.Publisher.Size()
-		n += 1 + l + sovYorkie(uint64(l))
+	if m.ChangePack != nil {
+		{
+			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientKey) > 0 {
+		i -= len(m.ClientKey)
+		copy(dAtA[i:], m.ClientKey)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchDocumentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchDocumentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchDocumentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Catchup {
+		i--
+		if m.Catchup {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
 	if len(m.DocumentKeys) > 0 {
-		for _, e := range m.DocumentKeys {
-			l = e.Size()
-			n += 1 + l + sovYorkie(uint64(l))
+		for iNdEx := len(m.DocumentKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DocumentKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYorkie(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Client != nil {
+		{
+			size, err := m.Client.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchDocumentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchDocumentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchDocumentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Body != nil {
+		{
+			size := m.Body.Size()
+			i -= size
+			if _, err := m.Body.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchDocumentsResponse_Initialization_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchDocumentsResponse_Initialization_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Initialization != nil {
+		{
+			size, err := m.Initialization.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *WatchDocumentsResponse_Event) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchDocumentsResponse_Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Event != nil {
+		{
+			size, err := m.Event.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *WatchDocumentsResponse_Keepalive_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchDocumentsResponse_Keepalive_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Keepalive != nil {
+		{
+			size, err := m.Keepalive.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *WatchDocumentsResponse_Initialization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchDocumentsResponse_Initialization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchDocumentsResponse_Initialization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ServerSeqs) > 0 {
+		for k := range m.ServerSeqs {
+			v := m.ServerSeqs[k]
+			baseI := i
+			i = encodeVarintYorkie(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintYorkie(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintYorkie(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PeersMapByDoc) > 0 {
+		for k := range m.PeersMapByDoc {
+			v := m.PeersMapByDoc[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintYorkie(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintYorkie(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintYorkie(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchDocumentsResponse_Keepalive) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchDocumentsResponse_Keepalive) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchDocumentsResponse_Keepalive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PushPullRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PushPullRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PushPullRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ChangePack != nil {
+		{
+			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PushPullResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PushPullResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PushPullResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ChangePack != nil {
+		{
+			size, err := m.ChangePack.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DocumentKeys) > 0 {
+		for iNdEx := len(m.DocumentKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DocumentKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYorkie(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Client != nil {
+		{
+			size, err := m.Client.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateClientMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateClientMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateClientMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Metadata) > 0 {
+		for k := range m.Metadata {
+			v := m.Metadata[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintYorkie(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintYorkie(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintYorkie(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateClientMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateClientMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateClientMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PullJSONPatchesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PullJSONPatchesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PullJSONPatchesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ServerSeq != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.ServerSeq))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DocumentKey != nil {
+		{
+			size, err := m.DocumentKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PullJSONPatchesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PullJSONPatchesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PullJSONPatchesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.HasMore {
+		i--
+		if m.HasMore {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Document) > 0 {
+		i -= len(m.Document)
+		copy(dAtA[i:], m.Document)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Document)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Patches) > 0 {
+		for iNdEx := len(m.Patches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Patches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYorkie(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ServerSeq != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.ServerSeq))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FetchSnapshotRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FetchSnapshotRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FetchSnapshotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Offset != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ServerSeq != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.ServerSeq))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DocumentKey != nil {
+		{
+			size, err := m.DocumentKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FetchSnapshotResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FetchSnapshotResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FetchSnapshotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Chunk) > 0 {
+		i -= len(m.Chunk)
+		copy(dAtA[i:], m.Chunk)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Chunk)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Offset != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalSize != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.TotalSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ServerSeq != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.ServerSeq))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JSONPatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JSONPatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JSONPatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Op) > 0 {
+		i -= len(m.Op)
+		copy(dAtA[i:], m.Op)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Op)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChangePack) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChangePack) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChangePack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PreferredRegion) > 0 {
+		i -= len(m.PreferredRegion)
+		copy(dAtA[i:], m.PreferredRegion)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.PreferredRegion)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.HasMore {
+		i--
+		if m.HasMore {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MinSyncedTicket != nil {
+		{
+			size, err := m.MinSyncedTicket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYorkie(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Snapshot) > 0 {
+		i -= len(m.Snapshot)
+		copy(dAtA[i:], m.Snapshot)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Snapshot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Checkpoint != nil {
+		{
+			size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DocumentKey != nil {
+		{
+			size, err := m.DocumentKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Change) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Change) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Change) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Operations) > 0 {
+		for iNdEx := len(m.Operations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Operations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYorkie(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != nil {
+		{
+			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChangeID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChangeID) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChangeID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Vector) > 0 {
+		for k := range m.Vector {
+			v := m.Vector[k]
+			baseI := i
+			i = encodeVarintYorkie(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintYorkie(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintYorkie(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ActorId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Lamport != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.Lamport))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ClientSeq != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.ClientSeq))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Operation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Operation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Body != nil {
+		{
+			size := m.Body.Size()
+			i -= size
+			if _, err := m.Body.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Operation_Set_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_Set_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Set != nil {
+		{
+			size, err := m.Set.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Operation_Add_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_Add_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Add != nil {
+		{
+			size, err := m.Add.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Operation_Move_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_Move_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Move != nil {
+		{
+			size, err := m.Move.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Operation_Remove_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_Remove_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Remove != nil {
+		{
+			size, err := m.Remove.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Operation_Edit_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_Edit_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Edit != nil {
+		{
+			size, err := m.Edit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Operation_Select_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_Select_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Select != nil {
+		{
+			size, err := m.Select.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Operation_RichEdit_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_RichEdit_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RichEdit != nil {
+		{
+			size, err := m.RichEdit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Operation_Style_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_Style_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Style != nil {
+		{
+			size, err := m.Style.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Operation_Increase_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_Increase_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Increase != nil {
+		{
+			size, err := m.Increase.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Operation_Set) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Operation_Set) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_Set) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ExecutedAt != nil {
+		{
+			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ParentCreatedAt != nil {
+		{
+			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Operation_Add) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Operation_Add) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_Add) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ExecutedAt != nil {
+		{
+			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PrevCreatedAt != nil {
+		{
+			size, err := m.PrevCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ParentCreatedAt != nil {
+		{
+			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Operation_Move) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Operation_Move) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_Move) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ExecutedAt != nil {
+		{
+			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PrevCreatedAt != nil {
+		{
+			size, err := m.PrevCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ParentCreatedAt != nil {
+		{
+			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Operation_Remove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Operation_Remove) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_Remove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ExecutedAt != nil {
+		{
+			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ParentCreatedAt != nil {
+		{
+			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Operation_Edit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Operation_Edit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_Edit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ExecutedAt != nil {
+		{
+			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CreatedAtMapByActor) > 0 {
+		for k := range m.CreatedAtMapByActor {
+			v := m.CreatedAtMapByActor[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintYorkie(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintYorkie(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintYorkie(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.To != nil {
+		{
+			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.From != nil {
+		{
+			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ParentCreatedAt != nil {
+		{
+			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Operation_Select) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Operation_Select) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_Select) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ExecutedAt != nil {
+		{
+			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.To != nil {
+		{
+			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.From != nil {
+		{
+			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ParentCreatedAt != nil {
+		{
+			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Operation_RichEdit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Operation_RichEdit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_RichEdit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ExecutedAt != nil {
+		{
+			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Attributes) > 0 {
+		for k := range m.Attributes {
+			v := m.Attributes[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintYorkie(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintYorkie(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintYorkie(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CreatedAtMapByActor) > 0 {
+		for k := range m.CreatedAtMapByActor {
+			v := m.CreatedAtMapByActor[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintYorkie(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintYorkie(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintYorkie(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.To != nil {
+		{
+			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.From != nil {
+		{
+			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ParentCreatedAt != nil {
+		{
+			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Operation_Style) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Operation_Style) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_Style) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ExecutedAt != nil {
+		{
+			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Attributes) > 0 {
+		for k := range m.Attributes {
+			v := m.Attributes[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintYorkie(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintYorkie(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintYorkie(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.To != nil {
+		{
+			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.From != nil {
+		{
+			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ParentCreatedAt != nil {
+		{
+			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Operation_Increase) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Operation_Increase) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Operation_Increase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ExecutedAt != nil {
+		{
+			size, err := m.ExecutedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ParentCreatedAt != nil {
+		{
+			size, err := m.ParentCreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JSONElementSimple) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JSONElementSimple) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JSONElementSimple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Type != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RemovedAt != nil {
+		{
+			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MovedAt != nil {
+		{
+			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JSONElement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JSONElement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JSONElement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Body != nil {
+		{
+			size := m.Body.Size()
+			i -= size
+			if _, err := m.Body.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JSONElement_JsonObject) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JSONElement_JsonObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.JsonObject != nil {
+		{
+			size, err := m.JsonObject.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *JSONElement_JsonArray) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JSONElement_JsonArray) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.JsonArray != nil {
+		{
+			size, err := m.JsonArray.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *JSONElement_Primitive_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JSONElement_Primitive_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Primitive != nil {
+		{
+			size, err := m.Primitive.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *JSONElement_Text_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JSONElement_Text_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Text != nil {
+		{
+			size, err := m.Text.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *JSONElement_RichText_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JSONElement_RichText_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RichText != nil {
+		{
+			size, err := m.RichText.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *JSONElement_Counter_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JSONElement_Counter_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Counter != nil {
+		{
+			size, err := m.Counter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *JSONElement_JSONObject) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JSONElement_JSONObject) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JSONElement_JSONObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.RemovedAt != nil {
+		{
+			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MovedAt != nil {
+		{
+			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYorkie(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JSONElement_JSONArray) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JSONElement_JSONArray) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JSONElement_JSONArray) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.RemovedAt != nil {
+		{
+			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MovedAt != nil {
+		{
+			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYorkie(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JSONElement_Primitive) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JSONElement_Primitive) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JSONElement_Primitive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.RemovedAt != nil {
+		{
+			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MovedAt != nil {
+		{
+			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JSONElement_Text) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JSONElement_Text) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JSONElement_Text) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.RemovedAt != nil {
+		{
+			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MovedAt != nil {
+		{
+			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYorkie(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JSONElement_RichText) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JSONElement_RichText) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JSONElement_RichText) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.RemovedAt != nil {
+		{
+			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MovedAt != nil {
+		{
+			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYorkie(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JSONElement_Counter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JSONElement_Counter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JSONElement_Counter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.RemovedAt != nil {
+		{
+			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MovedAt != nil {
+		{
+			size, err := m.MovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RHTNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RHTNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RHTNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Element != nil {
+		{
+			size, err := m.Element.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RGANode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RGANode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RGANode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Element != nil {
+		{
+			size, err := m.Element.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Next != nil {
+		{
+			size, err := m.Next.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TextNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TextNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TextNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.InsPrevId != nil {
+		{
+			size, err := m.InsPrevId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.RemovedAt != nil {
+		{
+			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != nil {
+		{
+			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RichTextNodeAttr) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RichTextNodeAttr) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RichTextNodeAttr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.UpdatedAt != nil {
+		{
+			size, err := m.UpdatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RichTextNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RichTextNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RichTextNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.InsPrevId != nil {
+		{
+			size, err := m.InsPrevId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.RemovedAt != nil {
+		{
+			size, err := m.RemovedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Attributes) > 0 {
+		keysForAttributes := make([]string, 0, len(m.Attributes))
+		for k := range m.Attributes {
+			keysForAttributes = append(keysForAttributes, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForAttributes)
+		for iNdEx := len(keysForAttributes) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Attributes[string(keysForAttributes[iNdEx])]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintYorkie(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(keysForAttributes[iNdEx])
+			copy(dAtA[i:], keysForAttributes[iNdEx])
+			i = encodeVarintYorkie(dAtA, i, uint64(len(keysForAttributes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintYorkie(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Id != nil {
+		{
+			size, err := m.Id.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TextNodeID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TextNodeID) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TextNodeID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Offset != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Metadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Metadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Data) > 0 {
+		for k := range m.Data {
+			v := m.Data[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintYorkie(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintYorkie(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintYorkie(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Clock != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.Clock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Client) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Client) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Client) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Clients) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Clients) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Clients) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Clients) > 0 {
+		for iNdEx := len(m.Clients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Clients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYorkie(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DocumentKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DocumentKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DocumentKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Document) > 0 {
+		i -= len(m.Document)
+		copy(dAtA[i:], m.Document)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Document)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Collection) > 0 {
+		i -= len(m.Collection)
+		copy(dAtA[i:], m.Collection)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.Collection)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Checkpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Checkpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Checkpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ClientSeq != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.ClientSeq))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ServerSeq != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.ServerSeq))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TextNodePos) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TextNodePos) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TextNodePos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.RelativeOffset != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.RelativeOffset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Offset != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimeTicket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimeTicket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimeTicket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ActorId) > 0 {
+		i -= len(m.ActorId)
+		copy(dAtA[i:], m.ActorId)
+		i = encodeVarintYorkie(dAtA, i, uint64(len(m.ActorId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Delimiter != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.Delimiter))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Lamport != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.Lamport))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DocEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DocEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DocEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Peers) > 0 {
+		for iNdEx := len(m.Peers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Peers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYorkie(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.FromServerSeq != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.FromServerSeq))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ServerSeq != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.ServerSeq))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DocumentKeys) > 0 {
+		for iNdEx := len(m.DocumentKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DocumentKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintYorkie(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Publisher != nil {
+		{
+			size, err := m.Publisher.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintYorkie(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintYorkie(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintYorkie(dAtA []byte, offset int, v uint64) int {
+	offset -= sovYorkie(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BroadcastEventRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublisherId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.Event != nil {
+		l = m.Event.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BroadcastEventResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateSnapshotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DocumentKey != nil {
+		l = m.DocumentKey.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateSnapshotResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ServerSeq != 0 {
+		n += 1 + sovYorkie(uint64(m.ServerSeq))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetDocumentGCDisabledRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DocumentKey != nil {
+		l = m.DocumentKey.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.GcDisabled {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetDocumentGCDisabledResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetServerInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetServerInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.GitCommit)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.BuildDate)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CompactChangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DocumentKeys) > 0 {
+		for _, e := range m.DocumentKeys {
+			l = e.Size()
+			n += 1 + l + sovYorkie(uint64(l))
+		}
+	}
+	if m.BeforeServerSeq != 0 {
+		n += 1 + sovYorkie(uint64(m.BeforeServerSeq))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CompactChangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CompactedDocuments != 0 {
+		n += 1 + sovYorkie(uint64(m.CompactedDocuments))
+	}
+	if m.CompactedChanges != 0 {
+		n += 1 + sovYorkie(uint64(m.CompactedChanges))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetDocumentStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DocumentKey != nil {
+		l = m.DocumentKey.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetDocumentStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ServerSeq != 0 {
+		n += 1 + sovYorkie(uint64(m.ServerSeq))
+	}
+	if m.SnapshotServerSeq != 0 {
+		n += 1 + sovYorkie(uint64(m.SnapshotServerSeq))
+	}
+	if m.ChangesSinceSnapshot != 0 {
+		n += 1 + sovYorkie(uint64(m.ChangesSinceSnapshot))
+	}
+	if m.SnapshotBytes != 0 {
+		n += 1 + sovYorkie(uint64(m.SnapshotBytes))
+	}
+	if m.ActorCount != 0 {
+		n += 1 + sovYorkie(uint64(m.ActorCount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DryRunGarbageCollectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DocumentKey != nil {
+		l = m.DocumentKey.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.MinSyncedTicket != nil {
+		l = m.MinSyncedTicket.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DryRunGarbageCollectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ServerSeq != 0 {
+		n += 1 + sovYorkie(uint64(m.ServerSeq))
+	}
+	if m.GcDisabled {
+		n += 2
+	}
+	if len(m.Garbage) > 0 {
+		for _, e := range m.Garbage {
+			l = e.Size()
+			n += 1 + l + sovYorkie(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Garbage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.ParentId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.RemovedAt != nil {
+		l = m.RemovedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovYorkie(uint64(m.Count))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetClientInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetClientInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.ClientKey)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovYorkie(uint64(len(k))) + 1 + len(v) + sovYorkie(uint64(len(v)))
+			n += mapEntrySize + 1 + sovYorkie(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Documents) > 0 {
+		for _, e := range m.Documents {
+			l = e.Size()
+			n += 1 + l + sovYorkie(uint64(l))
+		}
+	}
+	if m.UpdatedAt != nil {
+		l = m.UpdatedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClientDocInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DocumentId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.DocumentKey != nil {
+		l = m.DocumentKey.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.Checkpoint != nil {
+		l = m.Checkpoint.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetSnapshotMetaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DocumentKey != nil {
+		l = m.DocumentKey.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetSnapshotMetaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ServerSeq != 0 {
+		n += 1 + sovYorkie(uint64(m.ServerSeq))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StreamLogsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Level)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if len(m.LoggerPrefixes) > 0 {
+		for _, s := range m.LoggerPrefixes {
+			l = len(s)
+			n += 1 + l + sovYorkie(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StreamLogsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Time != nil {
+		l = m.Time.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.Level)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.Logger)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.Dropped != 0 {
+		n += 1 + sovYorkie(uint64(m.Dropped))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *QuarantineDocumentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DocumentKey != nil {
+		l = m.DocumentKey.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *QuarantineDocumentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Quarantined {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReleaseDocumentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DocumentKey != nil {
+		l = m.DocumentKey.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReleaseDocumentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Released {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DocumentSettings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SnapshotThreshold != 0 {
+		n += 1 + sovYorkie(uint64(m.SnapshotThreshold))
+	}
+	if m.SnapshotInterval != 0 {
+		n += 1 + sovYorkie(uint64(m.SnapshotInterval))
+	}
+	if m.MaxChangesPerPull != 0 {
+		n += 1 + sovYorkie(uint64(m.MaxChangesPerPull))
+	}
+	if m.DisableGarbageCollection {
+		n += 2
+	}
+	l = len(m.PreferredRegion)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.RequireSignedChanges {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateDocumentSettingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DocumentKey != nil {
+		l = m.DocumentKey.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.Settings != nil {
+		l = m.Settings.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.UpdateMask != nil {
+		l = m.UpdateMask.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateDocumentSettingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Settings != nil {
+		l = m.Settings.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExportDocumentHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DocumentKey != nil {
+		l = m.DocumentKey.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExportDocumentHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chunk)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CheckAccessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for _, e := range m.Attributes {
+			l = e.Size()
+			n += 1 + l + sovYorkie(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CheckAccessRequest_Attribute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DocumentKey != nil {
+		l = m.DocumentKey.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.Verb)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CheckAccessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowed {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ActivateClientRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientKey)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovYorkie(uint64(len(k))) + 1 + len(v) + sovYorkie(uint64(len(v)))
+			n += mapEntrySize + 1 + sovYorkie(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ActivateClientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientKey)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeactivateClientRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeactivateClientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttachDocumentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.ChangePack != nil {
+		l = m.ChangePack.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttachDocumentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.ChangePack != nil {
+		l = m.ChangePack.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DetachDocumentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.ChangePack != nil {
+		l = m.ChangePack.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DetachDocumentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientKey)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.ChangePack != nil {
+		l = m.ChangePack.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WatchDocumentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Client != nil {
+		l = m.Client.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if len(m.DocumentKeys) > 0 {
+		for _, e := range m.DocumentKeys {
+			l = e.Size()
+			n += 1 + l + sovYorkie(uint64(l))
+		}
+	}
+	if m.Catchup {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WatchDocumentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Body != nil {
+		n += m.Body.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WatchDocumentsResponse_Initialization_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Initialization != nil {
+		l = m.Initialization.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	return n
+}
+func (m *WatchDocumentsResponse_Event) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Event != nil {
+		l = m.Event.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	return n
+}
+func (m *WatchDocumentsResponse_Keepalive_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Keepalive != nil {
+		l = m.Keepalive.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	return n
+}
+func (m *WatchDocumentsResponse_Initialization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PeersMapByDoc) > 0 {
+		for k, v := range m.PeersMapByDoc {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovYorkie(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovYorkie(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovYorkie(uint64(mapEntrySize))
+		}
+	}
+	if len(m.ServerSeqs) > 0 {
+		for k, v := range m.ServerSeqs {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovYorkie(uint64(len(k))) + 1 + sovYorkie(uint64(v))
+			n += mapEntrySize + 1 + sovYorkie(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WatchDocumentsResponse_Keepalive) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PushPullRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.ChangePack != nil {
+		l = m.ChangePack.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PushPullResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.ChangePack != nil {
+		l = m.ChangePack.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Client != nil {
+		l = m.Client.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if len(m.DocumentKeys) > 0 {
+		for _, e := range m.DocumentKeys {
+			l = e.Size()
+			n += 1 + l + sovYorkie(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateClientMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovYorkie(uint64(len(k))) + 1 + len(v) + sovYorkie(uint64(len(v)))
+			n += mapEntrySize + 1 + sovYorkie(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateClientMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PullJSONPatchesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DocumentKey != nil {
+		l = m.DocumentKey.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.ServerSeq != 0 {
+		n += 1 + sovYorkie(uint64(m.ServerSeq))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PullJSONPatchesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ServerSeq != 0 {
+		n += 1 + sovYorkie(uint64(m.ServerSeq))
+	}
+	if len(m.Patches) > 0 {
+		for _, e := range m.Patches {
+			l = e.Size()
+			n += 1 + l + sovYorkie(uint64(l))
+		}
+	}
+	l = len(m.Document)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.HasMore {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FetchSnapshotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DocumentKey != nil {
+		l = m.DocumentKey.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.ServerSeq != 0 {
+		n += 1 + sovYorkie(uint64(m.ServerSeq))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovYorkie(uint64(m.Offset))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FetchSnapshotResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ServerSeq != 0 {
+		n += 1 + sovYorkie(uint64(m.ServerSeq))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovYorkie(uint64(m.TotalSize))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovYorkie(uint64(m.Offset))
+	}
+	l = len(m.Chunk)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JSONPatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Op)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChangePack) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DocumentKey != nil {
+		l = m.DocumentKey.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.Checkpoint != nil {
+		l = m.Checkpoint.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.Snapshot)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovYorkie(uint64(l))
+		}
+	}
+	if m.MinSyncedTicket != nil {
+		l = m.MinSyncedTicket.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.HasMore {
+		n += 2
+	}
+	l = len(m.PreferredRegion)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Change) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if len(m.Operations) > 0 {
+		for _, e := range m.Operations {
+			l = e.Size()
+			n += 1 + l + sovYorkie(uint64(l))
+		}
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChangeID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClientSeq != 0 {
+		n += 1 + sovYorkie(uint64(m.ClientSeq))
+	}
+	if m.Lamport != 0 {
+		n += 1 + sovYorkie(uint64(m.Lamport))
+	}
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if len(m.Vector) > 0 {
+		for k, v := range m.Vector {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovYorkie(uint64(len(k))) + 1 + sovYorkie(uint64(v))
+			n += mapEntrySize + 1 + sovYorkie(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Operation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Body != nil {
+		n += m.Body.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Operation_Set_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Set != nil {
+		l = m.Set.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	return n
+}
+func (m *Operation_Add_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Add != nil {
+		l = m.Add.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	return n
+}
+func (m *Operation_Move_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Move != nil {
+		l = m.Move.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	return n
+}
+func (m *Operation_Remove_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Remove != nil {
+		l = m.Remove.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	return n
+}
+func (m *Operation_Edit_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Edit != nil {
+		l = m.Edit.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	return n
+}
+func (m *Operation_Select_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Select != nil {
+		l = m.Select.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	return n
+}
+func (m *Operation_RichEdit_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RichEdit != nil {
+		l = m.RichEdit.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	return n
+}
+func (m *Operation_Style_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Style != nil {
+		l = m.Style.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	return n
+}
+func (m *Operation_Increase_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Increase != nil {
+		l = m.Increase.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	return n
+}
+func (m *Operation_Set) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentCreatedAt != nil {
+		l = m.ParentCreatedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.ExecutedAt != nil {
+		l = m.ExecutedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Operation_Add) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentCreatedAt != nil {
+		l = m.ParentCreatedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.PrevCreatedAt != nil {
+		l = m.PrevCreatedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.ExecutedAt != nil {
+		l = m.ExecutedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Operation_Move) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentCreatedAt != nil {
+		l = m.ParentCreatedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.PrevCreatedAt != nil {
+		l = m.PrevCreatedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.ExecutedAt != nil {
+		l = m.ExecutedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Operation_Remove) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentCreatedAt != nil {
+		l = m.ParentCreatedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.ExecutedAt != nil {
+		l = m.ExecutedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Operation_Edit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentCreatedAt != nil {
+		l = m.ParentCreatedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if len(m.CreatedAtMapByActor) > 0 {
+		for k, v := range m.CreatedAtMapByActor {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovYorkie(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovYorkie(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovYorkie(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.ExecutedAt != nil {
+		l = m.ExecutedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Operation_Select) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentCreatedAt != nil {
+		l = m.ParentCreatedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.ExecutedAt != nil {
+		l = m.ExecutedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Operation_RichEdit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentCreatedAt != nil {
+		l = m.ParentCreatedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if len(m.CreatedAtMapByActor) > 0 {
+		for k, v := range m.CreatedAtMapByActor {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovYorkie(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovYorkie(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovYorkie(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for k, v := range m.Attributes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovYorkie(uint64(len(k))) + 1 + len(v) + sovYorkie(uint64(len(v)))
+			n += mapEntrySize + 1 + sovYorkie(uint64(mapEntrySize))
+		}
+	}
+	if m.ExecutedAt != nil {
+		l = m.ExecutedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Operation_Style) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentCreatedAt != nil {
+		l = m.ParentCreatedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for k, v := range m.Attributes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovYorkie(uint64(len(k))) + 1 + len(v) + sovYorkie(uint64(len(v)))
+			n += mapEntrySize + 1 + sovYorkie(uint64(mapEntrySize))
+		}
+	}
+	if m.ExecutedAt != nil {
+		l = m.ExecutedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Operation_Increase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentCreatedAt != nil {
+		l = m.ParentCreatedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.ExecutedAt != nil {
+		l = m.ExecutedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JSONElementSimple) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.MovedAt != nil {
+		l = m.MovedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.RemovedAt != nil {
+		l = m.RemovedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovYorkie(uint64(m.Type))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JSONElement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Body != nil {
+		n += m.Body.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JSONElement_JsonObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JsonObject != nil {
+		l = m.JsonObject.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	return n
+}
+func (m *JSONElement_JsonArray) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JsonArray != nil {
+		l = m.JsonArray.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	return n
+}
+func (m *JSONElement_Primitive_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Primitive != nil {
+		l = m.Primitive.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	return n
+}
+func (m *JSONElement_Text_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Text != nil {
+		l = m.Text.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	return n
+}
+func (m *JSONElement_RichText_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RichText != nil {
+		l = m.RichText.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	return n
+}
+func (m *JSONElement_Counter_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Counter != nil {
+		l = m.Counter.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	return n
+}
+func (m *JSONElement_JSONObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovYorkie(uint64(l))
+		}
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.MovedAt != nil {
+		l = m.MovedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.RemovedAt != nil {
+		l = m.RemovedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JSONElement_JSONArray) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovYorkie(uint64(l))
+		}
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.MovedAt != nil {
+		l = m.MovedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.RemovedAt != nil {
+		l = m.RemovedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JSONElement_Primitive) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovYorkie(uint64(m.Type))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.MovedAt != nil {
+		l = m.MovedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.RemovedAt != nil {
+		l = m.RemovedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JSONElement_Text) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovYorkie(uint64(l))
+		}
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.MovedAt != nil {
+		l = m.MovedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.RemovedAt != nil {
+		l = m.RemovedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JSONElement_RichText) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovYorkie(uint64(l))
+		}
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.MovedAt != nil {
+		l = m.MovedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.RemovedAt != nil {
+		l = m.RemovedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JSONElement_Counter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovYorkie(uint64(m.Type))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.MovedAt != nil {
+		l = m.MovedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.RemovedAt != nil {
+		l = m.RemovedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RHTNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.Element != nil {
+		l = m.Element.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RGANode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Next != nil {
+		l = m.Next.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.Element != nil {
+		l = m.Element.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TextNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.RemovedAt != nil {
+		l = m.RemovedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.InsPrevId != nil {
+		l = m.InsPrevId.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RichTextNodeAttr) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.UpdatedAt != nil {
+		l = m.UpdatedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RichTextNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if len(m.Attributes) > 0 {
+		for k, v := range m.Attributes {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovYorkie(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovYorkie(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovYorkie(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.RemovedAt != nil {
+		l = m.RemovedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.InsPrevId != nil {
+		l = m.InsPrevId.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TextNodeID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovYorkie(uint64(m.Offset))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Metadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Clock != 0 {
+		n += 1 + sovYorkie(uint64(m.Clock))
+	}
+	if len(m.Data) > 0 {
+		for k, v := range m.Data {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovYorkie(uint64(len(k))) + 1 + len(v) + sovYorkie(uint64(len(v)))
+			n += mapEntrySize + 1 + sovYorkie(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Client) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Clients) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Clients) > 0 {
+		for _, e := range m.Clients {
+			l = e.Size()
+			n += 1 + l + sovYorkie(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DocumentKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Collection)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	l = len(m.Document)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Checkpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ServerSeq != 0 {
+		n += 1 + sovYorkie(uint64(m.ServerSeq))
+	}
+	if m.ClientSeq != 0 {
+		n += 1 + sovYorkie(uint64(m.ClientSeq))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TextNodePos) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovYorkie(uint64(m.Offset))
+	}
+	if m.RelativeOffset != 0 {
+		n += 1 + sovYorkie(uint64(m.RelativeOffset))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TimeTicket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Lamport != 0 {
+		n += 1 + sovYorkie(uint64(m.Lamport))
+	}
+	if m.Delimiter != 0 {
+		n += 1 + sovYorkie(uint64(m.Delimiter))
+	}
+	l = len(m.ActorId)
+	if l > 0 {
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DocEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovYorkie(uint64(m.Type))
+	}
+	if m.Publisher != nil {
+		l = m.Publisher.Size()
+		n += 1 + l + sovYorkie(uint64(l))
+	}
+	if len(m.DocumentKeys) > 0 {
+		for _, e := range m.DocumentKeys {
+			l = e.Size()
+			n += 1 + l + sovYorkie(uint64(l))
+		}
+	}
+	if m.ServerSeq != 0 {
+		n += 1 + sovYorkie(uint64(m.ServerSeq))
+	}
+	if m.FromServerSeq != 0 {
+		n += 1 + sovYorkie(uint64(m.FromServerSeq))
+	}
+	if len(m.Peers) > 0 {
+		for _, e := range m.Peers {
+			l = e.Size()
+			n += 1 + l + sovYorkie(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovYorkie(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozYorkie(x uint64) (n int) {
+	return sovYorkie(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BroadcastEventRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BroadcastEventRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BroadcastEventRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublisherId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublisherId = append(m.PublisherId[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublisherId == nil {
+				m.PublisherId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Event == nil {
+				m.Event = &DocEvent{}
+			}
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BroadcastEventResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BroadcastEventResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BroadcastEventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateSnapshotRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateSnapshotRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateSnapshotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DocumentKey == nil {
+				m.DocumentKey = &DocumentKey{}
+			}
+			if err := m.DocumentKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateSnapshotResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateSnapshotResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateSnapshotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerSeq", wireType)
+			}
+			m.ServerSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServerSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetDocumentGCDisabledRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetDocumentGCDisabledRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetDocumentGCDisabledRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DocumentKey == nil {
+				m.DocumentKey = &DocumentKey{}
+			}
+			if err := m.DocumentKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GcDisabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.GcDisabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetDocumentGCDisabledResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetDocumentGCDisabledResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetDocumentGCDisabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetServerInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetServerInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetServerInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetServerInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetServerInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetServerInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GitCommit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GitCommit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuildDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuildDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompactChangesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompactChangesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompactChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentKeys = append(m.DocumentKeys, &DocumentKey{})
+			if err := m.DocumentKeys[len(m.DocumentKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeServerSeq", wireType)
+			}
+			m.BeforeServerSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BeforeServerSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompactChangesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompactChangesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompactChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactedDocuments", wireType)
+			}
+			m.CompactedDocuments = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompactedDocuments |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactedChanges", wireType)
+			}
+			m.CompactedChanges = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompactedChanges |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDocumentStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDocumentStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDocumentStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DocumentKey == nil {
+				m.DocumentKey = &DocumentKey{}
+			}
+			if err := m.DocumentKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDocumentStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDocumentStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDocumentStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerSeq", wireType)
+			}
+			m.ServerSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServerSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotServerSeq", wireType)
+			}
+			m.SnapshotServerSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotServerSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangesSinceSnapshot", wireType)
+			}
+			m.ChangesSinceSnapshot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChangesSinceSnapshot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotBytes", wireType)
+			}
+			m.SnapshotBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorCount", wireType)
+			}
+			m.ActorCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActorCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DryRunGarbageCollectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DryRunGarbageCollectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DryRunGarbageCollectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DocumentKey == nil {
+				m.DocumentKey = &DocumentKey{}
+			}
+			if err := m.DocumentKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSyncedTicket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MinSyncedTicket == nil {
+				m.MinSyncedTicket = &TimeTicket{}
+			}
+			if err := m.MinSyncedTicket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DryRunGarbageCollectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DryRunGarbageCollectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DryRunGarbageCollectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerSeq", wireType)
+			}
+			m.ServerSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServerSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GcDisabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.GcDisabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Garbage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Garbage = append(m.Garbage, &Garbage{})
+			if err := m.Garbage[len(m.Garbage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Garbage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Garbage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Garbage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RemovedAt == nil {
+				m.RemovedAt = &TimeTicket{}
+			}
+			if err := m.RemovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetClientInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetClientInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetClientInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = append(m.ClientId[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClientId == nil {
+				m.ClientId = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetClientInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetClientInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetClientInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = append(m.ClientId[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClientId == nil {
+				m.ClientId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowYorkie
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowYorkie
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowYorkie
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipYorkie(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Documents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Documents = append(m.Documents, &ClientDocInfo{})
+			if err := m.Documents[len(m.Documents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdatedAt == nil {
+				m.UpdatedAt = &types.Timestamp{}
+			}
+			if err := m.UpdatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClientDocInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientDocInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientDocInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentId = append(m.DocumentId[:0], dAtA[iNdEx:postIndex]...)
+			if m.DocumentId == nil {
+				m.DocumentId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DocumentKey == nil {
+				m.DocumentKey = &DocumentKey{}
+			}
+			if err := m.DocumentKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Checkpoint == nil {
+				m.Checkpoint = &Checkpoint{}
+			}
+			if err := m.Checkpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetSnapshotMetaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetSnapshotMetaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetSnapshotMetaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DocumentKey == nil {
+				m.DocumentKey = &DocumentKey{}
+			}
+			if err := m.DocumentKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetSnapshotMetaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetSnapshotMetaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetSnapshotMetaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerSeq", wireType)
+			}
+			m.ServerSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServerSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &types.Timestamp{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamLogsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamLogsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Level = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoggerPrefixes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LoggerPrefixes = append(m.LoggerPrefixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamLogsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamLogsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = &types.Timestamp{}
+			}
+			if err := m.Time.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Level = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logger", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logger = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dropped", wireType)
+			}
+			m.Dropped = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Dropped |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuarantineDocumentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuarantineDocumentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuarantineDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DocumentKey == nil {
+				m.DocumentKey = &DocumentKey{}
+			}
+			if err := m.DocumentKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuarantineDocumentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuarantineDocumentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuarantineDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quarantined", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Quarantined = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReleaseDocumentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReleaseDocumentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReleaseDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DocumentKey == nil {
+				m.DocumentKey = &DocumentKey{}
+			}
+			if err := m.DocumentKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReleaseDocumentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReleaseDocumentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReleaseDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Released = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DocumentSettings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DocumentSettings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DocumentSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotThreshold", wireType)
+			}
+			m.SnapshotThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotInterval", wireType)
+			}
+			m.SnapshotInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangesPerPull", wireType)
+			}
+			m.MaxChangesPerPull = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxChangesPerPull |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableGarbageCollection", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableGarbageCollection = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreferredRegion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreferredRegion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireSignedChanges", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireSignedChanges = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateDocumentSettingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateDocumentSettingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateDocumentSettingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DocumentKey == nil {
+				m.DocumentKey = &DocumentKey{}
+			}
+			if err := m.DocumentKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Settings == nil {
+				m.Settings = &DocumentSettings{}
+			}
+			if err := m.Settings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdateMask == nil {
+				m.UpdateMask = &types.FieldMask{}
+			}
+			if err := m.UpdateMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateDocumentSettingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateDocumentSettingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateDocumentSettingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Settings == nil {
+				m.Settings = &DocumentSettings{}
+			}
+			if err := m.Settings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExportDocumentHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExportDocumentHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExportDocumentHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DocumentKey == nil {
+				m.DocumentKey = &DocumentKey{}
+			}
+			if err := m.DocumentKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExportDocumentHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExportDocumentHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExportDocumentHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chunk = append(m.Chunk[:0], dAtA[iNdEx:postIndex]...)
+			if m.Chunk == nil {
+				m.Chunk = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckAccessRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckAccessRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckAccessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes, &CheckAccessRequest_Attribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckAccessRequest_Attribute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Attribute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Attribute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DocumentKey == nil {
+				m.DocumentKey = &DocumentKey{}
+			}
+			if err := m.DocumentKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verb", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Verb = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CheckAccessResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CheckAccessResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CheckAccessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Allowed = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActivateClientRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActivateClientRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActivateClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowYorkie
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowYorkie
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowYorkie
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipYorkie(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActivateClientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActivateClientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActivateClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = append(m.ClientId[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClientId == nil {
+				m.ClientId = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeactivateClientRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeactivateClientRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeactivateClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = append(m.ClientId[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClientId == nil {
+				m.ClientId = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeactivateClientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeactivateClientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeactivateClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = append(m.ClientId[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClientId == nil {
+				m.ClientId = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttachDocumentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttachDocumentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttachDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = append(m.ClientId[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClientId == nil {
+				m.ClientId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChangePack == nil {
+				m.ChangePack = &ChangePack{}
+			}
+			if err := m.ChangePack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttachDocumentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttachDocumentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttachDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = append(m.ClientId[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClientId == nil {
+				m.ClientId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChangePack == nil {
+				m.ChangePack = &ChangePack{}
+			}
+			if err := m.ChangePack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
 
-func sovYorkie(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozYorkie(x uint64) (n int) {
-	return sovYorkie(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *BroadcastEventRequest) Unmarshal(dAtA []byte) error {
+func (m *DetachDocumentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9033,15 +20056,15 @@ func (m *BroadcastEventRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BroadcastEventRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DetachDocumentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BroadcastEventRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DetachDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublisherId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -9068,14 +20091,14 @@ func (m *BroadcastEventRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublisherId = append(m.PublisherId[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublisherId == nil {
-				m.PublisherId = []byte{}
+			m.ClientId = append(m.ClientId[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClientId == nil {
+				m.ClientId = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9102,10 +20125,10 @@ func (m *BroadcastEventRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Event == nil {
-				m.Event = &DocEvent{}
+			if m.ChangePack == nil {
+				m.ChangePack = &ChangePack{}
 			}
-			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ChangePack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9134,7 +20157,7 @@ func (m *BroadcastEventRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BroadcastEventResponse) Unmarshal(dAtA []byte) error {
+func (m *DetachDocumentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9157,12 +20180,80 @@ func (m *BroadcastEventResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BroadcastEventResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DetachDocumentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BroadcastEventResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DetachDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChangePack == nil {
+				m.ChangePack = &ChangePack{}
+			}
+			if err := m.ChangePack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipYorkie(dAtA[iNdEx:])
@@ -9188,7 +20279,7 @@ func (m *BroadcastEventResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ActivateClientRequest) Unmarshal(dAtA []byte) error {
+func (m *WatchDocumentsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9211,17 +20302,17 @@ func (m *ActivateClientRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActivateClientRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchDocumentsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActivateClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchDocumentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYorkie
@@ -9231,24 +20322,82 @@ func (m *ActivateClientRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthYorkie
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthYorkie
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientKey = string(dAtA[iNdEx:postIndex])
+			if m.Client == nil {
+				m.Client = &Client{}
+			}
+			if err := m.Client.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DocumentKeys = append(m.DocumentKeys, &DocumentKey{})
+			if err := m.DocumentKeys[len(m.DocumentKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Catchup", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Catchup = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipYorkie(dAtA[iNdEx:])
@@ -9274,7 +20423,7 @@ func (m *ActivateClientRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ActivateClientResponse) Unmarshal(dAtA []byte) error {
+func (m *WatchDocumentsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9297,17 +20446,52 @@ func (m *ActivateClientResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActivateClientResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: WatchDocumentsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActivateClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WatchDocumentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Initialization", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &WatchDocumentsResponse_Initialization{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &WatchDocumentsResponse_Initialization_{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYorkie
@@ -9317,29 +20501,32 @@ func (m *ActivateClientResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthYorkie
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthYorkie
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientKey = string(dAtA[iNdEx:postIndex])
+			v := &DocEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Body = &WatchDocumentsResponse_Event{v}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Keepalive", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYorkie
@@ -9349,25 +20536,26 @@ func (m *ActivateClientResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthYorkie
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthYorkie
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = append(m.ClientId[:0], dAtA[iNdEx:postIndex]...)
-			if m.ClientId == nil {
-				m.ClientId = []byte{}
+			v := &WatchDocumentsResponse_Keepalive{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			m.Body = &WatchDocumentsResponse_Keepalive_{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9394,7 +20582,7 @@ func (m *ActivateClientResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeactivateClientRequest) Unmarshal(dAtA []byte) error {
+func (m *WatchDocumentsResponse_Initialization) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9417,17 +20605,17 @@ func (m *DeactivateClientRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeactivateClientRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Initialization: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeactivateClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Initialization: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeersMapByDoc", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYorkie
@@ -9437,25 +20625,233 @@ func (m *DeactivateClientRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthYorkie
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthYorkie
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = append(m.ClientId[:0], dAtA[iNdEx:postIndex]...)
-			if m.ClientId == nil {
-				m.ClientId = []byte{}
+			if m.PeersMapByDoc == nil {
+				m.PeersMapByDoc = make(map[string]*Clients)
+			}
+			var mapkey string
+			var mapvalue *Clients
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowYorkie
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowYorkie
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowYorkie
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Clients{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipYorkie(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.PeersMapByDoc[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerSeqs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ServerSeqs == nil {
+				m.ServerSeqs = make(map[string]uint64)
+			}
+			var mapkey string
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowYorkie
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowYorkie
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowYorkie
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipYorkie(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.ServerSeqs[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9482,7 +20878,7 @@ func (m *DeactivateClientRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeactivateClientResponse) Unmarshal(dAtA []byte) error {
+func (m *WatchDocumentsResponse_Keepalive) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9505,46 +20901,12 @@ func (m *DeactivateClientResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeactivateClientResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Keepalive: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeactivateClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Keepalive: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowYorkie
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthYorkie
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthYorkie
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClientId = append(m.ClientId[:0], dAtA[iNdEx:postIndex]...)
-			if m.ClientId == nil {
-				m.ClientId = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipYorkie(dAtA[iNdEx:])
@@ -9570,7 +20932,7 @@ func (m *DeactivateClientResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AttachDocumentRequest) Unmarshal(dAtA []byte) error {
+func (m *PushPullRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9593,10 +20955,10 @@ func (m *AttachDocumentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AttachDocumentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PushPullRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttachDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PushPullRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9694,7 +21056,7 @@ func (m *AttachDocumentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AttachDocumentResponse) Unmarshal(dAtA []byte) error {
+func (m *PushPullResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9717,10 +21079,10 @@ func (m *AttachDocumentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AttachDocumentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PushPullResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttachDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PushPullResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9818,7 +21180,7 @@ func (m *AttachDocumentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DetachDocumentRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdateMetadataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9841,17 +21203,17 @@ func (m *DetachDocumentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DetachDocumentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateMetadataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DetachDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYorkie
@@ -9861,29 +21223,31 @@ func (m *DetachDocumentRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthYorkie
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthYorkie
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = append(m.ClientId[:0], dAtA[iNdEx:postIndex]...)
-			if m.ClientId == nil {
-				m.ClientId = []byte{}
+			if m.Client == nil {
+				m.Client = &Client{}
+			}
+			if err := m.Client.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKeys", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9910,10 +21274,8 @@ func (m *DetachDocumentRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChangePack == nil {
-				m.ChangePack = &ChangePack{}
-			}
-			if err := m.ChangePack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DocumentKeys = append(m.DocumentKeys, &DocumentKey{})
+			if err := m.DocumentKeys[len(m.DocumentKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9942,7 +21304,7 @@ func (m *DetachDocumentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DetachDocumentResponse) Unmarshal(dAtA []byte) error {
+func (m *UpdateMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9965,17 +21327,71 @@ func (m *DetachDocumentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DetachDocumentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DetachDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipYorkie(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateClientMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowYorkie
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateClientMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateClientMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYorkie
@@ -9985,27 +21401,29 @@ func (m *DetachDocumentResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthYorkie
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthYorkie
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientKey = string(dAtA[iNdEx:postIndex])
+			m.ClientId = append(m.ClientId[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClientId == nil {
+				m.ClientId = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10032,12 +21450,103 @@ func (m *DetachDocumentResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChangePack == nil {
-				m.ChangePack = &ChangePack{}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
 			}
-			if err := m.ChangePack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowYorkie
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowYorkie
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowYorkie
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipYorkie(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10064,7 +21573,7 @@ func (m *DetachDocumentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WatchDocumentsRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdateClientMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10087,82 +21596,12 @@ func (m *WatchDocumentsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WatchDocumentsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateClientMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WatchDocumentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateClientMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowYorkie
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthYorkie
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthYorkie
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Client == nil {
-				m.Client = &Client{}
-			}
-			if err := m.Client.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKeys", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowYorkie
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthYorkie
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthYorkie
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DocumentKeys = append(m.DocumentKeys, &DocumentKey{})
-			if err := m.DocumentKeys[len(m.DocumentKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipYorkie(dAtA[iNdEx:])
@@ -10188,7 +21627,7 @@ func (m *WatchDocumentsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WatchDocumentsResponse) Unmarshal(dAtA []byte) error {
+func (m *PullJSONPatchesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10211,15 +21650,15 @@ func (m *WatchDocumentsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WatchDocumentsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PullJSONPatchesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WatchDocumentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PullJSONPatchesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Initialization", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10246,17 +21685,18 @@ func (m *WatchDocumentsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &WatchDocumentsResponse_Initialization{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.DocumentKey == nil {
+				m.DocumentKey = &DocumentKey{}
+			}
+			if err := m.DocumentKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Body = &WatchDocumentsResponse_Initialization_{v}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerSeq", wireType)
 			}
-			var msglen int
+			m.ServerSeq = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYorkie
@@ -10266,27 +21706,11 @@ func (m *WatchDocumentsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ServerSeq |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthYorkie
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthYorkie
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &DocEvent{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Body = &WatchDocumentsResponse_Event{v}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipYorkie(dAtA[iNdEx:])
@@ -10312,7 +21736,7 @@ func (m *WatchDocumentsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WatchDocumentsResponse_Initialization) Unmarshal(dAtA []byte) error {
+func (m *PullJSONPatchesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10335,15 +21759,34 @@ func (m *WatchDocumentsResponse_Initialization) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Initialization: wiretype end group for non-group")
+			return fmt.Errorf("proto: PullJSONPatchesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Initialization: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PullJSONPatchesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerSeq", wireType)
+			}
+			m.ServerSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServerSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeersMapByDoc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Patches", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10370,106 +21813,63 @@ func (m *WatchDocumentsResponse_Initialization) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PeersMapByDoc == nil {
-				m.PeersMapByDoc = make(map[string]*Clients)
+			m.Patches = append(m.Patches, &JSONPatch{})
+			if err := m.Patches[len(m.Patches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue *Clients
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowYorkie
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Document", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowYorkie
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthYorkie
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthYorkie
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowYorkie
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthYorkie
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthYorkie
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &Clients{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipYorkie(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthYorkie
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.PeersMapByDoc[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Document = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMore", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasMore = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipYorkie(dAtA[iNdEx:])
@@ -10495,7 +21895,7 @@ func (m *WatchDocumentsResponse_Initialization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PushPullRequest) Unmarshal(dAtA []byte) error {
+func (m *FetchSnapshotRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10518,17 +21918,17 @@ func (m *PushPullRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PushPullRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FetchSnapshotRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PushPullRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FetchSnapshotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKey", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYorkie
@@ -10538,31 +21938,33 @@ func (m *PushPullRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthYorkie
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthYorkie
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = append(m.ClientId[:0], dAtA[iNdEx:postIndex]...)
-			if m.ClientId == nil {
-				m.ClientId = []byte{}
+			if m.DocumentKey == nil {
+				m.DocumentKey = &DocumentKey{}
+			}
+			if err := m.DocumentKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerSeq", wireType)
 			}
-			var msglen int
+			m.ServerSeq = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYorkie
@@ -10572,28 +21974,30 @@ func (m *PushPullRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ServerSeq |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthYorkie
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthYorkie
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ChangePack == nil {
-				m.ChangePack = &ChangePack{}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
-			if err := m.ChangePack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipYorkie(dAtA[iNdEx:])
@@ -10619,7 +22023,7 @@ func (m *PushPullRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PushPullResponse) Unmarshal(dAtA []byte) error {
+func (m *FetchSnapshotResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10642,17 +22046,17 @@ func (m *PushPullResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PushPullResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: FetchSnapshotResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PushPullResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FetchSnapshotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerSeq", wireType)
 			}
-			var byteLen int
+			m.ServerSeq = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYorkie
@@ -10662,31 +22066,54 @@ func (m *PushPullResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.ServerSeq |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthYorkie
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthYorkie
+			m.TotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
 			}
-			m.ClientId = append(m.ClientId[:0], dAtA[iNdEx:postIndex]...)
-			if m.ClientId == nil {
-				m.ClientId = []byte{}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChangePack", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYorkie
@@ -10696,26 +22123,24 @@ func (m *PushPullResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthYorkie
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthYorkie
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChangePack == nil {
-				m.ChangePack = &ChangePack{}
-			}
-			if err := m.ChangePack.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Chunk = append(m.Chunk[:0], dAtA[iNdEx:postIndex]...)
+			if m.Chunk == nil {
+				m.Chunk = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -10743,7 +22168,7 @@ func (m *PushPullResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *JSONPatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10766,17 +22191,17 @@ func (m *UpdateMetadataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateMetadataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: JSONPatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JSONPatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYorkie
@@ -10786,33 +22211,29 @@ func (m *UpdateMetadataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthYorkie
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthYorkie
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Client == nil {
-				m.Client = &Client{}
-			}
-			if err := m.Client.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Op = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DocumentKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowYorkie
@@ -10822,80 +22243,56 @@ func (m *UpdateMetadataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthYorkie
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthYorkie
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DocumentKeys = append(m.DocumentKeys, &DocumentKey{})
-			if err := m.DocumentKeys[len(m.DocumentKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipYorkie(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthYorkie
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthYorkie
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UpdateMetadataResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowYorkie
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateMetadataResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipYorkie(dAtA[iNdEx:])
@@ -11126,6 +22523,58 @@ func (m *ChangePack) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMore", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasMore = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreferredRegion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreferredRegion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipYorkie(dAtA[iNdEx:])
@@ -11282,6 +22731,40 @@ func (m *Change) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipYorkie(dAtA[iNdEx:])
@@ -11408,6 +22891,119 @@ func (m *ChangeID) Unmarshal(dAtA []byte) error {
 				m.ActorId = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Vector == nil {
+				m.Vector = make(map[string]uint64)
+			}
+			var mapkey string
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowYorkie
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowYorkie
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowYorkie
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipYorkie(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthYorkie
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Vector[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipYorkie(dAtA[iNdEx:])
@@ -17799,6 +29395,78 @@ func (m *DocEvent) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServerSeq", wireType)
+			}
+			m.ServerSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ServerSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromServerSeq", wireType)
+			}
+			m.FromServerSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromServerSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowYorkie
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthYorkie
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Peers = append(m.Peers, &Client{})
+			if err := m.Peers[len(m.Peers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipYorkie(dAtA[iNdEx:])
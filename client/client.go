@@ -18,8 +18,10 @@ package client
 
 import (
 	"context"
+	"crypto/ed25519"
 	"errors"
 	"fmt"
+	"io"
 
 	"github.com/rs/xid"
 	"go.uber.org/zap"
@@ -70,11 +72,14 @@ type Client struct {
 	dialOptions []grpc.DialOption
 	logger      *zap.Logger
 
-	id           *time.ActorID
-	key          string
-	metadataInfo types.MetadataInfo
-	status       status
-	attachments  map[string]*Attachment
+	id             *time.ActorID
+	key            string
+	metadataInfo   types.MetadataInfo
+	clientMetadata types.Metadata
+	status         status
+	attachments    map[string]*Attachment
+	watchCatchup   bool
+	signingKey     ed25519.PrivateKey
 }
 
 // WatchResponseType is type of watch response.
@@ -82,8 +87,9 @@ type WatchResponseType string
 
 // The values below are types of WatchResponseType.
 const (
-	DocumentsChanged WatchResponseType = "documents-changed"
-	PeersChanged     WatchResponseType = "peers-changed"
+	DocumentsChanged     WatchResponseType = "documents-changed"
+	PeersChanged         WatchResponseType = "peers-changed"
+	DocumentsQuarantined WatchResponseType = "documents-quarantined"
 )
 
 // WatchResponse is a structure representing response of Watch.
@@ -92,6 +98,12 @@ type WatchResponse struct {
 	Keys          []*key.Key
 	PeersMapByDoc map[string]map[string]types.Metadata
 	Err           error
+
+	// FromServerSeq and ServerSeq are the range (FromServerSeq, ServerSeq] of
+	// the server sequences of the changes of a DocumentsChanged event. Both
+	// are 0 if the range is unknown.
+	FromServerSeq uint64
+	ServerSeq     uint64
 }
 
 // New creates an instance of Client.
@@ -148,10 +160,13 @@ func New(opts ...Option) (*Client, error) {
 		dialOptions: dialOptions,
 		logger:      logger,
 
-		key:          k,
-		metadataInfo: types.MetadataInfo{Data: metadata},
-		status:       deactivated,
-		attachments:  make(map[string]*Attachment),
+		key:            k,
+		metadataInfo:   types.MetadataInfo{Data: metadata},
+		clientMetadata: options.ClientMetadata,
+		status:         deactivated,
+		attachments:    make(map[string]*Attachment),
+		watchCatchup:   options.WatchCatchup,
+		signingKey:     options.SigningKey,
 	}, nil
 }
 
@@ -201,6 +216,8 @@ func (c *Client) Activate(ctx context.Context) error {
 
 	response, err := c.client.ActivateClient(ctx, &api.ActivateClientRequest{
 		ClientKey: c.key,
+		Metadata:  c.clientMetadata,
+		PublicKey: c.publicKey(),
 	})
 	if err != nil {
 		return err
@@ -244,7 +261,7 @@ func (c *Client) Attach(ctx context.Context, doc *document.Document) error {
 
 	doc.SetActor(c.id)
 
-	pbChangePack, err := converter.ToChangePack(doc.CreateChangePack())
+	pbChangePack, err := c.createChangePack(doc)
 	if err != nil {
 		return err
 	}
@@ -280,6 +297,10 @@ func (c *Client) Attach(ctx context.Context, doc *document.Document) error {
 		peers: make(map[string]types.MetadataInfo),
 	}
 
+	if pack.HasMore {
+		return c.sync(ctx, doc.Key())
+	}
+
 	return nil
 }
 
@@ -298,7 +319,7 @@ func (c *Client) Detach(ctx context.Context, doc *document.Document) error {
 		return ErrDocumentNotAttached
 	}
 
-	pbChangePack, err := converter.ToChangePack(doc.CreateChangePack())
+	pbChangePack, err := c.createChangePack(doc)
 	if err != nil {
 		return err
 	}
@@ -366,6 +387,7 @@ func (c *Client) Watch(
 			MetadataInfo: c.metadataInfo,
 		}),
 		DocumentKeys: converter.ToDocumentKeys(keys),
+		Catchup:      c.watchCatchup,
 	})
 	if err != nil {
 		return nil, err
@@ -386,6 +408,28 @@ func (c *Client) Watch(
 				}
 			}
 
+			// NOTE: The documents behind the server sequences of the catchup
+			// should be synchronized, because the events of the changes up to
+			// them are not sent.
+			var behindKeys []*key.Key
+			for docID, serverSeq := range resp.Initialization.ServerSeqs {
+				attachment, ok := c.attachments[docID]
+				if !ok {
+					continue
+				}
+				if attachment.doc.Checkpoint().ServerSeq < serverSeq {
+					behindKeys = append(behindKeys, attachment.doc.Key())
+				}
+			}
+			if len(behindKeys) > 0 {
+				return &WatchResponse{
+					Type: DocumentsChanged,
+					Keys: behindKeys,
+				}, nil
+			}
+
+			return nil, nil
+		case *api.WatchDocumentsResponse_Keepalive_:
 			return nil, nil
 		case *api.WatchDocumentsResponse_Event:
 			eventType, err := converter.FromEventType(resp.Event.Type)
@@ -396,7 +440,14 @@ func (c *Client) Watch(
 			switch eventType {
 			case types.DocumentsChangedEvent:
 				return &WatchResponse{
-					Type: DocumentsChanged,
+					Type:          DocumentsChanged,
+					Keys:          converter.FromDocumentKeys(resp.Event.DocumentKeys),
+					FromServerSeq: resp.Event.FromServerSeq,
+					ServerSeq:     resp.Event.ServerSeq,
+				}, nil
+			case types.DocumentsQuarantinedEvent:
+				return &WatchResponse{
+					Type: DocumentsQuarantined,
 					Keys: converter.FromDocumentKeys(resp.Event.DocumentKeys),
 				}, nil
 			case types.DocumentsWatchedEvent, types.DocumentsUnwatchedEvent, types.MetadataChangedEvent:
@@ -421,6 +472,27 @@ func (c *Client) Watch(
 					Type:          PeersChanged,
 					PeersMapByDoc: c.PeersMapByDoc(),
 				}, nil
+			case types.PeersChangedEvent:
+				for _, k := range converter.FromDocumentKeys(resp.Event.DocumentKeys) {
+					attachment, ok := c.attachments[k.BSONKey()]
+					if !ok {
+						continue
+					}
+
+					peers := make(map[string]types.MetadataInfo)
+					for _, pbPeer := range resp.Event.Peers {
+						peer, err := converter.FromClient(pbPeer)
+						if err != nil {
+							return nil, err
+						}
+						peers[peer.ID.String()] = peer.MetadataInfo
+					}
+					attachment.peers = peers
+				}
+				return &WatchResponse{
+					Type:          PeersChanged,
+					PeersMapByDoc: c.PeersMapByDoc(),
+				}, nil
 			}
 		}
 		return nil, ErrUnsupportedWatchResponseType
@@ -430,11 +502,16 @@ func (c *Client) Watch(
 	if err != nil {
 		return nil, err
 	}
-	if _, err := handleResponse(pbResp); err != nil {
+	initResp, err := handleResponse(pbResp)
+	if err != nil {
 		return nil, err
 	}
 
 	go func() {
+		if initResp != nil {
+			rch <- *initResp
+		}
+
 		for {
 			pbResp, err := stream.Recv()
 			if err != nil {
@@ -448,6 +525,9 @@ func (c *Client) Watch(
 				close(rch)
 				return
 			}
+			if resp == nil {
+				continue
+			}
 			rch <- *resp
 		}
 	}()
@@ -490,6 +570,64 @@ func (c *Client) UpdateMetadata(ctx context.Context, k, v string) error {
 	return nil
 }
 
+// UpdateClientMetadata replaces the app-specific metadata of this client stored
+// in the agent.
+func (c *Client) UpdateClientMetadata(ctx context.Context, metadata types.Metadata) error {
+	if c.status != activated {
+		return ErrClientNotActivated
+	}
+
+	if _, err := c.client.UpdateClientMetadata(ctx, &api.UpdateClientMetadataRequest{
+		ClientId: c.id.Bytes(),
+		Metadata: metadata,
+	}); err != nil {
+		return err
+	}
+
+	c.clientMetadata = metadata
+
+	return nil
+}
+
+// FetchSnapshot writes the stored snapshot of the document of the given key
+// to the given writer from the given byte offset. If serverSeq is 0, the last
+// snapshot is fetched. It returns the server sequence of the snapshot and the
+// offset after the written bytes even if the transfer fails midway, so that
+// the transfer can be resumed by calling it again with them.
+func (c *Client) FetchSnapshot(
+	ctx context.Context,
+	docKey *key.Key,
+	serverSeq uint64,
+	offset uint64,
+	w io.Writer,
+) (uint64, uint64, error) {
+	stream, err := c.client.FetchSnapshot(ctx, &api.FetchSnapshotRequest{
+		DocumentKey: converter.ToDocumentKey(docKey),
+		ServerSeq:   serverSeq,
+		Offset:      offset,
+	})
+	if err != nil {
+		return serverSeq, offset, err
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return serverSeq, offset, nil
+		}
+		if err != nil {
+			return serverSeq, offset, err
+		}
+
+		serverSeq = resp.ServerSeq
+		n, err := w.Write(resp.Chunk)
+		offset += uint64(n)
+		if err != nil {
+			return serverSeq, offset, err
+		}
+	}
+}
+
 // ID returns the ID of this client.
 func (c *Client) ID() *time.ActorID {
 	return c.id
@@ -538,29 +676,63 @@ func (c *Client) sync(ctx context.Context, key *key.Key) error {
 		return ErrDocumentNotAttached
 	}
 
-	pbChangePack, err := converter.ToChangePack(attachment.doc.CreateChangePack())
-	if err != nil {
-		return err
-	}
+	// NOTE: If the agent splits the changes into several responses, we keep
+	// pulling until the rest of the changes are delivered.
+	for {
+		pbChangePack, err := c.createChangePack(attachment.doc)
+		if err != nil {
+			return err
+		}
 
-	res, err := c.client.PushPull(ctx, &api.PushPullRequest{
-		ClientId:   c.id.Bytes(),
-		ChangePack: pbChangePack,
-	})
-	if err != nil {
-		c.logger.Error("failed to sync", zap.Error(err))
-		return err
+		res, err := c.client.PushPull(ctx, &api.PushPullRequest{
+			ClientId:   c.id.Bytes(),
+			ChangePack: pbChangePack,
+		})
+		if err != nil {
+			c.logger.Error("failed to sync", zap.Error(err))
+			return err
+		}
+
+		pack, err := converter.FromChangePack(res.ChangePack)
+		if err != nil {
+			return err
+		}
+
+		if err := attachment.doc.ApplyChangePack(pack); err != nil {
+			c.logger.Error("failed to apply change pack", zap.Error(err))
+			return err
+		}
+
+		if !pack.HasMore {
+			return nil
+		}
 	}
+}
 
-	pack, err := converter.FromChangePack(res.ChangePack)
-	if err != nil {
-		return err
+// publicKey returns the public key of the signing key of this client. It
+// returns nil if the client does not sign its changes.
+func (c *Client) publicKey() []byte {
+	if c.signingKey == nil {
+		return nil
 	}
 
-	if err := attachment.doc.ApplyChangePack(pack); err != nil {
-		c.logger.Error("failed to apply change pack", zap.Error(err))
-		return err
+	return c.signingKey.Public().(ed25519.PublicKey)
+}
+
+// createChangePack creates the change pack of the given document in Protobuf
+// format. If the signing key is given, the changes are signed with it.
+func (c *Client) createChangePack(doc *document.Document) (*api.ChangePack, error) {
+	pack := doc.CreateChangePack()
+	if c.signingKey != nil {
+		for _, cn := range pack.Changes {
+			if len(cn.Signature()) > 0 {
+				continue
+			}
+			if err := converter.SignChange(c.signingKey, cn); err != nil {
+				return nil, err
+			}
+		}
 	}
 
-	return nil
+	return converter.ToChangePack(pack)
 }
@@ -17,6 +17,8 @@
 package client
 
 import (
+	"crypto/ed25519"
+
 	"go.uber.org/zap"
 
 	"github.com/yorkie-team/yorkie/pkg/types"
@@ -33,6 +35,11 @@ type Options struct {
 	// Metadata is the metadata of the client.
 	Metadata types.Metadata
 
+	// ClientMetadata is the app-specific metadata of the client such as device
+	// type or app version. Unlike Metadata, it is stored in the agent and is
+	// not shared with peers.
+	ClientMetadata types.Metadata
+
 	// Token is the token of the client. Each request will be authenticated with this token.
 	Token string
 
@@ -44,6 +51,15 @@ type Options struct {
 
 	// Logger is the Logger of the client.
 	Logger *zap.Logger
+
+	// SigningKey is the ed25519 private key to sign the changes of the client.
+	// Its public key is registered to the agent when the client is activated.
+	SigningKey ed25519.PrivateKey
+
+	// WatchCatchup is whether to watch the documents with the catchup. The
+	// agent returns the server sequences of the documents when the watch is
+	// established and sends each change after them exactly once.
+	WatchCatchup bool
 }
 
 // WithKey configures the key of the client.
@@ -56,6 +72,11 @@ func WithMetadata(metadata types.Metadata) Option {
 	return func(o *Options) { o.Metadata = metadata }
 }
 
+// WithClientMetadata configures the app-specific metadata of the client.
+func WithClientMetadata(metadata types.Metadata) Option {
+	return func(o *Options) { o.ClientMetadata = metadata }
+}
+
 // WithToken configures the token of the client.
 func WithToken(token string) Option {
 	return func(o *Options) { o.Token = token }
@@ -75,3 +96,13 @@ func WithServerNameOverride(serverNameOverride string) Option {
 func WithLogger(logger *zap.Logger) Option {
 	return func(o *Options) { o.Logger = logger }
 }
+
+// WithSigningKey configures the private key to sign the changes of the client.
+func WithSigningKey(signingKey ed25519.PrivateKey) Option {
+	return func(o *Options) { o.SigningKey = signingKey }
+}
+
+// WithWatchCatchup configures the client to watch the documents with the catchup.
+func WithWatchCatchup() Option {
+	return func(o *Options) { o.WatchCatchup = true }
+}
@@ -17,6 +17,7 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/signal"
@@ -28,28 +29,59 @@ import (
 	"github.com/yorkie-team/yorkie/yorkie"
 	"github.com/yorkie-team/yorkie/yorkie/backend/db/mongo"
 	"github.com/yorkie-team/yorkie/yorkie/backend/sync/etcd"
+	"github.com/yorkie-team/yorkie/yorkie/backend/sync/redis"
 	"github.com/yorkie-team/yorkie/yorkie/logging"
+	"github.com/yorkie-team/yorkie/yorkie/rpc"
 )
 
 var (
 	gracefulTimeout = 10 * time.Second
+
+	// drainTimeout is the timeout for draining the RPCs. It is shorter than
+	// gracefulTimeout to leave time for the backend to shut down.
+	drainTimeout = 8 * time.Second
 )
 
 var (
 	flagConfPath string
 	flagLogLevel string
 
-	housekeepingInterval            time.Duration
-	housekeepingDeactivateThreshold time.Duration
+	rpcWarmupDelay            time.Duration
+	rpcWatchKeepaliveInterval time.Duration
+
+	rpcKeepaliveMaxConnectionIdle time.Duration
+	rpcKeepaliveTime              time.Duration
+	rpcKeepaliveTimeout           time.Duration
+	rpcKeepaliveMinTime           time.Duration
+
+	rpcHealthCheckInterval time.Duration
+
+	housekeepingInterval              time.Duration
+	housekeepingDeactivateThreshold   time.Duration
+	housekeepingClientDeleteThreshold time.Duration
 
 	mongoConnectionURI     string
 	mongoConnectionTimeout time.Duration
 	mongoYorkieDatabase    string
 	mongoPingTimeout       time.Duration
+	mongoReadReplicaURI    string
+	mongoMaxPoolSize       uint64
+	mongoMinPoolSize       uint64
+	mongoSocketTimeout     time.Duration
+	mongoVerifyIndexesOnly bool
+
+	slowSnapshotThreshold time.Duration
+
+	dbMaxWaitInterval time.Duration
+
+	backgroundDrainTimeout time.Duration
 
 	authWebhookMaxWaitInterval time.Duration
 	authWebhookCacheAuthTTL    time.Duration
 	authWebhookCacheUnauthTTL  time.Duration
+	authWebhookCacheMaxAge     time.Duration
+
+	authWebhookDeltaResyncInterval time.Duration
 
 	etcdEndpoints     []string
 	etcdDialTimeout   time.Duration
@@ -57,6 +89,15 @@ var (
 	etcdPassword      string
 	etcdLockLeaseTime time.Duration
 
+	etcdFanoutEndpoints []string
+
+	redisAddress       string
+	redisDialTimeout   time.Duration
+	redisUsername      string
+	redisPassword      string
+	redisDB            int
+	redisLockLeaseTime time.Duration
+
 	conf = yorkie.NewConfig()
 )
 
@@ -65,12 +106,30 @@ func newAgentCmd() *cobra.Command {
 		Use:   "agent [options]",
 		Short: "Starts yorkie agent",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			// NOTE: The server listens on the socket instead of the default
+			//       port unless the port is given explicitly.
+			if conf.RPC.SocketPath != "" && !cmd.Flags().Changed("rpc-port") {
+				conf.RPC.Port = 0
+			}
+			conf.RPC.WarmupDelay = rpcWarmupDelay.String()
+			conf.RPC.WatchKeepaliveInterval = rpcWatchKeepaliveInterval.String()
+			conf.RPC.KeepaliveMaxConnectionIdle = rpcKeepaliveMaxConnectionIdle.String()
+			conf.RPC.KeepaliveTime = rpcKeepaliveTime.String()
+			conf.RPC.KeepaliveTimeout = rpcKeepaliveTimeout.String()
+			conf.RPC.KeepaliveMinTime = rpcKeepaliveMinTime.String()
+			conf.RPC.HealthCheckInterval = rpcHealthCheckInterval.String()
+			conf.Backend.SlowSnapshotThreshold = slowSnapshotThreshold.String()
+			conf.Backend.DBMaxWaitInterval = dbMaxWaitInterval.String()
+			conf.Backend.BackgroundDrainTimeout = backgroundDrainTimeout.String()
 			conf.Backend.AuthWebhookMaxWaitInterval = authWebhookMaxWaitInterval.String()
 			conf.Backend.AuthWebhookCacheAuthTTL = authWebhookCacheAuthTTL.String()
 			conf.Backend.AuthWebhookCacheUnauthTTL = authWebhookCacheUnauthTTL.String()
+			conf.Backend.AuthWebhookCacheMaxAge = authWebhookCacheMaxAge.String()
+			conf.Backend.AuthWebhookDeltaResyncInterval = authWebhookDeltaResyncInterval.String()
 
 			conf.Housekeeping.Interval = housekeepingInterval.String()
 			conf.Housekeeping.DeactivateThreshold = housekeepingDeactivateThreshold.String()
+			conf.Housekeeping.ClientDeleteThreshold = housekeepingClientDeleteThreshold.String()
 
 			if mongoConnectionURI != "" {
 				conf.Mongo = &mongo.Config{
@@ -78,16 +137,33 @@ func newAgentCmd() *cobra.Command {
 					ConnectionTimeout: mongoConnectionTimeout.String(),
 					YorkieDatabase:    mongoYorkieDatabase,
 					PingTimeout:       mongoPingTimeout.String(),
+					ReadReplicaURI:    mongoReadReplicaURI,
+					MaxPoolSize:       mongoMaxPoolSize,
+					MinPoolSize:       mongoMinPoolSize,
+					SocketTimeout:     mongoSocketTimeout.String(),
+					VerifyIndexesOnly: mongoVerifyIndexesOnly,
 				}
 			}
 
 			if etcdEndpoints != nil {
 				conf.ETCD = &etcd.Config{
-					Endpoints:     etcdEndpoints,
-					DialTimeout:   etcdDialTimeout.String(),
-					Username:      etcdUsername,
-					Password:      etcdPassword,
-					LockLeaseTime: etcdLockLeaseTime.String(),
+					Endpoints:       etcdEndpoints,
+					DialTimeout:     etcdDialTimeout.String(),
+					Username:        etcdUsername,
+					Password:        etcdPassword,
+					LockLeaseTime:   etcdLockLeaseTime.String(),
+					FanoutEndpoints: etcdFanoutEndpoints,
+				}
+			}
+
+			if redisAddress != "" {
+				conf.Redis = &redis.Config{
+					Address:       redisAddress,
+					DialTimeout:   redisDialTimeout.String(),
+					Username:      redisUsername,
+					Password:      redisPassword,
+					DB:            redisDB,
+					LockLeaseTime: redisLockLeaseTime.String(),
 				}
 			}
 
@@ -126,25 +202,24 @@ func handleSignal(r *yorkie.Yorkie) int {
 	sigCh := make(chan os.Signal, 1)
 	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
 
-	var sig os.Signal
-	select {
-	case s := <-sigCh:
-		sig = s
-	case <-r.ShutdownCh():
+	if !waitShutdownSignal(r, sigCh) {
 		// yorkie is already shutdown
 		return 0
 	}
 
-	graceful := false
-	if sig == syscall.SIGINT || sig == syscall.SIGTERM {
-		graceful = true
-	}
-
 	gracefulCh := make(chan struct{})
 	go func() {
-		if err := r.Shutdown(graceful); err != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), drainTimeout)
+		defer cancel()
+		drained, err := r.Drain(ctx)
+		if err != nil {
 			return
 		}
+		if drained {
+			logging.DefaultLogger().Infof("RPC drained cleanly")
+		} else {
+			logging.DefaultLogger().Warnf("RPC not drained in %s, connections closed forcibly", drainTimeout)
+		}
 		close(gracefulCh)
 	}()
 
@@ -158,6 +233,28 @@ func handleSignal(r *yorkie.Yorkie) int {
 	}
 }
 
+// waitShutdownSignal waits for the signal to shut down the agent. SIGHUP
+// reloads the TLS certificate and keeps the agent running. It returns false if
+// the agent is already shutdown.
+func waitShutdownSignal(r *yorkie.Yorkie, sigCh <-chan os.Signal) bool {
+	for {
+		select {
+		case sig := <-sigCh:
+			if sig != syscall.SIGHUP {
+				return true
+			}
+
+			if err := r.ReloadCertificates(); err != nil {
+				logging.DefaultLogger().Error(err)
+				continue
+			}
+			logging.DefaultLogger().Infof("TLS certificate reloaded")
+		case <-r.ShutdownCh():
+			return false
+		}
+	}
+}
+
 func init() {
 	cmd := newAgentCmd()
 	cmd.Flags().StringVarP(
@@ -180,6 +277,18 @@ func init() {
 		yorkie.DefaultRPCPort,
 		"RPC port",
 	)
+	cmd.Flags().StringVar(
+		&conf.RPC.Host,
+		"rpc-host",
+		"",
+		"IP address of the interface the RPC server binds to. If it is empty, all interfaces are bound.",
+	)
+	cmd.Flags().StringVar(
+		&conf.RPC.SocketPath,
+		"rpc-socket-path",
+		"",
+		"Path to the UNIX domain socket to listen on instead of the RPC port.",
+	)
 	cmd.Flags().StringVar(
 		&conf.RPC.CertFile,
 		"rpc-cert-file",
@@ -198,6 +307,78 @@ func init() {
 		yorkie.DefaultRPCMaxRequestsBytes,
 		"Maximum client request size in bytes the server will accept.",
 	)
+	cmd.Flags().Uint64Var(
+		&conf.RPC.MaxResponseBytes,
+		"rpc-max-response-bytes",
+		0,
+		"Maximum response size in bytes the server will send. Zero means unlimited.",
+	)
+	cmd.Flags().DurationVar(
+		&rpcWarmupDelay,
+		"rpc-warmup-delay",
+		0,
+		"Duration after the start during which the health status stays NOT_SERVING.",
+	)
+	cmd.Flags().DurationVar(
+		&rpcWatchKeepaliveInterval,
+		"rpc-watch-keepalive-interval",
+		0,
+		"Interval at which the server sends a keepalive message over the watch streams.",
+	)
+	cmd.Flags().DurationVar(
+		&rpcKeepaliveMaxConnectionIdle,
+		"rpc-keepalive-max-connection-idle",
+		0,
+		"Duration after which an idle connection without any RPCs is closed. Zero means never.",
+	)
+	cmd.Flags().DurationVar(
+		&rpcKeepaliveTime,
+		"rpc-keepalive-time",
+		rpc.DefaultKeepaliveTime,
+		"Idle duration after which the server pings the client to see if the connection is alive.",
+	)
+	cmd.Flags().DurationVar(
+		&rpcKeepaliveTimeout,
+		"rpc-keepalive-timeout",
+		rpc.DefaultKeepaliveTimeout,
+		"Duration the server waits for the response of a keepalive ping before closing the connection.",
+	)
+	cmd.Flags().DurationVar(
+		&rpcKeepaliveMinTime,
+		"rpc-keepalive-min-time",
+		rpc.DefaultKeepaliveMinTime,
+		"Minimum interval at which the clients are allowed to send keepalive pings.",
+	)
+	cmd.Flags().BoolVar(
+		&conf.RPC.KeepalivePermitWithoutStream,
+		"rpc-keepalive-permit-without-stream",
+		false,
+		"Allow the clients to send keepalive pings even when there are no active streams.",
+	)
+	cmd.Flags().BoolVar(
+		&conf.RPC.EnableReflection,
+		"rpc-enable-reflection",
+		false,
+		"Register the gRPC server reflection service for tools like grpcurl.",
+	)
+	cmd.Flags().DurationVar(
+		&rpcHealthCheckInterval,
+		"rpc-health-check-interval",
+		yorkie.DefaultRPCHealthCheckInterval,
+		"Interval at which the server pings the database and the coordinator for the health status.",
+	)
+	cmd.Flags().BoolVar(
+		&conf.RPC.RequestLogging,
+		"rpc-request-logging",
+		false,
+		"Log every RPC with the method, the client, the documents and the duration for audit.",
+	)
+	cmd.Flags().StringSliceVar(
+		&conf.RPC.RequestLogSkipMethods,
+		"rpc-request-log-skip-methods",
+		[]string{"/grpc.health.v1.Health/Check", "/grpc.health.v1.Health/Watch"},
+		"Full names of the methods whose RPCs are not logged.",
+	)
 	cmd.Flags().IntVar(
 		&conf.Profiling.Port,
 		"profiling-port",
@@ -222,6 +403,12 @@ func init() {
 		yorkie.DefaultHousekeepingDeactivateThreshold,
 		"time after which clients are considered deactivate",
 	)
+	cmd.Flags().DurationVar(
+		&housekeepingClientDeleteThreshold,
+		"housekeeping-client-delete-threshold",
+		yorkie.DefaultHousekeepingClientDeleteThreshold,
+		"time after which deactivated clients are deleted (0 to keep them)",
+	)
 	cmd.Flags().IntVar(
 		&conf.Housekeeping.CandidatesLimit,
 		"housekeeping-candidates-limit",
@@ -252,6 +439,36 @@ func init() {
 		yorkie.DefaultMongoPingTimeout,
 		"Mongo DB's ping timeout",
 	)
+	cmd.Flags().StringVar(
+		&mongoReadReplicaURI,
+		"mongo-read-replica-uri",
+		"",
+		"MongoDB's read replica URI for the PushPulls without changes to push",
+	)
+	cmd.Flags().Uint64Var(
+		&mongoMaxPoolSize,
+		"mongo-max-pool-size",
+		yorkie.DefaultMongoMaxPoolSize,
+		"maximum number of connections in MongoDB's connection pool",
+	)
+	cmd.Flags().Uint64Var(
+		&mongoMinPoolSize,
+		"mongo-min-pool-size",
+		0,
+		"minimum number of connections kept in MongoDB's connection pool",
+	)
+	cmd.Flags().DurationVar(
+		&mongoSocketTimeout,
+		"mongo-socket-timeout",
+		0,
+		"Mongo DB's socket timeout for reads and writes (0 for no timeout)",
+	)
+	cmd.Flags().BoolVar(
+		&mongoVerifyIndexesOnly,
+		"mongo-verify-indexes-only",
+		false,
+		"only verify MongoDB's indexes at startup without creating the missing ones",
+	)
 	cmd.Flags().StringSliceVar(
 		&etcdEndpoints,
 		"etcd-endpoints",
@@ -282,6 +499,48 @@ func init() {
 		etcd.DefaultLockLeaseTime,
 		"ETCD's lease time for lock",
 	)
+	cmd.Flags().StringSliceVar(
+		&etcdFanoutEndpoints,
+		"etcd-fanout-endpoints",
+		nil,
+		"Comma separated list of etcd endpoints dedicated to the event fanout",
+	)
+	cmd.Flags().StringVar(
+		&redisAddress,
+		"redis-address",
+		"",
+		"Redis's address to use as the coordinator when etcd is not configured",
+	)
+	cmd.Flags().DurationVar(
+		&redisDialTimeout,
+		"redis-dial-timeout",
+		redis.DefaultDialTimeout,
+		"Redis's dial timeout",
+	)
+	cmd.Flags().StringVar(
+		&redisUsername,
+		"redis-username",
+		"",
+		"Redis's user name",
+	)
+	cmd.Flags().StringVar(
+		&redisPassword,
+		"redis-password",
+		"",
+		"Redis's password",
+	)
+	cmd.Flags().IntVar(
+		&redisDB,
+		"redis-db",
+		0,
+		"Redis's database number",
+	)
+	cmd.Flags().DurationVar(
+		&redisLockLeaseTime,
+		"redis-lock-lease-time",
+		redis.DefaultLockLeaseTime,
+		"Redis's lease time for lock",
+	)
 	cmd.Flags().Uint64Var(
 		&conf.Backend.SnapshotThreshold,
 		"backend-snapshot-threshold",
@@ -295,6 +554,181 @@ func init() {
 		yorkie.DefaultSnapshotInterval,
 		"Interval of changes to create a snapshot.",
 	)
+	cmd.Flags().Uint64Var(
+		&conf.Backend.MaxChangesPerPull,
+		"backend-max-changes-per-pull",
+		yorkie.DefaultMaxChangesPerPull,
+		"Maximum number of changes sent to the client in a single response.",
+	)
+	cmd.Flags().Uint64Var(
+		&conf.Backend.MaxPushChanges,
+		"backend-max-push-changes",
+		yorkie.DefaultMaxPushChanges,
+		"Maximum number of changes that a client can push in a single request.",
+	)
+	cmd.Flags().IntVar(
+		&conf.Backend.MaxConcurrentSnapshots,
+		"backend-max-concurrent-snapshots",
+		yorkie.DefaultMaxConcurrentSnapshots,
+		"Maximum number of snapshots built concurrently in this agent. A negative value means no limit.",
+	)
+	cmd.Flags().IntVar(
+		&conf.Backend.SnapshotWorkers,
+		"backend-snapshot-workers",
+		yorkie.DefaultSnapshotWorkers,
+		"Number of workers that encode a snapshot concurrently.",
+	)
+	cmd.Flags().StringVar(
+		&conf.Backend.SnapshotCompression,
+		"backend-snapshot-compression",
+		yorkie.DefaultSnapshotCompression,
+		"Codec to compress the snapshots before storing them: none or gzip.",
+	)
+	cmd.Flags().IntVar(
+		&conf.Backend.PushPullConcurrency,
+		"backend-push-pull-concurrency",
+		0,
+		"Maximum number of PushPulls processed concurrently in this agent. If it is zero, there is no limit.",
+	)
+	cmd.Flags().IntVar(
+		&conf.Backend.PushPullQueueSize,
+		"backend-push-pull-queue-size",
+		yorkie.DefaultPushPullQueueSize,
+		"Maximum number of PushPulls waiting for their turn when the concurrency is reached.",
+	)
+	cmd.Flags().IntVar(
+		&conf.Backend.BackgroundWorkers,
+		"backend-background-workers",
+		yorkie.DefaultBackgroundWorkers,
+		"Maximum number of background tasks such as storing snapshots run concurrently in this agent.",
+	)
+	cmd.Flags().IntVar(
+		&conf.Backend.BackgroundQueueSize,
+		"backend-background-queue-size",
+		yorkie.DefaultBackgroundQueueSize,
+		"Maximum number of background tasks waiting for a worker. The snapshot tasks beyond it are dropped.",
+	)
+	cmd.Flags().DurationVar(
+		&backgroundDrainTimeout,
+		"backend-background-drain-timeout",
+		yorkie.DefaultBackgroundDrainTimeout,
+		"Duration that the shutdown waits for the background tasks before closing the DB. If it is zero, there is no limit.",
+	)
+	cmd.Flags().Float64Var(
+		&conf.Backend.ClientRateLimit,
+		"backend-client-rate-limit",
+		0,
+		"Number of requests per second allowed for each client. If it is zero, there is no limit.",
+	)
+	cmd.Flags().IntVar(
+		&conf.Backend.ClientRateBurst,
+		"backend-client-rate-burst",
+		0,
+		"Number of requests of a client allowed at once beyond the rate limit.",
+	)
+	cmd.Flags().StringVar(
+		&conf.Backend.SnapshotCorruptionPolicy,
+		"backend-snapshot-corruption-policy",
+		yorkie.DefaultSnapshotCorruptionPolicy,
+		"Policy for a snapshot that can not be decoded: recover or fail.",
+	)
+	cmd.Flags().StringVar(
+		&conf.Backend.DuplicateChangePolicy,
+		"backend-duplicate-change-policy",
+		yorkie.DefaultDuplicateChangePolicy,
+		"Policy for the changes duplicated within a pack: dedupe or reject.",
+	)
+	cmd.Flags().DurationVar(
+		&slowSnapshotThreshold,
+		"backend-slow-snapshot-threshold",
+		0,
+		"Duration above which the creation of a snapshot is reported as slow. If it is zero, no snapshot is reported.",
+	)
+	cmd.Flags().BoolVar(
+		&conf.Backend.RaiseSnapshotIntervalOnSlow,
+		"backend-raise-snapshot-interval-on-slow",
+		false,
+		"Whether to double the snapshot interval of the document whose snapshot is slow.",
+	)
+	cmd.Flags().Uint64Var(
+		&conf.Backend.MaxLamportSkew,
+		"backend-max-lamport-skew",
+		0,
+		"Maximum amount by which the lamport of a pushed change can exceed the expected lamport. If it is zero, the lamport is not checked.",
+	)
+	cmd.Flags().StringVar(
+		&conf.Backend.LamportSkewPolicy,
+		"backend-lamport-skew-policy",
+		yorkie.DefaultLamportSkewPolicy,
+		"Policy for the changes whose lamport exceeds the max lamport skew: warn or reject.",
+	)
+	cmd.Flags().BoolVar(
+		&conf.Backend.PayloadLogging,
+		"backend-payload-logging",
+		false,
+		"Whether to log the redacted operations of the pushed packs for debugging.",
+	)
+	cmd.Flags().Float64Var(
+		&conf.Backend.PayloadLogSampleRate,
+		"backend-payload-log-sample-rate",
+		yorkie.DefaultPayloadLogSampleRate,
+		"Ratio of the packs whose operations are logged by the payload logging.",
+	)
+	cmd.Flags().StringSliceVar(
+		&conf.Backend.PayloadLogRedactions,
+		"backend-payload-log-redactions",
+		[]string{},
+		"Kinds of the content redacted by the payload logging: key, value, text or attributes."+
+			" If no value is specified, all kinds will be redacted.",
+	)
+	cmd.Flags().Uint64Var(
+		&conf.Backend.DBMaxRetries,
+		"backend-db-max-retries",
+		yorkie.DefaultDBMaxRetries,
+		"Maximum number of retries of the writes of PushPull to the DB failed with transient errors.",
+	)
+	cmd.Flags().DurationVar(
+		&dbMaxWaitInterval,
+		"backend-db-max-wait-interval",
+		yorkie.DefaultDBMaxWaitInterval,
+		"Maximum wait interval before retrying the writes to the DB.",
+	)
+	cmd.Flags().StringToIntVar(
+		&conf.Backend.SnapshotIntervals,
+		"backend-snapshot-intervals",
+		nil,
+		"Snapshot interval of the documents by key pattern. e.g. hot$*=100",
+	)
+	cmd.Flags().BoolVar(
+		&conf.Backend.SyncSnapshot,
+		"backend-sync-snapshot",
+		false,
+		"Whether to store the snapshot within PushPull before responding instead of in the background.",
+	)
+	cmd.Flags().StringToIntVar(
+		&conf.Backend.MaxActorsPerDocument,
+		"backend-max-actors-per-document",
+		nil,
+		"Maximum number of distinct actors of a document by collection. e.g. small-group=10",
+	)
+	cmd.Flags().StringVar(
+		&conf.Backend.EncryptionKeyFile,
+		"backend-encryption-key-file",
+		"",
+		"Path to the hex-encoded 256-bit master key to encrypt the content of documents at rest.",
+	)
+	cmd.Flags().StringVar(
+		&conf.Backend.Region,
+		"backend-region",
+		"",
+		"Region where this agent runs. It is compared with the preferred region of documents.",
+	)
+	cmd.Flags().StringVar(
+		&conf.Backend.AdminToken,
+		"backend-admin-token",
+		"",
+		"Token to access admin RPCs. If it is empty, admin RPCs are disabled.",
+	)
 	cmd.Flags().StringVar(
 		&conf.Backend.AuthWebhookURL,
 		"auth-webhook-url",
@@ -320,6 +754,24 @@ func init() {
 		yorkie.DefaultAuthWebhookMaxWaitInterval,
 		"Maximum wait interval for authorization webhook.",
 	)
+	cmd.Flags().IntSliceVar(
+		&conf.Backend.AuthWebhookRetryableStatusCodes,
+		"auth-webhook-retryable-status-codes",
+		nil,
+		"Status codes of authorization webhook that are retried. If it is empty, 429, 500, 503 and 504 are retried.",
+	)
+	cmd.Flags().Uint64Var(
+		&conf.Backend.MaxAuthWebhookBodyBytes,
+		"max-auth-webhook-body-bytes",
+		yorkie.DefaultMaxAuthWebhookBodyBytes,
+		"Maximum size of the body of an authorization webhook request in bytes.",
+	)
+	cmd.Flags().StringToStringVar(
+		&conf.Backend.AuthWebhookMethodTimeouts,
+		"auth-webhook-method-timeouts",
+		nil,
+		"Timeout of each attempt of authorization webhook by method. e.g. PushPull=3s",
+	)
 	cmd.Flags().IntVar(
 		&conf.Backend.AuthWebhookCacheSize,
 		"auth-webhook-cache-size",
@@ -338,6 +790,72 @@ func init() {
 		yorkie.DefaultAuthWebhookCacheUnauthTTL,
 		"TTL value to set when caching unauthorized webhook response.",
 	)
+	cmd.Flags().DurationVar(
+		&authWebhookCacheMaxAge,
+		"auth-webhook-cache-max-age",
+		0,
+		"Maximum age of cached webhook responses capping their TTL. If it is zero, there is no cap.",
+	)
+	cmd.Flags().StringVar(
+		&conf.Backend.AuthWebhookSigningAlgorithm,
+		"auth-webhook-signing-algorithm",
+		"",
+		"Algorithm to sign the webhook request. Only ed25519 is supported. If it is empty, the request is not signed.",
+	)
+	cmd.Flags().StringVar(
+		&conf.Backend.AuthWebhookSigningKeyFile,
+		"auth-webhook-signing-key-file",
+		"",
+		"Path to the PEM encoded private key to sign the webhook request.",
+	)
+	cmd.Flags().StringVar(
+		&conf.Backend.AuthWebhookSigningKeyID,
+		"auth-webhook-signing-key-id",
+		"",
+		"ID of the signing key sent with the signature of the webhook request.",
+	)
+	cmd.Flags().StringVar(
+		&conf.Backend.AuthWebhookHMACSecret,
+		"auth-webhook-hmac-secret",
+		"",
+		"Shared secret to sign the webhook request with HMAC-SHA256. If it is empty, the request is not signed.",
+	)
+	cmd.Flags().StringVar(
+		&conf.Backend.AuthWebhookClientCertFile,
+		"auth-webhook-client-cert-file",
+		"",
+		"Path to the PEM encoded certificate presented to the webhook for mutual TLS.",
+	)
+	cmd.Flags().StringVar(
+		&conf.Backend.AuthWebhookClientKeyFile,
+		"auth-webhook-client-key-file",
+		"",
+		"Path to the PEM encoded private key of the client certificate.",
+	)
+	cmd.Flags().StringVar(
+		&conf.Backend.AuthWebhookCAFile,
+		"auth-webhook-ca-file",
+		"",
+		"Path to the PEM encoded CA certificates to verify the webhook. If it is empty, the system CAs are used.",
+	)
+	cmd.Flags().BoolVar(
+		&conf.Backend.AuthWebhookDelta,
+		"auth-webhook-delta",
+		false,
+		"Whether to send only the difference of the attributes against the last authorized attributes of the token.",
+	)
+	cmd.Flags().DurationVar(
+		&authWebhookDeltaResyncInterval,
+		"auth-webhook-delta-resync-interval",
+		yorkie.DefaultAuthWebhookDeltaResyncInterval,
+		"Interval to send the whole attributes again in the delta mode.",
+	)
+	cmd.Flags().BoolVar(
+		&conf.Backend.AuthWebhookDryRun,
+		"auth-webhook-dry-run",
+		false,
+		"Whether to only log the decisions of the webhook without denying the requests.",
+	)
 
 	rootCmd.AddCommand(cmd)
 }
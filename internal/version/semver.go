@@ -0,0 +1,156 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package version
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrInvalidVersion is returned when the given version is not a valid
+	// semantic version.
+	ErrInvalidVersion = errors.New("invalid version")
+)
+
+// Semver is a parsed semantic version, "major.minor.patch-preRelease".
+type Semver struct {
+	Major      uint64
+	Minor      uint64
+	Patch      uint64
+	PreRelease string
+}
+
+// Parse parses the given version into Semver. The leading "v" and the build
+// metadata after "+" are ignored.
+func Parse(version string) (*Semver, error) {
+	str := strings.TrimPrefix(version, "v")
+	if i := strings.Index(str, "+"); i >= 0 {
+		str = str[:i]
+	}
+
+	preRelease := ""
+	if i := strings.Index(str, "-"); i >= 0 {
+		str, preRelease = str[:i], str[i+1:]
+		if preRelease == "" {
+			return nil, fmt.Errorf("%s: %w", version, ErrInvalidVersion)
+		}
+	}
+
+	fields := strings.Split(str, ".")
+	if len(fields) != 3 {
+		return nil, fmt.Errorf("%s: %w", version, ErrInvalidVersion)
+	}
+
+	var numbers [3]uint64
+	for i, field := range fields {
+		number, err := strconv.ParseUint(field, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", version, ErrInvalidVersion)
+		}
+		numbers[i] = number
+	}
+
+	return &Semver{
+		Major:      numbers[0],
+		Minor:      numbers[1],
+		Patch:      numbers[2],
+		PreRelease: preRelease,
+	}, nil
+}
+
+// Compare returns an integer comparing two versions by semantic versioning.
+// The result will be 0 if a==b, -1 if a < b, and +1 if a > b.
+func Compare(a, b string) (int, error) {
+	semverA, err := Parse(a)
+	if err != nil {
+		return 0, err
+	}
+	semverB, err := Parse(b)
+	if err != nil {
+		return 0, err
+	}
+
+	return semverA.Compare(semverB), nil
+}
+
+// Compare returns an integer comparing two versions by semantic versioning.
+// A pre-release version has lower precedence than its normal version, and
+// the default version "0.0.0" is lower than any released version.
+// The result will be 0 if v==other, -1 if v < other, and +1 if v > other.
+func (v *Semver) Compare(other *Semver) int {
+	if result := compareUint(v.Major, other.Major); result != 0 {
+		return result
+	}
+	if result := compareUint(v.Minor, other.Minor); result != 0 {
+		return result
+	}
+	if result := compareUint(v.Patch, other.Patch); result != 0 {
+		return result
+	}
+
+	return comparePreRelease(v.PreRelease, other.PreRelease)
+}
+
+// comparePreRelease compares the given pre-release versions identifier by
+// identifier. The numeric identifiers are compared numerically and have lower
+// precedence than the alphanumeric ones.
+func comparePreRelease(a, b string) int {
+	if a == b {
+		return 0
+	}
+	if a == "" {
+		return 1
+	}
+	if b == "" {
+		return -1
+	}
+
+	idsA, idsB := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(idsA) && i < len(idsB); i++ {
+		numA, errA := strconv.ParseUint(idsA[i], 10, 64)
+		numB, errB := strconv.ParseUint(idsB[i], 10, 64)
+
+		var result int
+		switch {
+		case errA == nil && errB == nil:
+			result = compareUint(numA, numB)
+		case errA == nil:
+			result = -1
+		case errB == nil:
+			result = 1
+		default:
+			result = strings.Compare(idsA[i], idsB[i])
+		}
+		if result != 0 {
+			return result
+		}
+	}
+
+	return compareUint(uint64(len(idsA)), uint64(len(idsB)))
+}
+
+func compareUint(a, b uint64) int {
+	if a > b {
+		return 1
+	} else if a < b {
+		return -1
+	}
+	return 0
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package version_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/internal/version"
+)
+
+func TestSemver(t *testing.T) {
+	t.Run("parse test", func(t *testing.T) {
+		semver, err := version.Parse("v1.2.3-rc.1+build.5")
+		assert.NoError(t, err)
+		assert.Equal(t, &version.Semver{Major: 1, Minor: 2, Patch: 3, PreRelease: "rc.1"}, semver)
+
+		semver, err = version.Parse(version.Version)
+		assert.NoError(t, err)
+		assert.Equal(t, &version.Semver{}, semver)
+
+		for _, invalid := range []string{"", "1.2", "1.2.3.4", "1.a.3", "1.2.3-", "-1.2.3"} {
+			_, err := version.Parse(invalid)
+			assert.ErrorIs(t, err, version.ErrInvalidVersion, invalid)
+		}
+	})
+
+	t.Run("compare test", func(t *testing.T) {
+		for _, c := range []struct {
+			a, b     string
+			expected int
+		}{
+			{"1.2.3", "1.2.3", 0},
+			{"1.2.3", "v1.2.3+build.1", 0},
+			{"0.0.0", "0.1.0", -1},
+			{"1.10.0", "1.9.0", 1},
+			{"1.2.3", "1.2.4", -1},
+			{"2.0.0", "1.99.99", 1},
+			{"1.0.0-rc.1", "1.0.0", -1},
+			{"1.0.0-alpha", "1.0.0-alpha.1", -1},
+			{"1.0.0-alpha.1", "1.0.0-alpha.beta", -1},
+			{"1.0.0-rc.2", "1.0.0-rc.10", -1},
+			{"1.0.0-beta", "1.0.0-alpha", 1},
+		} {
+			result, err := version.Compare(c.a, c.b)
+			assert.NoError(t, err)
+			assert.Equal(t, c.expected, result, c.a+" vs "+c.b)
+		}
+
+		_, err := version.Compare("1.2.3", "invalid")
+		assert.ErrorIs(t, err, version.ErrInvalidVersion)
+	})
+}
@@ -111,3 +111,12 @@ func (c *LRUExpireCache) Get(key string) (interface{}, bool) {
 
 	return element.Value.(*cacheEntry).value, true
 }
+
+// Len returns the number of the entries in the cache. The expired entries are
+// counted until they are accessed or evicted.
+func (c *LRUExpireCache) Len() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.evictionList.Len()
+}
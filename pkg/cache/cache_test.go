@@ -52,4 +52,15 @@ func TestCache(t *testing.T) {
 		assert.False(t, ok)
 		assert.Nil(t, response)
 	})
+
+	t.Run("len test", func(t *testing.T) {
+		lruCache, err := cache.NewLRUExpireCache(2)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, lruCache.Len())
+
+		lruCache.Add("request1", "response1", time.Second)
+		lruCache.Add("request2", "response2", time.Second)
+		lruCache.Add("request3", "response3", time.Second)
+		assert.Equal(t, 2, lruCache.Len())
+	})
 }
@@ -32,6 +32,10 @@ type Change struct {
 
 	// operations represent a series of user edits.
 	operations []operation.Operation
+
+	// signature is the signature of this change by its author. It is empty if
+	// the change is not signed.
+	signature []byte
 }
 
 // New creates a new instance of Change.
@@ -68,6 +72,16 @@ func (c *Change) Operations() []operation.Operation {
 	return c.operations
 }
 
+// Signature returns the signature of this change.
+func (c *Change) Signature() []byte {
+	return c.signature
+}
+
+// SetSignature sets the given signature.
+func (c *Change) SetSignature(signature []byte) {
+	c.signature = signature
+}
+
 // ServerSeq returns the serverSeq of this change.
 func (c *Change) ServerSeq() *uint64 {
 	return c.id.ServerSeq()
@@ -83,6 +97,18 @@ func (c *Change) SetServerSeq(serverSeq uint64) {
 	c.id = c.id.SetServerSeq(&serverSeq)
 }
 
+// AssignServerSeq assigns the given serverSeq. It returns an error if the
+// given serverSeq is not greater than the existing one.
+func (c *Change) AssignServerSeq(serverSeq uint64) error {
+	id, err := c.id.AssignServerSeq(serverSeq)
+	if err != nil {
+		return err
+	}
+
+	c.id = id
+	return nil
+}
+
 // SetActor sets the given actorID.
 func (c *Change) SetActor(actor *time.ActorID) {
 	c.id = c.id.SetActor(actor)
@@ -17,6 +17,11 @@
 package change
 
 import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
 
@@ -24,6 +29,14 @@ var (
 	// InitialID represents the initial state ID. Usually this is used to
 	// represent a state where nothing has been edited.
 	InitialID = NewID(0, 0, time.InitialActorID)
+
+	// ErrInvalidIDString is returned when the given string is not a valid
+	// string form of ID.
+	ErrInvalidIDString = errors.New("invalid change ID string")
+
+	// ErrNonMonotonicServerSeq is returned when the server sequence to be
+	// assigned is not greater than the existing one.
+	ErrNonMonotonicServerSeq = errors.New("non-monotonic server seq")
 )
 
 // ID is for identifying the Change. It is immutable.
@@ -41,6 +54,10 @@ type ID struct {
 
 	// actorID is actorID of this ID.
 	actorID *time.ActorID
+
+	// vector is the vector clock of the change. It is optional, so it is nil
+	// if the change is made by a client that does not track it.
+	vector VectorClock
 }
 
 // NewID creates a new instance of ID.
@@ -58,12 +75,23 @@ func NewID(
 	return id
 }
 
-// Next creates a next ID of this ID.
+// Next creates a next ID of this ID. The entry of the actor in the vector
+// clock is updated to the lamport timestamp of the next ID. It panics if this
+// ID does not have the actor, because the tickets made by the next ID could
+// not be identified.
 func (id *ID) Next() *ID {
+	if !id.IsValid() {
+		panic("actorID of change.ID cannot be null")
+	}
+
+	vector := id.vector.clone()
+	vector[*id.actorID] = id.lamport + 1
+
 	return &ID{
 		clientSeq: id.clientSeq + 1,
 		lamport:   id.lamport + 1,
 		actorID:   id.actorID,
+		vector:    vector,
 	}
 }
 
@@ -79,25 +107,190 @@ func (id *ID) NewTimeTicket(delimiter uint32) *time.Ticket {
 // SyncLamport syncs lamport timestamp with the given ID.
 //  - receiving: https://en.wikipedia.org/wiki/Lamport_timestamps#Algorithm
 func (id *ID) SyncLamport(otherLamport uint64) *ID {
+	var newID *ID
 	if id.lamport < otherLamport {
-		return NewID(id.clientSeq, otherLamport, id.actorID)
+		newID = NewID(id.clientSeq, otherLamport, id.actorID)
+	} else {
+		newID = NewID(id.clientSeq, id.lamport+1, id.actorID)
 	}
+	newID.vector = id.vector
+	return newID
+}
 
-	return NewID(id.clientSeq, id.lamport+1, id.actorID)
+// SyncClocks syncs lamport timestamp with the given ID of a remote change and
+// merges its vector clock, so that the entry of its actor is updated.
+func (id *ID) SyncClocks(other *ID) *ID {
+	newID := id.SyncLamport(other.lamport)
+
+	vector := id.vector.merge(other.vector)
+	if vector[*other.actorID] < other.lamport {
+		vector[*other.actorID] = other.lamport
+	}
+	newID.vector = vector
+	return newID
 }
 
-// SetActor sets actorID.
+// SetActor sets actorID. The entry of the previous actor in the vector clock
+// is moved to the given actor. It panics if the given actor is nil.
 func (id *ID) SetActor(actor *time.ActorID) *ID {
-	return NewID(id.clientSeq, id.lamport, actor)
+	if actor == nil {
+		panic("actorID of change.ID cannot be null")
+	}
+
+	newID := NewID(id.clientSeq, id.lamport, actor)
+	if id.vector == nil {
+		return newID
+	}
+
+	newID.vector = id.vector.clone()
+	if lamport, ok := newID.vector[*id.actorID]; ok {
+		delete(newID.vector, *id.actorID)
+		newID.vector[*actor] = lamport
+	}
+	return newID
 }
 
 // SetServerSeq sets server sequence of this ID.
 func (id *ID) SetServerSeq(serverSeq *uint64) *ID {
 	newID := NewID(id.clientSeq, id.lamport, id.actorID)
 	newID.serverSeq = serverSeq
+	newID.vector = id.vector
+	return newID
+}
+
+// AssignServerSeq assigns the given server sequence to this ID. Unlike
+// SetServerSeq, which is used to decode the stored IDs, it returns an error if
+// the given sequence is not greater than the existing one.
+func (id *ID) AssignServerSeq(next uint64) (*ID, error) {
+	if id.serverSeq != nil && next <= *id.serverSeq {
+		return nil, fmt.Errorf("%d after %d: %w", next, *id.serverSeq, ErrNonMonotonicServerSeq)
+	}
+
+	return id.SetServerSeq(&next), nil
+}
+
+// SetVector sets the vector clock of this ID.
+func (id *ID) SetVector(vector VectorClock) *ID {
+	newID := NewID(id.clientSeq, id.lamport, id.actorID)
+	newID.serverSeq = id.serverSeq
+	newID.vector = vector
 	return newID
 }
 
+// HappensBefore returns whether the change of this ID happened before the
+// change of the given ID. If either of them does not have the vector clock,
+// only the changes of the same actor are ordered.
+func (id *ID) HappensBefore(other *ID) bool {
+	if id.vector == nil || other.vector == nil {
+		return id.actorID.Compare(other.actorID) == 0 && id.lamport < other.lamport
+	}
+
+	return id.vector.lessOrEqual(other.vector) && !other.vector.lessOrEqual(id.vector)
+}
+
+// Concurrent returns whether neither of the changes of this ID and the given
+// ID happened before the other.
+func (id *ID) Concurrent(other *ID) bool {
+	if id.Compare(other) == 0 {
+		return false
+	}
+
+	return !id.HappensBefore(other) && !other.HappensBefore(id)
+}
+
+// Equals returns whether the given ID is equal to this ID. The IDs without
+// server sequences are equal only to the IDs without them.
+func (id *ID) Equals(other *ID) bool {
+	if id.clientSeq != other.clientSeq ||
+		id.lamport != other.lamport ||
+		id.actorID.Compare(other.actorID) != 0 {
+		return false
+	}
+
+	if id.serverSeq == nil || other.serverSeq == nil {
+		return id.serverSeq == nil && other.serverSeq == nil
+	}
+
+	return *id.serverSeq == *other.serverSeq
+}
+
+// Compare returns an integer comparing two IDs in the total order of the
+// tickets, by lamport timestamp and then by actorID.
+// The result will be 0 if id==other, -1 if id < other, and +1 if id > other.
+// If the receiver or argument is nil, it would panic at runtime.
+func (id *ID) Compare(other *ID) int {
+	if id.lamport > other.lamport {
+		return 1
+	} else if id.lamport < other.lamport {
+		return -1
+	}
+
+	return id.actorID.Compare(other.actorID)
+}
+
+// String returns the string form of this ID,
+// "clientSeq:lamport:actorHex:serverSeq". The server sequence is left empty
+// if it is not set. The vector clock is not included.
+func (id *ID) String() string {
+	serverSeq := ""
+	if id.serverSeq != nil {
+		serverSeq = strconv.FormatUint(*id.serverSeq, 10)
+	}
+
+	return strconv.FormatUint(uint64(id.clientSeq), 10) +
+		":" +
+		strconv.FormatUint(id.lamport, 10) +
+		":" +
+		id.actorID.String() +
+		":" +
+		serverSeq
+}
+
+// ParseID parses the given string form of ID made by ID.String. It returns
+// InitialID for the string of InitialID.
+func ParseID(str string) (*ID, error) {
+	fields := strings.Split(str, ":")
+	if len(fields) != 4 {
+		return nil, fmt.Errorf("%s: %w", str, ErrInvalidIDString)
+	}
+
+	clientSeq, err := strconv.ParseUint(fields[0], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("client seq %s: %w", fields[0], ErrInvalidIDString)
+	}
+
+	lamport, err := strconv.ParseUint(fields[1], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("lamport %s: %w", fields[1], ErrInvalidIDString)
+	}
+
+	actorID, err := time.ActorIDFromHex(fields[2])
+	if err != nil {
+		return nil, err
+	}
+
+	id := NewID(uint32(clientSeq), lamport, actorID)
+	if fields[3] == "" {
+		if id.Equals(InitialID) {
+			return InitialID, nil
+		}
+		return id, nil
+	}
+
+	serverSeq, err := strconv.ParseUint(fields[3], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("server seq %s: %w", fields[3], ErrInvalidIDString)
+	}
+
+	return id.SetServerSeq(&serverSeq), nil
+}
+
+// IsValid returns whether this ID has the actor, so that the tickets can be
+// made by it.
+func (id *ID) IsValid() bool {
+	return id.actorID != nil
+}
+
 // ClientSeq returns the client sequence of this ID.
 func (id *ID) ClientSeq() uint32 {
 	return id.clientSeq
@@ -117,3 +310,9 @@ func (id *ID) Lamport() uint64 {
 func (id *ID) ActorID() *time.ActorID {
 	return id.actorID
 }
+
+// Vector returns the vector clock of this ID. It returns nil if the change
+// does not have the vector clock.
+func (id *ID) Vector() VectorClock {
+	return id.vector
+}
@@ -0,0 +1,174 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package change_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+func TestID(t *testing.T) {
+	actorA, err := time.ActorIDFromHex("000000000000000000000001")
+	assert.NoError(t, err)
+	actorB, err := time.ActorIDFromHex("000000000000000000000002")
+	assert.NoError(t, err)
+
+	t.Run("vector clock test", func(t *testing.T) {
+		a1 := change.InitialID.SetActor(actorA).Next()
+		assert.Equal(t, uint64(1), a1.Vector().Get(actorA))
+
+		// NOTE: B makes b1 after receiving a1, while A makes a2 without
+		//       receiving b1.
+		b1 := change.InitialID.SetActor(actorB).SyncClocks(a1).Next()
+		a2 := a1.Next()
+		assert.Equal(t, uint64(1), b1.Vector().Get(actorA))
+
+		assert.True(t, a1.HappensBefore(b1))
+		assert.False(t, b1.HappensBefore(a1))
+		assert.False(t, a1.Concurrent(b1))
+		assert.True(t, a1.HappensBefore(a2))
+
+		assert.True(t, a2.Concurrent(b1))
+		assert.True(t, b1.Concurrent(a2))
+		assert.False(t, a2.Concurrent(a2))
+	})
+
+	t.Run("without vector clock test", func(t *testing.T) {
+		a1 := change.NewID(1, 1, actorA)
+		a2 := change.NewID(2, 2, actorA)
+		b1 := change.NewID(1, 3, actorB)
+		assert.Nil(t, a1.Vector())
+
+		// NOTE: Without vector clocks, only the changes of the same actor are
+		//       ordered and the others are regarded as concurrent.
+		assert.True(t, a1.HappensBefore(a2))
+		assert.False(t, a1.HappensBefore(b1))
+		assert.True(t, a1.Concurrent(b1))
+	})
+
+	t.Run("set actor moves vector entry test", func(t *testing.T) {
+		id := change.InitialID.Next().SetActor(actorA)
+		assert.Equal(t, uint64(1), id.Vector().Get(actorA))
+		assert.Equal(t, uint64(0), id.Vector().Get(time.InitialActorID))
+	})
+
+	t.Run("equals test", func(t *testing.T) {
+		id := change.NewID(1, 2, actorA)
+		assert.True(t, id.Equals(change.NewID(1, 2, actorA)))
+		assert.False(t, id.Equals(change.NewID(2, 2, actorA)))
+		assert.False(t, id.Equals(change.NewID(1, 3, actorA)))
+		assert.False(t, id.Equals(change.NewID(1, 2, actorB)))
+
+		// NOTE: The IDs without server sequences are equal only to the IDs
+		//       without them.
+		serverSeq, otherServerSeq := uint64(1), uint64(2)
+		stored := id.SetServerSeq(&serverSeq)
+		assert.False(t, id.Equals(stored))
+		assert.False(t, stored.Equals(id))
+		assert.True(t, stored.Equals(id.SetServerSeq(&serverSeq)))
+		assert.False(t, stored.Equals(id.SetServerSeq(&otherServerSeq)))
+	})
+
+	t.Run("compare test", func(t *testing.T) {
+		id := change.NewID(1, 2, actorA)
+		assert.Equal(t, 0, id.Compare(change.NewID(3, 2, actorA)))
+		assert.Equal(t, -1, id.Compare(change.NewID(1, 3, actorA)))
+		assert.Equal(t, 1, change.NewID(1, 3, actorA).Compare(id))
+
+		// NOTE: The IDs of equal lamports are ordered by the actors like the
+		//       tickets.
+		other := change.NewID(1, 2, actorB)
+		assert.Equal(t, -1, id.Compare(other))
+		assert.Equal(t, 1, other.Compare(id))
+		assert.Equal(t, id.NewTimeTicket(0).Compare(other.NewTimeTicket(0)), id.Compare(other))
+	})
+
+	t.Run("string round-trip test", func(t *testing.T) {
+		id := change.NewID(1, 2, actorA)
+		assert.Equal(t, "1:2:"+actorA.String()+":", id.String())
+
+		parsed, err := change.ParseID(id.String())
+		assert.NoError(t, err)
+		assert.True(t, id.Equals(parsed))
+		assert.Nil(t, parsed.ServerSeq())
+
+		serverSeq := uint64(3)
+		stored := id.SetServerSeq(&serverSeq)
+		assert.Equal(t, "1:2:"+actorA.String()+":3", stored.String())
+
+		parsed, err = change.ParseID(stored.String())
+		assert.NoError(t, err)
+		assert.True(t, stored.Equals(parsed))
+		assert.Equal(t, serverSeq, *parsed.ServerSeq())
+
+		parsed, err = change.ParseID(change.InitialID.String())
+		assert.NoError(t, err)
+		assert.Equal(t, change.InitialID, parsed)
+	})
+
+	t.Run("parse invalid string test", func(t *testing.T) {
+		for _, str := range []string{
+			"",
+			"1:2:" + actorA.String(),
+			"a:2:" + actorA.String() + ":",
+			"1:-2:" + actorA.String() + ":",
+			"1:2:" + actorA.String() + ":b",
+			"4294967296:2:" + actorA.String() + ":",
+		} {
+			_, err := change.ParseID(str)
+			assert.ErrorIs(t, err, change.ErrInvalidIDString, str)
+		}
+
+		_, err := change.ParseID("1:2:invalid:")
+		assert.ErrorIs(t, err, time.ErrInvalidHexString)
+	})
+
+	t.Run("nil actor test", func(t *testing.T) {
+		id := change.NewID(1, 2, nil)
+		assert.False(t, id.IsValid())
+		assert.True(t, id.SetActor(actorA).IsValid())
+		assert.True(t, change.InitialID.IsValid())
+
+		assert.Panics(t, func() { id.Next() })
+		assert.Panics(t, func() { change.InitialID.SetActor(nil) })
+	})
+
+	t.Run("assign server seq test", func(t *testing.T) {
+		id, err := change.NewID(1, 2, actorA).AssignServerSeq(1)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(1), *id.ServerSeq())
+
+		id, err = id.AssignServerSeq(2)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(2), *id.ServerSeq())
+
+		_, err = id.AssignServerSeq(2)
+		assert.ErrorIs(t, err, change.ErrNonMonotonicServerSeq)
+		_, err = id.AssignServerSeq(1)
+		assert.ErrorIs(t, err, change.ErrNonMonotonicServerSeq)
+		assert.Equal(t, uint64(2), *id.ServerSeq())
+
+		// NOTE: SetServerSeq is kept for the decode paths, so it is not
+		//       checked.
+		serverSeq := uint64(1)
+		assert.Equal(t, serverSeq, *id.SetServerSeq(&serverSeq).ServerSeq())
+	})
+}
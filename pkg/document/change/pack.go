@@ -18,6 +18,7 @@ package change
 
 import (
 	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/operation"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
 
@@ -38,6 +39,14 @@ type Pack struct {
 	// MinSyncedTicket is the minimum logical time taken by clients who attach the document.
 	// It used to collect garbage on the replica on the client.
 	MinSyncedTicket *time.Ticket
+
+	// HasMore indicates whether there are more changes to pull. It is set when
+	// the changes were split into several responses.
+	HasMore bool
+
+	// PreferredRegion is the region the document prefers. It is set when the
+	// agent is not in the region, as a hint to route the client to it.
+	PreferredRegion string
 }
 
 // NewPack creates a new instance of Pack.
@@ -73,3 +82,14 @@ func (p *Pack) OperationsLen() int {
 	}
 	return operations
 }
+
+// OperationsLenByType returns the size of the operations by their type.
+func (p *Pack) OperationsLenByType() map[operation.Type]int {
+	operations := make(map[operation.Type]int)
+	for _, c := range p.Changes {
+		for _, op := range c.operations {
+			operations[op.Type()]++
+		}
+	}
+	return operations
+}
@@ -0,0 +1,63 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package change
+
+import (
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// VectorClock is the map of the actors to the lamport timestamps of their
+// latest changes known to a change. It is used to tell whether a change
+// happened before another one or they are concurrent.
+type VectorClock map[time.ActorID]uint64
+
+// Get returns the lamport timestamp of the given actor.
+func (v VectorClock) Get(actorID *time.ActorID) uint64 {
+	return v[*actorID]
+}
+
+// clone returns a copy of this vector clock.
+func (v VectorClock) clone() VectorClock {
+	cloned := make(VectorClock, len(v))
+	for actorID, lamport := range v {
+		cloned[actorID] = lamport
+	}
+	return cloned
+}
+
+// merge returns a copy of this vector clock with the greater lamport
+// timestamps of both vector clocks.
+func (v VectorClock) merge(other VectorClock) VectorClock {
+	merged := v.clone()
+	for actorID, lamport := range other {
+		if merged[actorID] < lamport {
+			merged[actorID] = lamport
+		}
+	}
+	return merged
+}
+
+// lessOrEqual returns whether every lamport timestamp of this vector clock is
+// less than or equal to the one of the given vector clock.
+func (v VectorClock) lessOrEqual(other VectorClock) bool {
+	for actorID, lamport := range v {
+		if lamport > other[actorID] {
+			return false
+		}
+	}
+	return true
+}
@@ -204,7 +204,9 @@ func (d *Document) ensureClone() {
 	}
 }
 
-func messageFromMsgAndArgs(msgAndArgs ...interface{}) string {
+// messageFromMsgAndArgs builds the message of the change from the message and
+// the arguments passed to Update.
+func messageFromMsgAndArgs(msgAndArgs []interface{}) string {
 	if len(msgAndArgs) == 0 {
 		return ""
 	}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/proxy"
@@ -412,6 +413,29 @@ func TestDocument(t *testing.T) {
 		assert.Equal(t, `{"age":120,"price":9000000000000000003,"width":130.000000}`, doc.Marshal())
 	})
 
+	t.Run("update message test", func(t *testing.T) {
+		doc := document.New("c1", "d1")
+
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k2", "v2")
+			return nil
+		}, "updates k2"))
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k3", "v3")
+			return nil
+		}, "updates %s", "k3"))
+
+		changes := doc.CreateChangePack().Changes
+		assert.Len(t, changes, 3)
+		assert.Equal(t, "", changes[0].Message())
+		assert.Equal(t, "updates k2", changes[1].Message())
+		assert.Equal(t, "updates k3", changes[2].Message())
+	})
+
 	t.Run("rollback test", func(t *testing.T) {
 		doc := document.New("c1", "d1")
 
@@ -511,4 +535,35 @@ func TestDocument(t *testing.T) {
 		assert.Equal(t, "{}", doc.Marshal())
 		assert.Equal(t, 0, doc.GarbageLen())
 	})
+
+	t.Run("garbage collection of tombstones in snapshot test", func(t *testing.T) {
+		doc := document.New("c1", "d1")
+
+		err := doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetInteger("a", 1)
+			root.SetNewArray("b").AddInteger(1, 2, 3).Delete(1)
+			root.SetNewText("c").Edit(0, 0, "ABCD").Edit(1, 3, "")
+			root.SetNewRichText("d").Edit(0, 0, "ABCD", nil).Edit(1, 3, "", nil)
+			root.Delete("a")
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 4, doc.GarbageLen())
+
+		snapshot, err := converter.ObjectToBytes(doc.RootObject())
+		assert.NoError(t, err)
+
+		// tombstones restored from the snapshot should be collectable.
+		snapDoc, err := document.NewInternalDocumentFromSnapshot("c1", "d1", 0, snapshot)
+		assert.NoError(t, err)
+		assert.Equal(t, doc.GarbageLen(), snapDoc.GarbageLen())
+
+		snapDoc.GarbageCollect(time.MaxTicket)
+		assert.Equal(t, 0, snapDoc.GarbageLen())
+		assert.Equal(t, doc.Marshal(), snapDoc.Marshal())
+
+		compacted, err := converter.ObjectToBytes(snapDoc.RootObject())
+		assert.NoError(t, err)
+		assert.Less(t, len(compacted), len(snapshot))
+	})
 }
@@ -132,6 +132,12 @@ func (d *InternalDocument) GarbageCollect(ticket *time.Ticket) int {
 	return d.root.GarbageCollect(ticket)
 }
 
+// FindGarbage returns the elements and the text nodes that would be purged by
+// GarbageCollect with the given ticket.
+func (d *InternalDocument) FindGarbage(ticket *time.Ticket) []*json.Garbage {
+	return d.root.FindGarbage(ticket)
+}
+
 // GarbageLen returns the count of removed elements.
 func (d *InternalDocument) GarbageLen() int {
 	return d.root.GarbageLen()
@@ -210,7 +216,7 @@ func (d *InternalDocument) applyChanges(changes []*change.Change) error {
 		if err := c.Execute(d.root); err != nil {
 			return err
 		}
-		d.changeID = d.changeID.SyncLamport(c.ID().Lamport())
+		d.changeID = d.changeID.SyncClocks(c.ID())
 	}
 
 	return nil
@@ -166,6 +166,11 @@ func (p *Counter) Remove(removedAt *time.Ticket) bool {
 	return false
 }
 
+// Value returns the value of this counter.
+func (p *Counter) Value() interface{} {
+	return p.value
+}
+
 // ValueType returns the type of the value.
 func (p *Counter) ValueType() CounterType {
 	return p.valueType
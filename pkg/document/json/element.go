@@ -39,6 +39,7 @@ type TextElement interface {
 	Element
 	removedNodesLen() int
 	purgeTextNodesWithGarbage(ticket *time.Ticket) int
+	findTextNodesWithGarbage(ticket *time.Ticket) []*RGATreeSplitNode
 }
 
 // Element represents JSON element.
@@ -369,7 +369,9 @@ func (s *RGATreeSplit) splitNode(node *RGATreeSplitNode, offset int) *RGATreeSpl
 	return splitNode
 }
 
-// InsertAfter inserts the given node after the given previous node.
+// InsertAfter inserts the given node after the given previous node. If the
+// node is a tombstone, such as one restored from a snapshot, it is also kept
+// in removedNodeMap so that it can be purged later.
 func (s *RGATreeSplit) InsertAfter(prev *RGATreeSplitNode, node *RGATreeSplitNode) *RGATreeSplitNode {
 	next := prev.next
 	node.setPrev(prev)
@@ -380,6 +382,10 @@ func (s *RGATreeSplit) InsertAfter(prev *RGATreeSplitNode, node *RGATreeSplitNod
 	s.treeByID.Put(node.id, node)
 	s.treeByIndex.InsertAfter(prev.indexNode, node.indexNode)
 
+	if node.removedAt != nil {
+		s.removedNodeMap[node.id.key()] = node
+	}
+
 	return node
 }
 
@@ -570,6 +576,19 @@ func (s *RGATreeSplit) purgeTextNodesWithGarbage(ticket *time.Ticket) int {
 	return count
 }
 
+// findTextNodesWithGarbage returns the nodes that have been removed before
+// the given ticket without purging them.
+func (s *RGATreeSplit) findTextNodesWithGarbage(ticket *time.Ticket) []*RGATreeSplitNode {
+	var nodes []*RGATreeSplitNode
+	for _, node := range s.removedNodeMap {
+		if node.removedAt != nil && ticket.Compare(node.removedAt) >= 0 {
+			nodes = append(nodes, node)
+		}
+	}
+
+	return nodes
+}
+
 // purge physically purge the given node from RGATreeSplit.
 func (s *RGATreeSplit) purge(node *RGATreeSplitNode) {
 	node.prev.next = node.next
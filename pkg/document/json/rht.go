@@ -140,6 +140,7 @@ func (rht *RHT) Elements() map[string]string {
 }
 
 // Nodes returns a map of elements because the map easy to use for loop.
+// The nodes are sorted by key so that the serialized form is deterministic.
 // TODO: If we encounter performance issues, we need to replace this with other solution.
 func (rht *RHT) Nodes() []*RHTNode {
 	var nodes []*RHTNode
@@ -147,6 +148,10 @@ func (rht *RHT) Nodes() []*RHTNode {
 		nodes = append(nodes, node)
 	}
 
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].key < nodes[j].key
+	})
+
 	return nodes
 }
 
@@ -178,6 +178,8 @@ func (rht *RHTPriorityQueueMap) Elements() map[string]Element {
 }
 
 // Nodes returns a map of elements because the map easy to use for loop.
+// The nodes are sorted by key and creation time so that the serialized form
+// of the map is deterministic.
 // TODO: If we encounter performance issues, we need to replace this with other solution.
 func (rht *RHTPriorityQueueMap) Nodes() []*RHTPQMapNode {
 	var nodes []*RHTPQMapNode
@@ -187,6 +189,13 @@ func (rht *RHTPriorityQueueMap) Nodes() []*RHTPQMapNode {
 		}
 	}
 
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].key != nodes[j].key {
+			return nodes[i].key < nodes[j].key
+		}
+		return nodes[i].elem.CreatedAt().Compare(nodes[j].elem.CreatedAt()) < 0
+	})
+
 	return nodes
 }
 
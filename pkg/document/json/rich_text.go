@@ -272,3 +272,9 @@ func (t *RichText) removedNodesLen() int {
 func (t *RichText) purgeTextNodesWithGarbage(ticket *time.Ticket) int {
 	return t.rgaTreeSplit.purgeTextNodesWithGarbage(ticket)
 }
+
+// findTextNodesWithGarbage returns the nodes that have been removed before the
+// given ticket.
+func (t *RichText) findTextNodesWithGarbage(ticket *time.Ticket) []*RGATreeSplitNode {
+	return t.rgaTreeSplit.findTextNodesWithGarbage(ticket)
+}
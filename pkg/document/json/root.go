@@ -17,6 +17,8 @@
 package json
 
 import (
+	"sort"
+
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
 
@@ -26,6 +28,25 @@ type ElementPair struct {
 	elem   Element
 }
 
+// Garbage represents an element or a text node that would be purged by the
+// garbage collection.
+type Garbage struct {
+	// ID is the ID of the element or the text node.
+	ID string
+
+	// ParentID is the ID of the container of the element or the text
+	// element of the text node.
+	ParentID string
+
+	// RemovedAt is the time when it was removed. Its lamport and actor
+	// identify the change that removed it.
+	RemovedAt *time.Ticket
+
+	// Count is the number of the elements or the text nodes purged with it,
+	// including itself.
+	Count int
+}
+
 // Root is a structure represents the root of JSON. It has a hash table of
 // all JSON elements to find a specific element when applying remote changes
 // received from agent.
@@ -50,8 +71,17 @@ func NewRoot(root *Object) *Root {
 	r.object = root
 	r.RegisterElement(root)
 
+	// NOTE: The given root can be restored from a snapshot that still has
+	// tombstones. Register them as garbage so that they can be collected once
+	// all clients have synced past their removal.
 	root.Descendants(func(elem Element, parent Container) bool {
 		r.RegisterElement(elem)
+		if elem.RemovedAt() != nil {
+			r.RegisterRemovedElementPair(parent, elem)
+		}
+		if text, ok := elem.(TextElement); ok && text.removedNodesLen() > 0 {
+			r.RegisterTextElementWithGarbage(text)
+		}
 		return false
 	})
 
@@ -120,6 +150,69 @@ func (r *Root) GarbageCollect(ticket *time.Ticket) int {
 	return count
 }
 
+// FindGarbage returns the elements and the text nodes that would be purged by
+// GarbageCollect with the given ticket without purging them. The elements in
+// the removed containers are counted in the containers.
+func (r *Root) FindGarbage(ticket *time.Ticket) []*Garbage {
+	candidates := make(map[string]ElementPair)
+	for key, pair := range r.removedElementPairMapByCreatedAt {
+		if pair.elem.RemovedAt() != nil && ticket.Compare(pair.elem.RemovedAt()) >= 0 {
+			candidates[key] = pair
+		}
+	}
+
+	covered := make(map[string]bool)
+	counts := make(map[string]int)
+	for key, pair := range candidates {
+		count := 1
+		callback := func(elem Element, parent Container) bool {
+			covered[elem.CreatedAt().Key()] = true
+			count++
+			return false
+		}
+		switch elem := pair.elem.(type) {
+		case *Object:
+			elem.Descendants(callback)
+		case *Array:
+			elem.Descendants(callback)
+		}
+		counts[key] = count
+	}
+
+	var garbage []*Garbage
+	for key, pair := range candidates {
+		if covered[key] {
+			continue
+		}
+		garbage = append(garbage, &Garbage{
+			ID:        pair.elem.CreatedAt().AnnotatedString(),
+			ParentID:  pair.parent.CreatedAt().AnnotatedString(),
+			RemovedAt: pair.elem.RemovedAt(),
+			Count:     counts[key],
+		})
+	}
+
+	for _, text := range r.textElementWithGarbageMapByCreatedAt {
+		for _, node := range text.findTextNodesWithGarbage(ticket) {
+			garbage = append(garbage, &Garbage{
+				ID:        node.ID().AnnotatedString(),
+				ParentID:  text.CreatedAt().AnnotatedString(),
+				RemovedAt: node.RemovedAt(),
+				Count:     1,
+			})
+		}
+	}
+
+	sort.Slice(garbage, func(i, j int) bool {
+		if cmp := garbage[i].RemovedAt.Compare(garbage[j].RemovedAt); cmp != 0 {
+			return cmp < 0
+		}
+		return garbage[i].ID < garbage[j].ID
+	})
+
+	return garbage
+}
+
 // ElementMapLen returns the size of element map.
 func (r *Root) ElementMapLen() int {
 	return len(r.elementMapByCreatedAt)
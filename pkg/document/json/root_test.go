@@ -190,4 +190,51 @@ func TestRoot(t *testing.T) {
 		assert.Equal(t, 1, root.GarbageCollect(time.MaxTicket))
 		assert.Equal(t, 0, root.GarbageLen())
 	})
+
+	t.Run("find garbage test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+
+		obj := root.Object()
+		arr := json.NewArray(json.NewRGATreeList(), ctx.IssueTimeTicket()).
+			Add(json.NewPrimitive(1, ctx.IssueTimeTicket())).
+			Add(json.NewPrimitive(2, ctx.IssueTimeTicket()))
+		obj.Set("1", arr)
+		obj.Set("2", json.NewPrimitive(2, ctx.IssueTimeTicket()))
+		text := json.NewText(json.NewRGATreeSplit(json.InitialTextNode()), ctx.IssueTimeTicket())
+		obj.Set("3", text)
+
+		fromPos, toPos := text.CreateRange(0, 0)
+		text.Edit(fromPos, toPos, nil, "Yorkie", ctx.IssueTimeTicket())
+
+		// the element removed in the removed container is counted in it.
+		elem := arr.Get(0)
+		arr.DeleteByCreatedAt(elem.CreatedAt(), ctx.IssueTimeTicket())
+		root.RegisterRemovedElementPair(arr, elem)
+		deleted := obj.Delete("1", ctx.IssueTimeTicket())
+		root.RegisterRemovedElementPair(obj, deleted)
+
+		fromPos, toPos = text.CreateRange(0, 2)
+		text.Edit(fromPos, toPos, nil, "", ctx.IssueTimeTicket())
+		registerTextElementWithGarbage(fromPos, toPos, root, text)
+		ticket := ctx.IssueTimeTicket()
+
+		deleted = obj.Delete("2", ctx.IssueTimeTicket())
+		root.RegisterRemovedElementPair(obj, deleted)
+		assert.Equal(t, `{"3":"rkie"}`, obj.Marshal())
+
+		garbage := root.FindGarbage(ticket)
+		assert.Len(t, garbage, 2)
+		assert.Equal(t, arr.CreatedAt().AnnotatedString(), garbage[0].ID)
+		assert.Equal(t, obj.CreatedAt().AnnotatedString(), garbage[0].ParentID)
+		assert.Equal(t, 3, garbage[0].Count)
+		assert.Equal(t, text.CreatedAt().AnnotatedString(), garbage[1].ParentID)
+		assert.Equal(t, 1, garbage[1].Count)
+		assert.Len(t, root.FindGarbage(time.MaxTicket), 3)
+
+		// the garbage is not purged.
+		assert.Equal(t, 6, root.GarbageLen())
+		assert.Equal(t, 4, root.GarbageCollect(ticket))
+		assert.Equal(t, 1, root.GarbageLen())
+	})
 }
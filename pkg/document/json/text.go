@@ -215,3 +215,9 @@ func (t *Text) removedNodesLen() int {
 func (t *Text) purgeTextNodesWithGarbage(ticket *time.Ticket) int {
 	return t.rgaTreeSplit.purgeTextNodesWithGarbage(ticket)
 }
+
+// findTextNodesWithGarbage returns the nodes that have been removed before the
+// given ticket.
+func (t *Text) findTextNodesWithGarbage(ticket *time.Ticket) []*RGATreeSplitNode {
+	return t.rgaTreeSplit.findTextNodesWithGarbage(ticket)
+}
@@ -0,0 +1,287 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package jsonpatch transforms the changes of a document into JSON-patch-like
+// operations(RFC 6902) so that clients without the CRDT implementation can
+// apply them to a plain JSON object.
+package jsonpatch
+
+import (
+	gojson "encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	gotime "time"
+
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/operation"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+var (
+	// ErrUnsupportedOperation is returned when the given operation can not be
+	// represented as a patch. The client should fetch the whole document
+	// instead.
+	ErrUnsupportedOperation = errors.New("unsupported operation for json patch")
+
+	// ErrUnsupportedElement is returned when the given element can not be
+	// encoded to JSON.
+	ErrUnsupportedElement = errors.New("unsupported element for json patch")
+)
+
+// Op represents the type of the operation of Patch.
+type Op string
+
+// Belows are the types of the operation of Patch.
+const (
+	Add     Op = "add"
+	Remove  Op = "remove"
+	Replace Op = "replace"
+)
+
+// Patch is an operation that modifies a plain JSON object. Path is a JSON
+// pointer(RFC 6901) and Value is the JSON encoded value.
+type Patch struct {
+	Op    Op
+	Path  string
+	Value []byte
+}
+
+// FromChanges applies the given changes to the given root and returns the
+// patches that make the same modification to a plain JSON object.
+func FromChanges(root *json.Root, changes []*change.Change) ([]*Patch, error) {
+	var patches []*Patch
+	for _, c := range changes {
+		for _, op := range c.Operations() {
+			patch, err := fromOperation(root, op)
+			if err != nil {
+				return nil, err
+			}
+			if patch != nil {
+				patches = append(patches, patch)
+			}
+		}
+	}
+
+	return patches, nil
+}
+
+// fromOperation executes the given operation and returns the patch of it. It
+// returns nil if the operation does not modify the visible state.
+func fromOperation(root *json.Root, op operation.Operation) (*Patch, error) {
+	switch op := op.(type) {
+	case *operation.Set:
+		if err := op.Execute(root); err != nil {
+			return nil, err
+		}
+
+		parent := root.FindByCreatedAt(op.ParentCreatedAt()).(*json.Object)
+		elem := parent.Get(op.Key())
+		if elem == nil || !isSameTicket(elem.CreatedAt(), op.Value().CreatedAt()) {
+			return nil, nil
+		}
+
+		parentPath, ok := findPath(root, op.ParentCreatedAt())
+		if !ok {
+			return nil, nil
+		}
+		return newPatch(Add, parentPath+"/"+escape(op.Key()), elem)
+	case *operation.Add:
+		if err := op.Execute(root); err != nil {
+			return nil, err
+		}
+
+		path, ok := findPath(root, op.Value().CreatedAt())
+		if !ok {
+			return nil, nil
+		}
+		return newPatch(Add, path, root.FindByCreatedAt(op.Value().CreatedAt()))
+	case *operation.Remove:
+		// NOTE: The path of the removed element can not be found after
+		// executing the operation, so find it first.
+		path, ok := findPath(root, op.CreatedAt())
+		if err := op.Execute(root); err != nil {
+			return nil, err
+		}
+
+		removed := root.FindByCreatedAt(op.CreatedAt())
+		if !ok || removed == nil || removed.RemovedAt() == nil {
+			return nil, nil
+		}
+		return &Patch{Op: Remove, Path: path}, nil
+	case *operation.Edit, *operation.Increase:
+		if err := op.Execute(root); err != nil {
+			return nil, err
+		}
+
+		path, ok := findPath(root, op.ParentCreatedAt())
+		if !ok {
+			return nil, nil
+		}
+		return newPatch(Replace, path, root.FindByCreatedAt(op.ParentCreatedAt()))
+	case *operation.Select:
+		// NOTE: Selection does not modify the content of the document.
+		return nil, op.Execute(root)
+	default:
+		return nil, fmt.Errorf("%s: %w", reflect.TypeOf(op), ErrUnsupportedOperation)
+	}
+}
+
+func newPatch(op Op, path string, elem json.Element) (*Patch, error) {
+	value, err := Marshal(elem)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Patch{Op: op, Path: path, Value: value}, nil
+}
+
+// Marshal returns the JSON encoding of the given element. Unlike
+// Element.Marshal, the result is always valid JSON.
+func Marshal(elem json.Element) ([]byte, error) {
+	value, err := toValue(elem)
+	if err != nil {
+		return nil, err
+	}
+
+	return gojson.Marshal(value)
+}
+
+// toValue converts the given element to a value that can be encoded by
+// encoding/json.
+func toValue(elem json.Element) (interface{}, error) {
+	switch elem := elem.(type) {
+	case *json.Object:
+		members := make(map[string]interface{})
+		for _, node := range elem.RHTNodes() {
+			member := elem.Get(node.Key())
+			if member == nil || member != node.Element() {
+				continue
+			}
+
+			value, err := toValue(member)
+			if err != nil {
+				return nil, err
+			}
+			members[node.Key()] = value
+		}
+		return members, nil
+	case *json.Array:
+		elements := make([]interface{}, 0)
+		for _, element := range elem.Elements() {
+			value, err := toValue(element)
+			if err != nil {
+				return nil, err
+			}
+			elements = append(elements, value)
+		}
+		return elements, nil
+	case *json.Primitive:
+		switch elem.ValueType() {
+		case json.Bytes:
+			return string(elem.Value().([]byte)), nil
+		case json.Date:
+			return elem.Value().(gotime.Time).Format(gotime.RFC3339), nil
+		default:
+			return elem.Value(), nil
+		}
+	case *json.Counter:
+		return elem.Value(), nil
+	case *json.Text:
+		var values []string
+		for _, node := range elem.Nodes() {
+			if node.RemovedAt() == nil {
+				values = append(values, node.String())
+			}
+		}
+		return strings.Join(values, ""), nil
+	case *json.RichText:
+		values := make([]interface{}, 0)
+		for _, node := range elem.Nodes() {
+			// NOTE: Skip the initial line of the rich text as RichText.Marshal.
+			if node.RemovedAt() != nil ||
+				isSameTicket(node.ID().CreatedAt(), elem.CreatedAt()) {
+				continue
+			}
+
+			value := node.Value().(*json.RichTextValue)
+			values = append(values, map[string]interface{}{
+				"attrs": value.Attrs().Elements(),
+				"val":   value.Value(),
+			})
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("%v: %w", reflect.TypeOf(elem), ErrUnsupportedElement)
+	}
+}
+
+// findPath returns the JSON pointer of the element of the given creation
+// time. It returns false if the element is not visible in the document.
+func findPath(root *json.Root, createdAt *time.Ticket) (string, bool) {
+	if isSameTicket(root.Object().CreatedAt(), createdAt) {
+		return "", true
+	}
+
+	return findPathIn(root.Object(), createdAt, "")
+}
+
+func findPathIn(container json.Container, createdAt *time.Ticket, prefix string) (string, bool) {
+	visit := func(elem json.Element, path string) (string, bool) {
+		if isSameTicket(elem.CreatedAt(), createdAt) {
+			return path, true
+		}
+
+		if child, ok := elem.(json.Container); ok {
+			return findPathIn(child, createdAt, path)
+		}
+		return "", false
+	}
+
+	switch container := container.(type) {
+	case *json.Object:
+		for _, node := range container.RHTNodes() {
+			if member := container.Get(node.Key()); member == nil || member != node.Element() {
+				continue
+			}
+
+			if path, ok := visit(node.Element(), prefix+"/"+escape(node.Key())); ok {
+				return path, true
+			}
+		}
+	case *json.Array:
+		for i, elem := range container.Elements() {
+			if path, ok := visit(elem, prefix+"/"+strconv.Itoa(i)); ok {
+				return path, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// escape escapes the given key to be used as a reference token of JSON
+// pointer.
+func escape(key string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+}
+
+func isSameTicket(a, b *time.Ticket) bool {
+	return a.Compare(b) == 0
+}
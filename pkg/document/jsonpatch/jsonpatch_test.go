@@ -0,0 +1,104 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package jsonpatch_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/jsonpatch"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+)
+
+func TestJSONPatch(t *testing.T) {
+	t.Run("from changes test", func(t *testing.T) {
+		doc := document.New("c1", "d1")
+		root := document.New("c1", "d1").InternalDocument().Root()
+
+		err := doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewObject("obj").SetString("k/1", "v")
+			root.SetNewArray("arr").AddInteger(1, 2)
+			root.SetNewText("text").Edit(0, 0, "AB").Select(0, 1)
+			root.SetNewCounter("cnt", 0).Increase(2)
+			return nil
+		})
+		assert.NoError(t, err)
+		err = doc.Update(func(root *proxy.ObjectProxy) error {
+			root.GetArray("arr").Delete(0)
+			root.Delete("obj")
+			return nil
+		})
+		assert.NoError(t, err)
+
+		patches, err := jsonpatch.FromChanges(root, doc.CreateChangePack().Changes)
+		assert.NoError(t, err)
+
+		var actual [][3]string
+		for _, patch := range patches {
+			actual = append(actual, [3]string{string(patch.Op), patch.Path, string(patch.Value)})
+		}
+		assert.Equal(t, [][3]string{
+			{"add", "/obj", `{}`},
+			{"add", "/obj/k~11", `"v"`},
+			{"add", "/arr", `[]`},
+			{"add", "/arr/0", `1`},
+			{"add", "/arr/1", `2`},
+			{"add", "/text", `""`},
+			{"replace", "/text", `"AB"`},
+			{"add", "/cnt", `0`},
+			{"replace", "/cnt", `2`},
+			{"remove", "/arr/0", ``},
+			{"remove", "/obj", ``},
+		}, actual)
+
+		value, err := jsonpatch.Marshal(root.Object())
+		assert.NoError(t, err)
+		assert.Equal(t, `{"arr":[2],"cnt":2,"text":"AB"}`, string(value))
+	})
+
+	t.Run("unsupported operation test", func(t *testing.T) {
+		doc := document.New("c1", "d1")
+		root := document.New("c1", "d1").InternalDocument().Root()
+
+		err := doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewRichText("rich").Edit(0, 0, "AB", nil)
+			return nil
+		})
+		assert.NoError(t, err)
+
+		_, err = jsonpatch.FromChanges(root, doc.CreateChangePack().Changes)
+		assert.True(t, errors.Is(err, jsonpatch.ErrUnsupportedOperation))
+	})
+
+	t.Run("marshal test", func(t *testing.T) {
+		doc := document.New("c1", "d1")
+
+		err := doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("quote", `"a"`)
+			root.SetNewRichText("rich").Edit(0, 0, "AB", map[string]string{"b": "1"})
+			return nil
+		})
+		assert.NoError(t, err)
+
+		value, err := jsonpatch.Marshal(doc.RootObject())
+		assert.NoError(t, err)
+		assert.Equal(t, `{"quote":"\"a\"","rich":[{"attrs":{"b":"1"},"val":"AB"}]}`, string(value))
+	})
+}
@@ -19,6 +19,7 @@ package key
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 )
 
@@ -26,10 +27,23 @@ const (
 	// BSONSplitter is used to separate collection and document in a string.
 	BSONSplitter = "$"
 	tokenLen     = 2
+
+	// MaxTokenLen is the maximum length of the collection and the document.
+	MaxTokenLen = 120
+)
+
+var (
+	// ErrInvalidBSONKey is returned when the given bsonKey is invalid.
+	ErrInvalidBSONKey = errors.New("invalid bson key")
+
+	// ErrInvalidKey is returned when the collection or the document of the
+	// given key is empty, too long or has characters that are not allowed.
+	ErrInvalidKey = errors.New("invalid key")
 )
 
-// ErrInvalidBSONKey is returned when the given bsonKey is invalid.
-var ErrInvalidBSONKey = errors.New("invalid bson key")
+// tokenPattern is the pattern of the characters allowed in the collection and
+// the document. BSONSplitter is not allowed so that the BSON key can be split.
+var tokenPattern = regexp.MustCompile(`^[a-zA-Z0-9_.:/@-]+$`)
 
 // Key represents the key of the Document.
 type Key struct {
@@ -51,3 +65,30 @@ func FromBSONKey(bsonKey string) (*Key, error) {
 func (k *Key) BSONKey() string {
 	return k.Collection + BSONSplitter + k.Document
 }
+
+// Validate returns an error if the collection or the document of this key is
+// empty, longer than MaxTokenLen or has characters other than alphanumerics
+// and '_', '.', ':', '/', '@', '-'.
+func (k *Key) Validate() error {
+	if err := validateToken(k.Collection); err != nil {
+		return fmt.Errorf("collection %q: %w", k.Collection, err)
+	}
+	if err := validateToken(k.Document); err != nil {
+		return fmt.Errorf("document %q: %w", k.Document, err)
+	}
+
+	return nil
+}
+
+// validateToken validates the given collection or document of a key.
+func validateToken(token string) error {
+	if len(token) == 0 || len(token) > MaxTokenLen {
+		return ErrInvalidKey
+	}
+
+	if !tokenPattern.MatchString(token) {
+		return ErrInvalidKey
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package key_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+func TestKey(t *testing.T) {
+	t.Run("validate key test", func(t *testing.T) {
+		for _, k := range []key.Key{
+			{Collection: "c1", Document: "d1"},
+			{Collection: "tests", Document: "TestKey/validate_key_test"},
+			{Collection: "user@example.com", Document: "doc-1.v2:draft"},
+			{Collection: "c1", Document: strings.Repeat("d", key.MaxTokenLen)},
+		} {
+			assert.NoError(t, k.Validate(), k.BSONKey())
+		}
+	})
+
+	t.Run("validate empty key test", func(t *testing.T) {
+		k := key.Key{}
+		assert.ErrorIs(t, k.Validate(), key.ErrInvalidKey)
+
+		k = key.Key{Collection: "c1"}
+		assert.ErrorIs(t, k.Validate(), key.ErrInvalidKey)
+
+		k = key.Key{Document: "d1"}
+		assert.ErrorIs(t, k.Validate(), key.ErrInvalidKey)
+	})
+
+	t.Run("validate oversized key test", func(t *testing.T) {
+		k := key.Key{Collection: strings.Repeat("c", key.MaxTokenLen+1), Document: "d1"}
+		assert.ErrorIs(t, k.Validate(), key.ErrInvalidKey)
+
+		k = key.Key{Collection: "c1", Document: strings.Repeat("d", key.MaxTokenLen+1)}
+		assert.ErrorIs(t, k.Validate(), key.ErrInvalidKey)
+	})
+
+	t.Run("validate key with disallowed characters test", func(t *testing.T) {
+		for _, document := range []string{
+			"d\x00",
+			"d\n1",
+			"d\t1",
+			"d\x7f",
+			"d 1",
+			"c1$d1",
+			"문서",
+		} {
+			k := key.Key{Collection: "c1", Document: document}
+			assert.ErrorIs(t, k.Validate(), key.ErrInvalidKey, document)
+		}
+	})
+}
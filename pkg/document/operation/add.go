@@ -77,6 +77,11 @@ func (o *Add) ParentCreatedAt() *time.Ticket {
 	return o.parentCreatedAt
 }
 
+// Type returns the type of this operation.
+func (o *Add) Type() Type {
+	return TypeAdd
+}
+
 // ExecutedAt returns execution time of this operation.
 func (o *Add) ExecutedAt() *time.Ticket {
 	return o.executedAt
@@ -104,6 +104,11 @@ func (e *Edit) ParentCreatedAt() *time.Ticket {
 	return e.parentCreatedAt
 }
 
+// Type returns the type of this operation.
+func (e *Edit) Type() Type {
+	return TypeEdit
+}
+
 // Content returns the content of Edit.
 func (e *Edit) Content() string {
 	return e.content
@@ -66,6 +66,11 @@ func (o *Increase) ParentCreatedAt() *time.Ticket {
 	return o.parentCreatedAt
 }
 
+// Type returns the type of this operation.
+func (o *Increase) Type() Type {
+	return TypeIncrease
+}
+
 // ExecutedAt returns execution time of this operation.
 func (o *Increase) ExecutedAt() *time.Ticket {
 	return o.executedAt
@@ -75,6 +75,11 @@ func (o *Move) ParentCreatedAt() *time.Ticket {
 	return o.parentCreatedAt
 }
 
+// Type returns the type of this operation.
+func (o *Move) Type() Type {
+	return TypeMove
+}
+
 // ExecutedAt returns execution time of this operation.
 func (o *Move) ExecutedAt() *time.Ticket {
 	return o.executedAt
@@ -29,6 +29,23 @@ var (
 	ErrNotApplicableDataType = errors.New("not applicable datatype")
 )
 
+// Type represents the type of an operation. It is used as the name of the
+// operation in the metrics and the logs, so it must not be changed.
+type Type string
+
+// Belows are the types of the operations.
+const (
+	TypeSet      Type = "set"
+	TypeAdd      Type = "add"
+	TypeMove     Type = "move"
+	TypeRemove   Type = "remove"
+	TypeEdit     Type = "edit"
+	TypeSelect   Type = "select"
+	TypeRichEdit Type = "rich_edit"
+	TypeStyle    Type = "style"
+	TypeIncrease Type = "increase"
+)
+
 // Operation represents an operation to be executed on a document.
 type Operation interface {
 	// Execute executes this operation on the given document(`root`).
@@ -43,4 +60,7 @@ type Operation interface {
 	// ParentCreatedAt returns the creation time of the target element to
 	// execute the operation.
 	ParentCreatedAt() *time.Ticket
+
+	// Type returns the type of this operation.
+	Type() Type
 }
@@ -69,6 +69,11 @@ func (o *Remove) ParentCreatedAt() *time.Ticket {
 	return o.parentCreatedAt
 }
 
+// Type returns the type of this operation.
+func (o *Remove) Type() Type {
+	return TypeRemove
+}
+
 // ExecutedAt returns execution time of this operation.
 func (o *Remove) ExecutedAt() *time.Ticket {
 	return o.executedAt
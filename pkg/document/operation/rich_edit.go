@@ -111,6 +111,11 @@ func (e *RichEdit) ParentCreatedAt() *time.Ticket {
 	return e.parentCreatedAt
 }
 
+// Type returns the type of this operation.
+func (e *RichEdit) Type() Type {
+	return TypeRichEdit
+}
+
 // Content returns the content of RichEdit.
 func (e *RichEdit) Content() string {
 	return e.content
@@ -91,3 +91,8 @@ func (s *Select) SetActor(actorID *time.ActorID) {
 func (s *Select) ParentCreatedAt() *time.Ticket {
 	return s.parentCreatedAt
 }
+
+// Type returns the type of this operation.
+func (s *Select) Type() Type {
+	return TypeSelect
+}
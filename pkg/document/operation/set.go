@@ -75,6 +75,11 @@ func (o *Set) ParentCreatedAt() *time.Ticket {
 	return o.parentCreatedAt
 }
 
+// Type returns the type of this operation.
+func (o *Set) Type() Type {
+	return TypeSet
+}
+
 // ExecutedAt returns execution time of this operation.
 func (o *Set) ExecutedAt() *time.Ticket {
 	return o.executedAt
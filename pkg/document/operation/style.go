@@ -93,6 +93,11 @@ func (e *Style) ParentCreatedAt() *time.Ticket {
 	return e.parentCreatedAt
 }
 
+// Type returns the type of this operation.
+func (e *Style) Type() Type {
+	return TypeStyle
+}
+
 // Attributes returns the attributes of this operation.
 func (e *Style) Attributes() map[string]string {
 	return e.attributes
@@ -0,0 +1,98 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package retry provides the retries with an exponential backoff for the
+// operations that may fail temporarily.
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// ErrRetriesExhausted is returned when the operation still fails after the
+// max count of retries.
+var ErrRetriesExhausted = errors.New("retries exhausted")
+
+// WaitHinter is implemented by the errors that suggest how long to wait
+// before the next retry, e.g. with the Retry-After header of HTTP.
+type WaitHinter interface {
+	// WaitHint returns the suggested interval. If it is zero, the interval
+	// grows exponentially.
+	WaitHint() time.Duration
+}
+
+// WithExponentialBackoff calls the given fn until it succeeds or shouldRetry
+// returns false for its error, which is returned as is. It waits before each
+// retry for the interval that grows exponentially up to maxWaitInterval. If
+// the error implements WaitHinter, its hint is used instead of the exponential
+// interval, still capped by maxWaitInterval. If fn still fails after
+// maxRetries retries, ErrRetriesExhausted is returned.
+func WithExponentialBackoff(
+	ctx context.Context,
+	maxRetries uint64,
+	maxWaitInterval time.Duration,
+	shouldRetry func(err error) bool,
+	fn func() error,
+) error {
+	var retries uint64
+	for {
+		err := fn()
+		if err == nil || !shouldRetry(err) {
+			return err
+		}
+
+		if retries >= maxRetries {
+			return fmt.Errorf("%s: %w", err.Error(), ErrRetriesExhausted)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(waitIntervalOf(err, retries, maxWaitInterval)):
+		}
+
+		retries++
+	}
+}
+
+// waitIntervalOf returns the interval to wait before the next retry of the
+// given error. The hint of the error takes precedence over the exponential
+// interval.
+func waitIntervalOf(err error, retries uint64, maxWaitInterval time.Duration) time.Duration {
+	var hinter WaitHinter
+	if !errors.As(err, &hinter) || hinter.WaitHint() <= 0 {
+		return WaitInterval(retries, maxWaitInterval)
+	}
+
+	if maxWaitInterval < hinter.WaitHint() {
+		return maxWaitInterval
+	}
+	return hinter.WaitHint()
+}
+
+// WaitInterval returns the interval of given retries. (2^retries * 100) milliseconds.
+func WaitInterval(retries uint64, maxWaitInterval time.Duration) time.Duration {
+	interval := time.Duration(math.Pow(2, float64(retries))) * 100 * time.Millisecond
+	if maxWaitInterval < interval {
+		return maxWaitInterval
+	}
+
+	return interval
+}
@@ -0,0 +1,135 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package retry_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/retry"
+)
+
+var (
+	errTransient = errors.New("transient")
+	errPermanent = errors.New("permanent")
+)
+
+// hintedError is a transient error with the interval to wait.
+type hintedError struct {
+	wait time.Duration
+}
+
+func (e *hintedError) Error() string {
+	return "hinted"
+}
+
+func (e *hintedError) Unwrap() error {
+	return errTransient
+}
+
+func (e *hintedError) WaitHint() time.Duration {
+	return e.wait
+}
+
+func isTransient(err error) bool {
+	return errors.Is(err, errTransient)
+}
+
+func TestRetry(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("succeed after retries test", func(t *testing.T) {
+		calls := 0
+		err := retry.WithExponentialBackoff(ctx, 3, time.Millisecond, isTransient, func() error {
+			calls++
+			if calls < 3 {
+				return errTransient
+			}
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("retries exhausted test", func(t *testing.T) {
+		calls := 0
+		err := retry.WithExponentialBackoff(ctx, 2, time.Millisecond, isTransient, func() error {
+			calls++
+			return errTransient
+		})
+		assert.ErrorIs(t, err, retry.ErrRetriesExhausted)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("non-retryable error test", func(t *testing.T) {
+		calls := 0
+		err := retry.WithExponentialBackoff(ctx, 3, time.Millisecond, isTransient, func() error {
+			calls++
+			return errPermanent
+		})
+		assert.ErrorIs(t, err, errPermanent)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("context canceled test", func(t *testing.T) {
+		canceledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		err := retry.WithExponentialBackoff(canceledCtx, 3, time.Second, isTransient, func() error {
+			return errTransient
+		})
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("wait hint test", func(t *testing.T) {
+		// the hint is used instead of the exponential interval of 100ms
+		calls := 0
+		start := time.Now()
+		err := retry.WithExponentialBackoff(ctx, 3, time.Second, isTransient, func() error {
+			calls++
+			if calls < 3 {
+				return &hintedError{wait: time.Millisecond}
+			}
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Less(t, time.Since(start), 100*time.Millisecond)
+
+		// the hint is capped by the max wait interval
+		calls = 0
+		start = time.Now()
+		err = retry.WithExponentialBackoff(ctx, 3, time.Millisecond, isTransient, func() error {
+			calls++
+			if calls < 2 {
+				return &hintedError{wait: time.Hour}
+			}
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Less(t, time.Since(start), time.Second)
+	})
+
+	t.Run("wait interval test", func(t *testing.T) {
+		assert.Equal(t, 100*time.Millisecond, retry.WaitInterval(0, time.Second))
+		assert.Equal(t, 400*time.Millisecond, retry.WaitInterval(2, time.Second))
+		assert.Equal(t, time.Second, retry.WaitInterval(10, time.Second))
+	})
+}
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"time"
 )
 
 // VerbType represents an action taken on the document.
@@ -43,6 +44,10 @@ var (
 	ErrInvalidWebhookResponse = errors.New("invalid authorization webhook response")
 )
 
+// MaxWebhookResponseBytes is the maximum size of the webhook response to read.
+// The rest of the larger response is not read, so it fails to be decoded.
+const MaxWebhookResponseBytes = 64 * 1024
+
 // Method represents a method name of RPC.
 type Method string
 
@@ -54,6 +59,10 @@ const (
 	DetachDocument   Method = "DetachDocument"
 	PushPull         Method = "PushPull"
 	WatchDocuments   Method = "WatchDocuments"
+
+	UpdateClientMetadata Method = "UpdateClientMetadata"
+	PullJSONPatches      Method = "PullJSONPatches"
+	FetchSnapshot        Method = "FetchSnapshot"
 )
 
 // IsAuthMethod returns whether the given method can be used for authorization.
@@ -75,6 +84,9 @@ func AuthMethods() []Method {
 		DetachDocument,
 		PushPull,
 		WatchDocuments,
+		UpdateClientMetadata,
+		PullJSONPatches,
+		FetchSnapshot,
 	}
 }
 
@@ -91,10 +103,27 @@ type AccessInfo struct {
 }
 
 // AuthWebhookRequest represents the request of authentication webhook.
+//
+// In the delta mode, the agent and the webhook share a context of the token
+// and the method that holds the last authorized attributes. The fields below
+// are set only in the delta mode for the requests with attributes:
+//   - ContextID is the ID of the context. It changes when the agent starts a
+//     new context, for example, after the resync interval.
+//   - Revision is the revision of the context after this request is allowed.
+//   - If Delta is true, Attributes and RemovedAttributes are the difference
+//     against the context at Revision-1. If the webhook does not have it, it
+//     should respond with Resync so that the whole attributes are sent.
+//
+// The webhook should update the context only when it allows the request.
 type AuthWebhookRequest struct {
 	Token      string            `json:"token"`
 	Method     Method            `json:"method"`
 	Attributes []AccessAttribute `json:"attributes"`
+
+	ContextID         string            `json:"context_id,omitempty"`
+	Revision          uint64            `json:"revision,omitempty"`
+	Delta             bool              `json:"delta,omitempty"`
+	RemovedAttributes []AccessAttribute `json:"removed_attributes,omitempty"`
 }
 
 // NewAuthWebhookRequest creates a new instance of AuthWebhookRequest.
@@ -108,23 +137,141 @@ func NewAuthWebhookRequest(reader io.Reader) (*AuthWebhookRequest, error) {
 	return req, nil
 }
 
+// WholeAttributes returns the whole attributes of this request by applying
+// the difference to the given attributes of the context. If this request is
+// not a delta, the attributes of this request are returned as is.
+func (r *AuthWebhookRequest) WholeAttributes(base []AccessAttribute) []AccessAttribute {
+	if !r.Delta {
+		return r.Attributes
+	}
+
+	removed := make(map[AccessAttribute]bool)
+	for _, attr := range r.RemovedAttributes {
+		removed[attr] = true
+	}
+
+	var attrs []AccessAttribute
+	for _, attr := range base {
+		if !removed[attr] {
+			attrs = append(attrs, attr)
+		}
+	}
+	return append(attrs, r.Attributes...)
+}
+
+// Belows are the headers of the signed authorization webhook request. The
+// signature is encoded in base64.
+const (
+	AuthWebhookSignatureHeader = "X-Yorkie-Signature"
+	AuthWebhookAlgorithmHeader = "X-Yorkie-Signature-Algorithm"
+	AuthWebhookKeyIDHeader     = "X-Yorkie-Key-ID"
+)
+
+// AuthWebhookRequestIDHeader is the header of the ID of the RPC that sends the
+// authorization webhook request, to correlate the logs of the agent and the
+// webhook.
+const AuthWebhookRequestIDHeader = "X-Request-ID"
+
+// SigningAlgorithm represents an algorithm to sign the authorization webhook
+// request.
+type SigningAlgorithm string
+
+// Belows are the supported signing algorithms.
+const (
+	// Ed25519 signs the request body with the Ed25519 private key.
+	Ed25519 SigningAlgorithm = "ed25519"
+
+	// HMACSHA256 signs the request body with the shared secret. It is used
+	// when the HMAC secret is set instead of a signing key.
+	HMACSHA256 SigningAlgorithm = "hmac-sha256"
+)
+
+// IsSigningAlgorithm returns whether the given algorithm is supported for the
+// signing key.
+func IsSigningAlgorithm(algorithm string) bool {
+	return SigningAlgorithm(algorithm) == Ed25519
+}
+
 // AuthWebhookResponse represents the response of authentication webhook.
 type AuthWebhookResponse struct {
 	Allowed bool   `json:"allowed"`
 	Reason  string `json:"reason"`
+
+	// Resync is set by the webhook when it can not apply the difference of a
+	// delta request. The agent sends the whole attributes again.
+	Resync bool `json:"resync,omitempty"`
+
+	// CacheTTL is the duration to cache this response such as "30s". It
+	// overrides the TTL of the agent for the allowed or denied results, e.g.
+	// to cache a transient denial briefly. If it is "0s", the response is not
+	// cached. If it is empty, the TTL of the agent is used.
+	CacheTTL string `json:"cacheTTL,omitempty"`
+
+	// DeniedAttributes is the attributes denied among the attributes of the
+	// request, e.g. the documents of a bulk request that the user can not
+	// access. It is set only when the request is not allowed. If it is empty,
+	// all the attributes are denied.
+	DeniedAttributes []AccessAttribute `json:"deniedAttributes,omitempty"`
 }
 
 // NewAuthWebhookResponse creates a new instance of AuthWebhookResponse.
 func NewAuthWebhookResponse(reader io.Reader) (*AuthWebhookResponse, error) {
 	resp := &AuthWebhookResponse{}
 
-	if err := json.NewDecoder(reader).Decode(resp); err != nil {
+	if err := json.NewDecoder(io.LimitReader(reader, MaxWebhookResponseBytes)).Decode(resp); err != nil {
 		return nil, fmt.Errorf("%s: %w", err.Error(), ErrInvalidWebhookResponse)
 	}
 
+	if resp.CacheTTL != "" {
+		ttl, err := time.ParseDuration(resp.CacheTTL)
+		if err != nil {
+			return nil, fmt.Errorf("cache ttl %s: %w", err.Error(), ErrInvalidWebhookResponse)
+		}
+		if ttl < 0 {
+			return nil, fmt.Errorf("negative cache ttl %s: %w", resp.CacheTTL, ErrInvalidWebhookResponse)
+		}
+	}
+
+	if resp.Allowed && len(resp.DeniedAttributes) > 0 {
+		return nil, fmt.Errorf("denied attributes of allowed response: %w", ErrInvalidWebhookResponse)
+	}
+
 	return resp, nil
 }
 
+// IsAllowed returns whether the given attribute of the request is allowed by
+// this response.
+func (r *AuthWebhookResponse) IsAllowed(attr AccessAttribute) bool {
+	if r.Allowed {
+		return true
+	}
+	if len(r.DeniedAttributes) == 0 {
+		return false
+	}
+
+	for _, denied := range r.DeniedAttributes {
+		if denied == attr {
+			return false
+		}
+	}
+	return true
+}
+
+// CacheTTLOf returns the TTL to cache this response. If CacheTTL is not set
+// or invalid, the given TTL is returned.
+func (r *AuthWebhookResponse) CacheTTLOf(ttl time.Duration) time.Duration {
+	if r.CacheTTL == "" {
+		return ttl
+	}
+
+	result, err := time.ParseDuration(r.CacheTTL)
+	if err != nil || result < 0 {
+		return ttl
+	}
+
+	return result
+}
+
 // Write writes this response to the given writer.
 func (r *AuthWebhookResponse) Write(writer io.Writer) (int, error) {
 	resBody, err := json.Marshal(r)
@@ -18,4 +18,12 @@ const (
 
 	// MetadataChangedEvent is an event indicating that metadata is changed.
 	MetadataChangedEvent DocEventType = "metadata-changed"
+
+	// DocumentsQuarantinedEvent is an event indicating that documents are
+	// quarantined by the admin and can not be synchronized.
+	DocumentsQuarantinedEvent DocEventType = "documents-quarantined"
+
+	// PeersChangedEvent is an event indicating that the peers of documents are
+	// changed because a client attached or detached them.
+	PeersChangedEvent DocEventType = "peers-changed"
 )
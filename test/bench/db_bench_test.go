@@ -0,0 +1,87 @@
+//go:build bench
+
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bench
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/test/helper"
+	"github.com/yorkie-team/yorkie/yorkie/backend/db"
+	"github.com/yorkie-team/yorkie/yorkie/backend/db/memory"
+	"github.com/yorkie-team/yorkie/yorkie/backend/db/mongo"
+)
+
+func BenchmarkDB(b *testing.B) {
+	memdb, err := memory.New()
+	assert.NoError(b, err)
+
+	cli, err := mongo.Dial(&mongo.Config{
+		ConnectionURI:     helper.MongoConnectionURI,
+		ConnectionTimeout: helper.MongoConnectionTimeout,
+		PingTimeout:       helper.MongoPingTimeout,
+		YorkieDatabase:    helper.TestDBName(),
+	})
+	assert.NoError(b, err)
+	defer func() {
+		assert.NoError(b, cli.Close())
+	}()
+
+	b.Run("memory create 500 change infos test", func(b *testing.B) {
+		benchmarkCreateChangeInfos(500, b, memdb)
+	})
+
+	b.Run("mongo create 500 change infos test", func(b *testing.B) {
+		benchmarkCreateChangeInfos(500, b, cli)
+	})
+}
+
+func benchmarkCreateChangeInfos(cnt int, b *testing.B, database db.DB) {
+	ctx := context.Background()
+	clientInfo, err := database.ActivateClient(ctx, b.Name())
+	assert.NoError(b, err)
+	actorID, err := time.ActorIDFromHex(clientInfo.ID.String())
+	assert.NoError(b, err)
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		docInfo, err := database.FindDocInfoByKey(ctx, clientInfo, fmt.Sprintf("%s$%d", b.Name(), i), true)
+		assert.NoError(b, err)
+
+		initialServerSeq := docInfo.ServerSeq
+		serverSeq := docInfo.ReserveServerSeqs(uint64(cnt))
+		var changes []*change.Change
+		id := change.InitialID.SetActor(actorID)
+		for j := 0; j < cnt; j++ {
+			id = id.Next()
+			cn := change.New(id, "", nil)
+			assert.NoError(b, cn.AssignServerSeq(serverSeq))
+			serverSeq++
+			changes = append(changes, cn)
+		}
+		b.StartTimer()
+
+		assert.NoError(b, database.CreateChangeInfos(ctx, docInfo, initialServerSeq, changes))
+	}
+}
@@ -50,8 +50,11 @@ const (
 	MongoConnectionTimeout = "5s"
 	MongoPingTimeout       = "5s"
 	SnapshotThreshold      = 10
+	MaxChangesPerPull      = 5
 	Collection             = "test-collection"
 
+	AdminToken = "admin-token"
+
 	AuthWebhookMaxWaitInterval = 3 * gotime.Millisecond
 	AuthWebhookSize            = 100
 	AuthWebhookCacheAuthTTL    = 10 * gotime.Second
@@ -111,6 +114,8 @@ func TestConfig(authWebhook string) *yorkie.Config {
 		},
 		Backend: &backend.Config{
 			SnapshotThreshold:          SnapshotThreshold,
+			MaxChangesPerPull:          MaxChangesPerPull,
+			AdminToken:                 AdminToken,
 			AuthWebhookURL:             authWebhook,
 			AuthWebhookMaxWaitInterval: AuthWebhookMaxWaitInterval.String(),
 			AuthWebhookCacheSize:       AuthWebhookSize,
@@ -23,6 +23,7 @@ import (
 	"io"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/codes"
@@ -72,4 +73,35 @@ func TestAgent(t *testing.T) {
 
 		wg.Wait()
 	})
+
+	t.Run("draining agent test", func(t *testing.T) {
+		ctx := context.Background()
+		agent := helper.TestYorkie()
+		assert.NoError(t, agent.Start())
+
+		cli, err := client.Dial(agent.RPCAddr())
+		assert.NoError(t, err)
+		assert.NoError(t, cli.Activate(ctx))
+
+		doc := document.New(helper.Collection, t.Name())
+		assert.NoError(t, cli.Attach(ctx, doc))
+
+		wrch, err := cli.Watch(ctx, doc)
+		assert.NoError(t, err)
+
+		// NOTE: The watch stream does not end on its own, but it is cancelled
+		//       by the drain so that the drain completes before the deadline.
+		drainCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		drained, err := agent.Drain(drainCtx)
+		assert.NoError(t, err)
+		assert.True(t, drained)
+
+		for wr := range wrch {
+			if wr.Err != nil {
+				assert.True(t, wr.Err == io.EOF || status.Code(wr.Err) == codes.Canceled)
+				break
+			}
+		}
+	})
 }
@@ -20,22 +20,37 @@ package integration
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/rs/xid"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
+	"github.com/yorkie-team/yorkie/api"
 	"github.com/yorkie-team/yorkie/client"
 	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/proxy"
 	"github.com/yorkie-team/yorkie/pkg/types"
 	"github.com/yorkie-team/yorkie/test/helper"
 	"github.com/yorkie-team/yorkie/yorkie"
+	"github.com/yorkie-team/yorkie/yorkie/auth"
 )
 
 func newAuthServer(t *testing.T) (*httptest.Server, string) {
@@ -77,6 +92,17 @@ func newUnavailableAuthServer(t *testing.T, recoveryCnt uint64) *httptest.Server
 	}))
 }
 
+// authProviderFunc is an adapter to use a function as backend.AuthProvider.
+type authProviderFunc func(req *types.AuthWebhookRequest) (*types.AuthWebhookResponse, error)
+
+// Verify calls the function with the given request.
+func (f authProviderFunc) Verify(
+	_ context.Context,
+	req *types.AuthWebhookRequest,
+) (*types.AuthWebhookResponse, error) {
+	return f(req)
+}
+
 func TestAuthWebhook(t *testing.T) {
 	t.Run("authorization webhook test", func(t *testing.T) {
 		server, token := newAuthServer(t)
@@ -189,6 +215,77 @@ func TestAuthWebhook(t *testing.T) {
 		assert.Equal(t, codes.Unauthenticated, status.Convert(err).Code())
 	})
 
+	t.Run("authorization webhook method timeout test", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(100 * time.Millisecond)
+
+			res := types.AuthWebhookResponse{Allowed: true}
+			_, err := res.Write(w)
+			assert.NoError(t, err)
+		}))
+
+		conf := helper.TestConfig(server.URL)
+		conf.Backend.AuthWebhookMaxRetries = 1
+		conf.Backend.AuthWebhookMethodTimeouts = map[string]string{
+			string(types.AttachDocument): "20ms",
+		}
+		agent, err := yorkie.New(conf)
+		assert.NoError(t, err)
+		assert.NoError(t, agent.Start())
+		defer func() { assert.NoError(t, agent.Shutdown(true)) }()
+
+		ctx := context.Background()
+		cli, err := client.Dial(agent.RPCAddr(), client.WithToken("token"))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+
+		// the method without the timeout waits for the webhook
+		assert.NoError(t, cli.Activate(ctx))
+
+		doc := document.New(helper.Collection, t.Name())
+		err = cli.Attach(ctx, doc)
+		assert.Equal(t, codes.Unauthenticated, status.Convert(err).Code())
+	})
+
+	t.Run("authorization webhook retry after test", func(t *testing.T) {
+		var mu sync.Mutex
+		var requestedAt []time.Time
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			requestedAt = append(requestedAt, time.Now())
+			throttled := len(requestedAt) == 1
+			mu.Unlock()
+
+			if throttled {
+				w.Header().Set("Retry-After", "1")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+
+			res := types.AuthWebhookResponse{Allowed: true}
+			_, err := res.Write(w)
+			assert.NoError(t, err)
+		}))
+
+		conf := helper.TestConfig(server.URL)
+		conf.Backend.AuthWebhookMaxRetries = 1
+		conf.Backend.AuthWebhookMaxWaitInterval = "3s"
+		agent, err := yorkie.New(conf)
+		assert.NoError(t, err)
+		assert.NoError(t, agent.Start())
+		defer func() { assert.NoError(t, agent.Shutdown(true)) }()
+
+		ctx := context.Background()
+		cli, err := client.Dial(agent.RPCAddr(), client.WithToken("token"))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+
+		// the retry waits for Retry-After instead of the exponential interval
+		assert.NoError(t, cli.Activate(ctx))
+		assert.Len(t, requestedAt, 2)
+		assert.GreaterOrEqual(t, requestedAt[1].Sub(requestedAt[0]), time.Second)
+	})
+
 	t.Run("authorized request cache test", func(t *testing.T) {
 		reqCnt := 0
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -249,6 +346,55 @@ func TestAuthWebhook(t *testing.T) {
 		assert.Equal(t, 2, reqCnt)
 	})
 
+	t.Run("cache max age test", func(t *testing.T) {
+		reqCnt := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			req, err := types.NewAuthWebhookRequest(r.Body)
+			assert.NoError(t, err)
+
+			var res types.AuthWebhookResponse
+			res.Allowed = true
+
+			_, err = res.Write(w)
+			assert.NoError(t, err)
+
+			if req.Method == types.PushPull {
+				reqCnt++
+			}
+		}))
+
+		maxAge := 1 * time.Second
+		conf := helper.TestConfig(server.URL)
+		conf.Backend.AuthWebhookCacheAuthTTL = time.Hour.String()
+		conf.Backend.AuthWebhookCacheMaxAge = maxAge.String()
+
+		agent, err := yorkie.New(conf)
+		assert.NoError(t, err)
+		assert.NoError(t, agent.Start())
+		defer func() { assert.NoError(t, agent.Shutdown(true)) }()
+
+		ctx := context.Background()
+		cli, err := client.Dial(agent.RPCAddr(), client.WithToken("token"))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+
+		doc := document.New(helper.Collection, t.Name())
+		assert.NoError(t, cli.Attach(ctx, doc))
+
+		// the cached result is evicted by the max age before the TTL.
+		for i := 0; i < 2; i++ {
+			assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+				root.SetNewObject("k1")
+				return nil
+			}))
+			assert.NoError(t, cli.Sync(ctx))
+			time.Sleep(maxAge)
+		}
+
+		assert.Equal(t, 2, reqCnt)
+	})
+
 	t.Run("unauthorized request cache test", func(t *testing.T) {
 		reqCnt := 0
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -292,4 +438,414 @@ func TestAuthWebhook(t *testing.T) {
 		}
 		assert.Equal(t, 2, reqCnt)
 	})
+	t.Run("cache ttl of response test", func(t *testing.T) {
+		var mu sync.Mutex
+		reqCnt := make(map[string]int)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			req, err := types.NewAuthWebhookRequest(r.Body)
+			assert.NoError(t, err)
+
+			mu.Lock()
+			reqCnt[req.Token]++
+			mu.Unlock()
+
+			// the denial of the token not yet propagated is transient
+			res := types.AuthWebhookResponse{Reason: "not propagated", CacheTTL: "100ms"}
+			if req.Token == "uncached-token" {
+				res = types.AuthWebhookResponse{Allowed: true, CacheTTL: "0s"}
+			}
+
+			_, err = res.Write(w)
+			assert.NoError(t, err)
+		}))
+
+		conf := helper.TestConfig(server.URL)
+		agent, err := yorkie.New(conf)
+		assert.NoError(t, err)
+		assert.NoError(t, agent.Start())
+		defer func() { assert.NoError(t, agent.Shutdown(true)) }()
+
+		ctx := context.Background()
+		cli, err := client.Dial(agent.RPCAddr(), client.WithToken("transient-token"))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+
+		// 01. the denial expires by the TTL of the response.
+		for i := 0; i < 3; i++ {
+			err = cli.Activate(ctx)
+			assert.Equal(t, codes.Unauthenticated, status.Convert(err).Code())
+		}
+		time.Sleep(100 * time.Millisecond)
+		err = cli.Activate(ctx)
+		assert.Equal(t, codes.Unauthenticated, status.Convert(err).Code())
+		assert.Equal(t, 2, reqCnt["transient-token"])
+
+		// 02. the response with zero TTL is not cached.
+		uncachedCli, err := client.Dial(agent.RPCAddr(), client.WithToken("uncached-token"))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, uncachedCli.Close()) }()
+		for i := 0; i < 3; i++ {
+			assert.NoError(t, uncachedCli.Activate(ctx))
+			assert.NoError(t, uncachedCli.Deactivate(ctx))
+		}
+		assert.Equal(t, 6, reqCnt["uncached-token"])
+	})
+
+	t.Run("delta authorization webhook request test", func(t *testing.T) {
+		type webhookContext struct {
+			revision   uint64
+			attributes []types.AccessAttribute
+		}
+
+		var mu sync.Mutex
+		forget := false
+		contexts := make(map[string]*webhookContext)
+		var watchReqs []*types.AuthWebhookRequest
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			req, err := types.NewAuthWebhookRequest(r.Body)
+			assert.NoError(t, err)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if forget {
+				contexts = make(map[string]*webhookContext)
+				forget = false
+			}
+
+			var res types.AuthWebhookResponse
+			webhookCtx, ok := contexts[req.ContextID]
+			if req.Delta && (!ok || webhookCtx.revision != req.Revision-1) {
+				res.Resync = true
+			} else {
+				var base []types.AccessAttribute
+				if ok {
+					base = webhookCtx.attributes
+				}
+				if req.ContextID != "" {
+					contexts[req.ContextID] = &webhookContext{
+						revision:   req.Revision,
+						attributes: req.WholeAttributes(base),
+					}
+				}
+				res.Allowed = true
+			}
+
+			if req.Method == types.WatchDocuments {
+				watchReqs = append(watchReqs, req)
+			}
+
+			_, err = res.Write(w)
+			assert.NoError(t, err)
+		}))
+
+		conf := helper.TestConfig(server.URL)
+		conf.Backend.AuthWebhookDelta = true
+		conf.Backend.AuthWebhookDeltaResyncInterval = time.Minute.String()
+
+		agent, err := yorkie.New(conf)
+		assert.NoError(t, err)
+		assert.NoError(t, agent.Start())
+		defer func() { assert.NoError(t, agent.Shutdown(true)) }()
+
+		ctx := context.Background()
+		cli, err := client.Dial(agent.RPCAddr(), client.WithToken("token"))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+		defer func() { assert.NoError(t, cli.Deactivate(ctx)) }()
+
+		var docs []*document.Document
+		watch := func() {
+			doc := document.New(helper.Collection, fmt.Sprintf("%s-%d", t.Name(), len(docs)))
+			assert.NoError(t, cli.Attach(ctx, doc))
+			docs = append(docs, doc)
+
+			watchCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			_, err := cli.Watch(watchCtx, docs...)
+			assert.NoError(t, err)
+		}
+
+		// 01. the whole attributes are sent first.
+		for i := 0; i < 3; i++ {
+			watch()
+		}
+		assert.False(t, watchReqs[0].Delta)
+		assert.Len(t, watchReqs[0].Attributes, 1)
+
+		// 02. only the added attribute is sent.
+		assert.True(t, watchReqs[2].Delta)
+		assert.Len(t, watchReqs[2].Attributes, 1)
+		assert.Equal(t, docs[2].Key().BSONKey(), watchReqs[2].Attributes[0].Key)
+		mu.Lock()
+		assert.Len(t, contexts[watchReqs[2].ContextID].attributes, 3)
+		mu.Unlock()
+
+		// 03. the whole attributes are sent again if the webhook lost the context.
+		mu.Lock()
+		forget = true
+		mu.Unlock()
+		watch()
+		assert.Len(t, watchReqs, 5)
+		assert.True(t, watchReqs[3].Delta)
+		assert.False(t, watchReqs[4].Delta)
+		assert.Len(t, watchReqs[4].Attributes, 4)
+	})
+
+	t.Run("signed authorization webhook request test", func(t *testing.T) {
+		publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		assert.NoError(t, err)
+		encodedKey, err := x509.MarshalPKCS8PrivateKey(privateKey)
+		assert.NoError(t, err)
+
+		keyFile := filepath.Join(t.TempDir(), "signing.key")
+		assert.NoError(t, ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{
+			Type:  "PRIVATE KEY",
+			Bytes: encodedKey,
+		}), 0600))
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := ioutil.ReadAll(r.Body)
+			assert.NoError(t, err)
+
+			signature, err := base64.StdEncoding.DecodeString(r.Header.Get(types.AuthWebhookSignatureHeader))
+			assert.NoError(t, err)
+
+			var res types.AuthWebhookResponse
+			res.Allowed = r.Header.Get(types.AuthWebhookKeyIDHeader) == "key-1" &&
+				r.Header.Get(types.AuthWebhookAlgorithmHeader) == string(types.Ed25519) &&
+				ed25519.Verify(publicKey, body, signature)
+
+			_, err = res.Write(w)
+			assert.NoError(t, err)
+		}))
+
+		conf := helper.TestConfig(server.URL)
+		conf.Backend.AuthWebhookSigningAlgorithm = string(types.Ed25519)
+		conf.Backend.AuthWebhookSigningKeyFile = keyFile
+		conf.Backend.AuthWebhookSigningKeyID = "key-1"
+
+		agent, err := yorkie.New(conf)
+		assert.NoError(t, err)
+		assert.NoError(t, agent.Start())
+		defer func() { assert.NoError(t, agent.Shutdown(true)) }()
+
+		ctx := context.Background()
+		cli, err := client.Dial(agent.RPCAddr(), client.WithToken("token"))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+
+		assert.NoError(t, cli.Activate(ctx))
+	})
+
+	t.Run("HMAC signed authorization webhook request test", func(t *testing.T) {
+		secret := "hmac-secret"
+		var signatures []string
+		var mu sync.Mutex
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := ioutil.ReadAll(r.Body)
+			assert.NoError(t, err)
+
+			mu.Lock()
+			signatures = append(signatures, r.Header.Get(types.AuthWebhookSignatureHeader))
+			retried := len(signatures) > 1
+			mu.Unlock()
+
+			// the first request fails to check that the retry is signed too
+			if !retried {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+
+			mac := hmac.New(sha256.New, []byte(secret))
+			_, err = mac.Write(body)
+			assert.NoError(t, err)
+			signature, err := base64.StdEncoding.DecodeString(r.Header.Get(types.AuthWebhookSignatureHeader))
+			assert.NoError(t, err)
+
+			var res types.AuthWebhookResponse
+			res.Allowed = r.Header.Get(types.AuthWebhookAlgorithmHeader) == string(types.HMACSHA256) &&
+				hmac.Equal(mac.Sum(nil), signature)
+
+			_, err = res.Write(w)
+			assert.NoError(t, err)
+		}))
+
+		conf := helper.TestConfig(server.URL)
+		conf.Backend.AuthWebhookHMACSecret = secret
+		conf.Backend.AuthWebhookMaxRetries = 1
+
+		agent, err := yorkie.New(conf)
+		assert.NoError(t, err)
+		assert.NoError(t, agent.Start())
+		defer func() { assert.NoError(t, agent.Shutdown(true)) }()
+
+		ctx := context.Background()
+		cli, err := client.Dial(agent.RPCAddr(), client.WithToken("token"))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+
+		assert.NoError(t, cli.Activate(ctx))
+		assert.Len(t, signatures, 2)
+		assert.NotEmpty(t, signatures[0])
+		assert.Equal(t, signatures[0], signatures[1])
+	})
+
+	t.Run("unsigned authorization webhook request test", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var res types.AuthWebhookResponse
+			res.Allowed = r.Header.Get(types.AuthWebhookSignatureHeader) == "" &&
+				r.Header.Get(types.AuthWebhookAlgorithmHeader) == ""
+
+			_, err := res.Write(w)
+			assert.NoError(t, err)
+		}))
+
+		conf := helper.TestConfig(server.URL)
+		agent, err := yorkie.New(conf)
+		assert.NoError(t, err)
+		assert.NoError(t, agent.Start())
+		defer func() { assert.NoError(t, agent.Shutdown(true)) }()
+
+		ctx := context.Background()
+		cli, err := client.Dial(agent.RPCAddr(), client.WithToken("token"))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+
+		assert.NoError(t, cli.Activate(ctx))
+	})
+
+	t.Run("request id of authorization webhook test", func(t *testing.T) {
+		var mu sync.Mutex
+		var requestIDs []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			requestIDs = append(requestIDs, r.Header.Get(types.AuthWebhookRequestIDHeader))
+			mu.Unlock()
+
+			res := types.AuthWebhookResponse{Allowed: true}
+			_, err := res.Write(w)
+			assert.NoError(t, err)
+		}))
+
+		conf := helper.TestConfig(server.URL)
+		agent, err := yorkie.New(conf)
+		assert.NoError(t, err)
+		assert.NoError(t, agent.Start())
+		defer func() { assert.NoError(t, agent.Shutdown(true)) }()
+
+		ctx := context.Background()
+		cli, err := client.Dial(agent.RPCAddr(), client.WithToken("token"))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+
+		assert.NoError(t, cli.Activate(ctx))
+		doc := document.New(helper.Collection, t.Name())
+		assert.NoError(t, cli.Attach(ctx, doc))
+
+		// each RPC sends its own request ID.
+		mu.Lock()
+		defer mu.Unlock()
+		assert.Len(t, requestIDs, 2)
+		assert.NotEmpty(t, requestIDs[0])
+		assert.NotEmpty(t, requestIDs[1])
+		assert.NotEqual(t, requestIDs[0], requestIDs[1])
+	})
+
+	t.Run("custom auth provider test", func(t *testing.T) {
+		var mu sync.Mutex
+		var calls int
+		provider := authProviderFunc(func(req *types.AuthWebhookRequest) (*types.AuthWebhookResponse, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			calls++
+
+			// the first call fails to check that the provider is retried
+			if calls == 1 {
+				return nil, &auth.StatusCodeError{StatusCode: http.StatusServiceUnavailable}
+			}
+
+			if req.Token != "valid-token" {
+				return &types.AuthWebhookResponse{Reason: "invalid token"}, nil
+			}
+			return &types.AuthWebhookResponse{Allowed: true}, nil
+		})
+
+		conf := helper.TestConfig("")
+		conf.Backend.AuthWebhookMaxRetries = 1
+		agent, err := yorkie.New(conf, yorkie.WithAuthProvider(provider))
+		assert.NoError(t, err)
+		assert.NoError(t, agent.Start())
+		defer func() { assert.NoError(t, agent.Shutdown(true)) }()
+
+		ctx := context.Background()
+		cli, err := client.Dial(agent.RPCAddr(), client.WithToken("valid-token"))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+		assert.Equal(t, 2, calls)
+
+		// the result of the provider is cached
+		assert.NoError(t, cli.Deactivate(ctx))
+		assert.NoError(t, cli.Activate(ctx))
+		assert.Equal(t, 3, calls)
+
+		unauthCli, err := client.Dial(agent.RPCAddr(), client.WithToken("invalid-token"))
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, unauthCli.Close()) }()
+		err = unauthCli.Activate(ctx)
+		assert.Equal(t, codes.Unauthenticated, status.Convert(err).Code())
+	})
+
+	t.Run("check access test", func(t *testing.T) {
+		server, token := newAuthServer(t)
+
+		agent, err := yorkie.New(helper.TestConfig(server.URL))
+		assert.NoError(t, err)
+		assert.NoError(t, agent.Start())
+		defer func() { assert.NoError(t, agent.Shutdown(true)) }()
+
+		conn, err := grpc.Dial(agent.RPCAddr(), grpc.WithInsecure())
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, conn.Close()) }()
+		admin := api.NewAdminClient(conn)
+		adminCtx := metadata.AppendToOutgoingContext(
+			context.Background(),
+			"authorization",
+			helper.AdminToken,
+		)
+
+		req := &api.CheckAccessRequest{
+			Token:  token,
+			Method: string(types.PushPull),
+			Attributes: []*api.CheckAccessRequest_Attribute{{
+				DocumentKey: &api.DocumentKey{Collection: helper.Collection, Document: t.Name()},
+				Verb:        string(types.ReadWrite),
+			}},
+		}
+		resp, err := admin.CheckAccess(adminCtx, req)
+		assert.NoError(t, err)
+		assert.True(t, resp.Allowed)
+
+		req.Token = "invalid"
+		resp, err = admin.CheckAccess(adminCtx, req)
+		assert.NoError(t, err)
+		assert.False(t, resp.Allowed)
+		assert.Equal(t, "invalid token", resp.Reason)
+
+		// the access of the others can not be probed without the admin token
+		_, err = admin.CheckAccess(
+			metadata.AppendToOutgoingContext(context.Background(), "authorization", token),
+			req,
+		)
+		assert.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+
+		_, err = admin.CheckAccess(adminCtx, &api.CheckAccessRequest{Token: token, Method: "Unknown"})
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+
+		req.Attributes[0].Verb = "w"
+		_, err = admin.CheckAccess(adminCtx, req)
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+	})
 }
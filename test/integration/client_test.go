@@ -20,11 +20,17 @@ package integration
 
 import (
 	"context"
+	"crypto/ed25519"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/test/helper"
 )
 
 func TestClient(t *testing.T) {
@@ -66,4 +72,47 @@ func TestClient(t *testing.T) {
 		assert.NoError(t, err)
 		assert.False(t, cli.IsActive())
 	})
+
+	t.Run("signed changes test", func(t *testing.T) {
+		ctx := context.Background()
+		_, signingKey, err := ed25519.GenerateKey(nil)
+		assert.NoError(t, err)
+		_, otherKey, err := ed25519.GenerateKey(nil)
+		assert.NoError(t, err)
+
+		c1, err := client.Dial(
+			defaultAgent.RPCAddr(),
+			client.WithKey(t.Name()),
+			client.WithSigningKey(signingKey),
+		)
+		assert.NoError(t, err)
+		assert.NoError(t, c1.Activate(ctx))
+		c2, err := client.Dial(defaultAgent.RPCAddr())
+		assert.NoError(t, err)
+		assert.NoError(t, c2.Activate(ctx))
+		defer cleanupClients(t, []*client.Client{c1, c2})
+
+		d1 := document.New(helper.Collection, t.Name())
+		assert.NoError(t, c1.Attach(ctx, d1))
+		d2 := document.New(helper.Collection, t.Name())
+		assert.NoError(t, c2.Attach(ctx, d2))
+
+		assert.NoError(t, d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		syncClientsThenAssertEqual(t, []clientAndDocPair{{c1, d1}, {c2, d2}})
+
+		// NOTE: Another key can not be registered for the same client key.
+		c3, err := client.Dial(
+			defaultAgent.RPCAddr(),
+			client.WithKey(t.Name()),
+			client.WithSigningKey(otherKey),
+		)
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, c3.Close())
+		}()
+		assert.Equal(t, codes.FailedPrecondition, status.Convert(c3.Activate(ctx)).Code())
+	})
 }
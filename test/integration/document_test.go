@@ -23,6 +23,7 @@ import (
 	"io"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -30,6 +31,7 @@ import (
 	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/proxy"
 	"github.com/yorkie-team/yorkie/test/helper"
+	"github.com/yorkie-team/yorkie/yorkie"
 )
 
 func TestDocument(t *testing.T) {
@@ -135,6 +137,8 @@ func TestDocument(t *testing.T) {
 				assert.NoError(t, resp.Err)
 
 				if resp.Type == client.DocumentsChanged {
+					assert.Equal(t, uint64(0), resp.FromServerSeq)
+					assert.Equal(t, uint64(1), resp.ServerSeq)
 					err := c1.Sync(ctx, resp.Keys...)
 					assert.NoError(t, err)
 					return
@@ -157,3 +161,109 @@ func TestDocument(t *testing.T) {
 		assert.Equal(t, d1.Marshal(), d2.Marshal())
 	})
 }
+
+func TestDocumentWatchKeepalive(t *testing.T) {
+	conf := helper.TestConfig("")
+	conf.RPC.WatchKeepaliveInterval = "50ms"
+	agent, err := yorkie.New(conf)
+	assert.NoError(t, err)
+	assert.NoError(t, agent.Start())
+	defer func() { assert.NoError(t, agent.Shutdown(true)) }()
+
+	ctx := context.Background()
+	var clients []*client.Client
+	for i := 0; i < 2; i++ {
+		c, err := client.Dial(agent.RPCAddr())
+		assert.NoError(t, err)
+		assert.NoError(t, c.Activate(ctx))
+		clients = append(clients, c)
+	}
+	defer cleanupClients(t, clients)
+	c1, c2 := clients[0], clients[1]
+
+	d1 := document.New(helper.Collection, t.Name())
+	assert.NoError(t, c1.Attach(ctx, d1))
+	d2 := document.New(helper.Collection, t.Name())
+	assert.NoError(t, c2.Attach(ctx, d2))
+
+	rch, err := c1.Watch(ctx, d1)
+	assert.NoError(t, err)
+
+	// NOTE: Wait for several keepalives to make sure that they do not close
+	// the stream and are not delivered as watch responses.
+	time.Sleep(300 * time.Millisecond)
+
+	assert.NoError(t, d2.Update(func(root *proxy.ObjectProxy) error {
+		root.SetString("key", "value")
+		return nil
+	}))
+	assert.NoError(t, c2.Sync(ctx))
+
+	select {
+	case resp := <-rch:
+		assert.NoError(t, resp.Err)
+		assert.Equal(t, client.DocumentsChanged, resp.Type)
+	case <-time.After(2 * time.Second):
+		assert.Fail(t, "watch response is not delivered")
+	}
+}
+
+func TestDocumentWatchCatchup(t *testing.T) {
+	ctx := context.Background()
+	c1, err := client.Dial(defaultAgent.RPCAddr(), client.WithWatchCatchup())
+	assert.NoError(t, err)
+	assert.NoError(t, c1.Activate(ctx))
+	c2, err := client.Dial(defaultAgent.RPCAddr())
+	assert.NoError(t, err)
+	assert.NoError(t, c2.Activate(ctx))
+	defer cleanupClients(t, []*client.Client{c1, c2})
+
+	d1 := document.New(helper.Collection, t.Name())
+	assert.NoError(t, c1.Attach(ctx, d1))
+	d2 := document.New(helper.Collection, t.Name())
+	assert.NoError(t, c2.Attach(ctx, d2))
+
+	// 01. c1 falls behind before watching the document.
+	assert.NoError(t, d2.Update(func(root *proxy.ObjectProxy) error {
+		root.SetString("k1", "v1")
+		return nil
+	}))
+	assert.NoError(t, c2.Sync(ctx))
+
+	rch, err := c1.Watch(ctx, d1)
+	assert.NoError(t, err)
+
+	// 02. The watch asks c1 to catch up with the changes before it.
+	select {
+	case resp := <-rch:
+		assert.NoError(t, resp.Err)
+		assert.Equal(t, client.DocumentsChanged, resp.Type)
+	case <-time.After(2 * time.Second):
+		assert.Fail(t, "catchup response is not delivered")
+	}
+	assert.NoError(t, c1.Sync(ctx))
+	assert.Equal(t, d2.Marshal(), d1.Marshal())
+
+	// 03. The changes after the catchup are delivered exactly once.
+	assert.NoError(t, d2.Update(func(root *proxy.ObjectProxy) error {
+		root.SetString("k2", "v2")
+		return nil
+	}))
+	assert.NoError(t, c2.Sync(ctx))
+
+	select {
+	case resp := <-rch:
+		assert.NoError(t, resp.Err)
+		assert.Equal(t, client.DocumentsChanged, resp.Type)
+	case <-time.After(2 * time.Second):
+		assert.Fail(t, "watch response is not delivered")
+	}
+	assert.NoError(t, c1.Sync(ctx))
+	assert.Equal(t, d2.Marshal(), d1.Marshal())
+
+	select {
+	case resp := <-rch:
+		assert.Fail(t, "unexpected watch response", resp.Type)
+	case <-time.After(300 * time.Millisecond):
+	}
+}
@@ -21,9 +21,14 @@ package integration
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/yorkie-team/yorkie/test/helper"
+	"github.com/yorkie-team/yorkie/yorkie"
 )
 
 func TestHealthCheck(t *testing.T) {
@@ -38,3 +43,29 @@ func TestHealthCheck(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, resp.Status, healthpb.HealthCheckResponse_SERVING)
 }
+
+func TestHealthCheckWarmup(t *testing.T) {
+	conf := helper.TestConfig("")
+	conf.RPC.WarmupDelay = "500ms"
+	agent, err := yorkie.New(conf)
+	assert.NoError(t, err)
+	assert.NoError(t, agent.Start())
+	defer func() { assert.NoError(t, agent.Shutdown(true)) }()
+
+	conn, err := grpc.Dial(agent.RPCAddr(), grpc.WithInsecure())
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, conn.Close())
+	}()
+
+	cli := healthpb.NewHealthClient(conn)
+	resp, err := cli.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, resp.Status)
+
+	assert.Eventually(t, func() bool {
+		resp, err := cli.Check(context.Background(), &healthpb.HealthCheckRequest{})
+		assert.NoError(t, err)
+		return resp.Status == healthpb.HealthCheckResponse_SERVING
+	}, 2*time.Second, 50*time.Millisecond)
+}
@@ -123,4 +123,82 @@ func TestPeerAwareness(t *testing.T) {
 
 		assert.Equal(t, expected, responsePairs)
 	})
+
+	t.Run("PeersChanged event on attach and detach test", func(t *testing.T) {
+		ctx := context.Background()
+
+		d1 := document.New(helper.Collection, t.Name())
+		d2 := document.New(helper.Collection, t.Name())
+		assert.NoError(t, c1.Attach(ctx, d1))
+		defer func() { assert.NoError(t, c1.Detach(ctx, d1)) }()
+
+		var responsePairs []watchResponsePair
+		wgEvents := sync.WaitGroup{}
+		wgEvents.Add(1)
+
+		watch1Ctx, cancel1 := context.WithCancel(ctx)
+		defer cancel1()
+		wrch, err := c1.Watch(watch1Ctx, d1)
+		assert.NoError(t, err)
+		go func() {
+			defer wgEvents.Done()
+			for {
+				select {
+				case <-time.After(time.Second):
+					assert.Fail(t, "timeout")
+					return
+				case wr := <-wrch:
+					if wr.Err != nil {
+						assert.Fail(t, "unexpected stream closing", wr.Err)
+						return
+					}
+
+					if wr.Type == client.PeersChanged {
+						responsePairs = append(responsePairs, watchResponsePair{
+							Type:  wr.Type,
+							Peers: wr.PeersMapByDoc[d1.Key().BSONKey()],
+						})
+
+						if len(responsePairs) == 3 {
+							return
+						}
+					}
+				}
+			}
+		}()
+
+		// 01. PeersChanged is triggered when another client attaches the
+		//     document, with the current peers of the document.
+		assert.NoError(t, c2.Attach(ctx, d2))
+
+		// 02. PeersChanged is triggered when the client watches the document.
+		watch2Ctx, cancel2 := context.WithCancel(ctx)
+		defer cancel2()
+		_, err = c2.Watch(watch2Ctx, d2)
+		assert.NoError(t, err)
+
+		// 03. PeersChanged is triggered when the client detaches the document,
+		//     without the client in the peers.
+		assert.NoError(t, c2.Detach(ctx, d2))
+
+		wgEvents.Wait()
+
+		assert.Equal(t, []watchResponsePair{
+			{
+				Type:  client.PeersChanged,
+				Peers: map[string]types.Metadata{c1.ID().String(): c1.Metadata()},
+			},
+			{
+				Type: client.PeersChanged,
+				Peers: map[string]types.Metadata{
+					c1.ID().String(): c1.Metadata(),
+					c2.ID().String(): c2.Metadata(),
+				},
+			},
+			{
+				Type:  client.PeersChanged,
+				Peers: map[string]types.Metadata{c1.ID().String(): c1.Metadata()},
+			},
+		}, responsePairs)
+	})
 }
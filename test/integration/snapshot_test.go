@@ -19,13 +19,19 @@
 package integration
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/proxy"
 	"github.com/yorkie-team/yorkie/test/helper"
@@ -120,4 +126,106 @@ func TestSnapshot(t *testing.T) {
 		assert.Equal(t, `{"k1":"하늘구름"}`, d1.Marshal())
 		assert.Equal(t, d1.Marshal(), d2.Marshal())
 	})
+	t.Run("pull changes over max changes per pull test", func(t *testing.T) {
+		ctx := context.Background()
+
+		d1 := document.New(helper.Collection, t.Name())
+		err := c1.Attach(ctx, d1)
+		assert.NoError(t, err)
+
+		d2 := document.New(helper.Collection, t.Name())
+		err = c2.Attach(ctx, d2)
+		assert.NoError(t, err)
+
+		// 01. Update changes over max changes per pull, but under snapshot threshold.
+		for i := 0; i < helper.MaxChangesPerPull+2; i++ {
+			err := d1.Update(func(root *proxy.ObjectProxy) error {
+				root.SetInteger(fmt.Sprintf("%d", i), i)
+				return nil
+			})
+			assert.NoError(t, err)
+		}
+		err = c1.Sync(ctx)
+		assert.NoError(t, err)
+
+		// 02. Makes local changes then pull the changes in several responses.
+		err = d2.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("key", "value")
+			return nil
+		})
+		assert.NoError(t, err)
+
+		err = c2.Sync(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, `"value"`, d2.RootObject().Get("key").Marshal())
+		assert.Equal(t, d1.RootObject().Get("0").Marshal(), d2.RootObject().Get("0").Marshal())
+
+		syncClientsThenAssertEqual(t, []clientAndDocPair{{c1, d1}, {c2, d2}})
+	})
+	t.Run("resume snapshot download test", func(t *testing.T) {
+		ctx := context.Background()
+
+		d1 := document.New(helper.Collection, t.Name())
+		err := c1.Attach(ctx, d1)
+		assert.NoError(t, err)
+
+		// 01. Store a snapshot larger than a single chunk.
+		err = d1.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", strings.Repeat("a", 100*1024))
+			return nil
+		})
+		assert.NoError(t, err)
+		err = c1.Sync(ctx)
+		assert.NoError(t, err)
+
+		var whole bytes.Buffer
+		assert.Eventually(t, func() bool {
+			whole.Reset()
+			_, _, err := c2.FetchSnapshot(ctx, d1.Key(), 0, 0, &whole)
+			return err == nil
+		}, time.Second, 10*time.Millisecond)
+
+		obj, err := converter.BytesToObject(whole.Bytes())
+		assert.NoError(t, err)
+		assert.Equal(t, d1.Marshal(), obj.Marshal())
+
+		// 02. Fail the transfer midway, then resume it from the offset.
+		var resumed bytes.Buffer
+		serverSeq, offset, err := c2.FetchSnapshot(ctx, d1.Key(), 0, 0, &limitedWriter{
+			w:     &resumed,
+			limit: 1000,
+		})
+		assert.Error(t, err)
+		assert.Equal(t, uint64(1000), offset)
+
+		_, offset, err = c2.FetchSnapshot(ctx, d1.Key(), serverSeq, offset, &resumed)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(whole.Len()), offset)
+		assert.Equal(t, whole.Bytes(), resumed.Bytes())
+
+		// 03. The offset beyond the snapshot or the missing snapshot is rejected.
+		_, _, err = c2.FetchSnapshot(ctx, d1.Key(), serverSeq, offset+1, &resumed)
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+		_, _, err = c2.FetchSnapshot(ctx, d1.Key(), serverSeq+1, 0, &resumed)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+	})
+}
+
+// limitedWriter is a writer that fails after writing the given number of
+// bytes.
+type limitedWriter struct {
+	w     io.Writer
+	limit int
+}
+
+func (w *limitedWriter) Write(p []byte) (int, error) {
+	if len(p) > w.limit {
+		n, _ := w.w.Write(p[:w.limit])
+		w.limit -= n
+		return n, io.ErrShortWrite
+	}
+
+	n, err := w.w.Write(p)
+	w.limit -= n
+	return n, err
 }
@@ -0,0 +1,48 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+
+	"github.com/yorkie-team/yorkie/yorkie/backend"
+)
+
+var (
+	// ErrAdminDisabled is returned when the admin token is not configured.
+	ErrAdminDisabled = errors.New("admin RPCs are disabled")
+
+	// ErrNotAdmin is returned when the given token is not the admin token.
+	ErrNotAdmin = errors.New("token is not the admin token")
+)
+
+// VerifyAdmin verifies whether the token of the given context is the admin
+// token of the backend.
+func VerifyAdmin(ctx context.Context, be *backend.Backend) error {
+	if len(be.Config.AdminToken) == 0 {
+		return ErrAdminDisabled
+	}
+
+	token := TokenFromCtx(ctx)
+	if subtle.ConstantTimeCompare([]byte(token), []byte(be.Config.AdminToken)) != 1 {
+		return ErrNotAdmin
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package auth
+
+import (
+	"context"
+	"sync"
+
+	"github.com/rs/xid"
+
+	"github.com/yorkie-team/yorkie/pkg/types"
+	"github.com/yorkie-team/yorkie/yorkie/backend"
+	"github.com/yorkie-team/yorkie/yorkie/logging"
+)
+
+// webhookContextsMu guards loading and creating the webhook contexts so that
+// a token has only one context for a method at a time.
+var webhookContextsMu sync.Mutex
+
+// webhookContext is the last authorized attributes of a token for a method
+// shared with the authorization webhook in the delta mode. The context is kept
+// per method because the methods are called with different attributes, e.g.
+// WatchDocuments with all attached documents and PushPull with one of them.
+type webhookContext struct {
+	// NOTE: mu is held while the request is sent so that the requests of the
+	// same token are computed against the context one by one.
+	mu sync.Mutex
+
+	id         string
+	revision   uint64
+	synced     bool
+	attributes []types.AccessAttribute
+}
+
+// loadWebhookContext returns the context of the given token and method. A new
+// context is created if there is no context or the resync interval has passed.
+func loadWebhookContext(be *backend.Backend, token string, method types.Method) *webhookContext {
+	webhookContextsMu.Lock()
+	defer webhookContextsMu.Unlock()
+
+	key := string(method) + ":" + token
+	if entry, ok := be.AuthWebhookContexts.Get(key); ok {
+		return entry.(*webhookContext)
+	}
+
+	webhookCtx := &webhookContext{
+		id: xid.New().String(),
+	}
+	be.AuthWebhookContexts.Add(key, webhookCtx, be.Config.ParseAuthWebhookDeltaResyncInterval())
+	return webhookCtx
+}
+
+// newRequest creates a request for the given request against this context. It
+// is a delta only if the difference is smaller than the whole attributes.
+func (c *webhookContext) newRequest(req *types.AuthWebhookRequest) *types.AuthWebhookRequest {
+	if !c.synced {
+		return c.newFullRequest(req)
+	}
+
+	base := make(map[types.AccessAttribute]bool)
+	for _, attr := range c.attributes {
+		base[attr] = true
+	}
+	current := make(map[types.AccessAttribute]bool)
+	var added []types.AccessAttribute
+	for _, attr := range req.Attributes {
+		current[attr] = true
+		if !base[attr] {
+			added = append(added, attr)
+		}
+	}
+
+	var removed []types.AccessAttribute
+	for _, attr := range c.attributes {
+		if !current[attr] {
+			removed = append(removed, attr)
+		}
+	}
+
+	if len(added)+len(removed) >= len(req.Attributes) {
+		return c.newFullRequest(req)
+	}
+
+	return &types.AuthWebhookRequest{
+		Token:             req.Token,
+		Method:            req.Method,
+		Attributes:        added,
+		ContextID:         c.id,
+		Revision:          c.revision + 1,
+		Delta:             true,
+		RemovedAttributes: removed,
+	}
+}
+
+// newFullRequest creates a request that replaces this context with the whole
+// attributes of the given request.
+func (c *webhookContext) newFullRequest(req *types.AuthWebhookRequest) *types.AuthWebhookRequest {
+	return &types.AuthWebhookRequest{
+		Token:      req.Token,
+		Method:     req.Method,
+		Attributes: req.Attributes,
+		ContextID:  c.id,
+		Revision:   c.revision + 1,
+	}
+}
+
+// update updates this context with the attributes of the allowed request.
+func (c *webhookContext) update(revision uint64, attrs []types.AccessAttribute) {
+	c.revision = revision
+	c.synced = true
+	c.attributes = attrs
+}
+
+// sendDeltaWebhookRequest sends the given request to the authorization webhook
+// in the delta mode. If the webhook asks for a resync, the whole attributes
+// are sent again. If the request is not allowed or fails, the next request of
+// the token sends the whole attributes.
+func sendDeltaWebhookRequest(
+	ctx context.Context,
+	be *backend.Backend,
+	req *types.AuthWebhookRequest,
+) (*types.AuthWebhookResponse, error) {
+	// NOTE: The requests without attributes such as ActivateClient have
+	// nothing to reduce, so they are sent without the context.
+	if len(req.Attributes) == 0 {
+		return verify(ctx, be, req)
+	}
+
+	webhookCtx := loadWebhookContext(be, req.Token, req.Method)
+	webhookCtx.mu.Lock()
+	defer webhookCtx.mu.Unlock()
+
+	deltaReq := webhookCtx.newRequest(req)
+	authResp, err := verify(ctx, be, deltaReq)
+	if deltaReq.Delta && authResp != nil && authResp.Resync {
+		logging.From(ctx).Infof("AUTH: resync context %s of revision %d", webhookCtx.id, webhookCtx.revision)
+		deltaReq = webhookCtx.newFullRequest(req)
+		authResp, err = verify(ctx, be, deltaReq)
+	}
+	if err != nil {
+		// NOTE: The webhook may or may not have updated its context when the
+		// request fails, so the whole attributes are sent next time.
+		webhookCtx.synced = false
+		return authResp, err
+	}
+
+	webhookCtx.update(deltaReq.Revision, req.Attributes)
+	return authResp, nil
+}
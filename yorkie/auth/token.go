@@ -24,7 +24,8 @@ const tokenKey key = 0
 
 // TokenFromCtx returns the tokenKey from the given context.
 func TokenFromCtx(ctx context.Context) string {
-	return ctx.Value(tokenKey).(string)
+	token, _ := ctx.Value(tokenKey).(string)
+	return token
 }
 
 // CtxWithToken creates a new context with the given token.
@@ -19,15 +19,22 @@ package auth
 import (
 	"bytes"
 	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"math"
 	"net/http"
+	"strconv"
+	"strings"
 	"syscall"
-	"time"
+	gotime "time"
 
 	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/retry"
 	"github.com/yorkie-team/yorkie/pkg/types"
 	"github.com/yorkie-team/yorkie/yorkie/backend"
 	"github.com/yorkie-team/yorkie/yorkie/logging"
@@ -42,137 +49,490 @@ var (
 
 	// ErrWebhookTimeout is returned when the webhook does not respond in time.
 	ErrWebhookTimeout = errors.New("webhook timeout")
+
+	// ErrInvalidMethod is returned when the given method can not be used for
+	// authorization.
+	ErrInvalidMethod = errors.New("invalid method for authorization")
+
+	// ErrInvalidVerb is returned when the given verb of an access attribute
+	// is neither read nor read-write.
+	ErrInvalidVerb = errors.New("invalid verb for authorization")
+
+	// ErrWebhookBodyTooLarge is returned when the body of the webhook request
+	// exceeds MaxAuthWebhookBodyBytes.
+	ErrWebhookBodyTooLarge = errors.New("webhook request body is too large")
+
+	// errAttemptTimeout is returned when an attempt of the webhook does not
+	// respond within the timeout of the method. It is retried.
+	errAttemptTimeout = errors.New("webhook attempt timeout")
 )
 
-// AccessAttributes returns an array of AccessAttribute from the given pack.
-func AccessAttributes(pack *change.Pack) []types.AccessAttribute {
-	verb := types.Read
-	if pack.HasChanges() {
-		verb = types.ReadWrite
+// StatusCodeError is returned by the AuthProvider when the response has a
+// status code other than 200. It is retried if the status code is transient,
+// so the custom providers can also return it to be retried.
+type StatusCodeError struct {
+	StatusCode int
+
+	// RetryAfter is the interval to wait before the next retry requested by
+	// the webhook. If it is zero, the interval grows exponentially.
+	RetryAfter gotime.Duration
+}
+
+// Error returns the message of this error.
+func (e *StatusCodeError) Error() string {
+	return fmt.Sprintf("unexpected status code from webhook: %d", e.StatusCode)
+}
+
+// Unwrap returns ErrUnexpectedStatusCode.
+func (e *StatusCodeError) Unwrap() error {
+	return ErrUnexpectedStatusCode
+}
+
+// WaitHint returns the interval to wait before the next retry.
+func (e *StatusCodeError) WaitHint() gotime.Duration {
+	return e.RetryAfter
+}
+
+// AccessAttributes returns an array of AccessAttribute from the given packs.
+// If several packs are of the same document, they are combined into one
+// attribute with the widest verb.
+func AccessAttributes(packs ...*change.Pack) []types.AccessAttribute {
+	var attrs []types.AccessAttribute
+	indexes := make(map[string]int)
+	for _, pack := range packs {
+		verb := types.Read
+		if pack.HasChanges() {
+			verb = types.ReadWrite
+		}
+
+		docKey := pack.DocumentKey.BSONKey()
+		if i, ok := indexes[docKey]; ok {
+			if verb == types.ReadWrite {
+				attrs[i].Verb = verb
+			}
+			continue
+		}
+
+		indexes[docKey] = len(attrs)
+		attrs = append(attrs, types.AccessAttribute{
+			Key:  docKey,
+			Verb: verb,
+		})
 	}
 
-	// NOTE(hackerwins): In the future, methods such as bulk PushPull can be
-	// added, so we declare it as an array.
-	return []types.AccessAttribute{{
-		Key:  pack.DocumentKey.BSONKey(),
-		Verb: verb,
-	}}
+	return attrs
 }
 
-// VerifyAccess verifies the given access.
+// VerifyAccess verifies the given access. If the webhook denies only some of
+// the attributes, the error names the keys of the denied attributes. In the
+// dry-run mode, the decision is only logged and nil is returned.
 func VerifyAccess(ctx context.Context, be *backend.Backend, info *types.AccessInfo) error {
-	if !be.Config.RequireAuth(info.Method) {
-		return nil
+	if be.RequireAuth(info.Method) {
+		logging.From(ctx).Debugf("AUTH: verify %s with %d attributes", info.Method, len(info.Attributes))
+	}
+
+	err := verifyAccess(ctx, be, info)
+	if !be.Config.AuthWebhookDryRun {
+		return err
 	}
 
-	reqBody, err := json.Marshal(types.AuthWebhookRequest{
-		Token:      TokenFromCtx(ctx),
+	if err != nil {
+		logging.From(ctx).Warnf("AUTH: dry run: %s would be denied: %s", info.Method, err)
+	} else if be.RequireAuth(info.Method) {
+		logging.From(ctx).Debugf("AUTH: dry run: %s would be allowed", info.Method)
+	}
+	return nil
+}
+
+// verifyAccess verifies the given access and returns the error of the
+// decision.
+func verifyAccess(ctx context.Context, be *backend.Backend, info *types.AccessInfo) error {
+	authResp, err := authorize(ctx, be, TokenFromCtx(ctx), info, be.AuthWebhookContexts != nil)
+	if err != nil {
+		return err
+	}
+
+	if !authResp.Allowed {
+		if len(authResp.DeniedAttributes) > 0 {
+			var keys []string
+			for _, attr := range authResp.DeniedAttributes {
+				keys = append(keys, attr.Key)
+			}
+			return fmt.Errorf("%s: %s: %w", strings.Join(keys, ", "), authResp.Reason, ErrNotAllowed)
+		}
+
+		return fmt.Errorf("%s: %w", authResp.Reason, ErrNotAllowed)
+	}
+
+	return nil
+}
+
+// VerifyPacksAccess verifies the access of the given method to the documents
+// of the given packs at once. The combined attributes are sent in a single
+// request and the result is cached for the combination.
+func VerifyPacksAccess(
+	ctx context.Context,
+	be *backend.Backend,
+	method types.Method,
+	packs []*change.Pack,
+) error {
+	return VerifyAccess(ctx, be, &types.AccessInfo{
+		Method:     method,
+		Attributes: AccessAttributes(packs...),
+	})
+}
+
+// CheckAccess returns the decision of the webhook for the given access with
+// the given token without performing the access. The decision is cached in
+// the same way as VerifyAccess.
+//
+// NOTE: The full attributes are always sent even in the delta mode, because
+// the webhook updates the context of the token when a delta is allowed.
+func CheckAccess(
+	ctx context.Context,
+	be *backend.Backend,
+	token string,
+	info *types.AccessInfo,
+) (*types.AuthWebhookResponse, error) {
+	return authorize(ctx, be, token, info, false)
+}
+
+// authorize returns the response of the provider for the given access from
+// the cache or the provider. If the access is not allowed, the response is
+// returned without an error.
+func authorize(
+	ctx context.Context,
+	be *backend.Backend,
+	token string,
+	info *types.AccessInfo,
+	delta bool,
+) (*types.AuthWebhookResponse, error) {
+	if !be.RequireAuth(info.Method) {
+		return &types.AuthWebhookResponse{Allowed: true}, nil
+	}
+
+	req := &types.AuthWebhookRequest{
+		Token:      token,
 		Method:     info.Method,
 		Attributes: info.Attributes,
-	})
+	}
+	reqBody, err := json.Marshal(req)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	cacheKey := string(reqBody)
 	if entry, ok := be.AuthWebhookCache.Get(cacheKey); ok {
-		resp := entry.(*types.AuthWebhookResponse)
-		if !resp.Allowed {
-			return fmt.Errorf("%s: %w", resp.Reason, ErrNotAllowed)
-		}
-		return nil
+		be.Metrics.AddAuthWebhookCacheHits(1)
+		return entry.(*types.AuthWebhookResponse), nil
 	}
+	be.Metrics.AddAuthWebhookCacheMisses(1)
+	defer func() {
+		be.Metrics.SetAuthWebhookCacheEntries(be.AuthWebhookCache.Len())
+	}()
 
 	var authResp *types.AuthWebhookResponse
-	if err := withExponentialBackoff(ctx, be.Config, func() (int, error) {
-		resp, err := http.Post(
-			be.Config.AuthWebhookURL,
-			"application/json",
-			bytes.NewBuffer(reqBody),
-		)
-		if err != nil {
-			return 0, err
+	if delta {
+		authResp, err = sendDeltaWebhookRequest(ctx, be, req)
+	} else {
+		authResp, err = verify(ctx, be, req)
+	}
+	if err != nil {
+		if errors.Is(err, ErrNotAllowed) {
+			be.AuthWebhookCache.Add(
+				cacheKey,
+				authResp,
+				be.Config.AuthWebhookCacheTTLOf(authResp.CacheTTLOf(be.Config.ParseAuthWebhookCacheUnauthTTL())),
+			)
+			return authResp, nil
 		}
 
-		defer func() {
-			if err := resp.Body.Close(); err != nil {
-				logging.From(ctx).Error(err)
-			}
-		}()
+		return nil, err
+	}
 
-		if http.StatusOK != resp.StatusCode {
-			return resp.StatusCode, ErrUnexpectedStatusCode
-		}
+	be.AuthWebhookCache.Add(
+		cacheKey,
+		authResp,
+		be.Config.AuthWebhookCacheTTLOf(authResp.CacheTTLOf(be.Config.ParseAuthWebhookCacheAuthTTL())),
+	)
+
+	return authResp, nil
+}
+
+// verify verifies the given request with the provider of the backend with
+// retries. If the request is not allowed, the response is returned with
+// ErrNotAllowed.
+func verify(
+	ctx context.Context,
+	be *backend.Backend,
+	req *types.AuthWebhookRequest,
+) (*types.AuthWebhookResponse, error) {
+	provider := be.AuthProvider
+	if provider == nil {
+		provider = &webhookProvider{be: be}
+	}
+
+	timeout := be.Config.AuthWebhookTimeoutOf(req.Method)
 
-		authResp, err = types.NewAuthWebhookResponse(resp.Body)
+	var authResp *types.AuthWebhookResponse
+	if err := withExponentialBackoff(ctx, be.Config, func() error {
+		resp, err := verifyAttempt(ctx, provider, req, timeout)
 		if err != nil {
-			return resp.StatusCode, err
+			return err
 		}
 
+		authResp = resp
 		if !authResp.Allowed {
-			return resp.StatusCode, fmt.Errorf("%s: %w", authResp.Reason, ErrNotAllowed)
+			return fmt.Errorf("%s: %w", authResp.Reason, ErrNotAllowed)
 		}
 
-		return resp.StatusCode, nil
+		return nil
 	}); err != nil {
-		if errors.Is(err, ErrNotAllowed) {
-			be.AuthWebhookCache.Add(cacheKey, authResp, be.Config.ParseAuthWebhookCacheUnauthTTL())
-		}
+		return authResp, err
+	}
 
-		return err
+	return authResp, nil
+}
+
+// verifyAttempt verifies the given request with the provider once. If the
+// timeout is given, the attempt is bounded by it. If the given context is
+// done, e.g. the client has disconnected, the error of the context is
+// returned so that the attempt is not retried.
+func verifyAttempt(
+	ctx context.Context,
+	provider backend.AuthProvider,
+	req *types.AuthWebhookRequest,
+	timeout gotime.Duration,
+) (*types.AuthWebhookResponse, error) {
+	attemptCtx := ctx
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		attemptCtx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
 	}
 
-	be.AuthWebhookCache.Add(cacheKey, authResp, be.Config.ParseAuthWebhookCacheAuthTTL())
+	resp, err := provider.Verify(attemptCtx, req)
+	if err == nil {
+		return resp, nil
+	}
 
-	return nil
+	if ctx.Err() != nil {
+		return nil, fmt.Errorf("%s: %w", req.Method, ctx.Err())
+	}
+	if errors.Is(attemptCtx.Err(), context.DeadlineExceeded) {
+		return nil, fmt.Errorf("%s %s: %w", req.Method, timeout, errAttemptTimeout)
+	}
+	return resp, err
 }
 
-func withExponentialBackoff(ctx context.Context, cfg *backend.Config, webhookFn func() (int, error)) error {
-	var retries uint64
-	var statusCode int
-	for retries <= cfg.AuthWebhookMaxRetries {
-		statusCode, err := webhookFn()
-		if !shouldRetry(statusCode, err) {
-			if err == ErrUnexpectedStatusCode {
-				return fmt.Errorf("unexpected status code from webhook: %d", statusCode)
-			}
+// webhookProvider is the AuthProvider that sends the request to the
+// authorization webhook over HTTP. It is used if no provider is set.
+type webhookProvider struct {
+	be *backend.Backend
+}
 
-			return err
+// Verify sends the given request to the authorization webhook.
+func (p *webhookProvider) Verify(
+	ctx context.Context,
+	req *types.AuthWebhookRequest,
+) (*types.AuthWebhookResponse, error) {
+	reqBody, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	maxBytes := p.be.Config.MaxAuthWebhookBodyBytes
+	if maxBytes > 0 && uint64(len(reqBody)) > maxBytes {
+		return nil, fmt.Errorf("%d > %d bytes: %w", len(reqBody), maxBytes, ErrWebhookBodyTooLarge)
+	}
+
+	// NOTE: The signatures of the supported algorithms are deterministic, so
+	// the retries of the same request carry the same signature.
+	header, err := signatureHeader(p.be, reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq, err := newWebhookRequest(ctx, p.be, reqBody, header)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: The request is aborted as soon as the context is canceled. If it
+	// is canceled before the response, Do closes the body by itself.
+	// Otherwise, the body is closed below even if reading it is aborted.
+	resp, err := p.be.AuthWebhookClient.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			logging.From(ctx).Error(err)
+		}
+	}()
+
+	if http.StatusOK != resp.StatusCode {
+		return nil, &StatusCodeError{
+			StatusCode: resp.StatusCode,
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), gotime.Now()),
 		}
+	}
 
-		waitBeforeRetry := waitInterval(retries, cfg.ParseAuthWebhookMaxWaitInterval())
+	return types.NewAuthWebhookResponse(resp.Body)
+}
+
+// parseRetryAfter parses the given value of the Retry-After header, which is
+// either the seconds to wait or the HTTP-date after which to retry. It returns
+// zero if the value is empty or invalid.
+func parseRetryAfter(value string, now gotime.Time) gotime.Duration {
+	if value == "" {
+		return 0
+	}
 
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		case <-time.After(waitBeforeRetry):
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
 		}
+		return gotime.Duration(seconds) * gotime.Second
+	}
 
-		retries++
+	date, err := http.ParseTime(value)
+	if err != nil || !date.After(now) {
+		return 0
 	}
+	return date.Sub(now)
+}
 
-	return fmt.Errorf("unexpected status code from webhook %d: %w", statusCode, ErrWebhookTimeout)
+// newWebhookRequest creates a new request to the authorization webhook with
+// the given body and the signature headers.
+func newWebhookRequest(
+	ctx context.Context,
+	be *backend.Backend,
+	body []byte,
+	header http.Header,
+) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		be.Config.AuthWebhookURL,
+		bytes.NewBuffer(body),
+	)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if id := logging.RequestIDFrom(ctx); id != "" {
+		req.Header.Set(types.AuthWebhookRequestIDHeader, id)
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+
+	return req, nil
 }
 
-// waitInterval returns the interval of given retries. (2^retries * 100) milliseconds.
-func waitInterval(retries uint64, maxWaitInterval time.Duration) time.Duration {
-	interval := time.Duration(math.Pow(2, float64(retries))) * 100 * time.Millisecond
-	if maxWaitInterval < interval {
-		return maxWaitInterval
+// signatureHeader returns the headers that carry the signature of the given
+// body. The body is signed with the HMAC secret or the signer if one of them
+// is configured, otherwise the headers are empty.
+func signatureHeader(be *backend.Backend, body []byte) (http.Header, error) {
+	header := http.Header{}
+
+	if be.Config.AuthWebhookHMACSecret != "" {
+		mac := hmac.New(sha256.New, []byte(be.Config.AuthWebhookHMACSecret))
+		if _, err := mac.Write(body); err != nil {
+			return nil, err
+		}
+
+		header.Set(types.AuthWebhookSignatureHeader, base64.StdEncoding.EncodeToString(mac.Sum(nil)))
+		header.Set(types.AuthWebhookAlgorithmHeader, string(types.HMACSHA256))
+		return header, nil
 	}
 
-	return interval
+	if be.AuthWebhookSigner == nil {
+		return header, nil
+	}
+
+	// NOTE: Ed25519 signs the message itself without hashing, so crypto.Hash(0)
+	// is given as the signer options.
+	signature, err := be.AuthWebhookSigner.Sign(rand.Reader, body, crypto.Hash(0))
+	if err != nil {
+		return nil, err
+	}
+
+	header.Set(types.AuthWebhookSignatureHeader, base64.StdEncoding.EncodeToString(signature))
+	header.Set(types.AuthWebhookAlgorithmHeader, be.Config.AuthWebhookSigningAlgorithm)
+	header.Set(types.AuthWebhookKeyIDHeader, be.Config.AuthWebhookSigningKeyID)
+
+	return header, nil
 }
 
-// shouldRetry returns true if the given error should be retried.
+// withExponentialBackoff calls the given webhookFn with retries. The error
+// returned by webhookFn determines whether it should be retried.
+func withExponentialBackoff(ctx context.Context, cfg *backend.Config, webhookFn func() error) error {
+	var statusCode int
+	err := retry.WithExponentialBackoff(
+		ctx,
+		cfg.AuthWebhookMaxRetries,
+		cfg.ParseAuthWebhookMaxWaitInterval(),
+		func(err error) bool {
+			return shouldRetry(cfg.AuthWebhookRetryableStatusCodes, err)
+		},
+		func() error {
+			err := webhookFn()
+			statusCode = 0
+			var statusErr *StatusCodeError
+			if errors.As(err, &statusErr) {
+				statusCode = statusErr.StatusCode
+			}
+			return err
+		},
+	)
+	if errors.Is(err, retry.ErrRetriesExhausted) {
+		return fmt.Errorf("unexpected status code from webhook %d: %w", statusCode, ErrWebhookTimeout)
+	}
+	if errors.Is(err, ErrUnexpectedStatusCode) {
+		return fmt.Errorf("unexpected status code from webhook: %d", statusCode)
+	}
+
+	return err
+}
+
+// shouldRetry returns true if the given error should be retried. The status
+// codes in the given retryable codes are retried, or the default set if it is
+// empty.
 // Refer to https://github.com/kubernetes/kubernetes/search?q=DefaultShouldRetry
-func shouldRetry(statusCode int, err error) bool {
+func shouldRetry(retryableCodes []int, err error) bool {
+	// If the request is canceled, the result is no longer needed.
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
 	// If the connection is reset, we should retry.
 	var errno syscall.Errno
 	if errors.As(err, &errno) {
 		return errno == syscall.ECONNRESET
 	}
 
+	if errors.Is(err, errAttemptTimeout) {
+		return true
+	}
+
+	var statusErr *StatusCodeError
+	if !errors.As(err, &statusErr) {
+		return false
+	}
+
+	statusCode := statusErr.StatusCode
+	if len(retryableCodes) > 0 {
+		for _, code := range retryableCodes {
+			if code == statusCode {
+				return true
+			}
+		}
+		return false
+	}
+
 	return statusCode == http.StatusInternalServerError ||
 		statusCode == http.StatusServiceUnavailable ||
 		statusCode == http.StatusGatewayTimeout ||
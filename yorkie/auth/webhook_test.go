@@ -0,0 +1,395 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package auth_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	gotime "time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/types"
+	"github.com/yorkie-team/yorkie/test/helper"
+	"github.com/yorkie-team/yorkie/yorkie/auth"
+	"github.com/yorkie-team/yorkie/yorkie/backend"
+	"github.com/yorkie-team/yorkie/yorkie/backend/housekeeping"
+	"github.com/yorkie-team/yorkie/yorkie/profiling/prometheus"
+)
+
+func TestWebhook(t *testing.T) {
+	newBackend := func(t *testing.T, webhookURL string, opts ...func(*backend.Config)) *backend.Backend {
+		met, err := prometheus.NewMetrics()
+		assert.NoError(t, err)
+
+		conf := &backend.Config{
+			AuthWebhookURL:             webhookURL,
+			AuthWebhookMaxWaitInterval: helper.AuthWebhookMaxWaitInterval.String(),
+			AuthWebhookCacheSize:       helper.AuthWebhookSize,
+			AuthWebhookCacheAuthTTL:    helper.AuthWebhookCacheAuthTTL.String(),
+			AuthWebhookCacheUnauthTTL:  helper.AuthWebhookCacheUnauthTTL.String(),
+		}
+		for _, opt := range opts {
+			opt(conf)
+		}
+
+		be, err := backend.New(conf, nil, nil, nil, &housekeeping.Config{
+			Interval:            helper.HousekeepingInterval.String(),
+			DeactivateThreshold: helper.HousekeepingDeactivateThreshold.String(),
+			CandidatesLimit:     helper.HousekeepingCandidatesLimit,
+		}, "", met)
+		assert.NoError(t, err)
+		t.Cleanup(func() {
+			assert.NoError(t, be.Shutdown())
+		})
+		return be
+	}
+
+	t.Run("cache metrics test", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			res := types.AuthWebhookResponse{Allowed: true}
+			_, err := res.Write(w)
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+		be := newBackend(t, server.URL)
+
+		metric := func(name string) float64 {
+			families, err := be.Metrics.Registry().Gather()
+			assert.NoError(t, err)
+			for _, family := range families {
+				if family.GetName() != name {
+					continue
+				}
+				m := family.GetMetric()[0]
+				if m.GetCounter() != nil {
+					return m.GetCounter().GetValue()
+				}
+				return m.GetGauge().GetValue()
+			}
+			return 0
+		}
+
+		for _, token := range []string{"token1", "token1", "token1", "token2"} {
+			ctx := auth.CtxWithToken(context.Background(), token)
+			assert.NoError(t, auth.VerifyAccess(ctx, be, &types.AccessInfo{
+				Method: types.ActivateClient,
+			}))
+		}
+
+		assert.Equal(t, float64(2), metric("yorkie_auth_webhook_cache_hits_total"))
+		assert.Equal(t, float64(2), metric("yorkie_auth_webhook_cache_misses_total"))
+		assert.Equal(t, float64(2), metric("yorkie_auth_webhook_cache_entries"))
+	})
+
+	t.Run("retryable status codes test", func(t *testing.T) {
+		// newServer creates a webhook that responds with 408 to the first
+		// request of each token.
+		newServer := func() *httptest.Server {
+			var mu sync.Mutex
+			requested := make(map[string]bool)
+			return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				req, err := types.NewAuthWebhookRequest(r.Body)
+				assert.NoError(t, err)
+
+				mu.Lock()
+				defer mu.Unlock()
+				if !requested[req.Token] {
+					requested[req.Token] = true
+					w.WriteHeader(http.StatusRequestTimeout)
+					return
+				}
+
+				res := types.AuthWebhookResponse{Allowed: true}
+				_, err = res.Write(w)
+				assert.NoError(t, err)
+			}))
+		}
+		info := &types.AccessInfo{Method: types.ActivateClient}
+		ctx := auth.CtxWithToken(context.Background(), "token")
+
+		// 01. 408 is not retried by default.
+		server := newServer()
+		defer server.Close()
+		be := newBackend(t, server.URL, func(conf *backend.Config) {
+			conf.AuthWebhookMaxRetries = 1
+		})
+		assert.Error(t, auth.VerifyAccess(ctx, be, info))
+
+		// 02. 408 is retried if it is configured.
+		server = newServer()
+		defer server.Close()
+		be = newBackend(t, server.URL, func(conf *backend.Config) {
+			conf.AuthWebhookMaxRetries = 1
+			conf.AuthWebhookRetryableStatusCodes = []int{http.StatusRequestTimeout}
+		})
+		assert.NoError(t, auth.VerifyAccess(ctx, be, info))
+	})
+
+	t.Run("context cancellation test", func(t *testing.T) {
+		var mu sync.Mutex
+		requests := 0
+		aborted := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			requests++
+			mu.Unlock()
+
+			// NOTE: The server notices the closed connection only after the
+			// body is read.
+			_, err := types.NewAuthWebhookRequest(r.Body)
+			assert.NoError(t, err)
+
+			select {
+			case <-r.Context().Done():
+				close(aborted)
+			case <-gotime.After(5 * gotime.Second):
+			}
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+		be := newBackend(t, server.URL, func(conf *backend.Config) {
+			conf.AuthWebhookMaxRetries = 10
+		})
+
+		ctx, cancel := context.WithCancel(auth.CtxWithToken(context.Background(), "token"))
+		go func() {
+			gotime.Sleep(50 * gotime.Millisecond)
+			cancel()
+		}()
+
+		start := gotime.Now()
+		err := auth.VerifyAccess(ctx, be, &types.AccessInfo{Method: types.ActivateClient})
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Less(t, gotime.Since(start), gotime.Second)
+
+		// the in-flight request is aborted and not retried
+		select {
+		case <-aborted:
+		case <-gotime.After(3 * gotime.Second):
+			assert.Fail(t, "the request is not aborted")
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		assert.Equal(t, 1, requests)
+	})
+
+	t.Run("mutual tls test", func(t *testing.T) {
+		dir := t.TempDir()
+		writePEM := func(name, blockType string, bytes []byte) string {
+			path := dir + "/" + name
+			encoded := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: bytes})
+			assert.NoError(t, ioutil.WriteFile(path, encoded, 0600))
+			return path
+		}
+
+		// 01. create a self-signed client certificate.
+		clientKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		assert.NoError(t, err)
+		clientDER, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+			SerialNumber:          big.NewInt(1),
+			Subject:               pkix.Name{CommonName: "yorkie"},
+			NotBefore:             gotime.Now().Add(-gotime.Hour),
+			NotAfter:              gotime.Now().Add(gotime.Hour),
+			KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+			ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+			BasicConstraintsValid: true,
+			IsCA:                  true,
+		}, &x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			Subject:      pkix.Name{CommonName: "yorkie"},
+		}, &clientKey.PublicKey, clientKey)
+		assert.NoError(t, err)
+		clientCert, err := x509.ParseCertificate(clientDER)
+		assert.NoError(t, err)
+		encodedKey, err := x509.MarshalPKCS8PrivateKey(clientKey)
+		assert.NoError(t, err)
+		certFile := writePEM("client.crt", "CERTIFICATE", clientDER)
+		keyFile := writePEM("client.key", "PRIVATE KEY", encodedKey)
+
+		// 02. start the webhook that requires the client certificate.
+		clientCAs := x509.NewCertPool()
+		clientCAs.AddCert(clientCert)
+		server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			res := types.AuthWebhookResponse{Allowed: true}
+			_, err := res.Write(w)
+			assert.NoError(t, err)
+		}))
+		server.TLS = &tls.Config{
+			ClientAuth: tls.RequireAndVerifyClientCert,
+			ClientCAs:  clientCAs,
+		}
+		server.StartTLS()
+		defer server.Close()
+		caFile := writePEM("ca.crt", "CERTIFICATE", server.Certificate().Raw)
+
+		info := &types.AccessInfo{Method: types.ActivateClient}
+
+		// 03. the request without the client certificate is rejected.
+		be := newBackend(t, server.URL, func(conf *backend.Config) {
+			conf.AuthWebhookCAFile = caFile
+		})
+		assert.Error(t, auth.VerifyAccess(auth.CtxWithToken(context.Background(), "token1"), be, info))
+
+		// 04. the request with the client certificate is allowed.
+		be = newBackend(t, server.URL, func(conf *backend.Config) {
+			conf.AuthWebhookClientCertFile = certFile
+			conf.AuthWebhookClientKeyFile = keyFile
+			conf.AuthWebhookCAFile = caFile
+		})
+		assert.NoError(t, auth.VerifyAccess(auth.CtxWithToken(context.Background(), "token2"), be, info))
+	})
+
+	t.Run("bulk access test", func(t *testing.T) {
+		var mu sync.Mutex
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			req, err := types.NewAuthWebhookRequest(r.Body)
+			assert.NoError(t, err)
+			mu.Lock()
+			requests++
+			mu.Unlock()
+
+			res := types.AuthWebhookResponse{Allowed: true}
+			for _, attr := range req.Attributes {
+				if attr.Verb == types.ReadWrite {
+					res.Allowed = false
+					res.Reason = "read only"
+					res.DeniedAttributes = append(res.DeniedAttributes, attr)
+				}
+			}
+			_, err = res.Write(w)
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+		be := newBackend(t, server.URL)
+
+		newPack := func(docKey string, hasChanges bool) *change.Pack {
+			var changes []*change.Change
+			if hasChanges {
+				changes = append(changes, change.New(change.InitialID, "", nil))
+			}
+			return change.NewPack(&key.Key{Collection: "c1", Document: docKey}, change.InitialCheckpoint, changes, nil)
+		}
+		ctx := auth.CtxWithToken(context.Background(), "token")
+
+		// 01. the packs of the same document are combined.
+		attrs := auth.AccessAttributes(newPack("d1", false), newPack("d1", true), newPack("d2", false))
+		assert.Equal(t, []types.AccessAttribute{
+			{Key: "c1$d1", Verb: types.ReadWrite},
+			{Key: "c1$d2", Verb: types.Read},
+		}, attrs)
+
+		// 02. the error names only the denied documents.
+		packs := []*change.Pack{newPack("d1", true), newPack("d2", false), newPack("d3", true)}
+		err := auth.VerifyPacksAccess(ctx, be, types.PushPull, packs)
+		assert.ErrorIs(t, err, auth.ErrNotAllowed)
+		assert.Contains(t, err.Error(), "c1$d1, c1$d3")
+		assert.NotContains(t, err.Error(), "c1$d2")
+
+		// 03. the result of the combination is cached.
+		assert.ErrorIs(t, auth.VerifyPacksAccess(ctx, be, types.PushPull, packs), auth.ErrNotAllowed)
+		assert.NoError(t, auth.VerifyPacksAccess(ctx, be, types.PushPull, packs[1:2]))
+		mu.Lock()
+		defer mu.Unlock()
+		assert.Equal(t, 2, requests)
+
+		// 04. the decision of each attribute is surfaced in the response.
+		resp, err := auth.CheckAccess(ctx, be, "token", &types.AccessInfo{
+			Method:     types.PushPull,
+			Attributes: auth.AccessAttributes(packs...),
+		})
+		assert.NoError(t, err)
+		assert.False(t, resp.IsAllowed(types.AccessAttribute{Key: "c1$d1", Verb: types.ReadWrite}))
+		assert.True(t, resp.IsAllowed(types.AccessAttribute{Key: "c1$d2", Verb: types.Read}))
+	})
+
+	t.Run("dry run test", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			res := types.AuthWebhookResponse{Allowed: false, Reason: "denied"}
+			_, err := res.Write(w)
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+		info := &types.AccessInfo{Method: types.ActivateClient}
+		ctx := auth.CtxWithToken(context.Background(), "token")
+
+		be := newBackend(t, server.URL)
+		assert.ErrorIs(t, auth.VerifyAccess(ctx, be, info), auth.ErrNotAllowed)
+
+		// the denial is only logged in the dry-run mode.
+		be = newBackend(t, server.URL, func(conf *backend.Config) {
+			conf.AuthWebhookDryRun = true
+		})
+		assert.NoError(t, auth.VerifyAccess(ctx, be, info))
+
+		resp, err := auth.CheckAccess(ctx, be, "token", info)
+		assert.NoError(t, err)
+		assert.False(t, resp.Allowed)
+	})
+
+	t.Run("body size limit test", func(t *testing.T) {
+		var mu sync.Mutex
+		requests := 0
+		reason := ""
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			requests++
+
+			res := types.AuthWebhookResponse{Allowed: true, Reason: reason}
+			_, err := res.Write(w)
+			assert.NoError(t, err)
+		}))
+		defer server.Close()
+		be := newBackend(t, server.URL, func(conf *backend.Config) {
+			conf.MaxAuthWebhookBodyBytes = 1024
+		})
+		ctx := auth.CtxWithToken(context.Background(), "token")
+
+		// 01. the large request is rejected without being sent.
+		var attrs []types.AccessAttribute
+		for i := 0; i < 100; i++ {
+			attrs = append(attrs, types.AccessAttribute{Key: "c1$d1", Verb: types.Read})
+		}
+		err := auth.VerifyAccess(ctx, be, &types.AccessInfo{Method: types.PushPull, Attributes: attrs})
+		assert.ErrorIs(t, err, auth.ErrWebhookBodyTooLarge)
+		mu.Lock()
+		assert.Equal(t, 0, requests)
+
+		// 02. the large response is not read entirely.
+		reason = strings.Repeat("a", types.MaxWebhookResponseBytes)
+		mu.Unlock()
+		err = auth.VerifyAccess(ctx, be, &types.AccessInfo{Method: types.PushPull, Attributes: attrs[:1]})
+		assert.ErrorIs(t, err, types.ErrInvalidWebhookResponse)
+	})
+}
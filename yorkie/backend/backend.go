@@ -17,24 +17,66 @@
 package backend
 
 import (
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
 	"os"
 	"time"
 
 	"github.com/rs/xid"
 
 	"github.com/yorkie-team/yorkie/pkg/cache"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/retry"
+	"github.com/yorkie-team/yorkie/pkg/types"
 	"github.com/yorkie-team/yorkie/yorkie/backend/background"
 	"github.com/yorkie-team/yorkie/yorkie/backend/db"
 	memdb "github.com/yorkie-team/yorkie/yorkie/backend/db/memory"
 	"github.com/yorkie-team/yorkie/yorkie/backend/db/mongo"
+	"github.com/yorkie-team/yorkie/yorkie/backend/encryption"
+	"github.com/yorkie-team/yorkie/yorkie/backend/fairqueue"
 	"github.com/yorkie-team/yorkie/yorkie/backend/housekeeping"
+	"github.com/yorkie-team/yorkie/yorkie/backend/ratelimit"
 	"github.com/yorkie-team/yorkie/yorkie/backend/sync"
 	"github.com/yorkie-team/yorkie/yorkie/backend/sync/etcd"
 	memsync "github.com/yorkie-team/yorkie/yorkie/backend/sync/memory"
+	"github.com/yorkie-team/yorkie/yorkie/backend/sync/redis"
 	"github.com/yorkie-team/yorkie/yorkie/logging"
 	"github.com/yorkie-team/yorkie/yorkie/profiling/prometheus"
 )
 
+var (
+	// ErrDocumentQuarantined is returned when the requested document is
+	// quarantined by the admin.
+	ErrDocumentQuarantined = errors.New("document is quarantined")
+
+	// ErrRateLimited is returned when a client sends more requests than the
+	// rate limit allows.
+	ErrRateLimited = errors.New("too many requests")
+)
+
+// AuthProvider verifies whether the requester of the given request is allowed
+// to access. The results are cached and the failed requests are retried with
+// an exponential backoff by the caller.
+type AuthProvider interface {
+	// Verify returns the response for the given request. If the access is not
+	// allowed, the response is returned with Allowed false without an error.
+	Verify(ctx context.Context, req *types.AuthWebhookRequest) (*types.AuthWebhookResponse, error)
+}
+
+// ChangeInterceptor intercepts the changes pushed by PushPull before they are
+// stored, for example to redact the content that should not be persisted.
+type ChangeInterceptor interface {
+	// Intercept returns the change to store instead of the given change of the
+	// given document. If the change is rejected, it returns an error and the
+	// PushPull fails.
+	Intercept(ctx context.Context, docKey *key.Key, cn *change.Change) (*change.Change, error)
+}
+
 // Backend manages Yorkie's backend such as Database and Coordinator. And it
 // has the server status such as the information of this Agent.
 type Backend struct {
@@ -47,13 +89,56 @@ type Backend struct {
 	Metrics          *prometheus.Metrics
 	Housekeeping     *housekeeping.Housekeeping
 	AuthWebhookCache *cache.LRUExpireCache
+
+	// ReadReplica is the read replica of DB for the PushPulls without changes
+	// to push. If it is nil, they are read from DB.
+	ReadReplica db.DB
+
+	// AuthProvider verifies the access of the requests. If it is nil, the
+	// authorization webhook of AuthWebhookURL is used.
+	AuthProvider AuthProvider
+
+	// AuthWebhookSigner signs the authorization webhook request. It is nil if
+	// the signing algorithm is not configured.
+	AuthWebhookSigner crypto.Signer
+
+	// AuthWebhookClient is the HTTP client to send the authorization webhook
+	// request. It is configured with the client certificate and the CAs if
+	// they are given.
+	AuthWebhookClient *http.Client
+
+	// AuthWebhookContexts holds the last authorized attributes of the tokens
+	// sent to the authorization webhook. It is nil if the delta mode is off.
+	AuthWebhookContexts *cache.LRUExpireCache
+
+	// ChangeInterceptor intercepts the pushed changes before they are stored.
+	// If it is nil, the changes are stored as they are pushed.
+	ChangeInterceptor ChangeInterceptor
+
+	// snapshotBuilds is a semaphore that limits the number of snapshots built
+	// concurrently. It is nil if there is no limit.
+	snapshotBuilds chan struct{}
+
+	// pushPullQueue is the queue that schedules PushPulls fairly across the
+	// clients. It is nil if there is no limit.
+	pushPullQueue *fairqueue.Queue
+
+	// rateLimiter limits the rate of the requests of each client. It is nil
+	// if there is no limit.
+	rateLimiter *ratelimit.Limiter
+
+	// isTransientDBError returns whether the given error of the DB is
+	// transient. It is nil if the DB does not have transient errors.
+	isTransientDBError func(err error) bool
 }
 
-// New creates a new instance of Backend.
+// New creates a new instance of Backend. The Coordinator is backed by etcd if
+// etcdConf is given, by Redis if redisConf is given, and by memory otherwise.
 func New(
 	conf *Config,
 	mongoConf *mongo.Config,
 	etcdConf *etcd.Config,
+	redisConf *redis.Config,
 	housekeepingConf *housekeeping.Config,
 	rpcAddr string,
 	metrics *prometheus.Metrics,
@@ -70,19 +155,48 @@ func New(
 		UpdatedAt: time.Now(),
 	}
 
-	bg := background.New()
+	bg := background.New(conf.BackgroundWorkers, conf.BackgroundQueueSize, metrics)
+
+	var cipher db.Cipher
+	if conf.EncryptionKeyFile != "" {
+		kms, err := encryption.NewLocalKMS(conf.EncryptionKeyFile)
+		if err != nil {
+			return nil, err
+		}
+
+		cipher, err = encryption.NewEnvelope(kms)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	var database db.DB
+	var readReplica db.DB
+	var isTransientDBError func(err error) bool
 	if mongoConf != nil {
-		database, err = mongo.Dial(mongoConf)
+		client, err := mongo.Dial(mongoConf)
 		if err != nil {
 			return nil, err
 		}
+		client.SetCipher(cipher)
+		database = client
+		isTransientDBError = mongo.IsTransientError
+
+		if mongoConf.ReadReplicaURI != "" {
+			replicaClient, err := mongo.DialReadReplica(mongoConf)
+			if err != nil {
+				return nil, err
+			}
+			replicaClient.SetCipher(cipher)
+			readReplica = replicaClient
+		}
 	} else {
-		database, err = memdb.New()
+		memDB, err := memdb.New()
 		if err != nil {
 			return nil, err
 		}
+		memDB.SetCipher(cipher)
+		database = memDB
 	}
 
 	var coordinator sync.Coordinator
@@ -96,6 +210,27 @@ func New(
 		}
 
 		coordinator = etcdClient
+		if fanoutConf := etcdConf.FanoutConfig(); fanoutConf != nil {
+			fanoutClient, err := etcd.Dial(fanoutConf, agentInfo)
+			if err != nil {
+				return nil, err
+			}
+			if err := fanoutClient.Initialize(); err != nil {
+				return nil, err
+			}
+
+			coordinator = sync.NewSplitCoordinator(etcdClient, fanoutClient)
+		}
+	} else if redisConf != nil {
+		redisClient, err := redis.Dial(redisConf, agentInfo)
+		if err != nil {
+			return nil, err
+		}
+		if err := redisClient.Initialize(); err != nil {
+			return nil, err
+		}
+
+		coordinator = redisClient
 	} else {
 		coordinator = memsync.NewCoordinator(agentInfo)
 	}
@@ -105,10 +240,57 @@ func New(
 		return nil, err
 	}
 
+	var authWebhookSigner crypto.Signer
+	if conf.AuthWebhookSigningAlgorithm != "" {
+		authWebhookSigner, err = conf.LoadAuthWebhookSigningKey()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	authWebhookClient := http.DefaultClient
+	tlsConfig, err := conf.LoadAuthWebhookTLSConfig()
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig != nil {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = tlsConfig
+		authWebhookClient = &http.Client{Transport: transport}
+	}
+
+	var authWebhookContexts *cache.LRUExpireCache
+	if conf.AuthWebhookDelta {
+		authWebhookContexts, err = cache.NewLRUExpireCache(conf.AuthWebhookCacheSize)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var snapshotBuilds chan struct{}
+	if conf.MaxConcurrentSnapshots > 0 {
+		snapshotBuilds = make(chan struct{}, conf.MaxConcurrentSnapshots)
+	}
+
+	var pushPullQueue *fairqueue.Queue
+	if conf.PushPullConcurrency > 0 {
+		pushPullQueue = fairqueue.New(conf.PushPullConcurrency, conf.PushPullQueueSize)
+	}
+
+	var rateLimiter *ratelimit.Limiter
+	if conf.ClientRateLimit > 0 {
+		burst := conf.ClientRateBurst
+		if burst == 0 {
+			burst = int(math.Ceil(conf.ClientRateLimit))
+		}
+		rateLimiter = ratelimit.New(conf.ClientRateLimit, burst)
+	}
+
 	keeping, err := housekeeping.Start(
 		housekeepingConf,
 		database,
 		coordinator,
+		metrics,
 	)
 	if err != nil {
 		return nil, err
@@ -130,19 +312,194 @@ func New(
 		Config:    conf,
 		agentInfo: agentInfo,
 
-		Background:       bg,
-		Metrics:          metrics,
-		DB:               database,
-		Coordinator:      coordinator,
-		Housekeeping:     keeping,
-		AuthWebhookCache: authWebhookCache,
+		Background:        bg,
+		Metrics:           metrics,
+		DB:                database,
+		ReadReplica:       readReplica,
+		Coordinator:       coordinator,
+		Housekeeping:      keeping,
+		AuthWebhookCache:  authWebhookCache,
+		AuthWebhookSigner: authWebhookSigner,
+		AuthWebhookClient: authWebhookClient,
+
+		AuthWebhookContexts: authWebhookContexts,
+
+		snapshotBuilds:     snapshotBuilds,
+		pushPullQueue:      pushPullQueue,
+		rateLimiter:        rateLimiter,
+		isTransientDBError: isTransientDBError,
 	}, nil
 }
 
+// AuthEnabled returns whether the requests are authorized by the AuthProvider
+// or the authorization webhook.
+func (b *Backend) AuthEnabled() bool {
+	return b.AuthProvider != nil || len(b.Config.AuthWebhookURL) > 0
+}
+
+// RequireAuth returns whether the given method requires authorization.
+func (b *Backend) RequireAuth(method types.Method) bool {
+	if !b.AuthEnabled() {
+		return false
+	}
+
+	return b.Config.includesAuthMethod(method)
+}
+
+// RetryDB calls the given fn that writes to the DB, and retries it while it
+// fails with transient errors of the DB up to the configured max retries.
+// The other errors are returned immediately.
+func (b *Backend) RetryDB(ctx context.Context, fn func() error) error {
+	if b.isTransientDBError == nil {
+		return fn()
+	}
+
+	return retry.WithExponentialBackoff(
+		ctx,
+		b.Config.DBMaxRetries,
+		b.Config.ParseDBMaxWaitInterval(),
+		func(err error) bool {
+			if !b.isTransientDBError(err) {
+				return false
+			}
+
+			logging.From(ctx).Warnf("DB: retrying after transient error: %s", err)
+			return true
+		},
+		fn,
+	)
+}
+
+// TryAcquireSnapshotBuild tries to acquire a slot to build a snapshot. It
+// returns false without blocking if the number of snapshots being built has
+// reached the limit.
+func (b *Backend) TryAcquireSnapshotBuild() bool {
+	if b.snapshotBuilds == nil {
+		return true
+	}
+
+	select {
+	case b.snapshotBuilds <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+// ReleaseSnapshotBuild releases the slot acquired by TryAcquireSnapshotBuild.
+func (b *Backend) ReleaseSnapshotBuild() {
+	if b.snapshotBuilds == nil {
+		return
+	}
+
+	<-b.snapshotBuilds
+}
+
+// AcquirePushPull waits for the turn of a PushPull of the given client. The
+// turns are granted in round-robin across the clients. It returns
+// fairqueue.ErrQueueFull if too many PushPulls are waiting.
+func (b *Backend) AcquirePushPull(ctx context.Context, clientKey string) error {
+	if b.pushPullQueue == nil {
+		return nil
+	}
+
+	if err := b.pushPullQueue.Acquire(ctx, clientKey); err != nil {
+		return fmt.Errorf("push pull: %w", err)
+	}
+	return nil
+}
+
+// CheckHealth returns an error if the database or the coordinator is not
+// reachable.
+func (b *Backend) CheckHealth(ctx context.Context) error {
+	if err := b.DB.Ping(ctx); err != nil {
+		return err
+	}
+
+	return b.Coordinator.Ping(ctx)
+}
+
+// AllowRequest returns ErrRateLimited if the given client has sent more
+// requests than the rate limit allows.
+func (b *Backend) AllowRequest(clientKey string) error {
+	if b.rateLimiter == nil || b.rateLimiter.Allow(clientKey) {
+		return nil
+	}
+
+	return fmt.Errorf("client %s: %w", clientKey, ErrRateLimited)
+}
+
+// ReleasePushPull releases the turn acquired by AcquirePushPull.
+func (b *Backend) ReleasePushPull() {
+	if b.pushPullQueue == nil {
+		return
+	}
+
+	b.pushPullQueue.Release()
+}
+
+// QuarantineDocument quarantines the given document so that the requests
+// for it are rejected by all the agents. The quarantine is stored in the
+// settings of the document, so it is kept after the agents restart. It
+// returns false if the document is already quarantined.
+func (b *Backend) QuarantineDocument(ctx context.Context, docKey *key.Key) (bool, error) {
+	return b.updateQuarantined(ctx, docKey, true)
+}
+
+// ReleaseDocument releases the given document from the quarantine. It
+// returns false if the document is not quarantined.
+func (b *Backend) ReleaseDocument(ctx context.Context, docKey *key.Key) (bool, error) {
+	return b.updateQuarantined(ctx, docKey, false)
+}
+
+// updateQuarantined updates whether the given document is quarantined. It
+// returns false if the document is already in the given state.
+func (b *Backend) updateQuarantined(
+	ctx context.Context,
+	docKey *key.Key,
+	quarantined bool,
+) (bool, error) {
+	docInfo, err := b.DB.FindDocInfoByKey(ctx, nil, docKey.BSONKey(), false)
+	if err != nil {
+		return false, err
+	}
+	if docInfo.Settings.Quarantined == quarantined {
+		return false, nil
+	}
+
+	settings := db.DocSettings{Quarantined: quarantined}
+	if _, err := b.DB.UpdateDocSettings(
+		ctx,
+		docInfo.ID,
+		settings,
+		[]string{db.SettingQuarantined},
+	); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// CheckQuarantine returns ErrDocumentQuarantined if one of the given
+// documents is quarantined.
+func CheckQuarantine(docInfos ...*db.DocInfo) error {
+	for _, docInfo := range docInfos {
+		if docInfo.Settings.Quarantined {
+			return fmt.Errorf("%s: %w", docInfo.Key, ErrDocumentQuarantined)
+		}
+	}
+	return nil
+}
+
 // Shutdown closes all resources of this instance.
 func (b *Backend) Shutdown() error {
-	// this will wait for all goroutines to exit
-	b.Background.Close()
+	// this will wait for all goroutines to exit so that the snapshots are not
+	// partially written when the DB is closed.
+	start := time.Now()
+	if err := b.Background.Drain(b.Config.ParseBackgroundDrainTimeout()); err != nil {
+		logging.DefaultLogger().Error(err)
+	}
+	b.Metrics.SetBackgroundDrainSeconds(time.Since(start).Seconds())
 
 	if err := b.Housekeeping.Stop(); err != nil {
 		return err
@@ -156,6 +513,12 @@ func (b *Backend) Shutdown() error {
 		logging.DefaultLogger().Error(err)
 	}
 
+	if b.ReadReplica != nil {
+		if err := b.ReadReplica.Close(); err != nil {
+			logging.DefaultLogger().Error(err)
+		}
+	}
+
 	logging.DefaultLogger().Infof(
 		"backend stoped: id: %s, rpc: %s",
 		b.agentInfo.ID,
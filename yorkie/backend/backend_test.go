@@ -0,0 +1,100 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backend_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	gotime "time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/test/helper"
+	"github.com/yorkie-team/yorkie/yorkie/backend"
+	"github.com/yorkie-team/yorkie/yorkie/backend/fairqueue"
+	"github.com/yorkie-team/yorkie/yorkie/backend/housekeeping"
+	"github.com/yorkie-team/yorkie/yorkie/profiling/prometheus"
+)
+
+func TestBackend(t *testing.T) {
+	newBackend := func(t *testing.T, conf *backend.Config) *backend.Backend {
+		met, err := prometheus.NewMetrics()
+		assert.NoError(t, err)
+
+		conf.AuthWebhookCacheSize = helper.AuthWebhookSize
+		be, err := backend.New(conf, nil, nil, nil, &housekeeping.Config{
+			Interval:            helper.HousekeepingInterval.String(),
+			DeactivateThreshold: helper.HousekeepingDeactivateThreshold.String(),
+			CandidatesLimit:     helper.HousekeepingCandidatesLimit,
+		}, "", met)
+		assert.NoError(t, err)
+		t.Cleanup(func() {
+			assert.NoError(t, be.Shutdown())
+		})
+		return be
+	}
+
+	t.Run("max concurrent snapshots test", func(t *testing.T) {
+		be := newBackend(t, &backend.Config{MaxConcurrentSnapshots: 2})
+
+		assert.True(t, be.TryAcquireSnapshotBuild())
+		assert.True(t, be.TryAcquireSnapshotBuild())
+		assert.False(t, be.TryAcquireSnapshotBuild())
+
+		be.ReleaseSnapshotBuild()
+		assert.True(t, be.TryAcquireSnapshotBuild())
+	})
+
+	t.Run("unlimited concurrent snapshots test", func(t *testing.T) {
+		be := newBackend(t, &backend.Config{MaxConcurrentSnapshots: -1})
+
+		for i := 0; i < 100; i++ {
+			assert.True(t, be.TryAcquireSnapshotBuild())
+		}
+	})
+
+	t.Run("push pull queue test", func(t *testing.T) {
+		be := newBackend(t, &backend.Config{
+			PushPullConcurrency: 1,
+			PushPullQueueSize:   1,
+		})
+		ctx := context.Background()
+
+		assert.NoError(t, be.AcquirePushPull(ctx, "c1"))
+
+		acquired := make(chan error, 1)
+		go func() {
+			acquired <- be.AcquirePushPull(ctx, "c2")
+		}()
+		assert.Eventually(t, func() bool {
+			return errors.Is(be.AcquirePushPull(ctx, "c3"), fairqueue.ErrQueueFull)
+		}, gotime.Second, gotime.Millisecond)
+
+		be.ReleasePushPull()
+		assert.NoError(t, <-acquired)
+		be.ReleasePushPull()
+	})
+
+	t.Run("unlimited push pull test", func(t *testing.T) {
+		be := newBackend(t, &backend.Config{})
+
+		for i := 0; i < 100; i++ {
+			assert.NoError(t, be.AcquirePushPull(context.Background(), "c1"))
+		}
+	})
+}
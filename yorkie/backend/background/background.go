@@ -2,13 +2,20 @@ package background
 
 import (
 	"context"
+	"errors"
 	"strconv"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/yorkie-team/yorkie/yorkie/logging"
+	"github.com/yorkie-team/yorkie/yorkie/profiling/prometheus"
 )
 
+// ErrDrainTimeout is returned when the attached goroutines do not finish
+// within the timeout of Drain.
+var ErrDrainTimeout = errors.New("timed out waiting for background goroutines")
+
 type routineID int32
 
 func (c *routineID) next() string {
@@ -16,6 +23,14 @@ func (c *routineID) next() string {
 	return "b" + strconv.Itoa(int(next))
 }
 
+// task is a function attached to the background and waiting for a worker.
+type task struct {
+	// key is the key to coalesce the tasks. It is empty if the task is not
+	// coalesced.
+	key string
+	f   func(ctx context.Context)
+}
+
 // Background is the background service.
 type Background struct {
 	// closing is closed by backend close.
@@ -30,17 +45,43 @@ type Background struct {
 
 	// routineID is used to generate routine ID.
 	routineID routineID
+
+	// tasks is the queue of the tasks waiting for a worker. It is nil if the
+	// number of workers is not limited, and each task runs on its own
+	// goroutine.
+	tasks chan *task
+
+	// pending is the tasks with keys waiting in the queue by key.
+	pending   map[string]*task
+	pendingMu sync.Mutex
+
+	metrics *prometheus.Metrics
 }
 
-// New creates a new background service.
-func New() *Background {
-	return &Background{
+// New creates a new background service. If workers is positive, the attached
+// functions run on that many goroutines and at most queueSize of them wait in
+// the queue. Otherwise, each function runs on its own goroutine.
+func New(workers, queueSize int, metrics *prometheus.Metrics) *Background {
+	b := &Background{
 		closing: make(chan struct{}),
+		pending: make(map[string]*task),
+		metrics: metrics,
 	}
+
+	if workers > 0 {
+		b.tasks = make(chan *task, queueSize)
+		b.wg.Add(workers)
+		for i := 0; i < workers; i++ {
+			go b.work()
+		}
+	}
+
+	return b
 }
 
 // AttachGoroutine creates a goroutine on a given function and tracks it using
-// the background's WaitGroup.
+// the background's WaitGroup. If the number of workers is limited, the
+// function waits in the queue, and this blocks while the queue is full.
 func (b *Background) AttachGoroutine(f func(ctx context.Context)) {
 	b.wgMu.RLock() // this blocks with ongoing close(b.closing)
 	defer b.wgMu.RUnlock()
@@ -51,6 +92,12 @@ func (b *Background) AttachGoroutine(f func(ctx context.Context)) {
 	default:
 	}
 
+	if b.tasks != nil {
+		b.tasks <- &task{f: f}
+		b.metrics.SetBackgroundQueueDepth(len(b.tasks))
+		return
+	}
+
 	// now safe to add since WaitGroup wait has not started yet
 	b.wg.Add(1)
 	routineLogger := logging.New(b.routineID.next())
@@ -60,12 +107,101 @@ func (b *Background) AttachGoroutine(f func(ctx context.Context)) {
 	}()
 }
 
-// Close closes the background service.
-func (b *Background) Close() {
+// AttachKeyedGoroutine is like AttachGoroutine, but the function replaces the
+// function of the same key still waiting in the queue instead of being queued
+// again, and it is dropped if the queue is full. It is used for the tasks
+// whose latest run makes the earlier ones redundant such as snapshots; the
+// dropped task is retried by a later attachment. It returns false if the
+// function is dropped.
+func (b *Background) AttachKeyedGoroutine(key string, f func(ctx context.Context)) bool {
+	if b.tasks == nil {
+		b.AttachGoroutine(f)
+		return true
+	}
+
+	b.wgMu.RLock()
+	defer b.wgMu.RUnlock()
+	select {
+	case <-b.closing:
+		logging.DefaultLogger().Warn("backend has closed; skipping AttachKeyedGoroutine")
+		return false
+	default:
+	}
+
+	b.pendingMu.Lock()
+	defer b.pendingMu.Unlock()
+	if t, ok := b.pending[key]; ok {
+		t.f = f
+		return true
+	}
+
+	t := &task{key: key, f: f}
+	select {
+	case b.tasks <- t:
+		b.pending[key] = t
+		b.metrics.SetBackgroundQueueDepth(len(b.tasks))
+		return true
+	default:
+		return false
+	}
+}
+
+// work runs the tasks in the queue until the queue is closed.
+func (b *Background) work() {
+	defer b.wg.Done()
+
+	for t := range b.tasks {
+		b.metrics.SetBackgroundQueueDepth(len(b.tasks))
+
+		var f func(ctx context.Context)
+		if t.key == "" {
+			f = t.f
+		} else {
+			b.pendingMu.Lock()
+			delete(b.pending, t.key)
+			f = t.f
+			b.pendingMu.Unlock()
+		}
+
+		routineLogger := logging.New(b.routineID.next())
+		f(logging.With(context.Background(), routineLogger))
+	}
+}
+
+// Drain closes the background service and blocks until all the attached
+// goroutines finish, including the ones waiting in the queue. If they do not
+// finish within the given timeout, it returns ErrDrainTimeout and leaves them
+// running. If the timeout is zero, it waits without a limit.
+func (b *Background) Drain(timeout time.Duration) error {
 	b.wgMu.Lock()
 	close(b.closing)
+	if b.tasks != nil {
+		// the workers run the remaining tasks in the queue before exiting.
+		close(b.tasks)
+	}
 	b.wgMu.Unlock()
 
-	// wait for goroutines before closing backend
-	b.wg.Wait()
+	if timeout <= 0 {
+		b.wg.Wait()
+		return nil
+	}
+
+	done := make(chan struct{})
+	go func() {
+		b.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-time.After(timeout):
+		return ErrDrainTimeout
+	}
+}
+
+// Close closes the background service and waits for all the attached
+// goroutines to finish.
+func (b *Background) Close() {
+	_ = b.Drain(0)
 }
@@ -0,0 +1,118 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package background_test
+
+import (
+	"context"
+	gosync "sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/yorkie/backend/background"
+	"github.com/yorkie-team/yorkie/yorkie/profiling/prometheus"
+)
+
+func TestBackground(t *testing.T) {
+	metrics, err := prometheus.NewMetrics()
+	assert.NoError(t, err)
+
+	t.Run("coalesce keyed goroutines test", func(t *testing.T) {
+		bg := background.New(1, 2, metrics)
+
+		// block the only worker until the tasks below are queued.
+		release := make(chan struct{})
+		started := make(chan struct{})
+		bg.AttachGoroutine(func(ctx context.Context) {
+			close(started)
+			<-release
+		})
+		<-started
+
+		var mu gosync.Mutex
+		var runs []string
+		record := func(name string) func(ctx context.Context) {
+			return func(ctx context.Context) {
+				mu.Lock()
+				defer mu.Unlock()
+				runs = append(runs, name)
+			}
+		}
+
+		assert.True(t, bg.AttachKeyedGoroutine("doc1", record("doc1-first")))
+		assert.True(t, bg.AttachKeyedGoroutine("doc1", record("doc1-second")))
+		assert.True(t, bg.AttachKeyedGoroutine("doc2", record("doc2")))
+
+		// the queue is full, so a task of another key is dropped.
+		assert.False(t, bg.AttachKeyedGoroutine("doc3", record("doc3")))
+
+		close(release)
+		bg.Close()
+
+		assert.Equal(t, []string{"doc1-second", "doc2"}, runs)
+	})
+
+	t.Run("close after running queued goroutines test", func(t *testing.T) {
+		bg := background.New(2, 10, metrics)
+
+		var mu gosync.Mutex
+		count := 0
+		for i := 0; i < 10; i++ {
+			bg.AttachGoroutine(func(ctx context.Context) {
+				mu.Lock()
+				defer mu.Unlock()
+				count++
+			})
+		}
+		bg.Close()
+		assert.Equal(t, 10, count)
+
+		// the goroutines attached after close are skipped.
+		bg.AttachGoroutine(func(ctx context.Context) {
+			count++
+		})
+		assert.False(t, bg.AttachKeyedGoroutine("doc1", func(ctx context.Context) {
+			count++
+		}))
+		assert.Equal(t, 10, count)
+	})
+
+	t.Run("drain timeout test", func(t *testing.T) {
+		bg := background.New(1, 1, metrics)
+
+		release := make(chan struct{})
+		bg.AttachGoroutine(func(ctx context.Context) {
+			<-release
+		})
+		assert.ErrorIs(t, bg.Drain(10*time.Millisecond), background.ErrDrainTimeout)
+
+		close(release)
+	})
+
+	t.Run("drain test", func(t *testing.T) {
+		bg := background.New(0, 0, metrics)
+
+		done := false
+		bg.AttachGoroutine(func(ctx context.Context) {
+			time.Sleep(10 * time.Millisecond)
+			done = true
+		})
+		assert.NoError(t, bg.Drain(time.Second))
+		assert.True(t, done)
+	})
+}
@@ -17,10 +17,98 @@
 package backend
 
 import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
 	"time"
 
 	"github.com/yorkie-team/yorkie/pkg/types"
+	"github.com/yorkie-team/yorkie/yorkie/backend/db"
+)
+
+var (
+	// ErrUnsupportedSigningAlgorithm is returned when the given signing
+	// algorithm is not supported.
+	ErrUnsupportedSigningAlgorithm = errors.New("unsupported signing algorithm")
+
+	// ErrInvalidSigningKey is returned when the signing key is not valid.
+	ErrInvalidSigningKey = errors.New("invalid signing key")
+
+	// ErrConflictingSigning is returned when the request is configured to be
+	// signed with both the signing key and the HMAC secret.
+	ErrConflictingSigning = errors.New("signing key and HMAC secret are both set")
+
+	// ErrIncompleteClientCertificate is returned when only one of the client
+	// cert file and the client key file is given.
+	ErrIncompleteClientCertificate = errors.New("client cert file and key file must be set together")
+
+	// ErrInvalidCAFile is returned when the CA file does not contain any
+	// PEM encoded certificate.
+	ErrInvalidCAFile = errors.New("invalid CA file")
+
+	// ErrMaxChangesPerPullTooLarge is returned when MaxChangesPerPull is not
+	// less than SnapshotThreshold, so the changes would never be split.
+	ErrMaxChangesPerPullTooLarge = errors.New("max changes per pull must be less than snapshot threshold")
+)
+
+// Belows are the policies for a snapshot that can not be decoded.
+const (
+	// SnapshotCorruptionRecover rebuilds the document from the closest valid
+	// snapshot before the corrupt one, or from the beginning.
+	SnapshotCorruptionRecover = "recover"
+
+	// SnapshotCorruptionFail returns an error to the client.
+	SnapshotCorruptionFail = "fail"
+)
+
+// Belows are the codecs to compress the snapshots before storing them.
+const (
+	// SnapshotCompressionNone stores the snapshots as is.
+	SnapshotCompressionNone = "none"
+
+	// SnapshotCompressionGzip compresses the snapshots with gzip.
+	SnapshotCompressionGzip = "gzip"
+)
+
+// Belows are the policies for the changes duplicated within a pack.
+const (
+	// DuplicateChangeDedupe stores only one of the duplicated changes.
+	DuplicateChangeDedupe = "dedupe"
+
+	// DuplicateChangeReject returns an error to the client.
+	DuplicateChangeReject = "reject"
+)
+
+// Belows are the policies for the changes whose lamport advances anomalously
+// fast.
+const (
+	// LamportSkewWarn stores the changes and logs the offender.
+	LamportSkewWarn = "warn"
+
+	// LamportSkewReject returns an error to the client.
+	LamportSkewReject = "reject"
+)
+
+// Belows are the kinds of the content redacted by the payload logging.
+const (
+	// RedactKey redacts the keys of objects.
+	RedactKey = "key"
+
+	// RedactValue redacts the values of primitives and counters.
+	RedactValue = "value"
+
+	// RedactText redacts the contents of texts and rich texts.
+	RedactText = "text"
+
+	// RedactAttributes redacts the values of the attributes of rich texts.
+	RedactAttributes = "attributes"
 )
 
 // Config is the configuration for creating a Backend instance.
@@ -32,6 +120,148 @@ type Config struct {
 	// SnapshotInterval is the interval of changes to create a snapshot.
 	SnapshotInterval uint64 `yaml:"SnapshotInterval"`
 
+	// SnapshotIntervals overrides SnapshotInterval for the documents whose
+	// keys match the patterns, e.g. {"hot$*": 100}. The patterns are matched
+	// against "collection$document" in the syntax of path.Match. If several
+	// patterns match, the smallest interval is used.
+	SnapshotIntervals map[string]int `yaml:"SnapshotIntervals"`
+
+	// SyncSnapshot is whether to store the snapshot within PushPull before
+	// responding instead of in the background, so that the error of the
+	// snapshot is returned to the client. It is mainly used for testing.
+	SyncSnapshot bool `yaml:"SyncSnapshot"`
+
+	// MaxChangesPerPull is the maximum number of changes that are sent to the
+	// client in a single response. The client pulls the rest in subsequent
+	// requests. It must be less than SnapshotThreshold, since more changes
+	// than SnapshotThreshold are sent with a snapshot instead. If it is zero,
+	// there is no limit, but the agent replaces zero with its default.
+	MaxChangesPerPull uint64 `yaml:"MaxChangesPerPull"`
+
+	// MaxPushChanges is the maximum number of changes that a client can push
+	// in a single request. The requests beyond it are rejected so that the
+	// client splits its push. If it is zero, there is no limit.
+	MaxPushChanges uint64 `yaml:"MaxPushChanges"`
+
+	// MaxConcurrentSnapshots is the maximum number of snapshots that are built
+	// concurrently in this agent. If the limit is reached, the snapshot is
+	// deferred to a later PushPull. If it is negative, there is no limit.
+	MaxConcurrentSnapshots int `yaml:"MaxConcurrentSnapshots"`
+
+	// SnapshotWorkers is the number of workers that encode a snapshot
+	// concurrently. If it is zero or one, a snapshot is encoded sequentially.
+	SnapshotWorkers int `yaml:"SnapshotWorkers"`
+
+	// SnapshotCompression is the codec to compress the snapshots before
+	// storing them. It is one of "none" and "gzip". If it is empty, the
+	// snapshots are not compressed.
+	SnapshotCompression string `yaml:"SnapshotCompression"`
+
+	// PushPullConcurrency is the maximum number of PushPulls that are processed
+	// concurrently in this agent. The others wait in a queue and are granted
+	// in round-robin across the clients. If it is zero, there is no limit.
+	PushPullConcurrency int `yaml:"PushPullConcurrency"`
+
+	// PushPullQueueSize is the maximum number of PushPulls that wait in the
+	// queue when PushPullConcurrency is reached. The PushPulls beyond it are
+	// rejected.
+	PushPullQueueSize int `yaml:"PushPullQueueSize"`
+
+	// BackgroundWorkers is the maximum number of background tasks such as
+	// storing snapshots that run concurrently in this agent. The others wait
+	// in a queue. If it is zero, each task runs on its own goroutine.
+	BackgroundWorkers int `yaml:"BackgroundWorkers"`
+
+	// BackgroundQueueSize is the maximum number of background tasks that wait
+	// in the queue when BackgroundWorkers is reached. The snapshot tasks
+	// beyond it are dropped and the others wait for room in the queue.
+	BackgroundQueueSize int `yaml:"BackgroundQueueSize"`
+
+	// BackgroundDrainTimeout is the duration that the shutdown waits for the
+	// background tasks to finish before closing the DB. If it is empty or
+	// zero, the shutdown waits without a limit.
+	BackgroundDrainTimeout string `yaml:"BackgroundDrainTimeout"`
+
+	// ClientRateLimit is the number of requests per second allowed for each
+	// client. The requests beyond it are rejected. If it is zero, there is no
+	// limit.
+	ClientRateLimit float64 `yaml:"ClientRateLimit"`
+
+	// ClientRateBurst is the number of requests of a client allowed at once
+	// beyond ClientRateLimit. If it is zero, it is ClientRateLimit rounded up.
+	ClientRateBurst int `yaml:"ClientRateBurst"`
+
+	// SnapshotCorruptionPolicy is the policy for a snapshot that can not be
+	// decoded. It is one of "recover" and "fail". If it is empty, "recover" is
+	// used.
+	SnapshotCorruptionPolicy string `yaml:"SnapshotCorruptionPolicy"`
+
+	// DuplicateChangePolicy is the policy for the changes that appear more
+	// than once in a pack with the same ID. It is one of "dedupe" and
+	// "reject". If it is empty, "dedupe" is used.
+	DuplicateChangePolicy string `yaml:"DuplicateChangePolicy"`
+
+	// SlowSnapshotThreshold is the duration above which the creation of a
+	// snapshot is reported as slow. If it is empty or zero, no snapshot is
+	// reported.
+	SlowSnapshotThreshold string `yaml:"SlowSnapshotThreshold"`
+
+	// RaiseSnapshotIntervalOnSlow is whether to double the snapshot interval
+	// of the document whose snapshot is slow, so that its snapshots are
+	// created less often.
+	RaiseSnapshotIntervalOnSlow bool `yaml:"RaiseSnapshotIntervalOnSlow"`
+
+	// MaxLamportSkew is the maximum amount by which the lamport of a pushed
+	// change can exceed the lamport expected from the latest lamport of the
+	// document. If it is zero, the lamport is not checked.
+	MaxLamportSkew uint64 `yaml:"MaxLamportSkew"`
+
+	// LamportSkewPolicy is the policy for the changes whose lamport exceeds
+	// MaxLamportSkew. It is one of "warn" and "reject". If it is empty, "warn"
+	// is used.
+	LamportSkewPolicy string `yaml:"LamportSkewPolicy"`
+
+	// PayloadLogging is whether to log the operations of the packs pushed by
+	// PushPull for debugging. The content of the operations is redacted by
+	// PayloadLogRedactions.
+	PayloadLogging bool `yaml:"PayloadLogging"`
+
+	// PayloadLogSampleRate is the ratio of the packs whose operations are
+	// logged when PayloadLogging is enabled. It is between 0 and 1.
+	PayloadLogSampleRate float64 `yaml:"PayloadLogSampleRate"`
+
+	// PayloadLogRedactions is the kinds of the content redacted by the payload
+	// logging. It is a subset of "key", "value", "text" and "attributes". If
+	// it is empty, all the kinds are redacted.
+	PayloadLogRedactions []string `yaml:"PayloadLogRedactions"`
+
+	// DBMaxRetries is the max count that retries the writes of PushPull to the
+	// DB failed with transient errors such as a primary step-down.
+	DBMaxRetries uint64 `yaml:"DBMaxRetries"`
+
+	// DBMaxWaitInterval is the max interval that waits before retrying the
+	// writes to the DB.
+	DBMaxWaitInterval string `yaml:"DBMaxWaitInterval"`
+
+	// MaxActorsPerDocument is the maximum number of distinct actors that can
+	// push changes to a document, by collection. The collections not listed
+	// here have no limit.
+	MaxActorsPerDocument map[string]int `yaml:"MaxActorsPerDocument"`
+
+	// EncryptionKeyFile is the path to the hex-encoded 256-bit master key to
+	// encrypt the content of documents at rest. If it is empty, the content
+	// is stored in plaintext.
+	EncryptionKeyFile string `yaml:"EncryptionKeyFile"`
+
+	// Region is the region where this agent runs. If a document prefers
+	// another region, the region is sent to the client as a routing hint. If
+	// it is empty, no hint is sent.
+	Region string `yaml:"Region"`
+
+	// AdminToken is the token to access the admin RPCs. If it is empty, the
+	// admin RPCs are disabled.
+	AdminToken string `yaml:"AdminToken"`
+
 	// AuthWebhookURL is the url of the authorization webhook.
 	AuthWebhookURL string `yaml:"AuthWebhookURL"`
 
@@ -42,8 +272,25 @@ type Config struct {
 	AuthWebhookMaxRetries uint64 `yaml:"AuthWebhookMaxRetries"`
 
 	// AuthWebhookMaxWaitInterval is the max interval that waits before retrying the authorization webhook.
+	// It also caps the interval requested by the Retry-After header of the webhook.
 	AuthWebhookMaxWaitInterval string `yaml:"AuthWebhookMaxWaitInterval"`
 
+	// AuthWebhookRetryableStatusCodes is the status codes of the authorization
+	// webhook that are retried. It replaces the default set of 429, 500, 503
+	// and 504. If it is empty, the default set is used. The reset connections
+	// are always retried.
+	AuthWebhookRetryableStatusCodes []int `yaml:"AuthWebhookRetryableStatusCodes"`
+
+	// AuthWebhookMethodTimeouts is the timeout of each attempt of the
+	// authorization webhook by method. The methods not listed here have no
+	// timeout per attempt.
+	AuthWebhookMethodTimeouts map[string]string `yaml:"AuthWebhookMethodTimeouts"`
+
+	// MaxAuthWebhookBodyBytes is the maximum size of the body of the
+	// authorization webhook request in bytes. The request larger than it is
+	// rejected without being sent. If it is zero, there is no limit.
+	MaxAuthWebhookBodyBytes uint64 `yaml:"MaxAuthWebhookBodyBytes"`
+
 	// AuthWebhookCacheSize is the cache size of the authorization webhook.
 	AuthWebhookCacheSize int `yaml:"AuthWebhookCacheSize"`
 
@@ -51,7 +298,56 @@ type Config struct {
 	AuthWebhookCacheAuthTTL string `yaml:"AuthWebhookCacheAuthTTL"`
 
 	// AuthWebhookCacheUnauthTTL is the TTL value to set when caching the unauthorized result.
+	// The TTLs are overridden by the CacheTTL of the response of the webhook if it is set.
 	AuthWebhookCacheUnauthTTL string `yaml:"AuthWebhookCacheUnauthTTL"`
+
+	// AuthWebhookCacheMaxAge is the maximum age of the cached results. The TTL
+	// of any result is capped by it. If it is empty or zero, there is no cap.
+	AuthWebhookCacheMaxAge string `yaml:"AuthWebhookCacheMaxAge"`
+
+	// AuthWebhookSigningAlgorithm is the algorithm to sign the body of the
+	// authorization webhook request. If it is empty, the request is not signed.
+	AuthWebhookSigningAlgorithm string `yaml:"AuthWebhookSigningAlgorithm"`
+
+	// AuthWebhookSigningKeyFile is the path to the PEM encoded private key
+	// to sign the authorization webhook request.
+	AuthWebhookSigningKeyFile string `yaml:"AuthWebhookSigningKeyFile"`
+
+	// AuthWebhookSigningKeyID is the ID of the signing key. It is sent with the
+	// signature so that the webhook can find the corresponding public key.
+	AuthWebhookSigningKeyID string `yaml:"AuthWebhookSigningKeyID"`
+
+	// AuthWebhookHMACSecret is the shared secret to sign the body of the
+	// authorization webhook request with HMAC-SHA256. It can not be used with
+	// AuthWebhookSigningAlgorithm. If it is empty, the request is not signed.
+	AuthWebhookHMACSecret string `yaml:"AuthWebhookHMACSecret"`
+
+	// AuthWebhookClientCertFile is the path to the PEM encoded certificate
+	// presented to the authorization webhook for mutual TLS. It must be set
+	// with AuthWebhookClientKeyFile.
+	AuthWebhookClientCertFile string `yaml:"AuthWebhookClientCertFile"`
+
+	// AuthWebhookClientKeyFile is the path to the PEM encoded private key of
+	// AuthWebhookClientCertFile.
+	AuthWebhookClientKeyFile string `yaml:"AuthWebhookClientKeyFile"`
+
+	// AuthWebhookCAFile is the path to the PEM encoded certificates of the CAs
+	// to verify the authorization webhook. If it is empty, the system CAs are
+	// used.
+	AuthWebhookCAFile string `yaml:"AuthWebhookCAFile"`
+
+	// AuthWebhookDelta is whether to send only the difference of the
+	// attributes against the last authorized attributes of the token.
+	AuthWebhookDelta bool `yaml:"AuthWebhookDelta"`
+
+	// AuthWebhookDeltaResyncInterval is the interval to send the whole
+	// attributes again in the delta mode.
+	AuthWebhookDeltaResyncInterval string `yaml:"AuthWebhookDeltaResyncInterval"`
+
+	// AuthWebhookDryRun is whether to only log the decisions of the
+	// authorization webhook without denying the requests. It is used to test
+	// a new policy against the real traffic before enforcing it.
+	AuthWebhookDryRun bool `yaml:"AuthWebhookDryRun"`
 }
 
 // RequireAuth returns whether the given method require authorization.
@@ -60,6 +356,12 @@ func (c *Config) RequireAuth(method types.Method) bool {
 		return false
 	}
 
+	return c.includesAuthMethod(method)
+}
+
+// includesAuthMethod returns whether the given method is one of the methods
+// of AuthWebhookMethods. If no method is given, all the methods are included.
+func (c *Config) includesAuthMethod(method types.Method) bool {
 	if len(c.AuthWebhookMethods) == 0 {
 		return true
 	}
@@ -81,6 +383,183 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	if c.MaxChangesPerPull > 0 && c.SnapshotThreshold > 0 && c.MaxChangesPerPull >= c.SnapshotThreshold {
+		return fmt.Errorf(
+			`invalid argument "%d" for "--backend-max-changes-per-pull" flag: %w`,
+			c.MaxChangesPerPull,
+			ErrMaxChangesPerPullTooLarge,
+		)
+	}
+
+	if c.SnapshotCompression != "" &&
+		c.SnapshotCompression != SnapshotCompressionNone &&
+		c.SnapshotCompression != SnapshotCompressionGzip {
+		return fmt.Errorf(
+			`invalid argument "%s" for "--backend-snapshot-compression" flag`,
+			c.SnapshotCompression,
+		)
+	}
+
+	if c.SnapshotCorruptionPolicy != "" &&
+		c.SnapshotCorruptionPolicy != SnapshotCorruptionRecover &&
+		c.SnapshotCorruptionPolicy != SnapshotCorruptionFail {
+		return fmt.Errorf(
+			`invalid argument "%s" for "--backend-snapshot-corruption-policy" flag`,
+			c.SnapshotCorruptionPolicy,
+		)
+	}
+
+	if c.DuplicateChangePolicy != "" &&
+		c.DuplicateChangePolicy != DuplicateChangeDedupe &&
+		c.DuplicateChangePolicy != DuplicateChangeReject {
+		return fmt.Errorf(
+			`invalid argument "%s" for "--backend-duplicate-change-policy" flag`,
+			c.DuplicateChangePolicy,
+		)
+	}
+
+	if c.LamportSkewPolicy != "" &&
+		c.LamportSkewPolicy != LamportSkewWarn &&
+		c.LamportSkewPolicy != LamportSkewReject {
+		return fmt.Errorf(
+			`invalid argument "%s" for "--backend-lamport-skew-policy" flag`,
+			c.LamportSkewPolicy,
+		)
+	}
+
+	if c.PayloadLogSampleRate < 0 || c.PayloadLogSampleRate > 1 {
+		return fmt.Errorf(
+			`invalid argument "%v" for "--backend-payload-log-sample-rate" flag`,
+			c.PayloadLogSampleRate,
+		)
+	}
+
+	for _, kind := range c.PayloadLogRedactions {
+		if kind != RedactKey &&
+			kind != RedactValue &&
+			kind != RedactText &&
+			kind != RedactAttributes {
+			return fmt.Errorf(
+				`invalid argument "%s" for "--backend-payload-log-redactions" flag`,
+				kind,
+			)
+		}
+	}
+
+	if c.PushPullConcurrency < 0 {
+		return fmt.Errorf(
+			`invalid argument "%d" for "--backend-push-pull-concurrency" flag`,
+			c.PushPullConcurrency,
+		)
+	}
+
+	if c.PushPullQueueSize < 0 {
+		return fmt.Errorf(
+			`invalid argument "%d" for "--backend-push-pull-queue-size" flag`,
+			c.PushPullQueueSize,
+		)
+	}
+
+	if c.BackgroundWorkers < 0 {
+		return fmt.Errorf(
+			`invalid argument "%d" for "--backend-background-workers" flag`,
+			c.BackgroundWorkers,
+		)
+	}
+
+	if c.BackgroundQueueSize < 0 {
+		return fmt.Errorf(
+			`invalid argument "%d" for "--backend-background-queue-size" flag`,
+			c.BackgroundQueueSize,
+		)
+	}
+
+	if c.BackgroundDrainTimeout != "" {
+		timeout, err := time.ParseDuration(c.BackgroundDrainTimeout)
+		if err == nil && timeout < 0 {
+			err = errors.New("negative duration")
+		}
+		if err != nil {
+			return fmt.Errorf(
+				`invalid argument "%s" for "--backend-background-drain-timeout" flag: %w`,
+				c.BackgroundDrainTimeout,
+				err,
+			)
+		}
+	}
+
+	if c.ClientRateLimit < 0 {
+		return fmt.Errorf(
+			`invalid argument "%f" for "--backend-client-rate-limit" flag`,
+			c.ClientRateLimit,
+		)
+	}
+
+	if c.ClientRateBurst < 0 {
+		return fmt.Errorf(
+			`invalid argument "%d" for "--backend-client-rate-burst" flag`,
+			c.ClientRateBurst,
+		)
+	}
+
+	if c.DBMaxWaitInterval != "" {
+		interval, err := time.ParseDuration(c.DBMaxWaitInterval)
+		if err == nil && interval < 0 {
+			err = errors.New("negative duration")
+		}
+		if err != nil {
+			return fmt.Errorf(
+				`invalid argument "%s" for "--backend-db-max-wait-interval" flag: %w`,
+				c.DBMaxWaitInterval,
+				err,
+			)
+		}
+	}
+
+	if c.SlowSnapshotThreshold != "" {
+		threshold, err := time.ParseDuration(c.SlowSnapshotThreshold)
+		if err == nil && threshold < 0 {
+			err = errors.New("negative duration")
+		}
+		if err != nil {
+			return fmt.Errorf(
+				`invalid argument "%s" for "--backend-slow-snapshot-threshold" flag: %w`,
+				c.SlowSnapshotThreshold,
+				err,
+			)
+		}
+	}
+
+	for pattern, interval := range c.SnapshotIntervals {
+		if _, err := path.Match(pattern, ""); err != nil || interval <= 0 {
+			return fmt.Errorf(
+				`invalid argument "%s=%d" for "--backend-snapshot-intervals" flag`,
+				pattern,
+				interval,
+			)
+		}
+	}
+
+	for collection, limit := range c.MaxActorsPerDocument {
+		if limit < 0 {
+			return fmt.Errorf(
+				`invalid argument "%s=%d" for "--backend-max-actors-per-document" flag`,
+				collection,
+				limit,
+			)
+		}
+	}
+
+	if c.EncryptionKeyFile != "" {
+		if _, err := os.Stat(c.EncryptionKeyFile); err != nil {
+			return fmt.Errorf(
+				`invalid argument "%s" for "--backend-encryption-key-file" flag: %w`,
+				c.EncryptionKeyFile,
+				err,
+			)
+		}
+	}
+
 	if _, err := time.ParseDuration(c.AuthWebhookMaxWaitInterval); err != nil {
 		return fmt.Errorf(
 			`invalid argument "%s" for "--auth-webhook-max-wait-interval" flag: %w`,
@@ -89,6 +568,38 @@ func (c *Config) Validate() error {
 		)
 	}
 
+	for _, code := range c.AuthWebhookRetryableStatusCodes {
+		if code < 100 || code > 599 {
+			return fmt.Errorf(
+				`invalid argument "%d" for "--auth-webhook-retryable-status-codes" flag`,
+				code,
+			)
+		}
+	}
+
+	for method, timeout := range c.AuthWebhookMethodTimeouts {
+		if !types.IsAuthMethod(method) {
+			return fmt.Errorf(
+				`invalid argument "%s=%s" for "--auth-webhook-method-timeouts" flag`,
+				method,
+				timeout,
+			)
+		}
+
+		parsed, err := time.ParseDuration(timeout)
+		if err == nil && parsed <= 0 {
+			err = errors.New("non-positive duration")
+		}
+		if err != nil {
+			return fmt.Errorf(
+				`invalid argument "%s=%s" for "--auth-webhook-method-timeouts" flag: %w`,
+				method,
+				timeout,
+				err,
+			)
+		}
+	}
+
 	if _, err := time.ParseDuration(c.AuthWebhookCacheAuthTTL); err != nil {
 		return fmt.Errorf(
 			`invalid argument "%s" for "--auth-webhook-cache-auth-ttl" flag: %w`,
@@ -105,9 +616,315 @@ func (c *Config) Validate() error {
 		)
 	}
 
+	if c.AuthWebhookCacheMaxAge != "" {
+		maxAge, err := time.ParseDuration(c.AuthWebhookCacheMaxAge)
+		if err == nil && maxAge < 0 {
+			err = errors.New("negative duration")
+		}
+		if err != nil {
+			return fmt.Errorf(
+				`invalid argument "%s" for "--auth-webhook-cache-max-age" flag: %w`,
+				c.AuthWebhookCacheMaxAge,
+				err,
+			)
+		}
+	}
+
+	if c.AuthWebhookDelta {
+		if _, err := time.ParseDuration(c.AuthWebhookDeltaResyncInterval); err != nil {
+			return fmt.Errorf(
+				`invalid argument "%s" for "--auth-webhook-delta-resync-interval" flag: %w`,
+				c.AuthWebhookDeltaResyncInterval,
+				err,
+			)
+		}
+	}
+
+	if c.AuthWebhookSigningAlgorithm != "" {
+		if !types.IsSigningAlgorithm(c.AuthWebhookSigningAlgorithm) {
+			return fmt.Errorf(
+				`invalid argument "%s" for "--auth-webhook-signing-algorithm" flag: %w`,
+				c.AuthWebhookSigningAlgorithm,
+				ErrUnsupportedSigningAlgorithm,
+			)
+		}
+
+		if _, err := os.Stat(c.AuthWebhookSigningKeyFile); err != nil {
+			return fmt.Errorf(
+				`invalid argument "%s" for "--auth-webhook-signing-key-file" flag: %w`,
+				c.AuthWebhookSigningKeyFile,
+				err,
+			)
+		}
+
+		if c.AuthWebhookHMACSecret != "" {
+			// NOTE: The secret is not included in the error to keep it out of
+			// the logs.
+			return fmt.Errorf(
+				`invalid argument for "--auth-webhook-hmac-secret" flag: %w`,
+				ErrConflictingSigning,
+			)
+		}
+	}
+
+	if (c.AuthWebhookClientCertFile == "") != (c.AuthWebhookClientKeyFile == "") {
+		return fmt.Errorf(
+			`invalid argument "%s" for "--auth-webhook-client-cert-file" flag: %w`,
+			c.AuthWebhookClientCertFile,
+			ErrIncompleteClientCertificate,
+		)
+	}
+
+	if c.AuthWebhookClientCertFile != "" {
+		if _, err := os.Stat(c.AuthWebhookClientCertFile); err != nil {
+			return fmt.Errorf(
+				`invalid argument "%s" for "--auth-webhook-client-cert-file" flag: %w`,
+				c.AuthWebhookClientCertFile,
+				err,
+			)
+		}
+
+		if _, err := os.Stat(c.AuthWebhookClientKeyFile); err != nil {
+			return fmt.Errorf(
+				`invalid argument "%s" for "--auth-webhook-client-key-file" flag: %w`,
+				c.AuthWebhookClientKeyFile,
+				err,
+			)
+		}
+	}
+
+	if c.AuthWebhookCAFile != "" {
+		if _, err := os.Stat(c.AuthWebhookCAFile); err != nil {
+			return fmt.Errorf(
+				`invalid argument "%s" for "--auth-webhook-ca-file" flag: %w`,
+				c.AuthWebhookCAFile,
+				err,
+			)
+		}
+	}
+
 	return nil
 }
 
+// FailOnCorruptSnapshot returns whether a corrupt snapshot should be
+// reported to the client instead of being recovered.
+func (c *Config) FailOnCorruptSnapshot() bool {
+	return c.SnapshotCorruptionPolicy == SnapshotCorruptionFail
+}
+
+// CompressesSnapshot returns whether the snapshots are compressed with gzip
+// before storing them.
+func (c *Config) CompressesSnapshot() bool {
+	return c.SnapshotCompression == SnapshotCompressionGzip
+}
+
+// RejectDuplicateChanges returns whether a pack with duplicated changes should
+// be rejected instead of being deduplicated.
+func (c *Config) RejectDuplicateChanges() bool {
+	return c.DuplicateChangePolicy == DuplicateChangeReject
+}
+
+// RejectLamportSkew returns whether a pack with the changes whose lamport
+// advances anomalously fast should be rejected instead of being stored.
+func (c *Config) RejectLamportSkew() bool {
+	return c.LamportSkewPolicy == LamportSkewReject
+}
+
+// RedactsPayload returns whether the given kind of the content is redacted by
+// the payload logging.
+func (c *Config) RedactsPayload(kind string) bool {
+	if len(c.PayloadLogRedactions) == 0 {
+		return true
+	}
+
+	for _, k := range c.PayloadLogRedactions {
+		if k == kind {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseSlowSnapshotThreshold returns the duration above which the creation of
+// a snapshot is reported as slow. If it is zero, no snapshot is reported.
+func (c *Config) ParseSlowSnapshotThreshold() time.Duration {
+	if c.SlowSnapshotThreshold == "" {
+		return 0
+	}
+
+	result, err := time.ParseDuration(c.SlowSnapshotThreshold)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
+
+// MaxActorsOf returns the maximum number of distinct actors of a document in
+// the given collection. If it is zero, there is no limit.
+func (c *Config) MaxActorsOf(collection string) int {
+	return c.MaxActorsPerDocument[collection]
+}
+
+// SnapshotThresholdOf returns the snapshot threshold of the document with the
+// given settings, falling back to SnapshotThreshold.
+func (c *Config) SnapshotThresholdOf(settings db.DocSettings) uint64 {
+	if settings.SnapshotThreshold > 0 {
+		return settings.SnapshotThreshold
+	}
+	return c.SnapshotThreshold
+}
+
+// SnapshotIntervalOf returns the snapshot interval of the document with the
+// given key and settings. The settings of the document take precedence over
+// SnapshotIntervals, falling back to SnapshotInterval.
+func (c *Config) SnapshotIntervalOf(docKey string, settings db.DocSettings) uint64 {
+	if settings.SnapshotInterval > 0 {
+		return settings.SnapshotInterval
+	}
+
+	var result uint64
+	for pattern, interval := range c.SnapshotIntervals {
+		if matched, err := path.Match(pattern, docKey); err != nil || !matched {
+			continue
+		}
+		if result == 0 || uint64(interval) < result {
+			result = uint64(interval)
+		}
+	}
+	if result > 0 {
+		return result
+	}
+
+	return c.SnapshotInterval
+}
+
+// MaxChangesPerPullOf returns the maximum number of changes in a single
+// response of the document with the given settings, falling back to
+// MaxChangesPerPull. If it is zero, there is no limit.
+func (c *Config) MaxChangesPerPullOf(settings db.DocSettings) uint64 {
+	if settings.MaxChangesPerPull > 0 {
+		return settings.MaxChangesPerPull
+	}
+	return c.MaxChangesPerPull
+}
+
+// ValidateDocSettings validates the given settings of a document against this
+// config, because the fields of the settings fall back to it.
+func (c *Config) ValidateDocSettings(settings db.DocSettings) error {
+	maxChanges := c.MaxChangesPerPullOf(settings)
+	threshold := c.SnapshotThresholdOf(settings)
+	if maxChanges > 0 && threshold > 0 && maxChanges >= threshold {
+		return fmt.Errorf(
+			"max changes per pull %d, snapshot threshold %d: %w",
+			maxChanges,
+			threshold,
+			ErrMaxChangesPerPullTooLarge,
+		)
+	}
+
+	return nil
+}
+
+// RoutingHintOf returns the preferred region of the document with the given
+// settings if this agent is not in it. Otherwise, it returns an empty string.
+func (c *Config) RoutingHintOf(settings db.DocSettings) string {
+	if c.Region == "" || settings.PreferredRegion == c.Region {
+		return ""
+	}
+	return settings.PreferredRegion
+}
+
+// LoadAuthWebhookSigningKey loads the private key to sign the authorization
+// webhook request from the key file.
+func (c *Config) LoadAuthWebhookSigningKey() (crypto.Signer, error) {
+	encoded, err := ioutil.ReadFile(c.AuthWebhookSigningKeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(encoded)
+	if block == nil {
+		return nil, fmt.Errorf("%s: %w", c.AuthWebhookSigningKeyFile, ErrInvalidSigningKey)
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", err.Error(), ErrInvalidSigningKey)
+	}
+
+	signer, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("%s is not an ed25519 key: %w", c.AuthWebhookSigningKeyFile, ErrInvalidSigningKey)
+	}
+
+	return signer, nil
+}
+
+// LoadAuthWebhookTLSConfig loads the TLS config to connect to the
+// authorization webhook from the client certificate and the CA files. It
+// returns nil if none of them is configured.
+func (c *Config) LoadAuthWebhookTLSConfig() (*tls.Config, error) {
+	if c.AuthWebhookClientCertFile == "" && c.AuthWebhookCAFile == "" {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if c.AuthWebhookClientCertFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.AuthWebhookClientCertFile, c.AuthWebhookClientKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if c.AuthWebhookCAFile != "" {
+		encoded, err := ioutil.ReadFile(c.AuthWebhookCAFile)
+		if err != nil {
+			return nil, err
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(encoded) {
+			return nil, fmt.Errorf("%s: %w", c.AuthWebhookCAFile, ErrInvalidCAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	return tlsConfig, nil
+}
+
+// ParseDBMaxWaitInterval returns the max interval that waits before retrying
+// the writes to the DB.
+func (c *Config) ParseDBMaxWaitInterval() time.Duration {
+	if c.DBMaxWaitInterval == "" {
+		return 0
+	}
+
+	result, err := time.ParseDuration(c.DBMaxWaitInterval)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
+
+// ParseBackgroundDrainTimeout returns the duration that the shutdown waits for
+// the background tasks. If it is zero, the shutdown waits without a limit.
+func (c *Config) ParseBackgroundDrainTimeout() time.Duration {
+	if c.BackgroundDrainTimeout == "" {
+		return 0
+	}
+
+	result, err := time.ParseDuration(c.BackgroundDrainTimeout)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
+
 // ParseAuthWebhookMaxWaitInterval returns max wait interval.
 func (c *Config) ParseAuthWebhookMaxWaitInterval() time.Duration {
 	result, err := time.ParseDuration(c.AuthWebhookMaxWaitInterval)
@@ -118,6 +935,23 @@ func (c *Config) ParseAuthWebhookMaxWaitInterval() time.Duration {
 	return result
 }
 
+// AuthWebhookTimeoutOf returns the timeout of each attempt of the
+// authorization webhook for the given method. If it is zero, there is no
+// timeout per attempt.
+func (c *Config) AuthWebhookTimeoutOf(method types.Method) time.Duration {
+	timeout, ok := c.AuthWebhookMethodTimeouts[string(method)]
+	if !ok {
+		return 0
+	}
+
+	result, err := time.ParseDuration(timeout)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
+
 // ParseAuthWebhookCacheAuthTTL returns TTL for authorized cache.
 func (c *Config) ParseAuthWebhookCacheAuthTTL() time.Duration {
 	result, err := time.ParseDuration(c.AuthWebhookCacheAuthTTL)
@@ -137,3 +971,32 @@ func (c *Config) ParseAuthWebhookCacheUnauthTTL() time.Duration {
 
 	return result
 }
+
+// AuthWebhookCacheTTLOf returns the given TTL capped by the max age of the
+// cached results.
+func (c *Config) AuthWebhookCacheTTLOf(ttl time.Duration) time.Duration {
+	if c.AuthWebhookCacheMaxAge == "" {
+		return ttl
+	}
+
+	maxAge, err := time.ParseDuration(c.AuthWebhookCacheMaxAge)
+	if err != nil {
+		panic(err)
+	}
+	if maxAge > 0 && ttl > maxAge {
+		return maxAge
+	}
+
+	return ttl
+}
+
+// ParseAuthWebhookDeltaResyncInterval returns the interval to send the whole
+// attributes again in the delta mode.
+func (c *Config) ParseAuthWebhookDeltaResyncInterval() time.Duration {
+	result, err := time.ParseDuration(c.AuthWebhookDeltaResyncInterval)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
@@ -1,12 +1,16 @@
 package backend_test
 
 import (
+	"io/ioutil"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/yorkie-team/yorkie/pkg/types"
 	"github.com/yorkie-team/yorkie/yorkie/backend"
+	"github.com/yorkie-team/yorkie/yorkie/backend/db"
 )
 
 func TestConfig(t *testing.T) {
@@ -63,5 +67,254 @@ func TestConfig(t *testing.T) {
 		conf4 := validConf
 		conf4.AuthWebhookCacheUnauthTTL = "s"
 		assert.Error(t, conf4.Validate())
+
+		// 5. Unsupported AuthWebhookSigningAlgorithm
+		conf5 := validConf
+		conf5.AuthWebhookSigningAlgorithm = "rsa"
+		assert.ErrorIs(t, conf5.Validate(), backend.ErrUnsupportedSigningAlgorithm)
+
+		// 6. Not exists AuthWebhookSigningKeyFile
+		conf6 := validConf
+		conf6.AuthWebhookSigningAlgorithm = "ed25519"
+		conf6.AuthWebhookSigningKeyFile = "nowhere.pem"
+		assert.Error(t, conf6.Validate())
+
+		// 7. Unsupported SnapshotCorruptionPolicy
+		conf7 := validConf
+		conf7.SnapshotCorruptionPolicy = "ignore"
+		assert.Error(t, conf7.Validate())
+
+		// 8. Negative MaxActorsPerDocument
+		conf8 := validConf
+		conf8.MaxActorsPerDocument = map[string]int{"tests": -1}
+		assert.Error(t, conf8.Validate())
+
+		// 9. Invalid AuthWebhookDeltaResyncInterval
+		conf9 := validConf
+		conf9.AuthWebhookDelta = true
+		conf9.AuthWebhookDeltaResyncInterval = "hour"
+		assert.Error(t, conf9.Validate())
+
+		// 10. Not exists EncryptionKeyFile
+		conf10 := validConf
+		conf10.EncryptionKeyFile = "nowhere.key"
+		assert.Error(t, conf10.Validate())
+
+		// 11. Invalid AuthWebhookCacheMaxAge
+		conf11 := validConf
+		conf11.AuthWebhookCacheMaxAge = "-1s"
+		assert.Error(t, conf11.Validate())
+
+		// 12. Invalid SlowSnapshotThreshold
+		conf12 := validConf
+		conf12.SlowSnapshotThreshold = "-1s"
+		assert.Error(t, conf12.Validate())
+		conf12.SlowSnapshotThreshold = "slow"
+		assert.Error(t, conf12.Validate())
+
+		// 13. Unsupported DuplicateChangePolicy
+		conf13 := validConf
+		conf13.DuplicateChangePolicy = "ignore"
+		assert.Error(t, conf13.Validate())
+
+		// 14. Unsupported LamportSkewPolicy
+		conf14 := validConf
+		conf14.LamportSkewPolicy = "clamp"
+		assert.Error(t, conf14.Validate())
+
+		// 15. Invalid DBMaxWaitInterval
+		conf15 := validConf
+		conf15.DBMaxWaitInterval = "-1s"
+		assert.Error(t, conf15.Validate())
+		conf15.DBMaxWaitInterval = "wait"
+		assert.Error(t, conf15.Validate())
+
+		// 16. Invalid PayloadLogSampleRate
+		conf16 := validConf
+		conf16.PayloadLogSampleRate = 1.5
+		assert.Error(t, conf16.Validate())
+
+		// 17. Invalid PayloadLogRedactions
+		conf17 := validConf
+		conf17.PayloadLogRedactions = []string{backend.RedactKey, "content"}
+		assert.Error(t, conf17.Validate())
+
+		// 18. Invalid PushPullConcurrency
+		conf18 := validConf
+		conf18.PushPullConcurrency = -1
+		assert.Error(t, conf18.Validate())
+
+		// 19. Invalid PushPullQueueSize
+		conf19 := validConf
+		conf19.PushPullQueueSize = -1
+		assert.Error(t, conf19.Validate())
+
+		// 20. Both AuthWebhookSigningAlgorithm and AuthWebhookHMACSecret
+		keyFile := filepath.Join(t.TempDir(), "signing.pem")
+		assert.NoError(t, ioutil.WriteFile(keyFile, nil, 0600))
+		conf20 := validConf
+		conf20.AuthWebhookSigningAlgorithm = "ed25519"
+		conf20.AuthWebhookSigningKeyFile = keyFile
+		assert.NoError(t, conf20.Validate())
+		conf20.AuthWebhookHMACSecret = "secret"
+		assert.ErrorIs(t, conf20.Validate(), backend.ErrConflictingSigning)
+
+		// 21. Invalid AuthWebhookMethodTimeouts
+		conf21 := validConf
+		conf21.AuthWebhookMethodTimeouts = map[string]string{"PushPull": "3s"}
+		assert.NoError(t, conf21.Validate())
+		conf21.AuthWebhookMethodTimeouts = map[string]string{"InvalidMethod": "3s"}
+		assert.Error(t, conf21.Validate())
+		conf21.AuthWebhookMethodTimeouts = map[string]string{"PushPull": "0s"}
+		assert.Error(t, conf21.Validate())
+
+		// 22. Invalid AuthWebhookRetryableStatusCodes
+		conf22 := validConf
+		conf22.AuthWebhookRetryableStatusCodes = []int{408, 503}
+		assert.NoError(t, conf22.Validate())
+		conf22.AuthWebhookRetryableStatusCodes = []int{1000}
+		assert.Error(t, conf22.Validate())
+
+		// 23. Incomplete or not exists client certificate files
+		conf23 := validConf
+		conf23.AuthWebhookClientCertFile = "client.crt"
+		assert.ErrorIs(t, conf23.Validate(), backend.ErrIncompleteClientCertificate)
+		conf23.AuthWebhookClientKeyFile = "client.key"
+		assert.Error(t, conf23.Validate())
+		conf23 = validConf
+		conf23.AuthWebhookCAFile = "nowhere.crt"
+		assert.Error(t, conf23.Validate())
+
+		// 24. Invalid SnapshotIntervals
+		conf24 := validConf
+		conf24.SnapshotIntervals = map[string]int{"hot$*": 100}
+		assert.NoError(t, conf24.Validate())
+		conf24.SnapshotIntervals = map[string]int{"hot$[": 100}
+		assert.Error(t, conf24.Validate())
+		conf24.SnapshotIntervals = map[string]int{"hot$*": 0}
+		assert.Error(t, conf24.Validate())
+
+		// 25. Unsupported SnapshotCompression
+		conf25 := validConf
+		conf25.SnapshotCompression = "snappy"
+		assert.Error(t, conf25.Validate())
+
+		// 26. Invalid BackgroundWorkers and BackgroundQueueSize
+		conf26 := validConf
+		conf26.BackgroundWorkers = -1
+		assert.Error(t, conf26.Validate())
+		conf26.BackgroundWorkers = 1
+		conf26.BackgroundQueueSize = -1
+		assert.Error(t, conf26.Validate())
+
+		// 27. Invalid BackgroundDrainTimeout
+		conf27 := validConf
+		conf27.BackgroundDrainTimeout = "-1s"
+		assert.Error(t, conf27.Validate())
+		conf27.BackgroundDrainTimeout = "drain"
+		assert.Error(t, conf27.Validate())
+
+		// 28. MaxChangesPerPull not less than SnapshotThreshold
+		conf28 := validConf
+		conf28.SnapshotThreshold = 500
+		conf28.MaxChangesPerPull = 100
+		assert.NoError(t, conf28.Validate())
+		conf28.MaxChangesPerPull = 500
+		assert.ErrorIs(t, conf28.Validate(), backend.ErrMaxChangesPerPullTooLarge)
+	})
+
+	t.Run("auth webhook cache ttl test", func(t *testing.T) {
+		conf := backend.Config{}
+		assert.Equal(t, 10*time.Second, conf.AuthWebhookCacheTTLOf(10*time.Second))
+
+		conf.AuthWebhookCacheMaxAge = "0s"
+		assert.Equal(t, 10*time.Second, conf.AuthWebhookCacheTTLOf(10*time.Second))
+
+		conf.AuthWebhookCacheMaxAge = "5s"
+		assert.Equal(t, 5*time.Second, conf.AuthWebhookCacheTTLOf(10*time.Second))
+		assert.Equal(t, 3*time.Second, conf.AuthWebhookCacheTTLOf(3*time.Second))
+	})
+
+	t.Run("auth webhook method timeout test", func(t *testing.T) {
+		conf := backend.Config{
+			AuthWebhookMethodTimeouts: map[string]string{"PushPull": "3s"},
+		}
+		assert.Equal(t, 3*time.Second, conf.AuthWebhookTimeoutOf(types.PushPull))
+		assert.Equal(t, time.Duration(0), conf.AuthWebhookTimeoutOf(types.ActivateClient))
+	})
+
+	t.Run("max actors of collection test", func(t *testing.T) {
+		conf := backend.Config{
+			MaxActorsPerDocument: map[string]int{"small-group": 3},
+		}
+		assert.Equal(t, 3, conf.MaxActorsOf("small-group"))
+		assert.Equal(t, 0, conf.MaxActorsOf("others"))
+	})
+
+	t.Run("doc settings test", func(t *testing.T) {
+		conf := backend.Config{
+			SnapshotThreshold: 500,
+			SnapshotInterval:  1000,
+			MaxChangesPerPull: 300,
+		}
+		assert.Equal(t, uint64(500), conf.SnapshotThresholdOf(db.DocSettings{}))
+		assert.Equal(t, uint64(1000), conf.SnapshotIntervalOf("c1$d1", db.DocSettings{}))
+		assert.Equal(t, uint64(300), conf.MaxChangesPerPullOf(db.DocSettings{}))
+
+		settings := db.DocSettings{
+			SnapshotThreshold: 50,
+			SnapshotInterval:  100,
+			MaxChangesPerPull: 30,
+		}
+		assert.Equal(t, uint64(50), conf.SnapshotThresholdOf(settings))
+		assert.Equal(t, uint64(100), conf.SnapshotIntervalOf("c1$d1", settings))
+		assert.Equal(t, uint64(30), conf.MaxChangesPerPullOf(settings))
+		assert.NoError(t, conf.ValidateDocSettings(settings))
+
+		// MaxChangesPerPull not less than the SnapshotThreshold it falls back to
+		assert.ErrorIs(
+			t,
+			conf.ValidateDocSettings(db.DocSettings{MaxChangesPerPull: 500}),
+			backend.ErrMaxChangesPerPullTooLarge,
+		)
+		assert.ErrorIs(
+			t,
+			conf.ValidateDocSettings(db.DocSettings{SnapshotThreshold: 300}),
+			backend.ErrMaxChangesPerPullTooLarge,
+		)
+	})
+
+	t.Run("snapshot intervals test", func(t *testing.T) {
+		conf := backend.Config{
+			SnapshotInterval: 1000,
+			SnapshotIntervals: map[string]int{
+				"hot$*":      100,
+				"hot$room-*": 10,
+			},
+		}
+		assert.Equal(t, uint64(1000), conf.SnapshotIntervalOf("cold$d1", db.DocSettings{}))
+		assert.Equal(t, uint64(100), conf.SnapshotIntervalOf("hot$d1", db.DocSettings{}))
+		assert.Equal(t, uint64(10), conf.SnapshotIntervalOf("hot$room-1", db.DocSettings{}))
+		assert.Equal(t, uint64(50), conf.SnapshotIntervalOf("hot$room-1", db.DocSettings{SnapshotInterval: 50}))
+	})
+
+	t.Run("payload redactions test", func(t *testing.T) {
+		conf := backend.Config{}
+		assert.True(t, conf.RedactsPayload(backend.RedactKey))
+		assert.True(t, conf.RedactsPayload(backend.RedactText))
+
+		conf.PayloadLogRedactions = []string{backend.RedactText}
+		assert.False(t, conf.RedactsPayload(backend.RedactKey))
+		assert.True(t, conf.RedactsPayload(backend.RedactText))
+	})
+
+	t.Run("routing hint test", func(t *testing.T) {
+		conf := backend.Config{}
+		assert.Equal(t, "", conf.RoutingHintOf(db.DocSettings{PreferredRegion: "eu"}))
+
+		conf.Region = "us"
+		assert.Equal(t, "", conf.RoutingHintOf(db.DocSettings{}))
+		assert.Equal(t, "", conf.RoutingHintOf(db.DocSettings{PreferredRegion: "us"}))
+		assert.Equal(t, "eu", conf.RoutingHintOf(db.DocSettings{PreferredRegion: "eu"}))
 	})
 }
@@ -18,6 +18,7 @@ package db
 
 import (
 	"errors"
+	gotime "time"
 
 	"github.com/yorkie-team/yorkie/api"
 	"github.com/yorkie-team/yorkie/api/converter"
@@ -39,6 +40,22 @@ type ChangeInfo struct {
 	ActorID    ID       `bson:"actor_id"`
 	Message    string   `bson:"message"`
 	Operations [][]byte `bson:"operations"`
+
+	// EncryptedKey is the encrypted data key of the operations. It is empty if
+	// the operations are not encrypted.
+	EncryptedKey []byte `bson:"encrypted_key,omitempty"`
+
+	// Signature is the signature of the change by its author. It is empty if
+	// the change is not signed.
+	Signature []byte `bson:"signature,omitempty"`
+
+	// Vector is the vector clock of the change keyed by the hex of the actor
+	// IDs. It is empty if the client does not track it.
+	Vector map[string]uint64 `bson:"vector,omitempty"`
+
+	// CreatedAt is the time when the change is stored. It is zero for the
+	// changes stored before it was introduced.
+	CreatedAt gotime.Time `bson:"created_at"`
 }
 
 // EncodeOperations encodes the given operations into bytes array.
@@ -61,6 +78,12 @@ func EncodeOperations(operations []operation.Operation) ([][]byte, error) {
 	return encodedOps, nil
 }
 
+// EncodeVectorClock encodes the given vector clock to the map keyed by the hex
+// of the actor IDs to store it.
+func EncodeVectorClock(vector change.VectorClock) map[string]uint64 {
+	return converter.ToVectorClock(vector)
+}
+
 // ToChange creates Change model from this ChangeInfo.
 func (i *ChangeInfo) ToChange() (*change.Change, error) {
 	actorID, err := time.ActorIDFromHex(i.ActorID.String())
@@ -68,7 +91,12 @@ func (i *ChangeInfo) ToChange() (*change.Change, error) {
 		return nil, err
 	}
 
-	changeID := change.NewID(i.ClientSeq, i.Lamport, actorID)
+	vector, err := converter.FromVectorClock(i.Vector)
+	if err != nil {
+		return nil, err
+	}
+
+	changeID := change.NewID(i.ClientSeq, i.Lamport, actorID).SetVector(vector)
 
 	var pbOps []*api.Operation
 	for _, bytesOp := range i.Operations {
@@ -86,6 +114,7 @@ func (i *ChangeInfo) ToChange() (*change.Change, error) {
 
 	c := change.New(changeID, i.Message, ops)
 	c.SetServerSeq(i.ServerSeq)
+	c.SetSignature(i.Signature)
 
 	return c, nil
 }
@@ -0,0 +1,133 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrCipherRequired is returned when the stored payloads are encrypted but no
+// cipher is set to decrypt them.
+var ErrCipherRequired = errors.New("cipher required for encrypted payloads")
+
+// Belows are the kinds of the records whose payloads are encrypted.
+const (
+	// PayloadKindChange is the kind of the operations of changes.
+	PayloadKindChange = "change"
+
+	// PayloadKindSnapshot is the kind of snapshots.
+	PayloadKindSnapshot = "snapshot"
+)
+
+// PayloadAAD returns the additional authenticated data of the payloads of the
+// record of the given kind at the given serverSeq of the given document. It
+// binds the encrypted payloads to the record, so that they can not be moved
+// to another document or serverSeq without failing the decryption.
+func PayloadAAD(kind string, docID ID, serverSeq uint64) []byte {
+	return []byte(fmt.Sprintf("%s:%s:%d", kind, docID, serverSeq))
+}
+
+// Cipher encrypts and decrypts the content payloads of documents such as the
+// operations of changes and snapshots. The metadata such as seqs, actors and
+// lamports are stored in plaintext for indexing and ordering.
+type Cipher interface {
+	// Encrypt encrypts the given payloads with a data key, authenticating the
+	// given additional data with them. It returns the encrypted payloads and
+	// the data key encrypted by the key management service, which is stored
+	// with the payloads.
+	Encrypt(ctx context.Context, payloads [][]byte, aad []byte) ([][]byte, []byte, error)
+
+	// Decrypt decrypts the given payloads with the data key encrypted as the
+	// given encryptedKey. The additional data must be the one given to Encrypt.
+	Decrypt(ctx context.Context, payloads [][]byte, encryptedKey []byte, aad []byte) ([][]byte, error)
+}
+
+// EncryptPayloads encrypts the given payloads with the given cipher, bound to
+// the given additional data. If the cipher is nil, the payloads are returned
+// as is without a key.
+func EncryptPayloads(
+	ctx context.Context,
+	cipher Cipher,
+	payloads [][]byte,
+	aad []byte,
+) ([][]byte, []byte, error) {
+	if cipher == nil {
+		return payloads, nil, nil
+	}
+
+	return cipher.Encrypt(ctx, payloads, aad)
+}
+
+// DecryptChangeInfo returns the given change info with the decrypted
+// operations. The given change info is returned as is if it is not
+// encrypted, so that the changes stored before the encryption is enabled can
+// be read.
+func DecryptChangeInfo(ctx context.Context, cipher Cipher, info *ChangeInfo) (*ChangeInfo, error) {
+	if len(info.EncryptedKey) == 0 {
+		return info, nil
+	}
+	if cipher == nil {
+		return nil, ErrCipherRequired
+	}
+
+	operations, err := cipher.Decrypt(
+		ctx,
+		info.Operations,
+		info.EncryptedKey,
+		PayloadAAD(PayloadKindChange, info.DocID, info.ServerSeq),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	decrypted := *info
+	decrypted.Operations = operations
+	decrypted.EncryptedKey = nil
+	return &decrypted, nil
+}
+
+// DecryptSnapshotInfo returns the given snapshot info with the decrypted
+// snapshot. The given snapshot info is returned as is if it is not encrypted.
+//
+// NOTE: If the snapshot can not be decrypted, the error is returned instead of
+// treating the snapshot as corrupted, since the failure may be transient such
+// as an outage of the KMS.
+func DecryptSnapshotInfo(ctx context.Context, cipher Cipher, info *SnapshotInfo) (*SnapshotInfo, error) {
+	if len(info.EncryptedKey) == 0 {
+		return info, nil
+	}
+	if cipher == nil {
+		return nil, ErrCipherRequired
+	}
+
+	snapshots, err := cipher.Decrypt(
+		ctx,
+		[][]byte{info.Snapshot},
+		info.EncryptedKey,
+		PayloadAAD(PayloadKindSnapshot, info.DocID, info.ServerSeq),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	decrypted := *info
+	decrypted.Snapshot = snapshots[0]
+	decrypted.EncryptedKey = nil
+	return &decrypted, nil
+}
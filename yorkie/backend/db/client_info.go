@@ -61,6 +61,15 @@ type ClientInfo struct {
 	// Status is the status of the client.
 	Status string `bson:"status"`
 
+	// Metadata is the app-specific metadata of the client such as device type
+	// or app version. It is not used for synchronization.
+	Metadata map[string]string `bson:"metadata"`
+
+	// PublicKey is the ed25519 public key registered by the client to verify
+	// the signatures of its changes. It is empty if the client did not
+	// register any key.
+	PublicKey []byte `bson:"public_key,omitempty"`
+
 	// Documents is a map of document which is attached to the client.
 	Documents map[ID]*ClientDocInfo `bson:"documents"`
 
@@ -174,10 +183,20 @@ func (i *ClientInfo) DeepCopy() *ClientInfo {
 		}
 	}
 
+	var metadata map[string]string
+	if i.Metadata != nil {
+		metadata = make(map[string]string, len(i.Metadata))
+		for k, v := range i.Metadata {
+			metadata[k] = v
+		}
+	}
+
 	return &ClientInfo{
 		ID:        i.ID,
 		Key:       i.Key,
 		Status:    i.Status,
+		Metadata:  metadata,
+		PublicKey: i.PublicKey,
 		Documents: documents,
 		CreatedAt: i.CreatedAt,
 		UpdatedAt: i.UpdatedAt,
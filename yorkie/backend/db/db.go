@@ -21,7 +21,6 @@ import (
 	"errors"
 	gotime "time"
 
-	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
@@ -35,6 +34,10 @@ var (
 
 	// ErrConflictOnUpdate is returned when a conflict occurs during update.
 	ErrConflictOnUpdate = errors.New("conflict on update")
+
+	// ErrChangesNotStored is returned when some of the given changes could not
+	// be stored.
+	ErrChangesNotStored = errors.New("changes not stored")
 )
 
 // DB represents database which reads or saves Yorkie data.
@@ -42,6 +45,9 @@ type DB interface {
 	// Close all resources of this database.
 	Close() error
 
+	// Ping checks whether the database is reachable.
+	Ping(ctx context.Context) error
+
 	// ActivateClient activates the client of the given key.
 	ActivateClient(ctx context.Context, key string) (*ClientInfo, error)
 
@@ -51,6 +57,25 @@ type DB interface {
 	// FindClientInfoByID finds the client of the given ID.
 	FindClientInfoByID(ctx context.Context, clientID ID) (*ClientInfo, error)
 
+	// FindClientInfoByIDReadOnly finds the client of the given ID without
+	// updating the last access time of the client.
+	FindClientInfoByIDReadOnly(ctx context.Context, clientID ID) (*ClientInfo, error)
+
+	// UpdateClientMetadata updates the metadata of the client of the given ID.
+	UpdateClientMetadata(
+		ctx context.Context,
+		clientID ID,
+		metadata map[string]string,
+	) (*ClientInfo, error)
+
+	// UpdateClientPublicKey updates the public key of the client of the
+	// given ID.
+	UpdateClientPublicKey(
+		ctx context.Context,
+		clientID ID,
+		publicKey []byte,
+	) (*ClientInfo, error)
+
 	// UpdateClientInfoAfterPushPull updates the client from the given clientInfo
 	// after handling PushPull.
 	UpdateClientInfoAfterPushPull(ctx context.Context, clientInfo *ClientInfo, docInfo *DocInfo) error
@@ -62,9 +87,22 @@ type DB interface {
 		candidatesLimit int,
 	) ([]*ClientInfo, error)
 
+	// FindDeleteCandidates finds the deactivated clients that have not been
+	// updated for the given threshold.
+	FindDeleteCandidates(
+		ctx context.Context,
+		deleteThreshold gotime.Duration,
+		candidatesLimit int,
+	) ([]*ClientInfo, error)
+
+	// DeleteClientInfo deletes the given deactivated client and its synced
+	// seqs, so that it does not hold the garbage collection of documents.
+	DeleteClientInfo(ctx context.Context, clientInfo *ClientInfo) error
+
 	// FindDocInfoByKey finds the document of the given key. If the
 	// createDocIfNotExist condition is true, create the document if it does not
-	// exist.
+	// exist. The clientInfo becomes the owner of the created document, so it
+	// can be nil only when createDocIfNotExist is false.
 	FindDocInfoByKey(
 		ctx context.Context,
 		clientInfo *ClientInfo,
@@ -72,6 +110,19 @@ type DB interface {
 		createDocIfNotExist bool,
 	) (*DocInfo, error)
 
+	// FindDocInfoByID finds the document of the given ID.
+	FindDocInfoByID(ctx context.Context, docID ID) (*DocInfo, error)
+
+	// UpdateDocSettings updates only the fields of the given names in the
+	// settings of the document of the given ID to the values of the given
+	// settings. The other fields are left untouched.
+	UpdateDocSettings(
+		ctx context.Context,
+		docID ID,
+		settings DocSettings,
+		fields []string,
+	) (*DocInfo, error)
+
 	// CreateChangeInfos stores the given changes then updates the given docInfo.
 	CreateChangeInfos(
 		ctx context.Context,
@@ -96,12 +147,31 @@ type DB interface {
 		to uint64,
 	) ([]*ChangeInfo, error)
 
-	// CreateSnapshotInfo stores the snapshot of the given document.
-	CreateSnapshotInfo(ctx context.Context, docID ID, doc *document.InternalDocument) error
+	// CompactChangeInfos deletes the changes of the given document whose
+	// serverSeq is less than or equal to the given serverSeq and records the
+	// serverSeq on the document. It returns the number of deleted changes.
+	CompactChangeInfos(ctx context.Context, docID ID, serverSeq uint64) (int, error)
+
+	// FindDocStats returns the statistics of the document of the given ID.
+	FindDocStats(ctx context.Context, docID ID) (*DocStats, error)
+
+	// CreateSnapshotInfo stores the given snapshot of the document at the
+	// given serverSeq with the given hash and records the serverSeq on the
+	// document. The hash is of the encoded snapshot before it is compressed,
+	// so it is the same for the same state regardless of the compression.
+	CreateSnapshotInfo(ctx context.Context, docID ID, serverSeq uint64, snapshot []byte, hash string) error
 
 	// FindLastSnapshotInfo finds the last snapshot of the given document.
 	FindLastSnapshotInfo(ctx context.Context, docID ID) (*SnapshotInfo, error)
 
+	// FindClosestSnapshotInfo finds the last snapshot of the given document
+	// whose serverSeq is less than or equal to the given serverSeq.
+	FindClosestSnapshotInfo(
+		ctx context.Context,
+		docID ID,
+		serverSeq uint64,
+	) (*SnapshotInfo, error)
+
 	// UpdateAndFindMinSyncedTicket updates the given serverSeq of the given client
 	// and returns the min synced ticket.
 	UpdateAndFindMinSyncedTicket(
@@ -118,4 +188,9 @@ type DB interface {
 		docID ID,
 		serverSeq uint64,
 	) error
+
+	// FindMinSyncedSeqInfo finds the syncedSeq of the given document whose
+	// serverSeq is the smallest. It returns nil if no client is attached to
+	// the document.
+	FindMinSyncedSeqInfo(ctx context.Context, docID ID) (*SyncedSeqInfo, error)
 }
@@ -17,11 +17,31 @@
 package db
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/yorkie-team/yorkie/pkg/document/key"
 )
 
+// The names of the fields of DocSettings. They are the same as the keys of
+// the fields in the database.
+const (
+	SettingSnapshotThreshold        = "snapshot_threshold"
+	SettingSnapshotInterval         = "snapshot_interval"
+	SettingMaxChangesPerPull        = "max_changes_per_pull"
+	SettingDisableGarbageCollection = "disable_garbage_collection"
+	SettingPreferredRegion          = "preferred_region"
+	SettingRequireSignedChanges     = "require_signed_changes"
+	SettingQuarantined              = "quarantined"
+)
+
+var (
+	// ErrUnknownDocSetting is returned when the given field is not a field of
+	// DocSettings.
+	ErrUnknownDocSetting = errors.New("unknown document setting")
+)
+
 // DocInfo is a structure representing information of the document.
 type DocInfo struct {
 	ID         ID        `bson:"_id"`
@@ -31,6 +51,113 @@ type DocInfo struct {
 	CreatedAt  time.Time `bson:"created_at"`
 	AccessedAt time.Time `bson:"accessed_at"`
 	UpdatedAt  time.Time `bson:"updated_at"`
+
+	// Actors is the distinct actors that have pushed changes to the document.
+	// It is only tracked for documents whose number of actors is limited.
+	Actors []string `bson:"actors"`
+
+	// Settings is the overrides of the global config for this document.
+	Settings DocSettings `bson:"settings"`
+
+	// MaxLamport is the greatest lamport of the changes pushed to the
+	// document. It is zero for the documents whose changes were pushed before
+	// it was introduced.
+	MaxLamport uint64 `bson:"max_lamport"`
+
+	// SnapshotServerSeq is the server sequence of the last snapshot of the
+	// document. It is zero for the documents without any snapshot or whose
+	// snapshots were created before it was introduced.
+	SnapshotServerSeq uint64 `bson:"snapshot_server_seq"`
+
+	// CompactedServerSeq is the server sequence up to which the changes of
+	// the document have been deleted by the compaction. The clients whose
+	// checkpoint is behind it receive a snapshot instead of the changes.
+	CompactedServerSeq uint64 `bson:"compacted_server_seq"`
+}
+
+// DocSettings is the per-document overrides of the global config that
+// affect the sync behavior of the document. The zero value of each field
+// means that the global config is used.
+type DocSettings struct {
+	// SnapshotThreshold overrides the threshold that determines if changes
+	// should be sent with snapshot.
+	SnapshotThreshold uint64 `bson:"snapshot_threshold"`
+
+	// SnapshotInterval overrides the interval of changes to create a snapshot.
+	SnapshotInterval uint64 `bson:"snapshot_interval"`
+
+	// MaxChangesPerPull overrides the maximum number of changes that are sent
+	// to the client in a single response. It must be less than the snapshot
+	// threshold of the document.
+	MaxChangesPerPull uint64 `bson:"max_changes_per_pull"`
+
+	// DisableGarbageCollection is whether to keep the whole history of the
+	// document, such as the documents under legal hold. The tombstones are
+	// not purged by the snapshots, and the changes are not compacted.
+	DisableGarbageCollection bool `bson:"disable_garbage_collection"`
+
+	// PreferredRegion is the region where most of the writers of the document
+	// are. The agents in other regions send it to the clients as a routing
+	// hint.
+	PreferredRegion string `bson:"preferred_region"`
+
+	// RequireSignedChanges is whether to reject the changes that are not
+	// signed by their authors.
+	RequireSignedChanges bool `bson:"require_signed_changes"`
+
+	// Quarantined is whether the requests for the document are rejected by
+	// all the agents, such as for the document causing repeated failures. It
+	// is set by the quarantine of the admin instead of the settings.
+	Quarantined bool `bson:"quarantined"`
+}
+
+// Field returns the value of the field of the given name.
+func (s *DocSettings) Field(name string) (interface{}, error) {
+	switch name {
+	case SettingSnapshotThreshold:
+		return s.SnapshotThreshold, nil
+	case SettingSnapshotInterval:
+		return s.SnapshotInterval, nil
+	case SettingMaxChangesPerPull:
+		return s.MaxChangesPerPull, nil
+	case SettingDisableGarbageCollection:
+		return s.DisableGarbageCollection, nil
+	case SettingPreferredRegion:
+		return s.PreferredRegion, nil
+	case SettingRequireSignedChanges:
+		return s.RequireSignedChanges, nil
+	case SettingQuarantined:
+		return s.Quarantined, nil
+	}
+
+	return nil, fmt.Errorf("%s: %w", name, ErrUnknownDocSetting)
+}
+
+// CopyFields copies the fields of the given names from the given settings,
+// leaving the other fields untouched.
+func (s *DocSettings) CopyFields(from DocSettings, names []string) error {
+	for _, name := range names {
+		switch name {
+		case SettingSnapshotThreshold:
+			s.SnapshotThreshold = from.SnapshotThreshold
+		case SettingSnapshotInterval:
+			s.SnapshotInterval = from.SnapshotInterval
+		case SettingMaxChangesPerPull:
+			s.MaxChangesPerPull = from.MaxChangesPerPull
+		case SettingDisableGarbageCollection:
+			s.DisableGarbageCollection = from.DisableGarbageCollection
+		case SettingPreferredRegion:
+			s.PreferredRegion = from.PreferredRegion
+		case SettingRequireSignedChanges:
+			s.RequireSignedChanges = from.RequireSignedChanges
+		case SettingQuarantined:
+			s.Quarantined = from.Quarantined
+		default:
+			return fmt.Errorf("%s: %w", name, ErrUnknownDocSetting)
+		}
+	}
+
+	return nil
 }
 
 // IncreaseServerSeq increases server sequence of the document.
@@ -39,6 +166,16 @@ func (info *DocInfo) IncreaseServerSeq() uint64 {
 	return info.ServerSeq
 }
 
+// HasActor returns whether the given actor has pushed changes to the document.
+func (info *DocInfo) HasActor(actor string) bool {
+	for _, a := range info.Actors {
+		if a == actor {
+			return true
+		}
+	}
+	return false
+}
+
 // GetKey creates Key instance of this DocInfo.
 func (info *DocInfo) GetKey() (*key.Key, error) {
 	docKey, err := key.FromBSONKey(info.Key)
@@ -63,5 +200,12 @@ func (info *DocInfo) DeepCopy() *DocInfo {
 		CreatedAt:  info.CreatedAt,
 		AccessedAt: info.AccessedAt,
 		UpdatedAt:  info.UpdatedAt,
+		Actors:     append([]string(nil), info.Actors...),
+		Settings:   info.Settings,
+		MaxLamport: info.MaxLamport,
+
+		SnapshotServerSeq: info.SnapshotServerSeq,
+
+		CompactedServerSeq: info.CompactedServerSeq,
 	}
 }
@@ -0,0 +1,39 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package db
+
+// DocStats is a structure representing the statistics of the document.
+type DocStats struct {
+	// ServerSeq is the server sequence of the last change of the document.
+	ServerSeq uint64
+
+	// SnapshotServerSeq is the server sequence of the last snapshot of the
+	// document. It is zero if the document has no snapshot.
+	SnapshotServerSeq uint64
+
+	// SnapshotBytes is the stored size of the last snapshot of the document,
+	// after compression and encryption.
+	SnapshotBytes int
+
+	// ChangesSinceSnapshot is the number of the stored changes after the last
+	// snapshot of the document.
+	ChangesSinceSnapshot int
+
+	// ActorCount is the number of the distinct actors of the stored changes.
+	// The actors of the compacted changes are not counted.
+	ActorCount int
+}
@@ -25,8 +25,6 @@ import (
 	"github.com/hashicorp/go-memdb"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 
-	"github.com/yorkie-team/yorkie/api/converter"
-	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/yorkie/backend/db"
@@ -35,6 +33,10 @@ import (
 // DB is an in-memory database for testing or temporarily.
 type DB struct {
 	db *memdb.MemDB
+
+	// cipher encrypts the content payloads. It is nil if they are stored in
+	// plaintext.
+	cipher db.Cipher
 }
 
 // New returns a new in-memory database.
@@ -49,11 +51,21 @@ func New() (*DB, error) {
 	}, nil
 }
 
+// SetCipher sets the cipher to encrypt the content payloads of documents.
+func (d *DB) SetCipher(cipher db.Cipher) {
+	d.cipher = cipher
+}
+
 // Close closes the database.
 func (d *DB) Close() error {
 	return nil
 }
 
+// Ping does nothing because the memory database is always reachable.
+func (d *DB) Ping(_ context.Context) error {
+	return nil
+}
+
 // ActivateClient activates a client.
 func (d *DB) ActivateClient(ctx context.Context, key string) (*db.ClientInfo, error) {
 	txn := d.db.Txn(true)
@@ -76,8 +88,10 @@ func (d *DB) ActivateClient(ctx context.Context, key string) (*db.ClientInfo, er
 		clientInfo.ID = newID()
 		clientInfo.CreatedAt = now
 	} else {
-		loaded := raw.(*db.ClientInfo)
+		loaded := raw.(*db.ClientInfo).DeepCopy()
 		clientInfo.ID = loaded.ID
+		clientInfo.Metadata = loaded.Metadata
+		clientInfo.PublicKey = loaded.PublicKey
 		clientInfo.CreatedAt = loaded.CreatedAt
 	}
 
@@ -142,6 +156,78 @@ func (d *DB) FindClientInfoByID(ctx context.Context, clientID db.ID) (*db.Client
 	return raw.(*db.ClientInfo).DeepCopy(), nil
 }
 
+// FindClientInfoByIDReadOnly finds a client by ID without updating the last
+// access time of the client.
+func (d *DB) FindClientInfoByIDReadOnly(ctx context.Context, clientID db.ID) (*db.ClientInfo, error) {
+	return d.FindClientInfoByID(ctx, clientID)
+}
+
+// UpdateClientMetadata updates the metadata of the client of the given ID.
+func (d *DB) UpdateClientMetadata(
+	ctx context.Context,
+	clientID db.ID,
+	metadata map[string]string,
+) (*db.ClientInfo, error) {
+	if err := clientID.Validate(); err != nil {
+		return nil, err
+	}
+
+	txn := d.db.Txn(true)
+	defer txn.Abort()
+
+	raw, err := txn.First(tblClients, "id", clientID.String())
+	if err != nil {
+		return nil, err
+	}
+	if raw == nil {
+		return nil, fmt.Errorf("%s: %w", clientID, db.ErrClientNotFound)
+	}
+
+	clientInfo := raw.(*db.ClientInfo).DeepCopy()
+	clientInfo.Metadata = metadata
+	clientInfo.UpdatedAt = gotime.Now()
+
+	if err := txn.Insert(tblClients, clientInfo); err != nil {
+		return nil, err
+	}
+
+	txn.Commit()
+	return clientInfo.DeepCopy(), nil
+}
+
+// UpdateClientPublicKey updates the public key of the client of the given ID.
+func (d *DB) UpdateClientPublicKey(
+	ctx context.Context,
+	clientID db.ID,
+	publicKey []byte,
+) (*db.ClientInfo, error) {
+	if err := clientID.Validate(); err != nil {
+		return nil, err
+	}
+
+	txn := d.db.Txn(true)
+	defer txn.Abort()
+
+	raw, err := txn.First(tblClients, "id", clientID.String())
+	if err != nil {
+		return nil, err
+	}
+	if raw == nil {
+		return nil, fmt.Errorf("%s: %w", clientID, db.ErrClientNotFound)
+	}
+
+	clientInfo := raw.(*db.ClientInfo).DeepCopy()
+	clientInfo.PublicKey = publicKey
+	clientInfo.UpdatedAt = gotime.Now()
+
+	if err := txn.Insert(tblClients, clientInfo); err != nil {
+		return nil, err
+	}
+
+	txn.Commit()
+	return clientInfo.DeepCopy(), nil
+}
+
 // UpdateClientInfoAfterPushPull updates the client from the given clientInfo
 // after handling PushPull.
 func (d *DB) UpdateClientInfoAfterPushPull(
@@ -208,17 +294,68 @@ func (d *DB) FindDeactivateCandidates(
 	ctx context.Context,
 	inactiveThreshold gotime.Duration,
 	candidatesLimit int,
+) ([]*db.ClientInfo, error) {
+	return d.findCandidates(db.ClientActivated, inactiveThreshold, candidatesLimit)
+}
+
+// FindDeleteCandidates finds the deactivated clients that need to be deleted.
+func (d *DB) FindDeleteCandidates(
+	ctx context.Context,
+	deleteThreshold gotime.Duration,
+	candidatesLimit int,
+) ([]*db.ClientInfo, error) {
+	return d.findCandidates(db.ClientDeactivated, deleteThreshold, candidatesLimit)
+}
+
+// DeleteClientInfo deletes the given deactivated client and its synced seqs.
+func (d *DB) DeleteClientInfo(ctx context.Context, clientInfo *db.ClientInfo) error {
+	txn := d.db.Txn(true)
+	defer txn.Abort()
+
+	raw, err := txn.First(tblClients, "id", clientInfo.ID.String())
+	if err != nil {
+		return err
+	}
+	if raw == nil || raw.(*db.ClientInfo).Status != db.ClientDeactivated {
+		return fmt.Errorf("%s: %w", clientInfo.ID, db.ErrClientNotFound)
+	}
+
+	for docID := range clientInfo.Documents {
+		if _, err = txn.DeleteAll(
+			tblSyncedSeqs,
+			"doc_id_client_id",
+			docID.String(),
+			clientInfo.ID.String(),
+		); err != nil {
+			return err
+		}
+	}
+
+	if err := txn.Delete(tblClients, raw); err != nil {
+		return err
+	}
+
+	txn.Commit()
+	return nil
+}
+
+// findCandidates finds the clients of the given status that have not been
+// updated for the given threshold.
+func (d *DB) findCandidates(
+	status string,
+	threshold gotime.Duration,
+	candidatesLimit int,
 ) ([]*db.ClientInfo, error) {
 	txn := d.db.Txn(false)
 	defer txn.Abort()
 
-	offset := gotime.Now().Add(-inactiveThreshold)
+	offset := gotime.Now().Add(-threshold)
 
 	var infos []*db.ClientInfo
 	iterator, err := txn.ReverseLowerBound(
 		tblClients,
 		"status_updated_at",
-		db.ClientActivated,
+		status,
 		offset,
 	)
 	if err != nil {
@@ -228,7 +365,7 @@ func (d *DB) FindDeactivateCandidates(
 	for raw := iterator.Next(); raw != nil; raw = iterator.Next() {
 		info := raw.(*db.ClientInfo)
 
-		if info.Status != db.ClientActivated ||
+		if info.Status != status ||
 			candidatesLimit <= len(infos) ||
 			info.UpdatedAt.After(offset) {
 			break
@@ -278,6 +415,62 @@ func (d *DB) FindDocInfoByKey(
 	return docInfo.DeepCopy(), nil
 }
 
+// FindDocInfoByID finds the document of the given ID.
+func (d *DB) FindDocInfoByID(ctx context.Context, docID db.ID) (*db.DocInfo, error) {
+	if err := docID.Validate(); err != nil {
+		return nil, err
+	}
+
+	txn := d.db.Txn(false)
+	defer txn.Abort()
+
+	raw, err := txn.First(tblDocuments, "id", docID.String())
+	if err != nil {
+		return nil, err
+	}
+	if raw == nil {
+		return nil, fmt.Errorf("%s: %w", docID, db.ErrDocumentNotFound)
+	}
+
+	return raw.(*db.DocInfo).DeepCopy(), nil
+}
+
+// UpdateDocSettings updates only the fields of the given names in the
+// settings of the document of the given ID.
+func (d *DB) UpdateDocSettings(
+	ctx context.Context,
+	docID db.ID,
+	settings db.DocSettings,
+	fields []string,
+) (*db.DocInfo, error) {
+	if err := docID.Validate(); err != nil {
+		return nil, err
+	}
+
+	txn := d.db.Txn(true)
+	defer txn.Abort()
+
+	raw, err := txn.First(tblDocuments, "id", docID.String())
+	if err != nil {
+		return nil, err
+	}
+	if raw == nil {
+		return nil, fmt.Errorf("%s: %w", docID, db.ErrDocumentNotFound)
+	}
+
+	docInfo := raw.(*db.DocInfo).DeepCopy()
+	if err := docInfo.Settings.CopyFields(settings, fields); err != nil {
+		return nil, err
+	}
+
+	if err := txn.Insert(tblDocuments, docInfo); err != nil {
+		return nil, err
+	}
+
+	txn.Commit()
+	return docInfo.DeepCopy(), nil
+}
+
 // CreateChangeInfos stores the given changes and doc info.
 func (d *DB) CreateChangeInfos(
 	ctx context.Context,
@@ -288,21 +481,35 @@ func (d *DB) CreateChangeInfos(
 	txn := d.db.Txn(true)
 	defer txn.Abort()
 
+	now := gotime.Now()
 	for _, cn := range changes {
 		encodedOperations, err := db.EncodeOperations(cn.Operations())
 		if err != nil {
 			return err
 		}
+		encodedOperations, encryptedKey, err := db.EncryptPayloads(
+			ctx,
+			d.cipher,
+			encodedOperations,
+			db.PayloadAAD(db.PayloadKindChange, docInfo.ID, *cn.ServerSeq()),
+		)
+		if err != nil {
+			return err
+		}
 
 		if err := txn.Insert(tblChanges, &db.ChangeInfo{
-			ID:         newID(),
-			DocID:      docInfo.ID,
-			ServerSeq:  *cn.ServerSeq(),
-			ActorID:    db.ID(cn.ID().ActorID().String()),
-			ClientSeq:  cn.ClientSeq(),
-			Lamport:    cn.ID().Lamport(),
-			Message:    cn.Message(),
-			Operations: encodedOperations,
+			ID:           newID(),
+			DocID:        docInfo.ID,
+			ServerSeq:    *cn.ServerSeq(),
+			ActorID:      db.ID(cn.ID().ActorID().String()),
+			ClientSeq:    cn.ClientSeq(),
+			Lamport:      cn.ID().Lamport(),
+			Message:      cn.Message(),
+			Operations:   encodedOperations,
+			EncryptedKey: encryptedKey,
+			Signature:    cn.Signature(),
+			Vector:       db.EncodeVectorClock(cn.ID().Vector()),
+			CreatedAt:    now,
 		}); err != nil {
 			return err
 		}
@@ -321,6 +528,8 @@ func (d *DB) CreateChangeInfos(
 	}
 
 	loadedDocInfo.ServerSeq = docInfo.ServerSeq
+	loadedDocInfo.MaxLamport = docInfo.MaxLamport
+	loadedDocInfo.Actors = append([]string(nil), docInfo.Actors...)
 	loadedDocInfo.UpdatedAt = gotime.Now()
 	if err := txn.Insert(tblDocuments, loadedDocInfo); err != nil {
 		return err
@@ -381,18 +590,148 @@ func (d *DB) FindChangeInfosBetweenServerSeqs(
 		if info.DocID != docID || info.ServerSeq > to {
 			break
 		}
+
+		info, err = db.DecryptChangeInfo(ctx, d.cipher, info)
+		if err != nil {
+			return nil, err
+		}
 		infos = append(infos, info)
 	}
 	return infos, nil
 }
 
-// CreateSnapshotInfo stores the snapshot of the given document.
+// CompactChangeInfos deletes the changes of the given document whose serverSeq
+// is less than or equal to the given serverSeq.
+func (d *DB) CompactChangeInfos(
+	ctx context.Context,
+	docID db.ID,
+	serverSeq uint64,
+) (int, error) {
+	txn := d.db.Txn(true)
+	defer txn.Abort()
+
+	iterator, err := txn.LowerBound(
+		tblChanges,
+		"doc_id_server_seq",
+		docID.String(),
+		uint64(0),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	var infos []*db.ChangeInfo
+	for raw := iterator.Next(); raw != nil; raw = iterator.Next() {
+		info := raw.(*db.ChangeInfo)
+		if info.DocID != docID || info.ServerSeq > serverSeq {
+			break
+		}
+		infos = append(infos, info)
+	}
+
+	for _, info := range infos {
+		if err := txn.Delete(tblChanges, info); err != nil {
+			return 0, err
+		}
+	}
+
+	raw, err := txn.First(tblDocuments, "id", docID.String())
+	if err != nil {
+		return 0, err
+	}
+	if raw == nil {
+		return 0, fmt.Errorf("%s: %w", docID, db.ErrDocumentNotFound)
+	}
+	if raw.(*db.DocInfo).CompactedServerSeq < serverSeq {
+		docInfo := raw.(*db.DocInfo).DeepCopy()
+		docInfo.CompactedServerSeq = serverSeq
+		if err := txn.Insert(tblDocuments, docInfo); err != nil {
+			return 0, err
+		}
+	}
+
+	txn.Commit()
+	return len(infos), nil
+}
+
+// FindDocStats returns the statistics of the document of the given ID.
+func (d *DB) FindDocStats(
+	_ context.Context,
+	docID db.ID,
+) (*db.DocStats, error) {
+	txn := d.db.Txn(false)
+	defer txn.Abort()
+
+	raw, err := txn.First(tblDocuments, "id", docID.String())
+	if err != nil {
+		return nil, err
+	}
+	if raw == nil {
+		return nil, fmt.Errorf("%s: %w", docID, db.ErrDocumentNotFound)
+	}
+	stats := &db.DocStats{
+		ServerSeq: raw.(*db.DocInfo).ServerSeq,
+	}
+
+	snapshots, err := txn.ReverseLowerBound(
+		tblSnapshots,
+		"doc_id_server_seq",
+		docID.String(),
+		uint64(math.MaxUint64),
+	)
+	if err != nil {
+		return nil, err
+	}
+	for raw := snapshots.Next(); raw != nil; raw = snapshots.Next() {
+		info := raw.(*db.SnapshotInfo)
+		if info.DocID == docID {
+			stats.SnapshotServerSeq = info.ServerSeq
+			stats.SnapshotBytes = len(info.Snapshot)
+			break
+		}
+	}
+
+	changes, err := txn.LowerBound(
+		tblChanges,
+		"doc_id_server_seq",
+		docID.String(),
+		uint64(0),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	actors := make(map[db.ID]struct{})
+	for raw := changes.Next(); raw != nil; raw = changes.Next() {
+		info := raw.(*db.ChangeInfo)
+		if info.DocID != docID {
+			break
+		}
+		if info.ServerSeq > stats.SnapshotServerSeq {
+			stats.ChangesSinceSnapshot++
+		}
+		actors[info.ActorID] = struct{}{}
+	}
+	stats.ActorCount = len(actors)
+
+	return stats, nil
+}
+
+// CreateSnapshotInfo stores the given snapshot of the document at the given
+// serverSeq with the given hash.
 func (d *DB) CreateSnapshotInfo(
 	ctx context.Context,
 	docID db.ID,
-	doc *document.InternalDocument,
+	serverSeq uint64,
+	snapshot []byte,
+	hash string,
 ) error {
-	snapshot, err := converter.ObjectToBytes(doc.RootObject())
+	encrypted, encryptedKey, err := db.EncryptPayloads(
+		ctx,
+		d.cipher,
+		[][]byte{snapshot},
+		db.PayloadAAD(db.PayloadKindSnapshot, docID, serverSeq),
+	)
 	if err != nil {
 		return err
 	}
@@ -401,14 +740,29 @@ func (d *DB) CreateSnapshotInfo(
 	defer txn.Abort()
 
 	if err := txn.Insert(tblSnapshots, &db.SnapshotInfo{
-		ID:        newID(),
-		DocID:     docID,
-		ServerSeq: doc.Checkpoint().ServerSeq,
-		Snapshot:  snapshot,
-		CreatedAt: gotime.Now(),
+		ID:           newID(),
+		DocID:        docID,
+		ServerSeq:    serverSeq,
+		Snapshot:     encrypted[0],
+		Hash:         hash,
+		CreatedAt:    gotime.Now(),
+		EncryptedKey: encryptedKey,
 	}); err != nil {
 		return err
 	}
+
+	raw, err := txn.First(tblDocuments, "id", docID.String())
+	if err != nil {
+		return err
+	}
+	if raw != nil && raw.(*db.DocInfo).SnapshotServerSeq < serverSeq {
+		docInfo := raw.(*db.DocInfo).DeepCopy()
+		docInfo.SnapshotServerSeq = serverSeq
+		if err := txn.Insert(tblDocuments, docInfo); err != nil {
+			return err
+		}
+	}
+
 	txn.Commit()
 	return nil
 }
@@ -417,6 +771,16 @@ func (d *DB) CreateSnapshotInfo(
 func (d *DB) FindLastSnapshotInfo(
 	ctx context.Context,
 	docID db.ID,
+) (*db.SnapshotInfo, error) {
+	return d.FindClosestSnapshotInfo(ctx, docID, uint64(math.MaxUint64))
+}
+
+// FindClosestSnapshotInfo finds the last snapshot of the given document whose
+// serverSeq is less than or equal to the given serverSeq.
+func (d *DB) FindClosestSnapshotInfo(
+	ctx context.Context,
+	docID db.ID,
+	serverSeq uint64,
 ) (*db.SnapshotInfo, error) {
 	txn := d.db.Txn(false)
 	defer txn.Abort()
@@ -425,7 +789,7 @@ func (d *DB) FindLastSnapshotInfo(
 		tblSnapshots,
 		"doc_id_server_seq",
 		docID.String(),
-		uint64(math.MaxUint64),
+		serverSeq,
 	)
 	if err != nil {
 		return nil, err
@@ -444,7 +808,7 @@ func (d *DB) FindLastSnapshotInfo(
 		return &db.SnapshotInfo{}, nil
 	}
 
-	return snapshotInfo, nil
+	return db.DecryptSnapshotInfo(ctx, d.cipher, snapshotInfo)
 }
 
 // UpdateAndFindMinSyncedTicket updates the given serverSeq of the given client
@@ -561,6 +925,40 @@ func (d *DB) UpdateSyncedSeq(
 	return nil
 }
 
+// FindMinSyncedSeqInfo finds the syncedSeq of the given document whose
+// serverSeq is the smallest.
+func (d *DB) FindMinSyncedSeqInfo(
+	ctx context.Context,
+	docID db.ID,
+) (*db.SyncedSeqInfo, error) {
+	txn := d.db.Txn(false)
+	defer txn.Abort()
+
+	iterator, err := txn.LowerBound(
+		tblSyncedSeqs,
+		"doc_id_lamport_actor_id",
+		docID.String(),
+		uint64(0),
+		time.InitialActorID.String(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var minInfo *db.SyncedSeqInfo
+	for raw := iterator.Next(); raw != nil; raw = iterator.Next() {
+		info := raw.(*db.SyncedSeqInfo)
+		if info.DocID != docID {
+			break
+		}
+		if minInfo == nil || info.ServerSeq < minInfo.ServerSeq {
+			minInfo = info
+		}
+	}
+
+	return minInfo, nil
+}
+
 func (d *DB) findTicketByServerSeq(
 	txn *memdb.Txn,
 	docID db.ID,
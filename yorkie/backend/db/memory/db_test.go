@@ -19,16 +19,21 @@ package memory_test
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/proxy"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/yorkie/backend/db"
 	"github.com/yorkie-team/yorkie/yorkie/backend/db/memory"
+	"github.com/yorkie-team/yorkie/yorkie/backend/encryption"
 )
 
 func TestDB(t *testing.T) {
@@ -81,6 +86,23 @@ func TestDB(t *testing.T) {
 		assert.Equal(t, clientInfo.Key, found.Key)
 	})
 
+	t.Run("activate and update client metadata test", func(t *testing.T) {
+		_, err := memdb.UpdateClientMetadata(ctx, notExistsID, map[string]string{"k": "v"})
+		assert.ErrorIs(t, err, db.ErrClientNotFound)
+
+		clientInfo, err := memdb.ActivateClient(ctx, t.Name())
+		assert.NoError(t, err)
+
+		clientInfo, err = memdb.UpdateClientMetadata(ctx, clientInfo.ID, map[string]string{"k": "v"})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"k": "v"}, clientInfo.Metadata)
+
+		// metadata should be kept after activating the client again.
+		clientInfo, err = memdb.ActivateClient(ctx, t.Name())
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"k": "v"}, clientInfo.Metadata)
+	})
+
 	t.Run("find docInfo test", func(t *testing.T) {
 		clientInfo, err := memdb.ActivateClient(ctx, t.Name())
 		assert.NoError(t, err)
@@ -92,6 +114,54 @@ func TestDB(t *testing.T) {
 		docInfo, err := memdb.FindDocInfoByKey(ctx, clientInfo, bsonDocKey, true)
 		assert.NoError(t, err)
 		assert.Equal(t, bsonDocKey, docInfo.Key)
+
+		_, err = memdb.FindDocInfoByID(ctx, notExistsID)
+		assert.ErrorIs(t, err, db.ErrDocumentNotFound)
+
+		found, err := memdb.FindDocInfoByID(ctx, docInfo.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, bsonDocKey, found.Key)
+	})
+
+	t.Run("update doc settings test", func(t *testing.T) {
+		clientInfo, err := memdb.ActivateClient(ctx, t.Name())
+		assert.NoError(t, err)
+
+		bsonDocKey := fmt.Sprintf("tests$%s", t.Name())
+		docInfo, err := memdb.FindDocInfoByKey(ctx, clientInfo, bsonDocKey, true)
+		assert.NoError(t, err)
+		assert.Equal(t, db.DocSettings{}, docInfo.Settings)
+
+		fields := []string{db.SettingSnapshotThreshold, db.SettingDisableGarbageCollection}
+		_, err = memdb.UpdateDocSettings(ctx, notExistsID, db.DocSettings{}, fields)
+		assert.ErrorIs(t, err, db.ErrDocumentNotFound)
+
+		_, err = memdb.UpdateDocSettings(ctx, docInfo.ID, db.DocSettings{}, []string{"unknown"})
+		assert.ErrorIs(t, err, db.ErrUnknownDocSetting)
+
+		settings := db.DocSettings{SnapshotThreshold: 10, DisableGarbageCollection: true}
+		updated, err := memdb.UpdateDocSettings(ctx, docInfo.ID, settings, fields)
+		assert.NoError(t, err)
+		assert.Equal(t, settings, updated.Settings)
+
+		// only the given fields are updated.
+		updated, err = memdb.UpdateDocSettings(
+			ctx,
+			docInfo.ID,
+			db.DocSettings{SnapshotInterval: 20},
+			[]string{db.SettingSnapshotInterval},
+		)
+		assert.NoError(t, err)
+		settings.SnapshotInterval = 20
+		assert.Equal(t, settings, updated.Settings)
+
+		// the settings are kept after storing changes.
+		docInfo, err = memdb.FindDocInfoByKey(ctx, clientInfo, bsonDocKey, false)
+		assert.NoError(t, err)
+		assert.NoError(t, memdb.CreateChangeInfos(ctx, docInfo, 0, nil))
+		found, err := memdb.FindDocInfoByID(ctx, docInfo.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, settings, found.Settings)
 	})
 
 	t.Run("update clientInfo after PushPull test", func(t *testing.T) {
@@ -150,6 +220,67 @@ func TestDB(t *testing.T) {
 		assert.Len(t, loadedChanges, 5)
 	})
 
+	t.Run("delete client info test", func(t *testing.T) {
+		bsonDocKey := fmt.Sprintf("tests$%s", t.Name())
+
+		clientA, err := memdb.ActivateClient(ctx, t.Name()+"A")
+		assert.NoError(t, err)
+		docInfo, err := memdb.FindDocInfoByKey(ctx, clientA, bsonDocKey, true)
+		assert.NoError(t, err)
+		assert.NoError(t, clientA.AttachDocument(docInfo.ID))
+		assert.NoError(t, memdb.UpdateClientInfoAfterPushPull(ctx, clientA, docInfo))
+		_, err = memdb.UpdateAndFindMinSyncedTicket(ctx, clientA, docInfo.ID, 0)
+		assert.NoError(t, err)
+
+		clientB, err := memdb.ActivateClient(ctx, t.Name()+"B")
+		assert.NoError(t, err)
+		assert.NoError(t, clientB.AttachDocument(docInfo.ID))
+		assert.NoError(t, memdb.UpdateClientInfoAfterPushPull(ctx, clientB, docInfo))
+
+		bytesID, _ := clientB.ID.Bytes()
+		actorID, _ := time.ActorIDFromBytes(bytesID)
+		doc := document.New("tests", t.Name())
+		doc.SetActor(actorID)
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		pack := doc.CreateChangePack()
+		pack.Changes[0].SetServerSeq(1)
+		docInfo.ServerSeq = 1
+		assert.NoError(t, memdb.CreateChangeInfos(ctx, docInfo, 0, pack.Changes))
+
+		// the deactivated client A holds the min synced ticket.
+		ticket, err := memdb.UpdateAndFindMinSyncedTicket(ctx, clientB, docInfo.ID, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, time.InitialTicket, ticket)
+
+		// the activated client is not deleted.
+		assert.ErrorIs(t, memdb.DeleteClientInfo(ctx, clientA), db.ErrClientNotFound)
+
+		_, err = memdb.DeactivateClient(ctx, clientA.ID)
+		assert.NoError(t, err)
+		candidates, err := memdb.FindDeleteCandidates(ctx, 0, 10)
+		assert.NoError(t, err)
+		var candidate *db.ClientInfo
+		for _, info := range candidates {
+			if info.ID == clientA.ID {
+				candidate = info
+			}
+		}
+		assert.NotNil(t, candidate)
+
+		assert.NoError(t, memdb.DeleteClientInfo(ctx, candidate))
+		_, err = memdb.FindClientInfoByID(ctx, clientA.ID)
+		assert.ErrorIs(t, err, db.ErrClientNotFound)
+
+		// the synced seq of the deleted client does not hold the min synced
+		// ticket anymore.
+		ticket, err = memdb.UpdateAndFindMinSyncedTicket(ctx, clientB, docInfo.ID, 1)
+		assert.NoError(t, err)
+		assert.NotEqual(t, time.InitialTicket, ticket)
+	})
+
 	t.Run("store and find snapshots test", func(t *testing.T) {
 		ctx := context.Background()
 		bsonDocKey := fmt.Sprintf("tests$%s", t.Name())
@@ -167,16 +298,144 @@ func TestDB(t *testing.T) {
 			return nil
 		}))
 
-		assert.NoError(t, memdb.CreateSnapshotInfo(ctx, docInfo.ID, doc.InternalDocument()))
+		createSnapshotInfo := func() error {
+			snapshot, err := converter.ObjectToBytes(doc.RootObject())
+			assert.NoError(t, err)
+			return memdb.CreateSnapshotInfo(ctx, docInfo.ID, doc.Checkpoint().ServerSeq, snapshot, db.SnapshotHash(snapshot))
+		}
+
+		assert.NoError(t, createSnapshotInfo())
 		snapshot, err := memdb.FindLastSnapshotInfo(ctx, docInfo.ID)
 		assert.NoError(t, err)
 		assert.Equal(t, uint64(0), snapshot.ServerSeq)
 
 		pack := change.NewPack(doc.Key(), doc.Checkpoint().NextServerSeq(1), nil, nil)
 		assert.NoError(t, doc.ApplyChangePack(pack))
-		assert.NoError(t, memdb.CreateSnapshotInfo(ctx, docInfo.ID, doc.InternalDocument()))
+		assert.NoError(t, createSnapshotInfo())
 		snapshot, err = memdb.FindLastSnapshotInfo(ctx, docInfo.ID)
 		assert.NoError(t, err)
 		assert.Equal(t, uint64(1), snapshot.ServerSeq)
+		assert.Equal(t, db.SnapshotHash(snapshot.Snapshot), snapshot.Hash)
+
+		pack = change.NewPack(doc.Key(), doc.Checkpoint().NextServerSeq(3), nil, nil)
+		assert.NoError(t, doc.ApplyChangePack(pack))
+		assert.NoError(t, createSnapshotInfo())
+		snapshot, err = memdb.FindClosestSnapshotInfo(ctx, docInfo.ID, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(1), snapshot.ServerSeq)
+		snapshot, err = memdb.FindClosestSnapshotInfo(ctx, docInfo.ID, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(3), snapshot.ServerSeq)
+	})
+
+	t.Run("find doc stats test", func(t *testing.T) {
+		bsonDocKey := fmt.Sprintf("tests$%s", t.Name())
+
+		clientInfo, _ := memdb.ActivateClient(ctx, t.Name())
+		docInfo, _ := memdb.FindDocInfoByKey(ctx, clientInfo, bsonDocKey, true)
+
+		bytesID, _ := clientInfo.ID.Bytes()
+		actorID, _ := time.ActorIDFromBytes(bytesID)
+		doc := document.New("tests", t.Name())
+		doc.SetActor(actorID)
+		for idx := 0; idx < 3; idx++ {
+			assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+				root.SetInteger("k1", idx)
+				return nil
+			}))
+		}
+		pack := doc.CreateChangePack()
+		for idx, change := range pack.Changes {
+			change.SetServerSeq(uint64(idx + 1))
+		}
+		docInfo.ServerSeq = 3
+		assert.NoError(t, memdb.CreateChangeInfos(ctx, docInfo, 0, pack.Changes))
+
+		stats, err := memdb.FindDocStats(ctx, docInfo.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, &db.DocStats{
+			ServerSeq:            3,
+			ChangesSinceSnapshot: 3,
+			ActorCount:           1,
+		}, stats)
+
+		assert.NoError(t, memdb.CreateSnapshotInfo(ctx, docInfo.ID, 2, []byte("snapshot"), db.SnapshotHash([]byte("snapshot"))))
+		stats, err = memdb.FindDocStats(ctx, docInfo.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, &db.DocStats{
+			ServerSeq:            3,
+			SnapshotServerSeq:    2,
+			SnapshotBytes:        len("snapshot"),
+			ChangesSinceSnapshot: 1,
+			ActorCount:           1,
+		}, stats)
+
+		_, err = memdb.FindDocStats(ctx, db.ID("000000000000000000000000"))
+		assert.ErrorIs(t, err, db.ErrDocumentNotFound)
+	})
+
+	t.Run("encrypted changes and snapshots test", func(t *testing.T) {
+		keyFile := filepath.Join(t.TempDir(), "master.key")
+		assert.NoError(t, ioutil.WriteFile(keyFile, []byte(strings.Repeat("ab", 32)), 0600))
+		kms, err := encryption.NewLocalKMS(keyFile)
+		assert.NoError(t, err)
+		envelope, err := encryption.NewEnvelope(kms)
+		assert.NoError(t, err)
+
+		encryptedDB, err := memory.New()
+		assert.NoError(t, err)
+		encryptedDB.SetCipher(envelope)
+
+		bsonDocKey := fmt.Sprintf("tests$%s", t.Name())
+		clientInfo, _ := encryptedDB.ActivateClient(ctx, t.Name())
+		docInfo, _ := encryptedDB.FindDocInfoByKey(ctx, clientInfo, bsonDocKey, true)
+
+		bytesID, _ := clientInfo.ID.Bytes()
+		actorID, _ := time.ActorIDFromBytes(bytesID)
+		doc := document.New("tests", t.Name())
+		doc.SetActor(actorID)
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "secret")
+			return nil
+		}))
+		pack := doc.CreateChangePack()
+		pack.Changes[0].SetServerSeq(docInfo.IncreaseServerSeq())
+		assert.NoError(t, encryptedDB.CreateChangeInfos(ctx, docInfo, 0, pack.Changes))
+
+		snapshot, err := converter.ObjectToBytes(doc.RootObject())
+		assert.NoError(t, err)
+		assert.NoError(t, encryptedDB.CreateSnapshotInfo(ctx, docInfo.ID, 1, snapshot, db.SnapshotHash(snapshot)))
+
+		// the payloads are decrypted transparently
+		infos, err := encryptedDB.FindChangeInfosBetweenServerSeqs(ctx, docInfo.ID, 1, 1)
+		assert.NoError(t, err)
+		assert.Len(t, infos, 1)
+		assert.Empty(t, infos[0].EncryptedKey)
+		changes, err := encryptedDB.FindChangesBetweenServerSeqs(ctx, docInfo.ID, 1, 1)
+		assert.NoError(t, err)
+		assert.Len(t, changes[0].Operations(), 1)
+
+		snapshotInfo, err := encryptedDB.FindLastSnapshotInfo(ctx, docInfo.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, snapshot, snapshotInfo.Snapshot)
+		assert.Equal(t, db.SnapshotHash(snapshot), snapshotInfo.Hash)
+
+		// the payloads can not be read without the cipher
+		encryptedDB.SetCipher(nil)
+		_, err = encryptedDB.FindChangeInfosBetweenServerSeqs(ctx, docInfo.ID, 1, 1)
+		assert.ErrorIs(t, err, db.ErrCipherRequired)
+		_, err = encryptedDB.FindLastSnapshotInfo(ctx, docInfo.ID)
+		assert.ErrorIs(t, err, db.ErrCipherRequired)
+
+		// the snapshot that can not be decrypted is not returned as corrupted
+		otherKeyFile := filepath.Join(t.TempDir(), "other.key")
+		assert.NoError(t, ioutil.WriteFile(otherKeyFile, []byte(strings.Repeat("cd", 32)), 0600))
+		otherKMS, err := encryption.NewLocalKMS(otherKeyFile)
+		assert.NoError(t, err)
+		otherEnvelope, err := encryption.NewEnvelope(otherKMS)
+		assert.NoError(t, err)
+		encryptedDB.SetCipher(otherEnvelope)
+		_, err = encryptedDB.FindLastSnapshotInfo(ctx, docInfo.ID)
+		assert.ErrorIs(t, err, encryption.ErrInvalidEncryptedKey)
 	})
 }
@@ -18,16 +18,16 @@ package mongo
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	gotime "time"
 
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/mongo/readpref"
 
-	"github.com/yorkie-team/yorkie/api/converter"
-	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/yorkie/backend/db"
@@ -38,6 +38,10 @@ import (
 type Client struct {
 	config *Config
 	client *mongo.Client
+
+	// cipher encrypts the content payloads. It is nil if they are stored in
+	// plaintext.
+	cipher db.Cipher
 }
 
 // Dial creates an instance of Client and dials the given MongoDB.
@@ -47,7 +51,7 @@ func Dial(conf *Config) (*Client, error) {
 
 	client, err := mongo.Connect(
 		ctx,
-		options.Client().
+		clientOptions(conf).
 			ApplyURI(conf.ConnectionURI).
 			SetRegistry(newRegistryBuilder().Build()),
 	)
@@ -65,7 +69,7 @@ func Dial(conf *Config) (*Client, error) {
 		return nil, err
 	}
 
-	if err := ensureIndexes(ctx, client.Database(conf.YorkieDatabase)); err != nil {
+	if err := ensureIndexes(ctx, client.Database(conf.YorkieDatabase), conf.VerifyIndexesOnly); err != nil {
 		logging.DefaultLogger().Error(err)
 		return nil, err
 	}
@@ -78,6 +82,68 @@ func Dial(conf *Config) (*Client, error) {
 	}, nil
 }
 
+// DialReadReplica creates an instance of Client that reads from the read
+// replica of the given config. Unlike Dial, it only verifies the indexes
+// because the replica is not writable.
+func DialReadReplica(conf *Config) (*Client, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), conf.ParseConnectionTimeout())
+	defer cancel()
+
+	client, err := mongo.Connect(
+		ctx,
+		clientOptions(conf).
+			ApplyURI(conf.ReadReplicaURI).
+			SetReadPreference(readpref.SecondaryPreferred()).
+			SetRegistry(newRegistryBuilder().Build()),
+	)
+	if err != nil {
+		logging.DefaultLogger().Error(err)
+		return nil, err
+	}
+
+	pingTimeout := conf.ParsePingTimeout()
+	ctxPing, cancel := context.WithTimeout(ctx, pingTimeout)
+	defer cancel()
+
+	if err := client.Ping(ctxPing, readpref.SecondaryPreferred()); err != nil {
+		logging.DefaultLogger().Errorf("fail to connect to %s in %f sec", conf.ReadReplicaURI, pingTimeout.Seconds())
+		return nil, err
+	}
+
+	if err := ensureIndexes(ctx, client.Database(conf.YorkieDatabase), true); err != nil {
+		logging.DefaultLogger().Error(err)
+		return nil, err
+	}
+
+	logging.DefaultLogger().Infof("MongoDB read replica connected, URI: %s, DB: %s", conf.ReadReplicaURI, conf.YorkieDatabase)
+
+	return &Client{
+		config: conf,
+		client: client,
+	}, nil
+}
+
+// clientOptions returns the client options with the connection pool and the
+// timeouts of the given config.
+func clientOptions(conf *Config) *options.ClientOptions {
+	opts := options.Client().
+		SetConnectTimeout(conf.ParseConnectionTimeout()).
+		SetMinPoolSize(conf.MinPoolSize)
+	if conf.MaxPoolSize > 0 {
+		opts = opts.SetMaxPoolSize(conf.MaxPoolSize)
+	}
+	if socketTimeout := conf.ParseSocketTimeout(); socketTimeout > 0 {
+		opts = opts.SetSocketTimeout(socketTimeout)
+	}
+
+	return opts
+}
+
+// SetCipher sets the cipher to encrypt the content payloads of documents.
+func (c *Client) SetCipher(cipher db.Cipher) {
+	c.cipher = cipher
+}
+
 // Close all resources of this client.
 func (c *Client) Close() error {
 	if err := c.client.Disconnect(context.Background()); err != nil {
@@ -88,6 +154,15 @@ func (c *Client) Close() error {
 	return nil
 }
 
+// Ping checks whether the primary of MongoDB is reachable.
+func (c *Client) Ping(ctx context.Context) error {
+	if err := c.client.Ping(ctx, readpref.Primary()); err != nil {
+		return fmt.Errorf("ping mongo: %w", err)
+	}
+
+	return nil
+}
+
 // ActivateClient activates the client of the given key.
 func (c *Client) ActivateClient(ctx context.Context, key string) (*db.ClientInfo, error) {
 	now := gotime.Now()
@@ -179,6 +254,94 @@ func (c *Client) FindClientInfoByID(ctx context.Context, clientID db.ID) (*db.Cl
 	return &clientInfo, nil
 }
 
+// FindClientInfoByIDReadOnly finds the client of the given ID without updating
+// the last access time of the client.
+func (c *Client) FindClientInfoByIDReadOnly(ctx context.Context, clientID db.ID) (*db.ClientInfo, error) {
+	encodedClientID, err := encodeID(clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := c.collection(colClients).FindOne(ctx, bson.M{
+		"_id": encodedClientID,
+	})
+
+	clientInfo := db.ClientInfo{}
+	if err := result.Decode(&clientInfo); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("%s: %w", clientID, db.ErrClientNotFound)
+		}
+		logging.From(ctx).Error(err)
+		return nil, err
+	}
+
+	return &clientInfo, nil
+}
+
+// UpdateClientMetadata updates the metadata of the client of the given ID.
+func (c *Client) UpdateClientMetadata(
+	ctx context.Context,
+	clientID db.ID,
+	metadata map[string]string,
+) (*db.ClientInfo, error) {
+	encodedClientID, err := encodeID(clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := c.collection(colClients).FindOneAndUpdate(ctx, bson.M{
+		"_id": encodedClientID,
+	}, bson.M{
+		"$set": bson.M{
+			"metadata":   metadata,
+			"updated_at": gotime.Now(),
+		},
+	}, options.FindOneAndUpdate().SetReturnDocument(options.After))
+
+	clientInfo := db.ClientInfo{}
+	if err := result.Decode(&clientInfo); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("%s: %w", clientID, db.ErrClientNotFound)
+		}
+		logging.From(ctx).Error(err)
+		return nil, err
+	}
+
+	return &clientInfo, nil
+}
+
+// UpdateClientPublicKey updates the public key of the client of the given ID.
+func (c *Client) UpdateClientPublicKey(
+	ctx context.Context,
+	clientID db.ID,
+	publicKey []byte,
+) (*db.ClientInfo, error) {
+	encodedClientID, err := encodeID(clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := c.collection(colClients).FindOneAndUpdate(ctx, bson.M{
+		"_id": encodedClientID,
+	}, bson.M{
+		"$set": bson.M{
+			"public_key": publicKey,
+			"updated_at": gotime.Now(),
+		},
+	}, options.FindOneAndUpdate().SetReturnDocument(options.After))
+
+	clientInfo := db.ClientInfo{}
+	if err := result.Decode(&clientInfo); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("%s: %w", clientID, db.ErrClientNotFound)
+		}
+		logging.From(ctx).Error(err)
+		return nil, err
+	}
+
+	return &clientInfo, nil
+}
+
 // UpdateClientInfoAfterPushPull updates the client from the given clientInfo
 // after handling PushPull.
 func (c *Client) UpdateClientInfoAfterPushPull(
@@ -236,11 +399,74 @@ func (c *Client) FindDeactivateCandidates(
 	ctx context.Context,
 	deactivateThreshold gotime.Duration,
 	candidatesLimit int,
+) ([]*db.ClientInfo, error) {
+	return c.findCandidates(ctx, db.ClientActivated, deactivateThreshold, candidatesLimit)
+}
+
+// FindDeleteCandidates finds the deactivated clients that need to be deleted.
+func (c *Client) FindDeleteCandidates(
+	ctx context.Context,
+	deleteThreshold gotime.Duration,
+	candidatesLimit int,
+) ([]*db.ClientInfo, error) {
+	return c.findCandidates(ctx, db.ClientDeactivated, deleteThreshold, candidatesLimit)
+}
+
+// DeleteClientInfo deletes the given deactivated client and its synced seqs.
+// The synced seqs are deleted first, so that they are not left behind if
+// deleting the client fails.
+func (c *Client) DeleteClientInfo(ctx context.Context, clientInfo *db.ClientInfo) error {
+	encodedClientID, err := encodeID(clientInfo.ID)
+	if err != nil {
+		return err
+	}
+
+	var encodedDocIDs []primitive.ObjectID
+	for docID := range clientInfo.Documents {
+		encodedDocID, err := encodeID(docID)
+		if err != nil {
+			return err
+		}
+		encodedDocIDs = append(encodedDocIDs, encodedDocID)
+	}
+
+	if len(encodedDocIDs) > 0 {
+		if _, err := c.collection(colSyncedSeqs).DeleteMany(ctx, bson.M{
+			"doc_id":    bson.M{"$in": encodedDocIDs},
+			"client_id": encodedClientID,
+		}); err != nil {
+			logging.From(ctx).Error(err)
+			return err
+		}
+	}
+
+	res, err := c.collection(colClients).DeleteOne(ctx, bson.M{
+		"_id":    encodedClientID,
+		"status": db.ClientDeactivated,
+	})
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return fmt.Errorf("%s: %w", clientInfo.ID, db.ErrClientNotFound)
+	}
+
+	return nil
+}
+
+// findCandidates finds the clients of the given status that have not been
+// updated for the given threshold.
+func (c *Client) findCandidates(
+	ctx context.Context,
+	status string,
+	threshold gotime.Duration,
+	candidatesLimit int,
 ) ([]*db.ClientInfo, error) {
 	cursor, err := c.collection(colClients).Find(ctx, bson.M{
-		"status": db.ClientActivated,
+		"status": status,
 		"updated_at": bson.M{
-			"$lte": gotime.Now().Add(-deactivateThreshold),
+			"$lte": gotime.Now().Add(-threshold),
 		},
 	}, options.Find().SetLimit(int64(candidatesLimit)))
 
@@ -265,11 +491,6 @@ func (c *Client) FindDocInfoByKey(
 	bsonDocKey string,
 	createDocIfNotExist bool,
 ) (*db.DocInfo, error) {
-	encodedOwnerID, err := encodeID(clientInfo.ID)
-	if err != nil {
-		return nil, err
-	}
-
 	now := gotime.Now()
 	res, err := c.collection(colDocuments).UpdateOne(ctx, bson.M{
 		"key": bsonDocKey,
@@ -285,6 +506,11 @@ func (c *Client) FindDocInfoByKey(
 
 	var result *mongo.SingleResult
 	if res.UpsertedCount > 0 {
+		encodedOwnerID, err := encodeID(clientInfo.ID)
+		if err != nil {
+			return nil, err
+		}
+
 		result = c.collection(colDocuments).FindOneAndUpdate(ctx, bson.M{
 			"_id": res.UpsertedID,
 		}, bson.M{
@@ -316,6 +542,72 @@ func (c *Client) FindDocInfoByKey(
 	return &docInfo, nil
 }
 
+// FindDocInfoByID finds the document of the given ID.
+func (c *Client) FindDocInfoByID(ctx context.Context, docID db.ID) (*db.DocInfo, error) {
+	encodedDocID, err := encodeID(docID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := c.collection(colDocuments).FindOne(ctx, bson.M{
+		"_id": encodedDocID,
+	})
+
+	docInfo := db.DocInfo{}
+	if err := result.Decode(&docInfo); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("%s: %w", docID, db.ErrDocumentNotFound)
+		}
+		logging.From(ctx).Error(err)
+		return nil, err
+	}
+
+	return &docInfo, nil
+}
+
+// UpdateDocSettings updates only the fields of the given names in the
+// settings of the document of the given ID.
+func (c *Client) UpdateDocSettings(
+	ctx context.Context,
+	docID db.ID,
+	settings db.DocSettings,
+	fields []string,
+) (*db.DocInfo, error) {
+	encodedDocID, err := encodeID(docID)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: Each field is set by its own path, so that the fields updated
+	//       concurrently by others, such as the quarantine, are not
+	//       overwritten.
+	updates := bson.M{}
+	for _, field := range fields {
+		value, err := settings.Field(field)
+		if err != nil {
+			return nil, err
+		}
+		updates["settings."+field] = value
+	}
+
+	result := c.collection(colDocuments).FindOneAndUpdate(ctx, bson.M{
+		"_id": encodedDocID,
+	}, bson.M{
+		"$set": updates,
+	}, options.FindOneAndUpdate().SetReturnDocument(options.After))
+
+	docInfo := db.DocInfo{}
+	if err := result.Decode(&docInfo); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, fmt.Errorf("%s: %w", docID, db.ErrDocumentNotFound)
+		}
+		logging.From(ctx).Error(err)
+		return nil, err
+	}
+
+	return &docInfo, nil
+}
+
 // CreateChangeInfos stores the given changes and doc info.
 func (c *Client) CreateChangeInfos(
 	ctx context.Context,
@@ -328,12 +620,22 @@ func (c *Client) CreateChangeInfos(
 		return err
 	}
 
+	now := gotime.Now()
 	var models []mongo.WriteModel
 	for _, cn := range changes {
 		encodedOperations, err := db.EncodeOperations(cn.Operations())
 		if err != nil {
 			return err
 		}
+		encodedOperations, encryptedKey, err := db.EncryptPayloads(
+			ctx,
+			c.cipher,
+			encodedOperations,
+			db.PayloadAAD(db.PayloadKindChange, docInfo.ID, *cn.ServerSeq()),
+		)
+		if err != nil {
+			return err
+		}
 
 		models = append(models, mongo.NewUpdateOneModel().SetFilter(bson.M{
 			"doc_id":     encodedDocID,
@@ -344,29 +646,47 @@ func (c *Client) CreateChangeInfos(
 			"lamport":    cn.ID().Lamport(),
 			"message":    cn.Message(),
 			"operations": encodedOperations,
+			"signature":  cn.Signature(),
+			"vector":     db.EncodeVectorClock(cn.ID().Vector()),
+			"created_at": now,
+
+			// NOTE: The key is always set to overwrite the key of the change
+			// stored by the failed push.
+			"encrypted_key": encryptedKey,
 		}}).SetUpsert(true))
 	}
 
+	// NOTE: The changes are upserted by their server seqs, so the order of the
+	// writes does not matter and the unordered bulk write lets the server
+	// apply them in a single round trip without stopping at the first error.
 	// TODO(hackerwins): We need to handle the updates for the two collections
 	// below atomically.
 	if _, err = c.collection(colChanges).BulkWrite(
 		ctx,
 		models,
-		options.BulkWrite().SetOrdered(true),
+		options.BulkWrite().SetOrdered(false),
 	); err != nil {
 		logging.From(ctx).Error(err)
-		return err
+		return toChangesNotStoredError(err, changes)
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"server_seq":  docInfo.ServerSeq,
+			"max_lamport": docInfo.MaxLamport,
+			"updated_at":  gotime.Now(),
+		},
+	}
+	if len(docInfo.Actors) > 0 {
+		update["$addToSet"] = bson.M{
+			"actors": bson.M{"$each": docInfo.Actors},
+		}
 	}
 
 	res, err := c.collection(colDocuments).UpdateOne(ctx, bson.M{
 		"_id":        encodedDocID,
 		"server_seq": initialServerSeq,
-	}, bson.M{
-		"$set": bson.M{
-			"server_seq": docInfo.ServerSeq,
-			"updated_at": gotime.Now(),
-		},
-	})
+	}, update)
 	if err != nil {
 		logging.From(ctx).Error(err)
 		return err
@@ -378,6 +698,30 @@ func (c *Client) CreateChangeInfos(
 	return nil
 }
 
+// toChangesNotStoredError returns an error naming the server seqs of the
+// changes that failed in the bulk write. If the given error is not about the
+// writes of the changes, it is returned as is.
+func toChangesNotStoredError(err error, changes []*change.Change) error {
+	var bwe mongo.BulkWriteException
+	if !errors.As(err, &bwe) || len(bwe.WriteErrors) == 0 {
+		return err
+	}
+
+	var serverSeqs []uint64
+	for _, writeErr := range bwe.WriteErrors {
+		if writeErr.Index < len(changes) {
+			serverSeqs = append(serverSeqs, *changes[writeErr.Index].ServerSeq())
+		}
+	}
+
+	return fmt.Errorf(
+		"server seqs %v, %s: %w",
+		serverSeqs,
+		bwe.WriteErrors[0].Message,
+		db.ErrChangesNotStored,
+	)
+}
+
 // FindChangesBetweenServerSeqs returns the changes between two server sequences.
 func (c *Client) FindChangesBetweenServerSeqs(
 	ctx context.Context,
@@ -432,29 +776,184 @@ func (c *Client) FindChangeInfosBetweenServerSeqs(
 		return nil, cursor.Err()
 	}
 
+	for i, info := range infos {
+		infos[i], err = db.DecryptChangeInfo(ctx, c.cipher, info)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return infos, nil
 }
 
-// CreateSnapshotInfo stores the snapshot of the given document.
+// CompactChangeInfos deletes the changes of the given document whose serverSeq
+// is less than or equal to the given serverSeq.
+func (c *Client) CompactChangeInfos(
+	ctx context.Context,
+	docID db.ID,
+	serverSeq uint64,
+) (int, error) {
+	encodedDocID, err := encodeID(docID)
+	if err != nil {
+		return 0, err
+	}
+
+	// NOTE: The compacted serverSeq is recorded first so that the clients
+	//       behind it receive a snapshot even if the deletion below fails
+	//       in the middle.
+	result, err := c.collection(colDocuments).UpdateOne(ctx, bson.M{
+		"_id": encodedDocID,
+	}, bson.M{
+		"$max": bson.M{
+			"compacted_server_seq": serverSeq,
+		},
+	})
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return 0, err
+	}
+	if result.MatchedCount == 0 {
+		return 0, fmt.Errorf("%s: %w", docID, db.ErrDocumentNotFound)
+	}
+
+	deleted, err := c.collection(colChanges).DeleteMany(ctx, bson.M{
+		"doc_id": encodedDocID,
+		"server_seq": bson.M{
+			"$lte": serverSeq,
+		},
+	})
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return 0, err
+	}
+
+	return int(deleted.DeletedCount), nil
+}
+
+// FindDocStats returns the statistics of the document of the given ID.
+func (c *Client) FindDocStats(
+	ctx context.Context,
+	docID db.ID,
+) (*db.DocStats, error) {
+	docInfo, err := c.FindDocInfoByID(ctx, docID)
+	if err != nil {
+		return nil, err
+	}
+	stats := &db.DocStats{
+		ServerSeq: docInfo.ServerSeq,
+	}
+
+	encodedDocID, err := encodeID(docID)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: The size of the snapshot is calculated on the server, so that the
+	//       snapshot itself is not transferred.
+	snapshotCursor, err := c.collection(colSnapshots).Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"doc_id": encodedDocID}}},
+		{{Key: "$sort", Value: bson.M{"server_seq": -1}}},
+		{{Key: "$limit", Value: 1}},
+		{{Key: "$project", Value: bson.M{
+			"server_seq": 1,
+			"bytes":      bson.M{"$binarySize": "$snapshot"},
+		}}},
+	})
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return nil, err
+	}
+
+	var snapshots []struct {
+		ServerSeq uint64 `bson:"server_seq"`
+		Bytes     int    `bson:"bytes"`
+	}
+	if err := snapshotCursor.All(ctx, &snapshots); err != nil {
+		logging.From(ctx).Error(err)
+		return nil, err
+	}
+	if len(snapshots) > 0 {
+		stats.SnapshotServerSeq = snapshots[0].ServerSeq
+		stats.SnapshotBytes = snapshots[0].Bytes
+	}
+
+	changeCursor, err := c.collection(colChanges).Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"doc_id": encodedDocID}}},
+		{{Key: "$group", Value: bson.M{
+			"_id": "$actor_id",
+			"changes": bson.M{"$sum": bson.M{"$cond": bson.A{
+				bson.M{"$gt": bson.A{"$server_seq", stats.SnapshotServerSeq}}, 1, 0,
+			}}},
+		}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":     nil,
+			"actors":  bson.M{"$sum": 1},
+			"changes": bson.M{"$sum": "$changes"},
+		}}},
+	})
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return nil, err
+	}
+
+	var changes []struct {
+		Actors  int `bson:"actors"`
+		Changes int `bson:"changes"`
+	}
+	if err := changeCursor.All(ctx, &changes); err != nil {
+		logging.From(ctx).Error(err)
+		return nil, err
+	}
+	if len(changes) > 0 {
+		stats.ActorCount = changes[0].Actors
+		stats.ChangesSinceSnapshot = changes[0].Changes
+	}
+
+	return stats, nil
+}
+
+// CreateSnapshotInfo stores the given snapshot of the document at the given
+// serverSeq with the given hash.
 func (c *Client) CreateSnapshotInfo(
 	ctx context.Context,
 	docID db.ID,
-	doc *document.InternalDocument,
+	serverSeq uint64,
+	snapshot []byte,
+	hash string,
 ) error {
 	encodedDocID, err := encodeID(docID)
 	if err != nil {
 		return err
 	}
-	snapshot, err := converter.ObjectToBytes(doc.RootObject())
+
+	encrypted, encryptedKey, err := db.EncryptPayloads(
+		ctx,
+		c.cipher,
+		[][]byte{snapshot},
+		db.PayloadAAD(db.PayloadKindSnapshot, docID, serverSeq),
+	)
 	if err != nil {
 		return err
 	}
 
 	if _, err := c.collection(colSnapshots).InsertOne(ctx, bson.M{
-		"doc_id":     encodedDocID,
-		"server_seq": doc.Checkpoint().ServerSeq,
-		"snapshot":   snapshot,
-		"created_at": gotime.Now(),
+		"doc_id":        encodedDocID,
+		"server_seq":    serverSeq,
+		"snapshot":      encrypted[0],
+		"hash":          hash,
+		"created_at":    gotime.Now(),
+		"encrypted_key": encryptedKey,
+	}); err != nil {
+		logging.From(ctx).Error(err)
+		return err
+	}
+
+	if _, err := c.collection(colDocuments).UpdateOne(ctx, bson.M{
+		"_id": encodedDocID,
+	}, bson.M{
+		"$max": bson.M{
+			"snapshot_server_seq": serverSeq,
+		},
 	}); err != nil {
 		logging.From(ctx).Error(err)
 		return err
@@ -493,7 +992,45 @@ func (c *Client) FindLastSnapshotInfo(
 		return nil, err
 	}
 
-	return snapshotInfo, nil
+	return db.DecryptSnapshotInfo(ctx, c.cipher, snapshotInfo)
+}
+
+// FindClosestSnapshotInfo finds the last snapshot of the given document whose
+// serverSeq is less than or equal to the given serverSeq.
+func (c *Client) FindClosestSnapshotInfo(
+	ctx context.Context,
+	docID db.ID,
+	serverSeq uint64,
+) (*db.SnapshotInfo, error) {
+	encodedDocID, err := encodeID(docID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := c.collection(colSnapshots).FindOne(ctx, bson.M{
+		"doc_id": encodedDocID,
+		"server_seq": bson.M{
+			"$lte": serverSeq,
+		},
+	}, options.FindOne().SetSort(bson.M{
+		"server_seq": -1,
+	}))
+
+	snapshotInfo := &db.SnapshotInfo{}
+	if result.Err() == mongo.ErrNoDocuments {
+		return snapshotInfo, nil
+	}
+
+	if result.Err() != nil {
+		logging.From(ctx).Error(result.Err())
+		return nil, result.Err()
+	}
+
+	if err := result.Decode(snapshotInfo); err != nil {
+		return nil, err
+	}
+
+	return db.DecryptSnapshotInfo(ctx, c.cipher, snapshotInfo)
 }
 
 // UpdateAndFindMinSyncedTicket updates the given serverSeq of the given client
@@ -603,6 +1140,38 @@ func (c *Client) UpdateSyncedSeq(
 	return nil
 }
 
+// FindMinSyncedSeqInfo finds the syncedSeq of the given document whose
+// serverSeq is the smallest.
+func (c *Client) FindMinSyncedSeqInfo(
+	ctx context.Context,
+	docID db.ID,
+) (*db.SyncedSeqInfo, error) {
+	encodedDocID, err := encodeID(docID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := c.collection(colSyncedSeqs).FindOne(ctx, bson.M{
+		"doc_id": encodedDocID,
+	}, options.FindOne().SetSort(bson.M{
+		"server_seq": 1,
+	}))
+	if result.Err() == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if result.Err() != nil {
+		logging.From(ctx).Error(result.Err())
+		return nil, result.Err()
+	}
+
+	syncedSeqInfo := db.SyncedSeqInfo{}
+	if err := result.Decode(&syncedSeqInfo); err != nil {
+		return nil, err
+	}
+
+	return &syncedSeqInfo, nil
+}
+
 func (c *Client) findTicketByServerSeq(
 	ctx context.Context,
 	docID db.ID,
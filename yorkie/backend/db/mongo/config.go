@@ -27,6 +27,27 @@ type Config struct {
 	ConnectionURI     string `yaml:"ConnectionURI"`
 	YorkieDatabase    string `yaml:"YorkieDatabase"`
 	PingTimeout       string `yaml:"PingTimeout"`
+
+	// ReadReplicaURI is the URI to connect to the read replica of MongoDB for
+	// the PushPulls without changes to push. If it is empty, those are read
+	// from ConnectionURI.
+	ReadReplicaURI string `yaml:"ReadReplicaURI"`
+
+	// MaxPoolSize is the maximum number of connections in the connection pool
+	// of MongoDB. If it is 0, the default of the driver is used.
+	MaxPoolSize uint64 `yaml:"MaxPoolSize"`
+
+	// MinPoolSize is the minimum number of connections kept in the connection
+	// pool of MongoDB.
+	MinPoolSize uint64 `yaml:"MinPoolSize"`
+
+	// SocketTimeout is the timeout for reading from and writing to the socket
+	// of MongoDB. If it is empty or 0, there is no timeout.
+	SocketTimeout string `yaml:"SocketTimeout"`
+
+	// VerifyIndexesOnly is whether to only verify the indexes at startup
+	// without creating the missing ones, e.g. for the read-only deployment.
+	VerifyIndexesOnly bool `yaml:"VerifyIndexesOnly"`
 }
 
 // Validate returns an error if the provided Config is invalidated.
@@ -47,6 +68,24 @@ func (c *Config) Validate() error {
 		)
 	}
 
+	if c.SocketTimeout != "" {
+		if _, err := time.ParseDuration(c.SocketTimeout); err != nil {
+			return fmt.Errorf(
+				`invalid argument "%s" for "--mongo-socket-timeout" flag: %w`,
+				c.SocketTimeout,
+				err,
+			)
+		}
+	}
+
+	if c.MaxPoolSize != 0 && c.MinPoolSize > c.MaxPoolSize {
+		return fmt.Errorf(
+			`invalid argument "%d" for "--mongo-min-pool-size" flag: greater than max pool size %d`,
+			c.MinPoolSize,
+			c.MaxPoolSize,
+		)
+	}
+
 	return nil
 }
 
@@ -69,3 +108,18 @@ func (c *Config) ParsePingTimeout() time.Duration {
 
 	return result
 }
+
+// ParseSocketTimeout returns socket timeout duration. It returns 0 if the
+// socket timeout is not set.
+func (c *Config) ParseSocketTimeout() time.Duration {
+	if c.SocketTimeout == "" {
+		return 0
+	}
+
+	result, err := time.ParseDuration(c.SocketTimeout)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
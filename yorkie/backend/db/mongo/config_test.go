@@ -41,5 +41,18 @@ func TestConfig(t *testing.T) {
 		config.ConnectionTimeout = "5s"
 		config.PingTimeout = "5"
 		assert.Error(t, config.Validate())
+
+		// 4. invalid socket timeout
+		config.PingTimeout = "5s"
+		config.SocketTimeout = "5"
+		assert.Error(t, config.Validate())
+
+		// 5. min pool size greater than max pool size
+		config.SocketTimeout = "10s"
+		config.MaxPoolSize = 10
+		config.MinPoolSize = 10
+		assert.NoError(t, config.Validate())
+		config.MinPoolSize = 11
+		assert.Error(t, config.Validate())
 	})
 }
@@ -0,0 +1,43 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mongo_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	gomongo "go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/yorkie-team/yorkie/yorkie/backend/db/mongo"
+)
+
+func TestIsTransientError(t *testing.T) {
+	// primary step-down
+	assert.True(t, mongo.IsTransientError(gomongo.CommandError{Code: 189}))
+	assert.True(t, mongo.IsTransientError(gomongo.CommandError{Code: 10107}))
+
+	// labeled by the driver
+	assert.True(t, mongo.IsTransientError(gomongo.CommandError{
+		Code:   1,
+		Labels: []string{"RetryableWriteError"},
+	}))
+
+	// not transient
+	assert.False(t, mongo.IsTransientError(gomongo.CommandError{Code: 11000}))
+	assert.False(t, mongo.IsTransientError(errors.New("unknown")))
+}
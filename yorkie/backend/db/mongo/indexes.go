@@ -18,10 +18,16 @@ package mongo
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/x/bsonx"
+
+	"github.com/yorkie-team/yorkie/yorkie/logging"
 )
 
 const (
@@ -32,6 +38,10 @@ const (
 	colSyncedSeqs = "syncedseqs"
 )
 
+// namespaceNotFound is the code of the server error returned when the
+// collection does not exist.
+const namespaceNotFound = 26
+
 type collectionInfo struct {
 	name    string
 	indexes []mongo.IndexModel
@@ -92,12 +102,86 @@ var collectionInfos = []collectionInfo{
 	},
 }
 
-func ensureIndexes(ctx context.Context, db *mongo.Database) error {
+// ensureIndexes verifies that the indexes of the collections exist, logs the
+// missing ones and creates them. If verifyOnly is true, the missing indexes
+// are only logged, e.g. for the read replica that is not writable. It is
+// idempotent, so it can be run at every startup.
+func ensureIndexes(ctx context.Context, db *mongo.Database, verifyOnly bool) error {
 	for _, info := range collectionInfos {
-		_, err := db.Collection(info.name).Indexes().CreateMany(ctx, info.indexes)
+		missing, err := findMissingIndexes(ctx, db.Collection(info.name), info.indexes)
 		if err != nil {
 			return err
 		}
+		if len(missing) == 0 {
+			continue
+		}
+
+		for _, index := range missing {
+			logging.DefaultLogger().Warnf(
+				"MongoDB index missing: %s.%s",
+				info.name,
+				indexKeyString(index.Keys.(bsonx.Doc)),
+			)
+		}
+		if verifyOnly {
+			continue
+		}
+
+		if _, err := db.Collection(info.name).Indexes().CreateMany(ctx, missing); err != nil {
+			return err
+		}
 	}
 	return nil
 }
+
+// findMissingIndexes returns the given indexes whose keys are not indexed in
+// the given collection.
+func findMissingIndexes(
+	ctx context.Context,
+	col *mongo.Collection,
+	indexes []mongo.IndexModel,
+) ([]mongo.IndexModel, error) {
+	cursor, err := col.Indexes().List(ctx)
+	if err != nil {
+		// NOTE: The indexes of the collection not created yet are all missing.
+		var se mongo.ServerError
+		if errors.As(err, &se) && se.HasErrorCode(namespaceNotFound) {
+			return indexes, nil
+		}
+		return nil, err
+	}
+
+	var listed []struct {
+		Key bson.D `bson:"key"`
+	}
+	if err := cursor.All(ctx, &listed); err != nil {
+		return nil, err
+	}
+
+	existing := make(map[string]bool)
+	for _, index := range listed {
+		var fields []string
+		for _, elem := range index.Key {
+			fields = append(fields, fmt.Sprintf("%s_%v", elem.Key, elem.Value))
+		}
+		existing[strings.Join(fields, "_")] = true
+	}
+
+	var missing []mongo.IndexModel
+	for _, index := range indexes {
+		if !existing[indexKeyString(index.Keys.(bsonx.Doc))] {
+			missing = append(missing, index)
+		}
+	}
+	return missing, nil
+}
+
+// indexKeyString returns the string of the given index keys in the form of
+// the default index name of MongoDB, e.g. "doc_id_1_server_seq_1".
+func indexKeyString(keys bsonx.Doc) string {
+	var fields []string
+	for _, elem := range keys {
+		fields = append(fields, fmt.Sprintf("%s_%d", elem.Key, elem.Value.Int32()))
+	}
+	return strings.Join(fields, "_")
+}
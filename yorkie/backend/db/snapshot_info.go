@@ -17,6 +17,8 @@
 package db
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"time"
 )
 
@@ -26,5 +28,17 @@ type SnapshotInfo struct {
 	DocID     ID        `bson:"doc_id"`
 	ServerSeq uint64    `bson:"server_seq"`
 	Snapshot  []byte    `bson:"snapshot"`
+	Hash      string    `bson:"hash"`
 	CreatedAt time.Time `bson:"created_at"`
+
+	// EncryptedKey is the encrypted data key of the snapshot. It is empty if
+	// the snapshot is not encrypted. The hash is of the decrypted snapshot
+	// before it is compressed.
+	EncryptedKey []byte `bson:"encrypted_key,omitempty"`
+}
+
+// SnapshotHash returns the hex-encoded SHA-256 hash of the given snapshot.
+func SnapshotHash(snapshot []byte) string {
+	hash := sha256.Sum256(snapshot)
+	return hex.EncodeToString(hash[:])
 }
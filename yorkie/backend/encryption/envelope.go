@@ -0,0 +1,159 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package encryption
+
+import (
+	"context"
+	"crypto/cipher"
+	"errors"
+	"fmt"
+	gosync "sync"
+	"time"
+
+	"github.com/yorkie-team/yorkie/pkg/cache"
+)
+
+// ErrDecryptionFailed is returned when the payload can not be decrypted.
+var ErrDecryptionFailed = errors.New("decryption failed")
+
+const (
+	// dataKeyLifetime is the lifetime of the data key used for encryption.
+	// A new data key is generated after it.
+	dataKeyLifetime = time.Hour
+
+	// dataKeyCacheSize is the number of the decrypted data keys cached to
+	// avoid calling the KMS for every read.
+	dataKeyCacheSize = 1000
+)
+
+// Envelope is a cipher that encrypts the payloads with data keys, which are
+// encrypted by the KMS and stored with the payloads.
+type Envelope struct {
+	kms KMS
+
+	mu           gosync.Mutex
+	aead         cipher.AEAD
+	encryptedKey []byte
+	generatedAt  time.Time
+
+	// aeads is the cache of AES-GCM by the encrypted data keys.
+	aeads *cache.LRUExpireCache
+}
+
+// NewEnvelope creates a new instance of Envelope with the given KMS.
+func NewEnvelope(kms KMS) (*Envelope, error) {
+	aeads, err := cache.NewLRUExpireCache(dataKeyCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Envelope{
+		kms:   kms,
+		aeads: aeads,
+	}, nil
+}
+
+// Encrypt encrypts the given payloads with the current data key, bound to the
+// given additional data. It returns the encrypted payloads and the encrypted
+// data key.
+func (e *Envelope) Encrypt(ctx context.Context, payloads [][]byte, aad []byte) ([][]byte, []byte, error) {
+	aead, encryptedKey, err := e.currentKey(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	encrypted := make([][]byte, len(payloads))
+	for i, payload := range payloads {
+		if encrypted[i], err = seal(aead, payload, aad); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return encrypted, encryptedKey, nil
+}
+
+// Decrypt decrypts the given payloads with the data key encrypted as the given
+// encryptedKey. It fails if the given additional data is not the one the
+// payloads are encrypted with.
+func (e *Envelope) Decrypt(
+	ctx context.Context,
+	payloads [][]byte,
+	encryptedKey []byte,
+	aad []byte,
+) ([][]byte, error) {
+	aead, err := e.keyOf(ctx, encryptedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	decrypted := make([][]byte, len(payloads))
+	for i, payload := range payloads {
+		if decrypted[i], err = open(aead, payload, aad); err != nil {
+			return nil, fmt.Errorf("%s: %w", err, ErrDecryptionFailed)
+		}
+	}
+
+	return decrypted, nil
+}
+
+// currentKey returns the data key for encryption. It generates a new one if
+// there is no key or the key has been used for its lifetime.
+func (e *Envelope) currentKey(ctx context.Context) (cipher.AEAD, []byte, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.aead != nil && time.Since(e.generatedAt) < dataKeyLifetime {
+		return e.aead, e.encryptedKey, nil
+	}
+
+	dataKey, encryptedKey, err := e.kms.GenerateDataKey(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	e.aead = aead
+	e.encryptedKey = encryptedKey
+	e.generatedAt = time.Now()
+	e.aeads.Add(string(encryptedKey), aead, dataKeyLifetime)
+
+	return aead, encryptedKey, nil
+}
+
+// keyOf returns the data key of the given encrypted data key.
+func (e *Envelope) keyOf(ctx context.Context, encryptedKey []byte) (cipher.AEAD, error) {
+	if entry, ok := e.aeads.Get(string(encryptedKey)); ok {
+		return entry.(cipher.AEAD), nil
+	}
+
+	dataKey, err := e.kms.DecryptDataKey(ctx, encryptedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	e.aeads.Add(string(encryptedKey), aead, dataKeyLifetime)
+	return aead, nil
+}
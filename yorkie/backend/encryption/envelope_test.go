@@ -0,0 +1,100 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package encryption_test
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/yorkie/backend/encryption"
+)
+
+func newLocalKMS(t *testing.T, hexKey string) (*encryption.LocalKMS, error) {
+	keyFile := filepath.Join(t.TempDir(), "master.key")
+	assert.NoError(t, ioutil.WriteFile(keyFile, []byte(hexKey+"\n"), 0600))
+	return encryption.NewLocalKMS(keyFile)
+}
+
+func TestEnvelope(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("encrypt and decrypt test", func(t *testing.T) {
+		kms, err := newLocalKMS(t, strings.Repeat("01", 32))
+		assert.NoError(t, err)
+		envelope, err := encryption.NewEnvelope(kms)
+		assert.NoError(t, err)
+
+		payloads := [][]byte{[]byte("hello"), {}, []byte("world")}
+		aad := []byte("change:doc:1")
+		encrypted, encryptedKey, err := envelope.Encrypt(ctx, payloads, aad)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, encryptedKey)
+		assert.Len(t, encrypted, 3)
+		assert.NotContains(t, string(encrypted[0]), "hello")
+
+		decrypted, err := envelope.Decrypt(ctx, encrypted, encryptedKey, aad)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("hello"), decrypted[0])
+		assert.Empty(t, decrypted[1])
+		assert.Equal(t, []byte("world"), decrypted[2])
+
+		// another envelope with the same master key, e.g. after restarting.
+		other, err := encryption.NewEnvelope(kms)
+		assert.NoError(t, err)
+		decrypted, err = other.Decrypt(ctx, encrypted, encryptedKey, aad)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("world"), decrypted[2])
+
+		// payload moved to another record
+		_, err = envelope.Decrypt(ctx, encrypted, encryptedKey, []byte("change:doc:2"))
+		assert.ErrorIs(t, err, encryption.ErrDecryptionFailed)
+
+		// tampered payload
+		encrypted[0][len(encrypted[0])-1] ^= 0xff
+		_, err = envelope.Decrypt(ctx, encrypted, encryptedKey, aad)
+		assert.ErrorIs(t, err, encryption.ErrDecryptionFailed)
+	})
+
+	t.Run("different master key test", func(t *testing.T) {
+		kms, err := newLocalKMS(t, strings.Repeat("01", 32))
+		assert.NoError(t, err)
+		envelope, err := encryption.NewEnvelope(kms)
+		assert.NoError(t, err)
+		encrypted, encryptedKey, err := envelope.Encrypt(ctx, [][]byte{[]byte("hello")}, nil)
+		assert.NoError(t, err)
+
+		otherKMS, err := newLocalKMS(t, strings.Repeat("02", 32))
+		assert.NoError(t, err)
+		other, err := encryption.NewEnvelope(otherKMS)
+		assert.NoError(t, err)
+		_, err = other.Decrypt(ctx, encrypted, encryptedKey, nil)
+		assert.ErrorIs(t, err, encryption.ErrInvalidEncryptedKey)
+	})
+
+	t.Run("invalid master key test", func(t *testing.T) {
+		_, err := newLocalKMS(t, "not-hex")
+		assert.ErrorIs(t, err, encryption.ErrInvalidMasterKey)
+
+		_, err = newLocalKMS(t, strings.Repeat("01", 16))
+		assert.ErrorIs(t, err, encryption.ErrInvalidMasterKey)
+	})
+}
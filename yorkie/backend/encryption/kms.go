@@ -0,0 +1,138 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package encryption
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+)
+
+var (
+	// ErrInvalidMasterKey is returned when the master key is not a
+	// hex-encoded 256-bit key.
+	ErrInvalidMasterKey = errors.New("invalid master key")
+
+	// ErrInvalidEncryptedKey is returned when the encrypted data key can not
+	// be decrypted.
+	ErrInvalidEncryptedKey = errors.New("invalid encrypted data key")
+)
+
+// dataKeySize is the size of the data keys. The data keys are AES-256 keys.
+const dataKeySize = 32
+
+// KMS is the key management service of the envelope encryption. It encrypts
+// the data keys with the key encryption key, which never leaves the service.
+type KMS interface {
+	// GenerateDataKey returns a new data key and the data key encrypted by
+	// the key encryption key.
+	GenerateDataKey(ctx context.Context) ([]byte, []byte, error)
+
+	// DecryptDataKey decrypts the given encrypted data key.
+	DecryptDataKey(ctx context.Context, encryptedKey []byte) ([]byte, error)
+}
+
+// LocalKMS is a KMS that encrypts the data keys with the master key loaded
+// from a local file.
+type LocalKMS struct {
+	aead cipher.AEAD
+}
+
+// NewLocalKMS creates a new instance of LocalKMS with the hex-encoded 256-bit
+// master key in the given file.
+func NewLocalKMS(keyFile string) (*LocalKMS, error) {
+	encoded, err := ioutil.ReadFile(keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	masterKey, err := hex.DecodeString(strings.TrimSpace(string(encoded)))
+	if err != nil || len(masterKey) != dataKeySize {
+		return nil, fmt.Errorf("%s: %w", keyFile, ErrInvalidMasterKey)
+	}
+
+	aead, err := newAEAD(masterKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LocalKMS{aead: aead}, nil
+}
+
+// GenerateDataKey returns a new data key and the data key encrypted by the
+// master key.
+func (k *LocalKMS) GenerateDataKey(ctx context.Context) ([]byte, []byte, error) {
+	dataKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, nil, err
+	}
+
+	encryptedKey, err := seal(k.aead, dataKey, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return dataKey, encryptedKey, nil
+}
+
+// DecryptDataKey decrypts the given data key with the master key.
+func (k *LocalKMS) DecryptDataKey(ctx context.Context, encryptedKey []byte) ([]byte, error) {
+	dataKey, err := open(k.aead, encryptedKey, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", err, ErrInvalidEncryptedKey)
+	}
+
+	return dataKey, nil
+}
+
+// newAEAD creates AES-GCM with the given key.
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts the given plaintext with a random nonce, authenticating the
+// given additional data. The nonce is prepended to the ciphertext.
+func seal(aead cipher.AEAD, plaintext []byte, aad []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(nonce, nonce, plaintext, aad), nil
+}
+
+// open decrypts the given ciphertext sealed by seal with the same additional
+// data.
+func open(aead cipher.AEAD, ciphertext []byte, aad []byte) ([]byte, error) {
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errors.New("ciphertext too short")
+	}
+
+	nonce, sealed := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	return aead.Open(nil, nonce, sealed, aad)
+}
@@ -0,0 +1,181 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package fairqueue provides a bounded queue that schedules the waiting
+// requests in round-robin across the keys of the requesters, so that a key
+// with many requests can not starve the others.
+package fairqueue
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"sync"
+)
+
+var (
+	// ErrQueueFull is returned when the number of waiting requests has
+	// reached the capacity of the queue.
+	ErrQueueFull = errors.New("queue is full")
+)
+
+// waiter is a request waiting for its turn.
+type waiter struct {
+	key   string
+	ready chan struct{}
+}
+
+// keyQueue is the FIFO of the waiters of a key.
+type keyQueue struct {
+	waiters *list.List
+
+	// elem is the element of this key in the ring of the keys.
+	elem *list.Element
+}
+
+// Queue is a bounded queue that runs a limited number of requests
+// concurrently. The waiting requests are granted in round-robin across the
+// keys, and in FIFO order within a key.
+type Queue struct {
+	mu sync.Mutex
+
+	concurrency int
+	capacity    int
+
+	running int
+	waiting int
+
+	// ring is the list of the keys that have waiters in order of their turn.
+	ring   *list.List
+	queues map[string]*keyQueue
+}
+
+// New creates a new instance of Queue that runs up to the given concurrency
+// requests and holds up to the given capacity waiting requests.
+func New(concurrency, capacity int) *Queue {
+	return &Queue{
+		concurrency: concurrency,
+		capacity:    capacity,
+		ring:        list.New(),
+		queues:      make(map[string]*keyQueue),
+	}
+}
+
+// Acquire waits for the turn of a request of the given key. It returns
+// ErrQueueFull without waiting if the queue is full. If it returns nil, the
+// caller must call Release after processing the request.
+func (q *Queue) Acquire(ctx context.Context, key string) error {
+	q.mu.Lock()
+	if q.running < q.concurrency && q.waiting == 0 {
+		q.running++
+		q.mu.Unlock()
+		return nil
+	}
+
+	if q.waiting >= q.capacity {
+		q.mu.Unlock()
+		return ErrQueueFull
+	}
+
+	w := &waiter{key: key, ready: make(chan struct{})}
+	elem := q.push(w)
+	q.mu.Unlock()
+
+	select {
+	case <-w.ready:
+		return nil
+	case <-ctx.Done():
+		q.mu.Lock()
+		defer q.mu.Unlock()
+
+		select {
+		case <-w.ready:
+			// NOTE: The turn was granted while the context was being done, so
+			// pass it on to the next waiter.
+			q.release()
+		default:
+			q.remove(elem)
+		}
+		return ctx.Err()
+	}
+}
+
+// Release releases the turn acquired by Acquire and grants it to the next
+// waiter.
+func (q *Queue) Release() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.release()
+}
+
+// Len returns the number of the waiting requests.
+func (q *Queue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.waiting
+}
+
+// release grants the turn to the waiter of the next key in the ring. If
+// there is no waiter, the number of running requests is decreased.
+func (q *Queue) release() {
+	front := q.ring.Front()
+	if front == nil {
+		q.running--
+		return
+	}
+
+	kq := q.queues[front.Value.(string)]
+	w := kq.waiters.Front()
+	q.remove(w)
+
+	// NOTE: The key goes to the back of the ring to give the other keys their
+	// turns before the next waiter of it.
+	if kq.waiters.Len() > 0 {
+		q.ring.MoveToBack(kq.elem)
+	}
+
+	close(w.Value.(*waiter).ready)
+}
+
+// push appends the given waiter to the queue of its key.
+func (q *Queue) push(w *waiter) *list.Element {
+	kq, ok := q.queues[w.key]
+	if !ok {
+		kq = &keyQueue{
+			waiters: list.New(),
+			elem:    q.ring.PushBack(w.key),
+		}
+		q.queues[w.key] = kq
+	}
+
+	q.waiting++
+	return kq.waiters.PushBack(w)
+}
+
+// remove removes the given element of a waiter from the queue of its key.
+func (q *Queue) remove(elem *list.Element) {
+	key := elem.Value.(*waiter).key
+	kq := q.queues[key]
+	kq.waiters.Remove(elem)
+	q.waiting--
+
+	if kq.waiters.Len() == 0 {
+		q.ring.Remove(kq.elem)
+		delete(q.queues, key)
+	}
+}
@@ -0,0 +1,106 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package fairqueue_test
+
+import (
+	"context"
+	"testing"
+	gotime "time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/yorkie/backend/fairqueue"
+)
+
+func TestQueue(t *testing.T) {
+	// enqueue makes a request of the given key wait in the queue and sends
+	// the given name to the order channel when its turn comes.
+	enqueue := func(q *fairqueue.Queue, key, name string, order chan<- string) {
+		waiting := q.Len()
+		go func() {
+			if err := q.Acquire(context.Background(), key); err != nil {
+				return
+			}
+			order <- name
+		}()
+		assert.Eventually(t, func() bool {
+			return q.Len() == waiting+1
+		}, gotime.Second, gotime.Millisecond)
+	}
+
+	t.Run("round-robin across keys test", func(t *testing.T) {
+		q := fairqueue.New(1, 10)
+		assert.NoError(t, q.Acquire(context.Background(), "a"))
+
+		order := make(chan string, 5)
+		enqueue(q, "a", "a1", order)
+		enqueue(q, "a", "a2", order)
+		enqueue(q, "a", "a3", order)
+		enqueue(q, "b", "b1", order)
+		enqueue(q, "c", "c1", order)
+
+		var names []string
+		for i := 0; i < 5; i++ {
+			q.Release()
+			names = append(names, <-order)
+		}
+		assert.Equal(t, []string{"a1", "b1", "c1", "a2", "a3"}, names)
+		assert.Equal(t, 0, q.Len())
+	})
+
+	t.Run("queue full test", func(t *testing.T) {
+		q := fairqueue.New(1, 1)
+		assert.NoError(t, q.Acquire(context.Background(), "a"))
+
+		order := make(chan string, 1)
+		enqueue(q, "a", "a1", order)
+
+		err := q.Acquire(context.Background(), "b")
+		assert.ErrorIs(t, err, fairqueue.ErrQueueFull)
+
+		q.Release()
+		assert.Equal(t, "a1", <-order)
+		q.Release()
+
+		// the queue is empty again
+		assert.NoError(t, q.Acquire(context.Background(), "b"))
+	})
+
+	t.Run("context cancellation test", func(t *testing.T) {
+		q := fairqueue.New(1, 10)
+		assert.NoError(t, q.Acquire(context.Background(), "a"))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- q.Acquire(ctx, "b")
+		}()
+		assert.Eventually(t, func() bool {
+			return q.Len() == 1
+		}, gotime.Second, gotime.Millisecond)
+
+		cancel()
+		assert.ErrorIs(t, <-errCh, context.Canceled)
+		assert.Equal(t, 0, q.Len())
+
+		// the canceled waiter does not take the turn
+		order := make(chan string, 1)
+		enqueue(q, "c", "c1", order)
+		q.Release()
+		assert.Equal(t, "c1", <-order)
+	})
+}
@@ -18,16 +18,19 @@ package housekeeping
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/yorkie-team/yorkie/yorkie/backend/db"
 	"github.com/yorkie-team/yorkie/yorkie/backend/sync"
 	"github.com/yorkie-team/yorkie/yorkie/logging"
+	"github.com/yorkie-team/yorkie/yorkie/profiling/prometheus"
 )
 
 const (
 	deactivateCandidatesKey = "housekeeping/deactivateCandidates"
+	deleteCandidatesKey     = "housekeeping/deleteCandidates"
 )
 
 // Config is the configuration for the housekeeping service.
@@ -41,6 +44,10 @@ type Config struct {
 
 	// CandidatesLimit is the maximum number of candidates to be returned.
 	CandidatesLimit int `yaml:"CandidatesLimit"`
+
+	// ClientDeleteThreshold is the time after which the deactivated clients
+	// are deleted. If it is empty, the deactivated clients are kept.
+	ClientDeleteThreshold string `yaml:"ClientDeleteThreshold"`
 }
 
 // Validate validates the configuration.
@@ -61,6 +68,16 @@ func (c *Config) Validate() error {
 		)
 	}
 
+	if c.ClientDeleteThreshold != "" {
+		if _, err := time.ParseDuration(c.ClientDeleteThreshold); err != nil {
+			return fmt.Errorf(
+				`invalid argument %s for "--housekeeping-client-delete-threshold" flag: %w`,
+				c.ClientDeleteThreshold,
+				err,
+			)
+		}
+	}
+
 	return nil
 }
 
@@ -68,10 +85,12 @@ func (c *Config) Validate() error {
 type Housekeeping struct {
 	database    db.DB
 	coordinator sync.Coordinator
+	metrics     *prometheus.Metrics
 
-	interval            time.Duration
-	deactivateThreshold time.Duration
-	candidatesLimit     int
+	interval              time.Duration
+	deactivateThreshold   time.Duration
+	clientDeleteThreshold time.Duration
+	candidatesLimit       int
 
 	ctx        context.Context
 	cancelFunc context.CancelFunc
@@ -82,8 +101,9 @@ func Start(
 	conf *Config,
 	database db.DB,
 	coordinator sync.Coordinator,
+	metrics *prometheus.Metrics,
 ) (*Housekeeping, error) {
-	h, err := New(conf, database, coordinator)
+	h, err := New(conf, database, coordinator, metrics)
 	if err != nil {
 		return nil, err
 	}
@@ -99,6 +119,7 @@ func New(
 	conf *Config,
 	database db.DB,
 	coordinator sync.Coordinator,
+	metrics *prometheus.Metrics,
 ) (*Housekeeping, error) {
 	interval, err := time.ParseDuration(conf.Interval)
 	if err != nil {
@@ -110,15 +131,25 @@ func New(
 		return nil, err
 	}
 
+	var clientDeleteThreshold time.Duration
+	if conf.ClientDeleteThreshold != "" {
+		clientDeleteThreshold, err = time.ParseDuration(conf.ClientDeleteThreshold)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	ctx, cancelFunc := context.WithCancel(context.Background())
 
 	return &Housekeeping{
 		database:    database,
 		coordinator: coordinator,
+		metrics:     metrics,
 
-		interval:            interval,
-		deactivateThreshold: deactivateThreshold,
-		candidatesLimit:     conf.CandidatesLimit,
+		interval:              interval,
+		deactivateThreshold:   deactivateThreshold,
+		clientDeleteThreshold: clientDeleteThreshold,
+		candidatesLimit:       conf.CandidatesLimit,
 
 		ctx:        ctx,
 		cancelFunc: cancelFunc,
@@ -145,6 +176,11 @@ func (h *Housekeeping) run() {
 		if err := h.deactivateCandidates(ctx); err != nil {
 			continue
 		}
+		if h.clientDeleteThreshold > 0 {
+			if err := h.deleteCandidates(ctx); err != nil {
+				logging.From(ctx).Error(err)
+			}
+		}
 
 		select {
 		case <-time.After(h.interval):
@@ -219,3 +255,58 @@ func (h *Housekeeping) deactivateCandidates(ctx context.Context) error {
 
 	return nil
 }
+
+// deleteCandidates deletes the deactivated clients that have not been updated
+// for the delete threshold, so that their records do not accumulate and their
+// synced seqs do not hold the garbage collection.
+func (h *Housekeeping) deleteCandidates(ctx context.Context) error {
+	start := time.Now()
+	locker, err := h.coordinator.NewLocker(ctx, deleteCandidatesKey)
+	if err != nil {
+		return err
+	}
+
+	if err := locker.Lock(ctx); err != nil {
+		return err
+	}
+
+	defer func() {
+		if err := locker.Unlock(ctx); err != nil {
+			logging.From(ctx).Error(err)
+		}
+	}()
+
+	candidates, err := h.database.FindDeleteCandidates(
+		ctx,
+		h.clientDeleteThreshold,
+		h.candidatesLimit,
+	)
+	if err != nil {
+		return err
+	}
+
+	deletedCount := 0
+	for _, clientInfo := range candidates {
+		// NOTE: The client may have been activated again after it was found.
+		if err := h.database.DeleteClientInfo(ctx, clientInfo); err != nil {
+			if errors.Is(err, db.ErrClientNotFound) {
+				continue
+			}
+			return err
+		}
+
+		deletedCount++
+	}
+	h.metrics.SetHousekeepingDeletedClients(deletedCount)
+
+	if len(candidates) > 0 {
+		logging.From(ctx).Infof(
+			"HSKP: delete candidates %d, deleted %d, %s",
+			len(candidates),
+			deletedCount,
+			time.Since(start),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package ratelimit provides an in-memory rate limiter that applies a token
+// bucket to each key, so that a key with too many requests can not starve the
+// others.
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// sweepInterval is the interval at which the idle buckets are evicted.
+const sweepInterval = time.Minute
+
+// bucket is the token bucket of a key.
+type bucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+// Limiter is a rate limiter that allows up to the given rate of requests per
+// second for each key, with bursts of up to the given number of requests.
+type Limiter struct {
+	mu sync.Mutex
+
+	rate  float64
+	burst float64
+
+	buckets   map[string]*bucket
+	lastSwept time.Time
+}
+
+// New creates a new instance of Limiter that allows the given rate of
+// requests per second with the given burst for each key.
+func New(rate float64, burst int) *Limiter {
+	return &Limiter{
+		rate:      rate,
+		burst:     float64(burst),
+		buckets:   make(map[string]*bucket),
+		lastSwept: time.Now(),
+	}
+}
+
+// Allow reports whether a request of the given key is allowed now. If it is,
+// a token of the key is consumed.
+func (l *Limiter) Allow(key string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(l.lastSwept) >= sweepInterval {
+		l.sweep(now)
+	}
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: l.burst, updated: now}
+		l.buckets[key] = b
+	}
+
+	b.tokens = l.refill(b, now)
+	b.updated = now
+	if b.tokens < 1 {
+		return false
+	}
+
+	b.tokens--
+	return true
+}
+
+// Sweep evicts the buckets of the idle keys.
+func (l *Limiter) Sweep() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.sweep(time.Now())
+}
+
+// Len returns the number of the keys that have buckets.
+func (l *Limiter) Len() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return len(l.buckets)
+}
+
+// sweep evicts the buckets that have been refilled up to the burst. A new
+// bucket starts with the burst, so the eviction does not change the requests
+// allowed for the keys.
+func (l *Limiter) sweep(now time.Time) {
+	for key, b := range l.buckets {
+		if l.refill(b, now) >= l.burst {
+			delete(l.buckets, key)
+		}
+	}
+	l.lastSwept = now
+}
+
+// refill returns the tokens of the given bucket at the given time.
+func (l *Limiter) refill(b *bucket, now time.Time) float64 {
+	tokens := b.tokens + now.Sub(b.updated).Seconds()*l.rate
+	if tokens > l.burst {
+		return l.burst
+	}
+	return tokens
+}
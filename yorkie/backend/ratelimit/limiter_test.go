@@ -0,0 +1,59 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ratelimit_test
+
+import (
+	"testing"
+	gotime "time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/yorkie/backend/ratelimit"
+)
+
+func TestLimiter(t *testing.T) {
+	t.Run("allow within burst test", func(t *testing.T) {
+		limiter := ratelimit.New(1, 2)
+		assert.True(t, limiter.Allow("c1"))
+		assert.True(t, limiter.Allow("c1"))
+		assert.False(t, limiter.Allow("c1"))
+
+		// NOTE: The other keys are not affected by the exhausted key.
+		assert.True(t, limiter.Allow("c2"))
+	})
+
+	t.Run("refill tokens test", func(t *testing.T) {
+		limiter := ratelimit.New(100, 1)
+		assert.True(t, limiter.Allow("c1"))
+		assert.False(t, limiter.Allow("c1"))
+
+		gotime.Sleep(20 * gotime.Millisecond)
+		assert.True(t, limiter.Allow("c1"))
+	})
+
+	t.Run("evict idle keys test", func(t *testing.T) {
+		limiter := ratelimit.New(100, 1)
+		assert.True(t, limiter.Allow("c1"))
+		assert.True(t, limiter.Allow("c2"))
+		assert.Equal(t, 2, limiter.Len())
+
+		gotime.Sleep(20 * gotime.Millisecond)
+		assert.True(t, limiter.Allow("c2"))
+		limiter.Sweep()
+		assert.Equal(t, 1, limiter.Len())
+	})
+}
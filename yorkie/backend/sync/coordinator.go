@@ -64,6 +64,14 @@ type Coordinator interface {
 	// PublishToLocal publishes the given event.
 	PublishToLocal(ctx context.Context, publisherID *time.ActorID, event DocEvent)
 
+	// SubscriberCount returns the number of the subscribers of the given
+	// document across the cluster.
+	SubscriberCount(ctx context.Context, docKey *key.Key) (int, error)
+
+	// Peers returns the subscribers of the given document across the cluster
+	// with their metadata.
+	Peers(ctx context.Context, docKey *key.Key) ([]types.Client, error)
+
 	// UpdateMetadata updates the metadata of the given client.
 	UpdateMetadata(
 		ctx context.Context,
@@ -74,6 +82,9 @@ type Coordinator interface {
 	// Members returns the members of this cluster.
 	Members() map[string]*AgentInfo
 
+	// Ping checks whether the coordinator is reachable.
+	Ping(ctx context.Context) error
+
 	// Close closes all resources of this Coordinator.
 	Close() error
 }
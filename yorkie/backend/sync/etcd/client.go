@@ -18,6 +18,7 @@ package etcd
 
 import (
 	"context"
+	"fmt"
 	gosync "sync"
 
 	clientv3 "go.etcd.io/etcd/client/v3"
@@ -111,6 +112,15 @@ func (c *Client) Dial() error {
 	return nil
 }
 
+// Ping checks whether ETCD is reachable.
+func (c *Client) Ping(ctx context.Context) error {
+	if _, err := c.client.Get(ctx, agentsPath, clientv3.WithCountOnly()); err != nil {
+		return fmt.Errorf("ping etcd: %w", err)
+	}
+
+	return nil
+}
+
 // Close all resources of this client.
 func (c *Client) Close() error {
 	c.cancelFunc()
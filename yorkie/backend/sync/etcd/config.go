@@ -43,6 +43,11 @@ type Config struct {
 	Password    string   `yaml:"Password"`
 
 	LockLeaseTime string `yaml:"LockLeaseTime"`
+
+	// FanoutEndpoints is the endpoints of the etcd dedicated to the event
+	// fanout. If it is empty, the etcd of Endpoints is used for both of the
+	// locking and the event fanout.
+	FanoutEndpoints []string `yaml:"FanoutEndpoints"`
 }
 
 // Validate validates this config.
@@ -70,6 +75,22 @@ func (c *Config) Validate() error {
 	return nil
 }
 
+// FanoutConfig returns the configuration of the etcd dedicated to the event
+// fanout. It returns nil if the etcd is not configured.
+func (c *Config) FanoutConfig() *Config {
+	if len(c.FanoutEndpoints) == 0 {
+		return nil
+	}
+
+	return &Config{
+		Endpoints:     c.FanoutEndpoints,
+		DialTimeout:   c.DialTimeout,
+		Username:      c.Username,
+		Password:      c.Password,
+		LockLeaseTime: c.LockLeaseTime,
+	}
+}
+
 // ParseDialTimeout returns timeout for lock.
 func (c *Config) ParseDialTimeout() time.Duration {
 	result, err := time.ParseDuration(c.DialTimeout)
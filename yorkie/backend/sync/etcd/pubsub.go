@@ -97,6 +97,34 @@ func (c *Client) PublishToLocal(
 	c.localPubSub.Publish(ctx, publisherID, event)
 }
 
+// SubscriberCount returns the number of the subscribers of the given document
+// across the cluster.
+func (c *Client) SubscriberCount(
+	ctx context.Context,
+	docKey *key.Key,
+) (int, error) {
+	getResponse, err := c.client.Get(
+		ctx,
+		path.Join(subscriptionsPath, docKey.BSONKey()),
+		clientv3.WithPrefix(),
+		clientv3.WithCountOnly(),
+	)
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return 0, err
+	}
+
+	return int(getResponse.Count), nil
+}
+
+// Peers returns the subscribers of the given document across the cluster.
+func (c *Client) Peers(
+	ctx context.Context,
+	docKey *key.Key,
+) ([]types.Client, error) {
+	return c.pullSubscriptions(ctx, docKey)
+}
+
 // UpdateMetadata updates the metadata of the given client.
 func (c *Client) UpdateMetadata(
 	ctx context.Context,
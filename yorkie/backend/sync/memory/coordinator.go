@@ -19,19 +19,18 @@ package memory
 import (
 	"context"
 
-	"github.com/moby/locker"
-
 	"github.com/yorkie-team/yorkie/pkg/document/key"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/pkg/types"
 	"github.com/yorkie-team/yorkie/yorkie/backend/sync"
 )
 
-// Coordinator is a memory-based implementation of sync.Coordinator.
+// Coordinator is a memory-based implementation of sync.Coordinator. It is used
+// for the single-node deployment where neither etcd nor Redis is configured.
 type Coordinator struct {
 	agentInfo *sync.AgentInfo
 
-	locks  *locker.Locker
+	locks  *locks
 	pubSub *PubSub
 }
 
@@ -39,7 +38,7 @@ type Coordinator struct {
 func NewCoordinator(agentInfo *sync.AgentInfo) *Coordinator {
 	return &Coordinator{
 		agentInfo: agentInfo,
-		locks:     locker.New(),
+		locks:     newLocks(),
 		pubSub:    NewPubSub(),
 	}
 }
@@ -98,6 +97,22 @@ func (c *Coordinator) PublishToLocal(
 	c.pubSub.Publish(ctx, publisherID, event)
 }
 
+// SubscriberCount returns the number of the subscribers of the given document.
+func (c *Coordinator) SubscriberCount(
+	_ context.Context,
+	docKey *key.Key,
+) (int, error) {
+	return c.pubSub.SubscriberCount(docKey), nil
+}
+
+// Peers returns the subscribers of the given document.
+func (c *Coordinator) Peers(
+	_ context.Context,
+	docKey *key.Key,
+) ([]types.Client, error) {
+	return c.pubSub.Peers(docKey), nil
+}
+
 // UpdateMetadata updates the metadata of the given client.
 func (c *Coordinator) UpdateMetadata(
 	_ context.Context,
@@ -120,6 +135,11 @@ func (c *Coordinator) Members() map[string]*sync.AgentInfo {
 	return members
 }
 
+// Ping does nothing because the memory coordinator is always reachable.
+func (c *Coordinator) Ping(_ context.Context) error {
+	return nil
+}
+
 // Close closes all resources of this Coordinator.
 func (c *Coordinator) Close() error {
 	return nil
@@ -0,0 +1,71 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package memory_test
+
+import (
+	"context"
+	"testing"
+	gotime "time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/yorkie/backend/sync"
+	"github.com/yorkie-team/yorkie/yorkie/backend/sync/memory"
+)
+
+func TestCoordinator(t *testing.T) {
+	t.Run("try lock test", func(t *testing.T) {
+		coordinator := memory.NewCoordinator(nil)
+		ctx := context.Background()
+
+		lockerA, err := coordinator.NewLocker(ctx, sync.NewKey(t.Name()))
+		assert.NoError(t, err)
+		lockerB, err := coordinator.NewLocker(ctx, sync.NewKey(t.Name()))
+		assert.NoError(t, err)
+
+		assert.NoError(t, lockerA.TryLock(ctx))
+		assert.ErrorIs(t, lockerB.TryLock(ctx), sync.ErrAlreadyLocked)
+
+		assert.NoError(t, lockerA.Unlock(ctx))
+		assert.NoError(t, lockerB.TryLock(ctx))
+		assert.NoError(t, lockerB.Unlock(ctx))
+		assert.ErrorIs(t, lockerB.Unlock(ctx), memory.ErrNotLocked)
+	})
+
+	t.Run("lock with context test", func(t *testing.T) {
+		coordinator := memory.NewCoordinator(nil)
+		ctx := context.Background()
+
+		lockerA, err := coordinator.NewLocker(ctx, sync.NewKey(t.Name()))
+		assert.NoError(t, err)
+		lockerB, err := coordinator.NewLocker(ctx, sync.NewKey(t.Name()))
+		assert.NoError(t, err)
+		assert.NoError(t, lockerA.Lock(ctx))
+
+		timeoutCtx, cancel := context.WithTimeout(ctx, 10*gotime.Millisecond)
+		defer cancel()
+		assert.ErrorIs(t, lockerB.Lock(timeoutCtx), context.DeadlineExceeded)
+
+		locked := make(chan error, 1)
+		go func() {
+			locked <- lockerB.Lock(ctx)
+		}()
+		assert.NoError(t, lockerA.Unlock(ctx))
+		assert.NoError(t, <-locked)
+		assert.NoError(t, lockerB.Unlock(ctx))
+	})
+}
@@ -18,38 +18,114 @@ package memory
 
 import (
 	"context"
+	"errors"
+	gosync "sync"
 
-	"github.com/moby/locker"
-
+	"github.com/yorkie-team/yorkie/yorkie/backend/sync"
 	"github.com/yorkie-team/yorkie/yorkie/logging"
 )
 
+// ErrNotLocked is returned when the lock to unlock is not locked.
+var ErrNotLocked = errors.New("not locked")
+
+// lockEntry is the mutex of a key. The mutex is locked while ch holds a
+// value, so that Lock can wait with a context and TryLock can give up.
+type lockEntry struct {
+	ch   chan struct{}
+	refs int
+}
+
+// locks is a set of mutexes keyed by the lock key. The mutex of a key is
+// removed when no locker holds or waits for it.
+type locks struct {
+	mu      gosync.Mutex
+	entries map[string]*lockEntry
+}
+
+// newLocks creates an instance of locks.
+func newLocks() *locks {
+	return &locks{
+		entries: make(map[string]*lockEntry),
+	}
+}
+
+// ref returns the mutex of the given key, creating it if it does not exist.
+func (l *locks) ref(key string) *lockEntry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	entry, ok := l.entries[key]
+	if !ok {
+		entry = &lockEntry{ch: make(chan struct{}, 1)}
+		l.entries[key] = entry
+	}
+	entry.refs++
+	return entry
+}
+
+// unref releases the reference to the mutex of the given key.
+func (l *locks) unref(key string, entry *lockEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	entry.refs--
+	if entry.refs == 0 {
+		delete(l.entries, key)
+	}
+}
+
+// get returns the mutex of the given key if it exists.
+func (l *locks) get(key string) (*lockEntry, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	entry, ok := l.entries[key]
+	return entry, ok
+}
+
 type internalLocker struct {
 	key   string
-	locks *locker.Locker
+	locks *locks
 }
 
-// Lock locks the mutex.
+// Lock locks the mutex with a cancelable context
 func (il *internalLocker) Lock(ctx context.Context) error {
-	il.locks.Lock(il.key)
-
-	return nil
+	entry := il.locks.ref(il.key)
+	select {
+	case entry.ch <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		il.locks.unref(il.key, entry)
+		return ctx.Err()
+	}
 }
 
 // TryLock locks the mutex if not already locked by another session.
-func (il *internalLocker) TryLock(ctx context.Context) error {
-	// TODO(hackerwins): We need to replace Lock with TryLock.
-	il.locks.Lock(il.key)
-
-	return nil
+func (il *internalLocker) TryLock(_ context.Context) error {
+	entry := il.locks.ref(il.key)
+	select {
+	case entry.ch <- struct{}{}:
+		return nil
+	default:
+		il.locks.unref(il.key, entry)
+		return sync.ErrAlreadyLocked
+	}
 }
 
 // Unlock unlocks the mutex.
-func (il *internalLocker) Unlock(ctx context.Context) error {
-	if err := il.locks.Unlock(il.key); err != nil {
-		logging.DefaultLogger().Error(err)
-		return err
+func (il *internalLocker) Unlock(_ context.Context) error {
+	entry, ok := il.locks.get(il.key)
+	if !ok {
+		logging.DefaultLogger().Error(ErrNotLocked)
+		return ErrNotLocked
 	}
 
-	return nil
+	select {
+	case <-entry.ch:
+		il.locks.unref(il.key, entry)
+		return nil
+	default:
+		logging.DefaultLogger().Error(ErrNotLocked)
+		return ErrNotLocked
+	}
 }
@@ -101,7 +101,7 @@ func (m *PubSub) Subscribe(
 	m.subscriptionsMapMu.Lock()
 	defer m.subscriptionsMapMu.Unlock()
 
-	sub := sync.NewSubscription(subscriber)
+	sub := sync.NewSubscription(subscriber, keys)
 	m.subscriptionMapBySubscriber[sub.SubscriberID()] = sub
 
 	for _, docKey := range keys {
@@ -136,6 +136,35 @@ func (m *PubSub) BuildPeersMap(keys []*key.Key) map[string][]types.Client {
 	return peersMap
 }
 
+// SubscriberCount returns the number of the subscribers of the given key.
+func (m *PubSub) SubscriberCount(docKey *key.Key) int {
+	m.subscriptionsMapMu.RLock()
+	defer m.subscriptionsMapMu.RUnlock()
+
+	subs, ok := m.subscriptionsMapByDocKey[docKey.BSONKey()]
+	if !ok {
+		return 0
+	}
+	return subs.Len()
+}
+
+// Peers returns the subscribers of the given key.
+func (m *PubSub) Peers(docKey *key.Key) []types.Client {
+	m.subscriptionsMapMu.RLock()
+	defer m.subscriptionsMapMu.RUnlock()
+
+	subs, ok := m.subscriptionsMapByDocKey[docKey.BSONKey()]
+	if !ok {
+		return nil
+	}
+
+	var peers []types.Client
+	for _, sub := range subs.Map() {
+		peers = append(peers, sub.Subscriber())
+	}
+	return peers
+}
+
 // Unsubscribe unsubscribes the given docKeys.
 func (m *PubSub) Unsubscribe(
 	ctx context.Context,
@@ -176,7 +205,9 @@ func (m *PubSub) Unsubscribe(
 	}
 }
 
-// Publish publishes the given event.
+// Publish publishes the given event to the subscriptions of its documents.
+// Each subscription receives the event at most once, with only the documents
+// it subscribes to.
 func (m *PubSub) Publish(
 	ctx context.Context,
 	publisherID *time.ActorID,
@@ -185,6 +216,7 @@ func (m *PubSub) Publish(
 	m.subscriptionsMapMu.RLock()
 	defer m.subscriptionsMapMu.RUnlock()
 
+	published := make(map[string]struct{})
 	for _, docKey := range event.DocumentKeys {
 		k := docKey.BSONKey()
 
@@ -198,6 +230,16 @@ func (m *PubSub) Publish(
 					continue
 				}
 
+				if _, ok := published[sub.ID()]; ok {
+					continue
+				}
+				published[sub.ID()] = struct{}{}
+
+				filtered, ok := sub.Filter(event)
+				if !ok {
+					continue
+				}
+
 				if logging.Enabled(zap.DebugLevel) {
 					logging.From(ctx).Debugf(
 						`Publish(%s,%s) to %s`,
@@ -210,7 +252,7 @@ func (m *PubSub) Publish(
 				// NOTE: When a subscription is being closed by a subscriber,
 				// the subscriber may not receive messages.
 				select {
-				case sub.Events() <- event:
+				case sub.Events() <- filtered:
 				case <-gotime.After(100 * gotime.Millisecond):
 					logging.From(ctx).Warnf(
 						`Publish(%s,%s) to %s timeout`,
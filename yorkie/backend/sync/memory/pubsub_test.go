@@ -70,6 +70,51 @@ func TestPubSub(t *testing.T) {
 		wg.Wait()
 	})
 
+	t.Run("filter events by document keys test", func(t *testing.T) {
+		pubSub := memory.NewPubSub()
+		docKey := func(document string) *key.Key {
+			return &key.Key{
+				Collection: helper.Collection,
+				Document:   t.Name() + document,
+			}
+		}
+
+		ctx := context.Background()
+		// subscribe the documents d1 and d2 by actorA
+		subA, err := pubSub.Subscribe(ctx, actorA, []*key.Key{docKey("d1"), docKey("d2")})
+		assert.NoError(t, err)
+
+		// publish the event of the documents d1, d2 and d3 by actorB
+		pubSub.Publish(ctx, actorB.ID, sync.DocEvent{
+			Type:         types.DocumentsChangedEvent,
+			Publisher:    actorB,
+			DocumentKeys: []*key.Key{docKey("d1"), docKey("d2"), docKey("d3")},
+		})
+
+		// the event is delivered once with the subscribed documents only.
+		e := <-subA.Events()
+		assert.Equal(t, []*key.Key{docKey("d1"), docKey("d2")}, e.DocumentKeys)
+		select {
+		case e := <-subA.Events():
+			assert.Fail(t, "duplicate event", "%v", e)
+		default:
+		}
+
+		// the event of the other documents is not delivered.
+		pubSub.Publish(ctx, actorB.ID, sync.DocEvent{
+			Type:         types.DocumentsChangedEvent,
+			Publisher:    actorB,
+			DocumentKeys: []*key.Key{docKey("d3")},
+		})
+		select {
+		case e := <-subA.Events():
+			assert.Fail(t, "unexpected event", "%v", e)
+		default:
+		}
+
+		pubSub.Unsubscribe(ctx, []*key.Key{docKey("d1"), docKey("d2")}, subA)
+	})
+
 	t.Run("subscriptions map test", func(t *testing.T) {
 		pubSub := memory.NewPubSub()
 		docKeys := []*key.Key{
@@ -89,4 +134,27 @@ func TestPubSub(t *testing.T) {
 			assert.Len(t, subs[docKeys[0].BSONKey()], i+1)
 		}
 	})
+
+	t.Run("subscriber count test", func(t *testing.T) {
+		pubSub := memory.NewPubSub()
+		docKeys := []*key.Key{
+			{
+				Collection: helper.Collection,
+				Document:   t.Name(),
+			},
+		}
+
+		ctx := context.Background()
+		assert.Equal(t, 0, pubSub.SubscriberCount(docKeys[0]))
+
+		subA, err := pubSub.Subscribe(ctx, actorA, docKeys)
+		assert.NoError(t, err)
+		subB, err := pubSub.Subscribe(ctx, actorB, docKeys)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, pubSub.SubscriberCount(docKeys[0]))
+
+		pubSub.Unsubscribe(ctx, docKeys, subA)
+		pubSub.Unsubscribe(ctx, docKeys, subB)
+		assert.Equal(t, 0, pubSub.SubscriberCount(docKeys[0]))
+	})
 }
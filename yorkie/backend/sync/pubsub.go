@@ -29,14 +29,26 @@ type Subscription struct {
 	subscriber types.Client
 	closed     bool
 	events     chan DocEvent
+
+	// docKeys is the set of the BSON keys of the documents that this
+	// subscription cares about. The events of the other documents are not
+	// delivered to it.
+	docKeys map[string]struct{}
 }
 
-// NewSubscription creates a new instance of Subscription.
-func NewSubscription(subscriber types.Client) *Subscription {
+// NewSubscription creates a new instance of Subscription that receives the
+// events of the given documents.
+func NewSubscription(subscriber types.Client, docKeys []*key.Key) *Subscription {
+	keys := make(map[string]struct{}, len(docKeys))
+	for _, docKey := range docKeys {
+		keys[docKey.BSONKey()] = struct{}{}
+	}
+
 	return &Subscription{
 		id:         xid.New().String(),
 		subscriber: subscriber,
 		events:     make(chan DocEvent, 1),
+		docKeys:    keys,
 	}
 }
 
@@ -50,6 +62,21 @@ type DocEvent struct {
 	Type         types.DocEventType
 	Publisher    types.Client
 	DocumentKeys []*key.Key
+
+	// ServerSeq is the server sequence of the document after the changes of
+	// the DocumentsChanged event. It is used to align the event with the
+	// server sequence read when the watch is established.
+	ServerSeq uint64
+
+	// FromServerSeq is the server sequence of the document before the changes
+	// of the DocumentsChanged event, so that the changes of the event are in
+	// the range (FromServerSeq, ServerSeq]. A client at FromServerSeq can know
+	// how many changes to expect from the next PushPull.
+	FromServerSeq uint64
+
+	// Peers is the current peers of the document of the PeersChanged event
+	// with their metadata.
+	Peers []types.Client
 }
 
 // Events returns the DocEvent channel of this subscription.
@@ -57,6 +84,23 @@ func (s *Subscription) Events() chan DocEvent {
 	return s.events
 }
 
+// Filter returns the given event with only the documents that this
+// subscription cares about. It returns false if the event has none of them.
+func (s *Subscription) Filter(event DocEvent) (DocEvent, bool) {
+	var docKeys []*key.Key
+	for _, docKey := range event.DocumentKeys {
+		if _, ok := s.docKeys[docKey.BSONKey()]; ok {
+			docKeys = append(docKeys, docKey)
+		}
+	}
+	if len(docKeys) == 0 {
+		return event, false
+	}
+
+	event.DocumentKeys = docKeys
+	return event, true
+}
+
 // Subscriber returns the subscriber of this subscription.
 func (s *Subscription) Subscriber() types.Client {
 	return s.subscriber
@@ -0,0 +1,130 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package redis
+
+import (
+	"context"
+	"fmt"
+	gosync "sync"
+
+	"github.com/go-redis/redis/v8"
+
+	"github.com/yorkie-team/yorkie/yorkie/backend/sync"
+	"github.com/yorkie-team/yorkie/yorkie/backend/sync/memory"
+	"github.com/yorkie-team/yorkie/yorkie/logging"
+)
+
+// Client is a client that connects to Redis.
+type Client struct {
+	config    *Config
+	agentInfo *sync.AgentInfo
+
+	localPubSub *memory.PubSub
+
+	memberMapMu *gosync.RWMutex
+	memberMap   map[string]*sync.AgentInfo
+
+	client *redis.Client
+	events *redis.PubSub
+
+	ctx        context.Context
+	cancelFunc context.CancelFunc
+}
+
+// newClient creates a new instance of Client.
+func newClient(
+	conf *Config,
+	agentInfo *sync.AgentInfo,
+) *Client {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+
+	return &Client{
+		config:    conf,
+		agentInfo: agentInfo,
+
+		localPubSub: memory.NewPubSub(),
+
+		memberMapMu: &gosync.RWMutex{},
+		memberMap:   make(map[string]*sync.AgentInfo),
+
+		ctx:        ctx,
+		cancelFunc: cancelFunc,
+	}
+}
+
+// Dial creates a new instance of Client and dials the given Redis.
+func Dial(
+	conf *Config,
+	agentInfo *sync.AgentInfo,
+) (*Client, error) {
+	c := newClient(conf, agentInfo)
+
+	if err := c.Dial(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// Dial dials the given Redis.
+func (c *Client) Dial() error {
+	cli := redis.NewClient(&redis.Options{
+		Addr:        c.config.Address,
+		Username:    c.config.Username,
+		Password:    c.config.Password,
+		DB:          c.config.DB,
+		DialTimeout: c.config.ParseDialTimeout(),
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.ParseDialTimeout())
+	defer cancel()
+	if err := cli.Ping(ctx).Err(); err != nil {
+		logging.DefaultLogger().Error(err)
+		return err
+	}
+
+	logging.DefaultLogger().Infof("redis connected, URI: %s", c.config.Address)
+
+	c.client = cli
+	return nil
+}
+
+// Ping checks whether Redis is reachable.
+func (c *Client) Ping(ctx context.Context) error {
+	if err := c.client.Ping(ctx).Err(); err != nil {
+		return fmt.Errorf("ping redis: %w", err)
+	}
+
+	return nil
+}
+
+// Close all resources of this client.
+func (c *Client) Close() error {
+	c.cancelFunc()
+
+	if err := c.removeAgent(context.Background()); err != nil {
+		logging.DefaultLogger().Error(err)
+	}
+
+	if c.events != nil {
+		if err := c.events.Close(); err != nil {
+			logging.DefaultLogger().Error(err)
+		}
+	}
+
+	return c.client.Close()
+}
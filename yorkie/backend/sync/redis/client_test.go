@@ -0,0 +1,204 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package redis_test
+
+import (
+	"context"
+	"path"
+	"testing"
+	gotime "time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/pkg/types"
+	"github.com/yorkie-team/yorkie/test/helper"
+	"github.com/yorkie-team/yorkie/yorkie/backend"
+	"github.com/yorkie-team/yorkie/yorkie/backend/housekeeping"
+	"github.com/yorkie-team/yorkie/yorkie/backend/sync"
+	"github.com/yorkie-team/yorkie/yorkie/backend/sync/redis"
+	"github.com/yorkie-team/yorkie/yorkie/profiling/prometheus"
+)
+
+func TestClient(t *testing.T) {
+	actorA := types.Client{ID: &time.ActorID{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}}
+	actorB := types.Client{ID: &time.ActorID{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}}
+
+	server, err := miniredis.Run()
+	assert.NoError(t, err)
+	defer server.Close()
+
+	newBackend := func(t *testing.T) *backend.Backend {
+		met, err := prometheus.NewMetrics()
+		assert.NoError(t, err)
+
+		be, err := backend.New(&backend.Config{
+			AuthWebhookCacheSize: helper.AuthWebhookSize,
+		}, nil, nil, &redis.Config{
+			Address:       server.Addr(),
+			DialTimeout:   redis.DefaultDialTimeout.String(),
+			LockLeaseTime: redis.DefaultLockLeaseTime.String(),
+		}, &housekeeping.Config{
+			Interval:            helper.HousekeepingInterval.String(),
+			DeactivateThreshold: helper.HousekeepingDeactivateThreshold.String(),
+			CandidatesLimit:     helper.HousekeepingCandidatesLimit,
+		}, "", met)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() {
+			assert.NoError(t, be.Shutdown())
+		})
+		return be
+	}
+
+	t.Run("publish subscribe across backends test", func(t *testing.T) {
+		beA := newBackend(t)
+		beB := newBackend(t)
+		docKeys := []*key.Key{
+			{
+				Collection: helper.Collection,
+				Document:   t.Name(),
+			},
+		}
+
+		ctx := context.Background()
+		// subscribe the documents by actorA through backend A
+		subA, _, err := beA.Coordinator.Subscribe(ctx, actorA, docKeys)
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, beA.Coordinator.Unsubscribe(ctx, docKeys, subA))
+		}()
+
+		// subscribe the documents by actorB through backend B
+		subB, peersMap, err := beB.Coordinator.Subscribe(ctx, actorB, docKeys)
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, beB.Coordinator.Unsubscribe(ctx, docKeys, subB))
+		}()
+		assert.Len(t, peersMap[docKeys[0].BSONKey()], 2)
+
+		count, err := beA.Coordinator.SubscriberCount(ctx, docKeys[0])
+		assert.NoError(t, err)
+		assert.Equal(t, 2, count)
+
+		// publish the event to the documents by actorB through backend B
+		beB.Coordinator.Publish(ctx, actorB.ID, sync.DocEvent{
+			Type:         types.DocumentsChangedEvent,
+			Publisher:    actorB,
+			DocumentKeys: docKeys,
+		})
+
+		select {
+		case e := <-subA.Events():
+			assert.Equal(t, types.DocumentsChangedEvent, e.Type)
+			assert.Equal(t, actorB.ID, e.Publisher.ID)
+			assert.Equal(t, docKeys[0].BSONKey(), e.DocumentKeys[0].BSONKey())
+		case <-gotime.After(5 * gotime.Second):
+			t.Fatal("event is not received from the other backend")
+		}
+	})
+
+	t.Run("locker across backends test", func(t *testing.T) {
+		beA := newBackend(t)
+		beB := newBackend(t)
+		ctx := context.Background()
+
+		lockerA, err := beA.Coordinator.NewLocker(ctx, sync.NewKey(t.Name()))
+		assert.NoError(t, err)
+		lockerB, err := beB.Coordinator.NewLocker(ctx, sync.NewKey(t.Name()))
+		assert.NoError(t, err)
+
+		assert.NoError(t, lockerA.Lock(ctx))
+		assert.ErrorIs(t, lockerB.TryLock(ctx), sync.ErrAlreadyLocked)
+		assert.ErrorIs(t, lockerB.Unlock(ctx), redis.ErrNotLockOwner)
+
+		locked := make(chan error, 1)
+		go func() {
+			locked <- lockerB.Lock(ctx)
+		}()
+		assert.NoError(t, lockerA.Unlock(ctx))
+		assert.NoError(t, <-locked)
+		assert.NoError(t, lockerB.Unlock(ctx))
+	})
+
+	t.Run("lock lease renewal test", func(t *testing.T) {
+		leaseTime := 300 * gotime.Millisecond
+		cli, err := redis.Dial(&redis.Config{
+			Address:       server.Addr(),
+			DialTimeout:   redis.DefaultDialTimeout.String(),
+			LockLeaseTime: leaseTime.String(),
+		}, &sync.AgentInfo{ID: t.Name()})
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, cli.Close())
+		}()
+
+		ctx := context.Background()
+		lockerA, err := cli.NewLocker(ctx, sync.NewKey(t.Name()))
+		assert.NoError(t, err)
+		lockerB, err := cli.NewLocker(ctx, sync.NewKey(t.Name()))
+		assert.NoError(t, err)
+
+		// 01. the lease is extended while the lock is held beyond it.
+		lockKey := path.Join("/locks", t.Name())
+		assert.NoError(t, lockerA.Lock(ctx))
+		for i := 0; i < 3; i++ {
+			server.FastForward(leaseTime - 50*gotime.Millisecond)
+			assert.Eventually(t, func() bool {
+				return server.TTL(lockKey) > leaseTime/2
+			}, leaseTime, 10*gotime.Millisecond)
+		}
+		assert.ErrorIs(t, lockerB.TryLock(ctx), sync.ErrAlreadyLocked)
+		assert.NoError(t, lockerA.Unlock(ctx))
+
+		// 02. the lock lost after its lease expired is not unlocked.
+		assert.NoError(t, lockerB.TryLock(ctx))
+		server.FastForward(leaseTime)
+		assert.ErrorIs(t, lockerB.Unlock(ctx), redis.ErrNotLockOwner)
+	})
+
+	t.Run("members test", func(t *testing.T) {
+		newBackend(t)
+		be := newBackend(t)
+
+		assert.GreaterOrEqual(t, len(be.Coordinator.Members()), 2)
+	})
+}
+
+func TestConfig_Validate(t *testing.T) {
+	scenarios := []*struct {
+		config   *redis.Config
+		expected error
+	}{
+		{config: &redis.Config{}, expected: redis.ErrEmptyAddress},
+		{
+			config: &redis.Config{
+				Address:       "localhost:6379",
+				DialTimeout:   "5s",
+				LockLeaseTime: "30s",
+			},
+			expected: nil,
+		},
+	}
+	for _, scenario := range scenarios {
+		assert.ErrorIs(
+			t, scenario.config.Validate(), scenario.expected, "provided config: %#v", scenario.config)
+	}
+}
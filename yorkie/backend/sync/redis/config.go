@@ -0,0 +1,92 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package redis
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultDialTimeout is the default dial timeout of Redis connection.
+	DefaultDialTimeout = 5 * time.Second
+
+	// DefaultLockLeaseTime is the default lease time of lock.
+	DefaultLockLeaseTime = 30 * time.Second
+)
+
+var (
+	// ErrEmptyAddress occurs when the address in the config is empty.
+	ErrEmptyAddress = errors.New("redis address must not be empty")
+)
+
+// Config is the configuration for creating a Client instance.
+type Config struct {
+	Address     string `yaml:"Address"`
+	DialTimeout string `yaml:"DialTimeout"`
+	Username    string `yaml:"Username"`
+	Password    string `yaml:"Password"`
+	DB          int    `yaml:"DB"`
+
+	LockLeaseTime string `yaml:"LockLeaseTime"`
+}
+
+// Validate validates this config.
+func (c *Config) Validate() error {
+	if c.Address == "" {
+		return ErrEmptyAddress
+	}
+
+	if _, err := time.ParseDuration(c.DialTimeout); err != nil {
+		return fmt.Errorf(
+			`invalid argument "%s" for "--redis-dial-timeout" flag: %w`,
+			c.DialTimeout,
+			err,
+		)
+	}
+
+	if _, err := time.ParseDuration(c.LockLeaseTime); err != nil {
+		return fmt.Errorf(
+			`invalid argument "%s" for "--redis-lock-lease-time" flag: %w`,
+			c.LockLeaseTime,
+			err,
+		)
+	}
+
+	return nil
+}
+
+// ParseDialTimeout returns timeout for connecting to Redis.
+func (c *Config) ParseDialTimeout() time.Duration {
+	result, err := time.ParseDuration(c.DialTimeout)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
+
+// ParseLockLeaseTime returns lease time for lock.
+func (c *Config) ParseLockLeaseTime() time.Duration {
+	result, err := time.ParseDuration(c.LockLeaseTime)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
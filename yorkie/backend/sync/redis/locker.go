@@ -0,0 +1,177 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package redis
+
+import (
+	"context"
+	"errors"
+	"path"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/rs/xid"
+
+	"github.com/yorkie-team/yorkie/yorkie/backend/sync"
+	"github.com/yorkie-team/yorkie/yorkie/logging"
+)
+
+const (
+	locksPath         = "/locks"
+	lockRetryInterval = 50 * time.Millisecond
+)
+
+var (
+	// ErrNotLockOwner is returned when the lock is unlocked by a locker that
+	// does not own it, e.g. after the lease of the lock is expired.
+	ErrNotLockOwner = errors.New("not the owner of the lock")
+
+	// unlockScript deletes the lock only if it is still owned by the locker,
+	// so that a locker never releases the lock acquired by another one after
+	// its lease is expired.
+	unlockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+
+	// extendScript extends the lease of the lock only if it is still owned by
+	// the locker.
+	extendScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0`)
+)
+
+// NewLocker creates locker of the given key.
+func (c *Client) NewLocker(
+	_ context.Context,
+	key sync.Key,
+) (sync.Locker, error) {
+	return &internalLocker{
+		client:    c.client,
+		key:       path.Join(locksPath, key.String()),
+		token:     xid.New().String(),
+		leaseTime: c.config.ParseLockLeaseTime(),
+	}, nil
+}
+
+type internalLocker struct {
+	client    *redis.Client
+	key       string
+	token     string
+	leaseTime time.Duration
+
+	// stopKeepAlive stops extending the lease of the acquired lock, and
+	// keepAliveDone is closed when it is stopped.
+	stopKeepAlive chan struct{}
+	keepAliveDone chan struct{}
+}
+
+// Lock locks the mutex with a cancelable context
+func (il *internalLocker) Lock(ctx context.Context) error {
+	for {
+		err := il.TryLock(ctx)
+		if err == nil {
+			return nil
+		}
+		if err != sync.ErrAlreadyLocked {
+			return err
+		}
+
+		select {
+		case <-time.After(lockRetryInterval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// TryLock locks the mutex if not already locked by another session.
+func (il *internalLocker) TryLock(ctx context.Context) error {
+	locked, err := il.client.SetNX(ctx, il.key, il.token, il.leaseTime).Result()
+	if err != nil {
+		logging.DefaultLogger().Error(err)
+		return err
+	}
+	if !locked {
+		return sync.ErrAlreadyLocked
+	}
+
+	if il.leaseTime > 0 {
+		il.stopKeepAlive = make(chan struct{})
+		il.keepAliveDone = make(chan struct{})
+		go il.keepAlive(il.stopKeepAlive, il.keepAliveDone)
+	}
+
+	return nil
+}
+
+// keepAlive extends the lease of the acquired lock every third of the lease
+// time until it is stopped, like the session of etcd, so that the lock is not
+// expired while the holder still works on it. If the lock is lost, e.g. the
+// lease has already expired, it stops and Unlock returns ErrNotLockOwner.
+func (il *internalLocker) keepAlive(stop <-chan struct{}, done chan<- struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(il.leaseTime / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			extended, err := extendScript.Run(
+				context.Background(),
+				il.client,
+				[]string{il.key},
+				il.token,
+				il.leaseTime.Milliseconds(),
+			).Int()
+			if err != nil {
+				logging.DefaultLogger().Error(err)
+				continue
+			}
+			if extended == 0 {
+				logging.DefaultLogger().Errorf("%s: %s", il.key, ErrNotLockOwner)
+				return
+			}
+		case <-stop:
+			return
+		}
+	}
+}
+
+// Unlock unlocks the mutex.
+func (il *internalLocker) Unlock(ctx context.Context) error {
+	if il.stopKeepAlive != nil {
+		close(il.stopKeepAlive)
+		<-il.keepAliveDone
+		il.stopKeepAlive = nil
+	}
+
+	deleted, err := unlockScript.Run(ctx, il.client, []string{il.key}, il.token).Int()
+	if err != nil {
+		logging.DefaultLogger().Error(err)
+		return err
+	}
+	if deleted == 0 {
+		logging.DefaultLogger().Error(ErrNotLockOwner)
+		return ErrNotLockOwner
+	}
+
+	return nil
+}
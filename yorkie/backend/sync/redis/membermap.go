@@ -0,0 +1,157 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/yorkie-team/yorkie/yorkie/backend/sync"
+	"github.com/yorkie-team/yorkie/yorkie/logging"
+)
+
+const (
+	agentsPath     = "/agents"
+	putAgentPeriod = 5 * time.Second
+	agentValueTTL  = 7 * time.Second
+)
+
+// Initialize puts this agent to Redis with TTL periodically and starts
+// receiving the events published by the other agents.
+func (c *Client) Initialize() error {
+	ctx := context.Background()
+	if err := c.putAgent(ctx); err != nil {
+		return err
+	}
+	if err := c.syncMemberMap(ctx); err != nil {
+		return err
+	}
+	if err := c.subscribeEvents(ctx); err != nil {
+		return err
+	}
+
+	go c.receiveEvents()
+	go c.putAgentPeriodically()
+
+	return nil
+}
+
+// Members returns the members of this cluster.
+func (c *Client) Members() map[string]*sync.AgentInfo {
+	c.memberMapMu.RLock()
+	defer c.memberMapMu.RUnlock()
+
+	memberMap := make(map[string]*sync.AgentInfo)
+	for _, member := range c.memberMap {
+		memberMap[member.ID] = &sync.AgentInfo{
+			ID:        member.ID,
+			Hostname:  member.Hostname,
+			RPCAddr:   member.RPCAddr,
+			UpdatedAt: member.UpdatedAt,
+		}
+	}
+
+	return memberMap
+}
+
+// syncMemberMap replaces the local member map with the agents in Redis.
+// Redis does not notify the expiration of the keys by default, so the map is
+// synced whenever the local agent is put.
+func (c *Client) syncMemberMap(ctx context.Context) error {
+	var keys []string
+	iter := c.client.Scan(ctx, 0, path.Join(agentsPath, "*"), 0).Iterator()
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+	}
+	if err := iter.Err(); err != nil {
+		return fmt.Errorf("scan %s: %w", agentsPath, err)
+	}
+
+	memberMap := make(map[string]*sync.AgentInfo)
+	if len(keys) > 0 {
+		values, err := c.client.MGet(ctx, keys...).Result()
+		if err != nil {
+			return fmt.Errorf("get %s: %w", agentsPath, err)
+		}
+
+		for i, value := range values {
+			// The agent may be expired between SCAN and MGET.
+			encoded, ok := value.(string)
+			if !ok {
+				continue
+			}
+
+			var info sync.AgentInfo
+			if err := json.Unmarshal([]byte(encoded), &info); err != nil {
+				return fmt.Errorf("unmarshal %s: %w", keys[i], err)
+			}
+			memberMap[keys[i]] = &info
+		}
+	}
+
+	c.memberMapMu.Lock()
+	defer c.memberMapMu.Unlock()
+	c.memberMap = memberMap
+
+	return nil
+}
+
+// putAgentPeriodically puts the local agent in Redis periodically.
+func (c *Client) putAgentPeriodically() {
+	for {
+		select {
+		case <-time.After(putAgentPeriod):
+		case <-c.ctx.Done():
+			return
+		}
+
+		if err := c.putAgent(c.ctx); err != nil {
+			logging.DefaultLogger().Error(err)
+		}
+		if err := c.syncMemberMap(c.ctx); err != nil {
+			logging.DefaultLogger().Error(err)
+		}
+	}
+}
+
+// putAgent puts the local agent in Redis.
+func (c *Client) putAgent(ctx context.Context) error {
+	agentInfo := *c.agentInfo
+	agentInfo.UpdatedAt = time.Now()
+	bytes, err := json.Marshal(agentInfo)
+	if err != nil {
+		return fmt.Errorf("marshal %s: %w", c.agentInfo.ID, err)
+	}
+
+	k := path.Join(agentsPath, c.agentInfo.ID)
+	if err := c.client.Set(ctx, k, bytes, agentValueTTL).Err(); err != nil {
+		return fmt.Errorf("put %s: %w", k, err)
+	}
+	return nil
+}
+
+// removeAgent removes the local agent in Redis.
+func (c *Client) removeAgent(ctx context.Context) error {
+	k := path.Join(agentsPath, c.agentInfo.ID)
+	if err := c.client.Del(ctx, k).Err(); err != nil {
+		return fmt.Errorf("remove %s: %w", k, err)
+	}
+	return nil
+}
@@ -0,0 +1,331 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/yorkie-team/yorkie/api"
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/pkg/types"
+	"github.com/yorkie-team/yorkie/yorkie/backend/sync"
+	"github.com/yorkie-team/yorkie/yorkie/logging"
+)
+
+const (
+	subscriptionsPath = "/subscriptions"
+	eventsChannel     = "/events"
+)
+
+// eventMessage is the message of the events channel. The agent ID is used to
+// skip the events published by the agent itself.
+type eventMessage struct {
+	AgentID string `json:"agent_id"`
+
+	// Request is the encoded api.BroadcastEventRequest of the event.
+	Request []byte `json:"request"`
+}
+
+// Subscribe subscribes to the given keys.
+func (c *Client) Subscribe(
+	ctx context.Context,
+	subscriber types.Client,
+	keys []*key.Key,
+) (*sync.Subscription, map[string][]types.Client, error) {
+	sub, err := c.localPubSub.Subscribe(ctx, subscriber, keys)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// TODO: If the agent is not stopped gracefully, there may
+	// be garbage subscriptions left. Consider introducing a TTL and
+	// updating it periodically.
+	if err := c.putSubscriptions(ctx, keys, sub); err != nil {
+		return nil, nil, err
+	}
+
+	peersMap := make(map[string][]types.Client)
+	for _, k := range keys {
+		subs, err := c.pullSubscriptions(ctx, k)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		peersMap[k.BSONKey()] = subs
+	}
+
+	return sub, peersMap, nil
+}
+
+// Unsubscribe unsubscribes the given keys.
+func (c *Client) Unsubscribe(
+	ctx context.Context,
+	keys []*key.Key,
+	sub *sync.Subscription,
+) error {
+	c.localPubSub.Unsubscribe(ctx, keys, sub)
+	return c.removeSubscriptions(ctx, keys, sub)
+}
+
+// Publish publishes the given event.
+func (c *Client) Publish(
+	ctx context.Context,
+	publisherID *time.ActorID,
+	event sync.DocEvent,
+) {
+	c.localPubSub.Publish(ctx, publisherID, event)
+	if err := c.publishToMembers(ctx, publisherID, event); err != nil {
+		logging.From(ctx).Error(err)
+	}
+}
+
+// PublishToLocal publishes the given event.
+func (c *Client) PublishToLocal(
+	ctx context.Context,
+	publisherID *time.ActorID,
+	event sync.DocEvent,
+) {
+	c.localPubSub.Publish(ctx, publisherID, event)
+}
+
+// SubscriberCount returns the number of the subscribers of the given document
+// across the cluster.
+func (c *Client) SubscriberCount(
+	ctx context.Context,
+	docKey *key.Key,
+) (int, error) {
+	count, err := c.client.HLen(ctx, path.Join(subscriptionsPath, docKey.BSONKey())).Result()
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return 0, err
+	}
+
+	return int(count), nil
+}
+
+// Peers returns the subscribers of the given document across the cluster.
+func (c *Client) Peers(
+	ctx context.Context,
+	docKey *key.Key,
+) ([]types.Client, error) {
+	return c.pullSubscriptions(ctx, docKey)
+}
+
+// UpdateMetadata updates the metadata of the given client.
+func (c *Client) UpdateMetadata(
+	ctx context.Context,
+	publisher *types.Client,
+	keys []*key.Key,
+) (*sync.DocEvent, error) {
+	if sub := c.localPubSub.UpdateMetadata(publisher, keys); sub != nil {
+		if err := c.putSubscriptions(ctx, keys, sub); err != nil {
+			return nil, err
+		}
+	}
+
+	return &sync.DocEvent{
+		Type:         types.MetadataChangedEvent,
+		Publisher:    *publisher,
+		DocumentKeys: keys,
+	}, nil
+}
+
+// publishToMembers publishes the given event to the other agents through the
+// events channel.
+func (c *Client) publishToMembers(
+	ctx context.Context,
+	publisherID *time.ActorID,
+	event sync.DocEvent,
+) error {
+	docEvent, err := converter.ToDocEvent(event)
+	if err != nil {
+		return err
+	}
+
+	req, err := proto.Marshal(&api.BroadcastEventRequest{
+		PublisherId: publisherID.Bytes(),
+		Event:       docEvent,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal event: %w", err)
+	}
+
+	msg, err := json.Marshal(&eventMessage{
+		AgentID: c.agentInfo.ID,
+		Request: req,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal event: %w", err)
+	}
+
+	if err := c.client.Publish(ctx, eventsChannel, msg).Err(); err != nil {
+		return fmt.Errorf("publish %s: %w", eventsChannel, err)
+	}
+
+	return nil
+}
+
+// subscribeEvents subscribes to the events channel. It waits for the
+// confirmation of the subscription so that no event published after
+// Initialize is missed.
+func (c *Client) subscribeEvents(ctx context.Context) error {
+	events := c.client.Subscribe(ctx, eventsChannel)
+	if _, err := events.Receive(ctx); err != nil {
+		return fmt.Errorf("subscribe %s: %w", eventsChannel, err)
+	}
+
+	c.events = events
+	return nil
+}
+
+// receiveEvents publishes the events of the other agents to the local
+// subscribers.
+func (c *Client) receiveEvents() {
+	ch := c.events.Channel()
+	for {
+		select {
+		case msg, ok := <-ch:
+			if !ok {
+				return
+			}
+
+			if err := c.handleEvent(c.ctx, msg); err != nil {
+				logging.DefaultLogger().Error(err)
+			}
+		case <-c.ctx.Done():
+			return
+		}
+	}
+}
+
+// handleEvent publishes the event of the given message to the local
+// subscribers in the same way as the BroadcastEvent of the cluster server.
+func (c *Client) handleEvent(ctx context.Context, msg *redis.Message) error {
+	var eventMsg eventMessage
+	if err := json.Unmarshal([]byte(msg.Payload), &eventMsg); err != nil {
+		return fmt.Errorf("unmarshal event: %w", err)
+	}
+	if eventMsg.AgentID == c.agentInfo.ID {
+		return nil
+	}
+
+	req := &api.BroadcastEventRequest{}
+	if err := proto.Unmarshal(eventMsg.Request, req); err != nil {
+		return fmt.Errorf("unmarshal event: %w", err)
+	}
+
+	actorID, err := time.ActorIDFromBytes(req.PublisherId)
+	if err != nil {
+		return err
+	}
+
+	docEvent, err := converter.FromDocEvent(req.Event)
+	if err != nil {
+		return err
+	}
+
+	switch docEvent.Type {
+	case types.DocumentsWatchedEvent,
+		types.DocumentsUnwatchedEvent,
+		types.DocumentsChangedEvent,
+		types.PeersChangedEvent:
+		c.PublishToLocal(ctx, actorID, *docEvent)
+	case types.MetadataChangedEvent:
+		if _, err := c.UpdateMetadata(
+			ctx,
+			&docEvent.Publisher,
+			docEvent.DocumentKeys,
+		); err != nil {
+			return err
+		}
+
+		c.PublishToLocal(ctx, actorID, *docEvent)
+	}
+
+	return nil
+}
+
+// putSubscriptions puts the given subscriptions in Redis.
+func (c *Client) putSubscriptions(
+	ctx context.Context,
+	keys []*key.Key,
+	sub *sync.Subscription,
+) error {
+	cli := sub.Subscriber()
+	encoded, err := cli.Marshal()
+	if err != nil {
+		return fmt.Errorf("marshal %s: %w", sub.ID(), err)
+	}
+
+	for _, docKey := range keys {
+		k := path.Join(subscriptionsPath, docKey.BSONKey())
+		if err := c.client.HSet(ctx, k, sub.ID(), encoded).Err(); err != nil {
+			logging.From(ctx).Error(err)
+			return fmt.Errorf("put %s: %w", k, err)
+		}
+	}
+
+	return nil
+}
+
+// pullSubscriptions pulls the subscriptions of the given document key.
+func (c *Client) pullSubscriptions(
+	ctx context.Context,
+	docKey *key.Key,
+) ([]types.Client, error) {
+	values, err := c.client.HVals(ctx, path.Join(subscriptionsPath, docKey.BSONKey())).Result()
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return nil, err
+	}
+
+	var clients []types.Client
+	for _, value := range values {
+		cli, err := types.NewClient([]byte(value))
+		if err != nil {
+			return nil, err
+		}
+		clients = append(clients, *cli)
+	}
+
+	return clients, nil
+}
+
+// removeSubscriptions removes the given subscription in Redis.
+func (c *Client) removeSubscriptions(
+	ctx context.Context,
+	keys []*key.Key,
+	sub *sync.Subscription,
+) error {
+	for _, docKey := range keys {
+		k := path.Join(subscriptionsPath, docKey.BSONKey())
+		if err := c.client.HDel(ctx, k, sub.ID()).Err(); err != nil {
+			logging.From(ctx).Error(err)
+			return err
+		}
+	}
+
+	return nil
+}
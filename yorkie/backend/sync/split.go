@@ -0,0 +1,139 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sync
+
+import (
+	"context"
+
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/pkg/types"
+)
+
+// SplitCoordinator is a Coordinator that splits the responsibilities of the
+// coordinator into two: the locking coordinator provides the locks, and the
+// fanout coordinator provides the event Pub/Sub and the members. It allows the
+// event fanout to scale independently of the locking for PushPull and
+// snapshots.
+type SplitCoordinator struct {
+	locking Coordinator
+	fanout  Coordinator
+}
+
+// NewSplitCoordinator creates a new instance of SplitCoordinator.
+func NewSplitCoordinator(locking, fanout Coordinator) *SplitCoordinator {
+	return &SplitCoordinator{
+		locking: locking,
+		fanout:  fanout,
+	}
+}
+
+// NewLocker creates a sync.Locker from the locking coordinator.
+func (c *SplitCoordinator) NewLocker(ctx context.Context, key Key) (Locker, error) {
+	return c.locking.NewLocker(ctx, key)
+}
+
+// Subscribe subscribes to the given documents through the fanout coordinator.
+func (c *SplitCoordinator) Subscribe(
+	ctx context.Context,
+	subscriber types.Client,
+	docKeys []*key.Key,
+) (*Subscription, map[string][]types.Client, error) {
+	return c.fanout.Subscribe(ctx, subscriber, docKeys)
+}
+
+// Unsubscribe unsubscribes from the given documents through the fanout
+// coordinator.
+func (c *SplitCoordinator) Unsubscribe(
+	ctx context.Context,
+	docKeys []*key.Key,
+	sub *Subscription,
+) error {
+	return c.fanout.Unsubscribe(ctx, docKeys, sub)
+}
+
+// Publish publishes the given event through the fanout coordinator.
+func (c *SplitCoordinator) Publish(
+	ctx context.Context,
+	publisherID *time.ActorID,
+	event DocEvent,
+) {
+	c.fanout.Publish(ctx, publisherID, event)
+}
+
+// PublishToLocal publishes the given event to the local subscribers of the
+// fanout coordinator.
+func (c *SplitCoordinator) PublishToLocal(
+	ctx context.Context,
+	publisherID *time.ActorID,
+	event DocEvent,
+) {
+	c.fanout.PublishToLocal(ctx, publisherID, event)
+}
+
+// SubscriberCount returns the number of the subscribers of the given document
+// from the fanout coordinator.
+func (c *SplitCoordinator) SubscriberCount(
+	ctx context.Context,
+	docKey *key.Key,
+) (int, error) {
+	return c.fanout.SubscriberCount(ctx, docKey)
+}
+
+// Peers returns the subscribers of the given document from the fanout
+// coordinator.
+func (c *SplitCoordinator) Peers(
+	ctx context.Context,
+	docKey *key.Key,
+) ([]types.Client, error) {
+	return c.fanout.Peers(ctx, docKey)
+}
+
+// UpdateMetadata updates the metadata of the given client through the fanout
+// coordinator.
+func (c *SplitCoordinator) UpdateMetadata(
+	ctx context.Context,
+	publisher *types.Client,
+	keys []*key.Key,
+) (*DocEvent, error) {
+	return c.fanout.UpdateMetadata(ctx, publisher, keys)
+}
+
+// Members returns the members of the fanout cluster. The events are broadcast
+// to these members.
+func (c *SplitCoordinator) Members() map[string]*AgentInfo {
+	return c.fanout.Members()
+}
+
+// Ping checks whether both of the coordinators are reachable.
+func (c *SplitCoordinator) Ping(ctx context.Context) error {
+	if err := c.locking.Ping(ctx); err != nil {
+		return err
+	}
+
+	return c.fanout.Ping(ctx)
+}
+
+// Close closes both of the coordinators.
+func (c *SplitCoordinator) Close() error {
+	fanoutErr := c.fanout.Close()
+	if err := c.locking.Close(); err != nil {
+		return err
+	}
+
+	return fanoutErr
+}
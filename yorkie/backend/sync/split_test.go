@@ -0,0 +1,90 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sync_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/pkg/types"
+	"github.com/yorkie-team/yorkie/test/helper"
+	"github.com/yorkie-team/yorkie/yorkie/backend/sync"
+	"github.com/yorkie-team/yorkie/yorkie/backend/sync/memory"
+)
+
+func TestSplitCoordinator(t *testing.T) {
+	actorA := types.Client{ID: &time.ActorID{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}}
+	actorB := types.Client{ID: &time.ActorID{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}}
+
+	t.Run("publish through fanout coordinator test", func(t *testing.T) {
+		ctx := context.Background()
+		locking := memory.NewCoordinator(&sync.AgentInfo{ID: "locking"})
+		fanout := memory.NewCoordinator(&sync.AgentInfo{ID: "fanout"})
+		coordinator := sync.NewSplitCoordinator(locking, fanout)
+		defer func() {
+			assert.NoError(t, coordinator.Close())
+		}()
+
+		docKeys := []*key.Key{{Collection: helper.Collection, Document: t.Name()}}
+		sub, _, err := coordinator.Subscribe(ctx, actorA, docKeys)
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, coordinator.Unsubscribe(ctx, docKeys, sub))
+		}()
+
+		// NOTE: The subscription is registered only in the fanout coordinator.
+		_, peers, err := fanout.Subscribe(ctx, actorB, docKeys)
+		assert.NoError(t, err)
+		assert.Len(t, peers[docKeys[0].BSONKey()], 2)
+
+		event := sync.DocEvent{
+			Type:         types.DocumentsChangedEvent,
+			Publisher:    actorB,
+			DocumentKeys: docKeys,
+		}
+		locking.Publish(ctx, actorB.ID, event)
+		coordinator.Publish(ctx, actorB.ID, event)
+		assert.Equal(t, event, <-sub.Events())
+		assert.Len(t, sub.Events(), 0)
+
+		assert.Contains(t, coordinator.Members(), "fanout")
+	})
+
+	t.Run("lock through locking coordinator test", func(t *testing.T) {
+		ctx := context.Background()
+		locking := memory.NewCoordinator(&sync.AgentInfo{ID: "locking"})
+		fanout := memory.NewCoordinator(&sync.AgentInfo{ID: "fanout"})
+		coordinator := sync.NewSplitCoordinator(locking, fanout)
+
+		locker, err := coordinator.NewLocker(ctx, sync.NewKey(t.Name()))
+		assert.NoError(t, err)
+		assert.NoError(t, locker.Lock(ctx))
+
+		// NOTE: The lock is held only in the locking coordinator, so the same
+		// key can be locked in the fanout coordinator without blocking.
+		other, err := fanout.NewLocker(ctx, sync.NewKey(t.Name()))
+		assert.NoError(t, err)
+		assert.NoError(t, other.Lock(ctx))
+		assert.NoError(t, other.Unlock(ctx))
+
+		assert.NoError(t, locker.Unlock(ctx))
+	})
+}
@@ -17,8 +17,11 @@
 package clients
 
 import (
+	"bytes"
 	"context"
+	"crypto/ed25519"
 	"errors"
+	"fmt"
 
 	"github.com/yorkie-team/yorkie/pkg/document/key"
 	"github.com/yorkie-team/yorkie/yorkie/backend"
@@ -31,15 +34,53 @@ var (
 
 	// ErrInvalidClientID is returned when the given Key is not valid ClientID.
 	ErrInvalidClientID = errors.New("invalid client id")
+
+	// ErrInvalidPublicKey is returned when the given public key is not an
+	// ed25519 public key.
+	ErrInvalidPublicKey = errors.New("invalid public key")
+
+	// ErrPublicKeyMismatch is returned when the given public key is different
+	// from the public key already registered by the client.
+	ErrPublicKeyMismatch = errors.New("public key mismatch")
 )
 
-// Activate activates the given client.
+// Activate activates the given client. If the metadata is given, it is
+// stored in the clientInfo. If the public key is given, it is registered to
+// verify the signatures of the changes of the client.
 func Activate(
 	ctx context.Context,
 	be *backend.Backend,
 	clientKey string,
+	metadata map[string]string,
+	publicKey []byte,
 ) (*db.ClientInfo, error) {
-	return be.DB.ActivateClient(ctx, clientKey)
+	if len(publicKey) > 0 && len(publicKey) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("%d bytes: %w", len(publicKey), ErrInvalidPublicKey)
+	}
+
+	clientInfo, err := be.DB.ActivateClient(ctx, clientKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(metadata) > 0 {
+		clientInfo, err = be.DB.UpdateClientMetadata(ctx, clientInfo.ID, metadata)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(publicKey) == 0 || bytes.Equal(clientInfo.PublicKey, publicKey) {
+		return clientInfo, nil
+	}
+
+	// NOTE: The registered key can not be replaced, otherwise anyone who knows
+	// the key of the client could sign the changes on behalf of it.
+	if len(clientInfo.PublicKey) > 0 {
+		return nil, fmt.Errorf("%s: %w", clientKey, ErrPublicKeyMismatch)
+	}
+
+	return be.DB.UpdateClientPublicKey(ctx, clientInfo.ID, publicKey)
 }
 
 // Deactivate deactivates the given client.
@@ -51,6 +92,41 @@ func Deactivate(
 	return be.DB.DeactivateClient(ctx, clientID)
 }
 
+// UpdateMetadata updates the metadata of the given client.
+func UpdateMetadata(
+	ctx context.Context,
+	be *backend.Backend,
+	clientID db.ID,
+	metadata map[string]string,
+) (*db.ClientInfo, error) {
+	return be.DB.UpdateClientMetadata(ctx, clientID, metadata)
+}
+
+// FindClientInfo finds the client of the given ID and the documents attached
+// to the client. Since it is used for lookup, the access time of the client is
+// not updated.
+func FindClientInfo(
+	ctx context.Context,
+	be *backend.Backend,
+	clientID db.ID,
+) (*db.ClientInfo, map[db.ID]*db.DocInfo, error) {
+	clientInfo, err := be.DB.FindClientInfoByIDReadOnly(ctx, clientID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	docInfos := make(map[db.ID]*db.DocInfo)
+	for docID := range clientInfo.Documents {
+		docInfo, err := be.DB.FindDocInfoByID(ctx, docID)
+		if err != nil {
+			return nil, nil, err
+		}
+		docInfos[docID] = docInfo
+	}
+
+	return clientInfo, docInfos, nil
+}
+
 // FindClientAndDocument finds the client and the document.
 func FindClientAndDocument(
 	ctx context.Context,
@@ -17,8 +17,10 @@
 package yorkie
 
 import (
+	"errors"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"path/filepath"
 	"time"
 
@@ -28,6 +30,7 @@ import (
 	"github.com/yorkie-team/yorkie/yorkie/backend/db/mongo"
 	"github.com/yorkie-team/yorkie/yorkie/backend/housekeeping"
 	"github.com/yorkie-team/yorkie/yorkie/backend/sync/etcd"
+	"github.com/yorkie-team/yorkie/yorkie/backend/sync/redis"
 	"github.com/yorkie-team/yorkie/yorkie/logging"
 	"github.com/yorkie-team/yorkie/yorkie/profiling"
 	"github.com/yorkie-team/yorkie/yorkie/rpc"
@@ -38,25 +41,56 @@ const (
 	DefaultRPCPort             = 11101
 	DefaultRPCMaxRequestsBytes = 4 * 1024 * 1024 // 4MiB
 
+	DefaultRPCHealthCheckInterval = 10 * time.Second
+
 	DefaultProfilingPort = 11102
 
-	DefaultHousekeepingInterval            = time.Minute
-	DefaultHousekeepingDeactivateThreshold = 7 * 24 * time.Hour
-	DefaultHousekeepingCandidateLimit      = 500
+	DefaultHousekeepingInterval              = time.Minute
+	DefaultHousekeepingDeactivateThreshold   = 7 * 24 * time.Hour
+	DefaultHousekeepingCandidateLimit        = 500
+	DefaultHousekeepingClientDeleteThreshold = 30 * 24 * time.Hour
 
 	DefaultMongoConnectionURI     = "mongodb://localhost:27017"
 	DefaultMongoConnectionTimeout = 5 * time.Second
 	DefaultMongoPingTimeout       = 5 * time.Second
 	DefaultMongoYorkieDatabase    = "yorkie-meta"
+	DefaultMongoMaxPoolSize       = 100
 
 	DefaultSnapshotThreshold = 500
 	DefaultSnapshotInterval  = 1000
+	DefaultMaxChangesPerPull = 100
+	DefaultMaxPushChanges    = 10000
+
+	DefaultMaxConcurrentSnapshots   = 10
+	DefaultSnapshotWorkers          = 1
+	DefaultSnapshotCompression      = backend.SnapshotCompressionGzip
+	DefaultPushPullQueueSize        = 1000
+	DefaultBackgroundWorkers        = 100
+	DefaultBackgroundQueueSize      = 1000
+	DefaultBackgroundDrainTimeout   = 10 * time.Second
+	DefaultSnapshotCorruptionPolicy = backend.SnapshotCorruptionRecover
+	DefaultDuplicateChangePolicy    = backend.DuplicateChangeDedupe
+	DefaultLamportSkewPolicy        = backend.LamportSkewWarn
+
+	DefaultPayloadLogSampleRate = 0.01
+
+	DefaultDBMaxRetries      = 3
+	DefaultDBMaxWaitInterval = 1000 * time.Millisecond
 
 	DefaultAuthWebhookMaxRetries      = 10
 	DefaultAuthWebhookMaxWaitInterval = 3000 * time.Millisecond
+	DefaultMaxAuthWebhookBodyBytes    = 1024 * 1024 // 1MiB
 	DefaultAuthWebhookCacheSize       = 5000
 	DefaultAuthWebhookCacheAuthTTL    = 10 * time.Second
 	DefaultAuthWebhookCacheUnauthTTL  = 10 * time.Second
+
+	DefaultAuthWebhookDeltaResyncInterval = 5 * time.Minute
+)
+
+var (
+	// ErrMultipleCoordinators occurs when both ETCD and Redis are configured,
+	// while only one of them can be the coordinator of the cluster.
+	ErrMultipleCoordinators = errors.New("only one of ETCD and Redis can be configured")
 )
 
 // Config is the configuration for creating a Yorkie instance.
@@ -67,6 +101,7 @@ type Config struct {
 	Backend      *backend.Config      `yaml:"Backend"`
 	Mongo        *mongo.Config        `yaml:"Mongo"`
 	ETCD         *etcd.Config         `yaml:"ETCD"`
+	Redis        *redis.Config        `yaml:"Redis"`
 }
 
 // NewConfig returns a Config struct that contains reasonable defaults
@@ -93,13 +128,27 @@ func NewConfigFromFile(path string) (*Config, error) {
 	return conf, nil
 }
 
-// RPCAddr returns the RPC address.
+// RPCAddr returns the RPC address. If the RPC server listens on a UNIX domain
+// socket, it is the target of the socket for gRPC. If the server binds to a
+// specific interface, it is the address of the interface.
 func (c *Config) RPCAddr() string {
+	if c.RPC.SocketPath != "" {
+		return "unix://" + c.RPC.SocketPath
+	}
+
+	if ip := net.ParseIP(c.RPC.Host); ip != nil && !ip.IsUnspecified() {
+		return c.RPC.Addr()
+	}
+
 	return fmt.Sprintf("localhost:%d", c.RPC.Port)
 }
 
 // Validate returns an error if the provided Config is invalidated.
 func (c *Config) Validate() error {
+	if c.ETCD != nil && c.Redis != nil {
+		return ErrMultipleCoordinators
+	}
+
 	if err := c.RPC.Validate(); err != nil {
 		return err
 	}
@@ -123,7 +172,13 @@ func (c *Config) Validate() error {
 	}
 
 	if c.ETCD != nil {
-		return c.ETCD.Validate()
+		if err := c.ETCD.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if c.Redis != nil {
+		return c.Redis.Validate()
 	}
 	return nil
 }
@@ -131,7 +186,7 @@ func (c *Config) Validate() error {
 // ensureDefaultValue sets the value of the option to which the default value
 // should be applied when the user does not input it.
 func (c *Config) ensureDefaultValue() {
-	if c.RPC.Port == 0 {
+	if c.RPC.Port == 0 && c.RPC.SocketPath == "" {
 		c.RPC.Port = DefaultRPCPort
 	}
 
@@ -139,6 +194,10 @@ func (c *Config) ensureDefaultValue() {
 		c.RPC.MaxRequestBytes = DefaultRPCMaxRequestsBytes
 	}
 
+	if c.RPC.HealthCheckInterval == "" {
+		c.RPC.HealthCheckInterval = DefaultRPCHealthCheckInterval.String()
+	}
+
 	if c.Profiling.Port == 0 {
 		c.Profiling.Port = DefaultProfilingPort
 	}
@@ -151,10 +210,74 @@ func (c *Config) ensureDefaultValue() {
 		c.Backend.SnapshotInterval = DefaultSnapshotInterval
 	}
 
+	if c.Backend.MaxChangesPerPull == 0 {
+		c.Backend.MaxChangesPerPull = DefaultMaxChangesPerPull
+	}
+
+	if c.Backend.MaxPushChanges == 0 {
+		c.Backend.MaxPushChanges = DefaultMaxPushChanges
+	}
+
+	if c.Backend.MaxConcurrentSnapshots == 0 {
+		c.Backend.MaxConcurrentSnapshots = DefaultMaxConcurrentSnapshots
+	}
+
+	if c.Backend.SnapshotWorkers == 0 {
+		c.Backend.SnapshotWorkers = DefaultSnapshotWorkers
+	}
+
+	if c.Backend.SnapshotCompression == "" {
+		c.Backend.SnapshotCompression = DefaultSnapshotCompression
+	}
+
+	if c.Backend.PushPullQueueSize == 0 {
+		c.Backend.PushPullQueueSize = DefaultPushPullQueueSize
+	}
+
+	if c.Backend.BackgroundWorkers == 0 {
+		c.Backend.BackgroundWorkers = DefaultBackgroundWorkers
+	}
+
+	if c.Backend.BackgroundQueueSize == 0 {
+		c.Backend.BackgroundQueueSize = DefaultBackgroundQueueSize
+	}
+
+	if c.Backend.BackgroundDrainTimeout == "" {
+		c.Backend.BackgroundDrainTimeout = DefaultBackgroundDrainTimeout.String()
+	}
+
+	if c.Backend.SnapshotCorruptionPolicy == "" {
+		c.Backend.SnapshotCorruptionPolicy = DefaultSnapshotCorruptionPolicy
+	}
+
+	if c.Backend.DuplicateChangePolicy == "" {
+		c.Backend.DuplicateChangePolicy = DefaultDuplicateChangePolicy
+	}
+
+	if c.Backend.LamportSkewPolicy == "" {
+		c.Backend.LamportSkewPolicy = DefaultLamportSkewPolicy
+	}
+
+	if c.Backend.MaxAuthWebhookBodyBytes == 0 {
+		c.Backend.MaxAuthWebhookBodyBytes = DefaultMaxAuthWebhookBodyBytes
+	}
+
 	if c.Backend.AuthWebhookCacheSize == 0 {
 		c.Backend.AuthWebhookCacheSize = DefaultAuthWebhookCacheSize
 	}
 
+	if c.Backend.PayloadLogSampleRate == 0 {
+		c.Backend.PayloadLogSampleRate = DefaultPayloadLogSampleRate
+	}
+
+	if c.Backend.DBMaxRetries == 0 {
+		c.Backend.DBMaxRetries = DefaultDBMaxRetries
+	}
+
+	if c.Backend.DBMaxWaitInterval == "" {
+		c.Backend.DBMaxWaitInterval = DefaultDBMaxWaitInterval.String()
+	}
+
 	if c.Backend.AuthWebhookMaxRetries == 0 {
 		c.Backend.AuthWebhookMaxRetries = DefaultAuthWebhookMaxRetries
 	}
@@ -171,6 +294,10 @@ func (c *Config) ensureDefaultValue() {
 		c.Backend.AuthWebhookCacheUnauthTTL = DefaultAuthWebhookCacheUnauthTTL.String()
 	}
 
+	if c.Backend.AuthWebhookDeltaResyncInterval == "" {
+		c.Backend.AuthWebhookDeltaResyncInterval = DefaultAuthWebhookDeltaResyncInterval.String()
+	}
+
 	if c.Mongo != nil {
 		if c.Mongo.ConnectionURI == "" {
 			c.Mongo.ConnectionURI = DefaultMongoConnectionURI
@@ -187,6 +314,10 @@ func (c *Config) ensureDefaultValue() {
 		if c.Mongo.PingTimeout == "" {
 			c.Mongo.PingTimeout = DefaultMongoPingTimeout.String()
 		}
+
+		if c.Mongo.MaxPoolSize == 0 {
+			c.Mongo.MaxPoolSize = DefaultMongoMaxPoolSize
+		}
 	}
 
 	if c.ETCD != nil {
@@ -198,24 +329,52 @@ func (c *Config) ensureDefaultValue() {
 			c.ETCD.LockLeaseTime = etcd.DefaultLockLeaseTime.String()
 		}
 	}
+
+	if c.Redis != nil {
+		if c.Redis.DialTimeout == "" {
+			c.Redis.DialTimeout = redis.DefaultDialTimeout.String()
+		}
+
+		if c.Redis.LockLeaseTime == "" {
+			c.Redis.LockLeaseTime = redis.DefaultLockLeaseTime.String()
+		}
+	}
 }
 
 func newConfig(port int, profilingPort int) *Config {
 	return &Config{
 		RPC: &rpc.Config{
-			Port: port,
+			Port:                port,
+			HealthCheckInterval: DefaultRPCHealthCheckInterval.String(),
 		},
 		Profiling: &profiling.Config{
 			Port: profilingPort,
 		},
 		Housekeeping: &housekeeping.Config{
-			Interval:            DefaultHousekeepingInterval.String(),
-			DeactivateThreshold: DefaultHousekeepingDeactivateThreshold.String(),
-			CandidatesLimit:     DefaultHousekeepingCandidateLimit,
+			Interval:              DefaultHousekeepingInterval.String(),
+			DeactivateThreshold:   DefaultHousekeepingDeactivateThreshold.String(),
+			CandidatesLimit:       DefaultHousekeepingCandidateLimit,
+			ClientDeleteThreshold: DefaultHousekeepingClientDeleteThreshold.String(),
 		},
 		Backend: &backend.Config{
-			SnapshotThreshold: DefaultSnapshotThreshold,
-			SnapshotInterval:  DefaultSnapshotInterval,
+			SnapshotThreshold:        DefaultSnapshotThreshold,
+			SnapshotInterval:         DefaultSnapshotInterval,
+			MaxChangesPerPull:        DefaultMaxChangesPerPull,
+			MaxPushChanges:           DefaultMaxPushChanges,
+			MaxConcurrentSnapshots:   DefaultMaxConcurrentSnapshots,
+			SnapshotWorkers:          DefaultSnapshotWorkers,
+			SnapshotCompression:      DefaultSnapshotCompression,
+			PushPullQueueSize:        DefaultPushPullQueueSize,
+			BackgroundWorkers:        DefaultBackgroundWorkers,
+			BackgroundQueueSize:      DefaultBackgroundQueueSize,
+			BackgroundDrainTimeout:   DefaultBackgroundDrainTimeout.String(),
+			SnapshotCorruptionPolicy: DefaultSnapshotCorruptionPolicy,
+			DuplicateChangePolicy:    DefaultDuplicateChangePolicy,
+			LamportSkewPolicy:        DefaultLamportSkewPolicy,
+			PayloadLogSampleRate:     DefaultPayloadLogSampleRate,
+			DBMaxRetries:             DefaultDBMaxRetries,
+			DBMaxWaitInterval:        DefaultDBMaxWaitInterval.String(),
+			MaxAuthWebhookBodyBytes:  DefaultMaxAuthWebhookBodyBytes,
 		},
 	}
 }
@@ -25,6 +25,7 @@ import (
 
 	"github.com/yorkie-team/yorkie/yorkie"
 	"github.com/yorkie-team/yorkie/yorkie/backend/sync/etcd"
+	"github.com/yorkie-team/yorkie/yorkie/backend/sync/redis"
 )
 
 func TestNewConfigFromFile(t *testing.T) {
@@ -36,12 +37,30 @@ func TestNewConfigFromFile(t *testing.T) {
 		assert.Equal(t, conf.RPC.Port, yorkie.DefaultRPCPort)
 		assert.Equal(t, conf.RPC.CertFile, "")
 		assert.Equal(t, conf.RPC.KeyFile, "")
+		assert.Equal(t, conf.RPC.HealthCheckInterval, yorkie.DefaultRPCHealthCheckInterval.String())
 
 		assert.Equal(t, conf.Backend.SnapshotThreshold, uint64(yorkie.DefaultSnapshotThreshold))
 		assert.Equal(t, conf.Backend.SnapshotInterval, uint64(yorkie.DefaultSnapshotInterval))
+		assert.Equal(t, conf.Backend.MaxChangesPerPull, uint64(yorkie.DefaultMaxChangesPerPull))
+		assert.Equal(t, conf.Backend.MaxPushChanges, uint64(yorkie.DefaultMaxPushChanges))
+		assert.Equal(t, conf.Backend.MaxConcurrentSnapshots, yorkie.DefaultMaxConcurrentSnapshots)
+		assert.Equal(t, conf.Backend.SnapshotWorkers, yorkie.DefaultSnapshotWorkers)
+		assert.Equal(t, conf.Backend.SnapshotCompression, yorkie.DefaultSnapshotCompression)
+		assert.Equal(t, conf.Backend.PushPullQueueSize, yorkie.DefaultPushPullQueueSize)
+		assert.Equal(t, conf.Backend.BackgroundWorkers, yorkie.DefaultBackgroundWorkers)
+		assert.Equal(t, conf.Backend.BackgroundQueueSize, yorkie.DefaultBackgroundQueueSize)
+		assert.Equal(t, conf.Backend.BackgroundDrainTimeout, yorkie.DefaultBackgroundDrainTimeout.String())
+		assert.Equal(t, conf.Backend.SnapshotCorruptionPolicy, yorkie.DefaultSnapshotCorruptionPolicy)
+		assert.Equal(t, conf.Backend.DuplicateChangePolicy, yorkie.DefaultDuplicateChangePolicy)
+		assert.Equal(t, conf.Backend.LamportSkewPolicy, yorkie.DefaultLamportSkewPolicy)
+		assert.Equal(t, conf.Backend.PayloadLogSampleRate, yorkie.DefaultPayloadLogSampleRate)
+		assert.Equal(t, conf.Backend.DBMaxRetries, uint64(yorkie.DefaultDBMaxRetries))
+		assert.Equal(t, conf.Backend.DBMaxWaitInterval, yorkie.DefaultDBMaxWaitInterval.String())
 		assert.Equal(t, conf.Backend.AuthWebhookURL, "")
+		assert.Equal(t, conf.Backend.MaxAuthWebhookBodyBytes, uint64(yorkie.DefaultMaxAuthWebhookBodyBytes))
 
 		assert.Nil(t, conf.ETCD)
+		assert.Nil(t, conf.Redis)
 	})
 
 	t.Run("read config file test", func(t *testing.T) {
@@ -52,6 +71,7 @@ func TestNewConfigFromFile(t *testing.T) {
 		assert.Equal(t, conf.RPC.Port, yorkie.DefaultRPCPort)
 		assert.Equal(t, conf.RPC.CertFile, "")
 		assert.Equal(t, conf.RPC.KeyFile, "")
+		assert.Equal(t, conf.RPC.HealthCheckInterval, yorkie.DefaultRPCHealthCheckInterval.String())
 
 		connTimeout, err := time.ParseDuration(conf.Mongo.ConnectionTimeout)
 		assert.NoError(t, err)
@@ -62,10 +82,31 @@ func TestNewConfigFromFile(t *testing.T) {
 		pingTimeout, err := time.ParseDuration(conf.Mongo.PingTimeout)
 		assert.NoError(t, err)
 		assert.Equal(t, pingTimeout, yorkie.DefaultMongoPingTimeout)
+		assert.Equal(t, conf.Mongo.MaxPoolSize, uint64(yorkie.DefaultMongoMaxPoolSize))
+
+		clientDeleteThreshold, err := time.ParseDuration(conf.Housekeeping.ClientDeleteThreshold)
+		assert.NoError(t, err)
+		assert.Equal(t, clientDeleteThreshold, yorkie.DefaultHousekeepingClientDeleteThreshold)
 		assert.Equal(t, conf.Backend.SnapshotThreshold, uint64(yorkie.DefaultSnapshotThreshold))
 		assert.Equal(t, conf.Backend.SnapshotInterval, uint64(yorkie.DefaultSnapshotInterval))
+		assert.Equal(t, conf.Backend.MaxChangesPerPull, uint64(yorkie.DefaultMaxChangesPerPull))
+		assert.Equal(t, conf.Backend.MaxPushChanges, uint64(yorkie.DefaultMaxPushChanges))
+		assert.Equal(t, conf.Backend.MaxConcurrentSnapshots, yorkie.DefaultMaxConcurrentSnapshots)
+		assert.Equal(t, conf.Backend.SnapshotWorkers, yorkie.DefaultSnapshotWorkers)
+		assert.Equal(t, conf.Backend.SnapshotCompression, yorkie.DefaultSnapshotCompression)
+		assert.Equal(t, conf.Backend.PushPullQueueSize, yorkie.DefaultPushPullQueueSize)
+		assert.Equal(t, conf.Backend.BackgroundWorkers, yorkie.DefaultBackgroundWorkers)
+		assert.Equal(t, conf.Backend.BackgroundQueueSize, yorkie.DefaultBackgroundQueueSize)
+		assert.Equal(t, conf.Backend.BackgroundDrainTimeout, yorkie.DefaultBackgroundDrainTimeout.String())
+		assert.Equal(t, conf.Backend.SnapshotCorruptionPolicy, yorkie.DefaultSnapshotCorruptionPolicy)
+		assert.Equal(t, conf.Backend.DuplicateChangePolicy, yorkie.DefaultDuplicateChangePolicy)
+		assert.Equal(t, conf.Backend.LamportSkewPolicy, yorkie.DefaultLamportSkewPolicy)
+		assert.Equal(t, conf.Backend.PayloadLogSampleRate, yorkie.DefaultPayloadLogSampleRate)
+		assert.Equal(t, conf.Backend.DBMaxRetries, uint64(yorkie.DefaultDBMaxRetries))
+		assert.Equal(t, conf.Backend.DBMaxWaitInterval, yorkie.DefaultDBMaxWaitInterval.String())
 		assert.Equal(t, conf.Backend.AuthWebhookURL, "")
 		assert.Equal(t, conf.Backend.AuthWebhookMaxRetries, uint64(yorkie.DefaultAuthWebhookMaxRetries))
+		assert.Equal(t, conf.Backend.MaxAuthWebhookBodyBytes, uint64(yorkie.DefaultMaxAuthWebhookBodyBytes))
 
 		authWebhookMaxWaitInterval, err := time.ParseDuration(conf.Backend.AuthWebhookMaxWaitInterval)
 		assert.NoError(t, err)
@@ -79,6 +120,10 @@ func TestNewConfigFromFile(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, authWebhookCacheUnauthTTL, yorkie.DefaultAuthWebhookCacheUnauthTTL)
 
+		authWebhookDeltaResyncInterval, err := time.ParseDuration(conf.Backend.AuthWebhookDeltaResyncInterval)
+		assert.NoError(t, err)
+		assert.Equal(t, authWebhookDeltaResyncInterval, yorkie.DefaultAuthWebhookDeltaResyncInterval)
+
 		assert.NotNil(t, conf.ETCD)
 		etcdDialTimeout, err := time.ParseDuration(conf.ETCD.DialTimeout)
 		assert.NoError(t, err)
@@ -87,5 +132,36 @@ func TestNewConfigFromFile(t *testing.T) {
 		lockLeaseTime, err := time.ParseDuration(conf.ETCD.LockLeaseTime)
 		assert.NoError(t, err)
 		assert.Equal(t, lockLeaseTime, etcd.DefaultLockLeaseTime)
+
+		// NOTE: Redis is commented out in the sample, since only one of ETCD
+		//       and Redis can be configured.
+		assert.Nil(t, conf.Redis)
+	})
+
+	t.Run("multiple coordinators test", func(t *testing.T) {
+		conf := yorkie.NewConfig()
+		conf.ETCD = &etcd.Config{
+			Endpoints:     []string{"localhost:2379"},
+			DialTimeout:   etcd.DefaultDialTimeout.String(),
+			LockLeaseTime: etcd.DefaultLockLeaseTime.String(),
+		}
+		conf.Redis = &redis.Config{
+			Address:       "localhost:6379",
+			DialTimeout:   redis.DefaultDialTimeout.String(),
+			LockLeaseTime: redis.DefaultLockLeaseTime.String(),
+		}
+		assert.ErrorIs(t, conf.Validate(), yorkie.ErrMultipleCoordinators)
+	})
+
+	t.Run("rpc addr test", func(t *testing.T) {
+		conf := yorkie.NewConfig()
+		conf.RPC.Host = "0.0.0.0"
+		assert.Equal(t, "localhost:"+strconv.Itoa(yorkie.DefaultRPCPort), conf.RPCAddr())
+
+		conf.RPC.Host = "10.0.0.1"
+		assert.Equal(t, "10.0.0.1:"+strconv.Itoa(yorkie.DefaultRPCPort), conf.RPCAddr())
+
+		conf.RPC.SocketPath = "/tmp/yorkie.sock"
+		assert.Equal(t, "unix:///tmp/yorkie.sock", conf.RPCAddr())
 	})
 }
@@ -25,3 +25,25 @@ func From(ctx context.Context) Logger {
 
 	return logger
 }
+
+// requestIDKey is the type used for the request ID key in context.
+type requestIDKey struct{}
+
+// WithRequestID returns a new context with the provided request ID. The ID is
+// also added to the logger of the context so that the logs of the request can
+// be correlated.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	ctx = context.WithValue(ctx, requestIDKey{}, id)
+	return With(ctx, From(ctx).With("request_id", id))
+}
+
+// RequestIDFrom returns the request ID stored in the provided context. It
+// returns an empty string if there is no request ID.
+func RequestIDFrom(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
@@ -17,6 +17,7 @@
 package logging
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -26,6 +27,11 @@ import (
 	"go.uber.org/zap/zapcore"
 )
 
+var (
+	// ErrInvalidLogLevel is returned when the given log level is not supported.
+	ErrInvalidLogLevel = errors.New("invalid log level")
+)
+
 // Logger is a wrapper of zap.Logger.
 type Logger = *zap.SugaredLogger
 
@@ -36,23 +42,33 @@ var loggerOnce sync.Once
 // SetLogLevel sets the level of global logger with ["debug", "info", "warn", "error", "panic", "fatal"].
 // SetLoglevel must be sets before calling DefaultLogger() or New().
 func SetLogLevel(level string) error {
+	parsed, err := parseLevel(level)
+	if err != nil {
+		return err
+	}
+
+	logLevel = parsed
+	return nil
+}
+
+// parseLevel parses the given level among ["debug", "info", "warn", "error", "panic", "fatal"].
+func parseLevel(level string) (zapcore.Level, error) {
 	switch strings.ToLower(level) {
 	case "debug":
-		logLevel = zapcore.DebugLevel
+		return zapcore.DebugLevel, nil
 	case "info":
-		logLevel = zapcore.InfoLevel
+		return zapcore.InfoLevel, nil
 	case "warn":
-		logLevel = zapcore.WarnLevel
+		return zapcore.WarnLevel, nil
 	case "error":
-		logLevel = zapcore.ErrorLevel
+		return zapcore.ErrorLevel, nil
 	case "panic":
-		logLevel = zapcore.PanicLevel
+		return zapcore.PanicLevel, nil
 	case "fatal":
-		logLevel = zapcore.FatalLevel
+		return zapcore.FatalLevel, nil
 	default:
-		return fmt.Errorf("invalid log level: %s", level)
+		return zapcore.InfoLevel, fmt.Errorf("%s: %w", level, ErrInvalidLogLevel)
 	}
-	return nil
 }
 
 // New creates a new logger with the given configuration.
@@ -81,6 +97,7 @@ func newLogger(name string) Logger {
 			zapcore.AddSync(os.Stdout),
 			logLevel,
 		),
+		newSinkCore(defaultSink, logLevel),
 	), zap.AddStacktrace(zap.ErrorLevel)).Named(name).Sugar()
 }
 
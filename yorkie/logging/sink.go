@@ -0,0 +1,213 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package logging
+
+import (
+	gojson "encoding/json"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// Entry is a log entry delivered to the subscribers.
+type Entry struct {
+	Time    time.Time
+	Level   zapcore.Level
+	Logger  string
+	Message string
+}
+
+// Subscription is a subscription to the log entries of this agent.
+type Subscription struct {
+	level    zapcore.Level
+	prefixes []string
+	entries  chan Entry
+	dropped  uint64
+}
+
+// Entries returns the channel of the log entries.
+func (s *Subscription) Entries() <-chan Entry {
+	return s.entries
+}
+
+// Dropped returns the number of entries dropped since the last call because
+// the subscriber could not keep up, and resets it.
+func (s *Subscription) Dropped() uint64 {
+	return atomic.SwapUint64(&s.dropped, 0)
+}
+
+// matches returns whether the given entry should be delivered to this
+// subscription.
+func (s *Subscription) matches(ent zapcore.Entry) bool {
+	if ent.Level < s.level {
+		return false
+	}
+
+	if len(s.prefixes) == 0 {
+		return true
+	}
+
+	for _, prefix := range s.prefixes {
+		if strings.HasPrefix(ent.LoggerName, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sink fans out the log entries to the subscriptions.
+type sink struct {
+	mu            sync.RWMutex
+	subscriptions map[*Subscription]struct{}
+}
+
+var defaultSink = &sink{
+	subscriptions: make(map[*Subscription]struct{}),
+}
+
+// Subscribe subscribes to the log entries of the given level or above whose
+// logger name starts with one of the given prefixes. If prefixes is empty,
+// entries of all loggers are delivered. Entries below the level of the agent
+// are not delivered regardless of the given level.
+func Subscribe(level string, prefixes []string, bufferSize int) (*Subscription, error) {
+	subscriptionLevel := logLevel
+	if level != "" {
+		var err error
+		if subscriptionLevel, err = parseLevel(level); err != nil {
+			return nil, err
+		}
+	}
+
+	sub := &Subscription{
+		level:    subscriptionLevel,
+		prefixes: prefixes,
+		entries:  make(chan Entry, bufferSize),
+	}
+
+	defaultSink.mu.Lock()
+	defer defaultSink.mu.Unlock()
+	defaultSink.subscriptions[sub] = struct{}{}
+
+	return sub, nil
+}
+
+// Unsubscribe stops delivering entries to the given subscription and closes
+// its channel.
+func Unsubscribe(sub *Subscription) {
+	defaultSink.mu.Lock()
+	defer defaultSink.mu.Unlock()
+
+	if _, ok := defaultSink.subscriptions[sub]; !ok {
+		return
+	}
+	delete(defaultSink.subscriptions, sub)
+	close(sub.entries)
+}
+
+// hasSubscriptions returns whether there are subscriptions.
+func (s *sink) hasSubscriptions() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return len(s.subscriptions) > 0
+}
+
+// publish delivers the given entry to the matching subscriptions.
+func (s *sink) publish(ent zapcore.Entry, message string) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for sub := range s.subscriptions {
+		if !sub.matches(ent) {
+			continue
+		}
+
+		// NOTE: Logging should not be blocked by slow subscribers, so the
+		// entry is dropped if the buffer of the subscription is full.
+		select {
+		case sub.entries <- Entry{
+			Time:    ent.Time,
+			Level:   ent.Level,
+			Logger:  ent.LoggerName,
+			Message: message,
+		}:
+		default:
+			atomic.AddUint64(&sub.dropped, 1)
+		}
+	}
+}
+
+// sinkCore is a zapcore.Core that writes the entries to the sink.
+type sinkCore struct {
+	zapcore.LevelEnabler
+	sink   *sink
+	fields []zapcore.Field
+}
+
+// newSinkCore creates a new instance of sinkCore.
+func newSinkCore(sink *sink, enabler zapcore.LevelEnabler) *sinkCore {
+	return &sinkCore{
+		LevelEnabler: enabler,
+		sink:         sink,
+	}
+}
+
+// With adds the given fields to the entries written by this core.
+func (c *sinkCore) With(fields []zapcore.Field) zapcore.Core {
+	clone := newSinkCore(c.sink, c.LevelEnabler)
+	clone.fields = append(append(clone.fields, c.fields...), fields...)
+	return clone
+}
+
+// Check adds this core to the given checked entry if there are subscriptions.
+func (c *sinkCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(ent.Level) && c.sink.hasSubscriptions() {
+		return ce.AddCore(ent, c)
+	}
+	return ce
+}
+
+// Write publishes the given entry with the fields to the sink.
+func (c *sinkCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	message := ent.Message
+
+	all := append(append([]zapcore.Field{}, c.fields...), fields...)
+	if len(all) > 0 {
+		encoder := zapcore.NewMapObjectEncoder()
+		for _, field := range all {
+			field.AddTo(encoder)
+		}
+
+		encoded, err := gojson.Marshal(encoder.Fields)
+		if err != nil {
+			return err
+		}
+		message += " " + string(encoded)
+	}
+
+	c.sink.publish(ent, message)
+	return nil
+}
+
+// Sync does nothing because the entries are not buffered in this core.
+func (c *sinkCore) Sync() error {
+	return nil
+}
@@ -0,0 +1,131 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs
+
+import (
+	"context"
+
+	"github.com/yorkie-team/yorkie/yorkie/backend"
+	"github.com/yorkie-team/yorkie/yorkie/backend/db"
+	"github.com/yorkie-team/yorkie/yorkie/logging"
+)
+
+// CompactChanges deletes the changes of the given document that are already
+// covered by a snapshot and received by all the attached clients, and returns
+// the number of the deleted changes. If beforeSeq is not zero, only the
+// changes whose serverSeq is less than it are deleted.
+//
+// The changes are deleted up to an intact snapshot so that the document can
+// still be built from the snapshot and the changes after it. The change at
+// the serverSeq of each attached client is kept to look up its synced
+// ticket.
+func CompactChanges(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *db.DocInfo,
+	beforeSeq uint64,
+) (int, error) {
+	docKey, err := docInfo.GetKey()
+	if err != nil {
+		return 0, err
+	}
+
+	// NOTE: The pushpull locker is held so that the PushPulls with changes do
+	//       not pull the changes being deleted, and the snapshot locker is
+	//       held so that no snapshot is built from them. They are acquired in
+	//       the same order as PushPull. The pulls without changes are not
+	//       locked, so they check the pulled changes for a gap instead.
+	pushPullLocker, err := be.Coordinator.NewLocker(ctx, NewPushPullKey(docKey))
+	if err != nil {
+		return 0, err
+	}
+	if err := pushPullLocker.Lock(ctx); err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err := pushPullLocker.Unlock(ctx); err != nil {
+			logging.From(ctx).Error(err)
+		}
+	}()
+
+	locker, err := be.Coordinator.NewLocker(ctx, NewSnapshotKey(docKey))
+	if err != nil {
+		return 0, err
+	}
+	if err := locker.Lock(ctx); err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err := locker.Unlock(ctx); err != nil {
+			logging.From(ctx).Error(err)
+		}
+	}()
+
+	docInfo, err = be.DB.FindDocInfoByID(ctx, docInfo.ID)
+	if err != nil {
+		return 0, err
+	}
+	// NOTE: The documents whose garbage collection is disabled, such as the
+	//       documents under legal hold, keep the whole history.
+	if docInfo.Settings.DisableGarbageCollection || docInfo.ServerSeq == 0 {
+		return 0, nil
+	}
+
+	// 01. find the serverSeq up to which the changes are not needed anymore.
+	upTo := docInfo.ServerSeq - 1
+	if beforeSeq > 0 && beforeSeq-1 < upTo {
+		upTo = beforeSeq - 1
+	}
+
+	minSyncedSeqInfo, err := be.DB.FindMinSyncedSeqInfo(ctx, docInfo.ID)
+	if err != nil {
+		return 0, err
+	}
+	if minSyncedSeqInfo != nil {
+		if minSyncedSeqInfo.ServerSeq == 0 {
+			return 0, nil
+		}
+		if minSyncedSeqInfo.ServerSeq-1 < upTo {
+			upTo = minSyncedSeqInfo.ServerSeq - 1
+		}
+	}
+
+	// 02. align it to the closest intact snapshot.
+	snapshotInfo, err := be.DB.FindClosestSnapshotInfo(ctx, docInfo.ID, upTo)
+	if err != nil {
+		return 0, err
+	}
+	if snapshotInfo.ServerSeq <= docInfo.CompactedServerSeq || !isSnapshotIntact(snapshotInfo) {
+		return 0, nil
+	}
+
+	// 03. delete the changes covered by the snapshot.
+	compacted, err := be.DB.CompactChangeInfos(ctx, docInfo.ID, snapshotInfo.ServerSeq)
+	if err != nil {
+		return 0, err
+	}
+
+	logging.From(ctx).Infof(
+		"COMPACT: '%s' deletes %d changes, serverSeq: %d -> %d",
+		docInfo.Key,
+		compacted,
+		docInfo.CompactedServerSeq,
+		snapshotInfo.ServerSeq,
+	)
+
+	return compacted, nil
+}
@@ -0,0 +1,69 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs
+
+import (
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/operation"
+	"github.com/yorkie-team/yorkie/yorkie/backend/db"
+)
+
+// countConflicts returns the number of operations of the pushed changes that
+// target the same element as an operation of the concurrent changes.
+//
+// NOTE: The concurrent changes are the changes after the checkpoint of the
+// client, so the client had not seen them when it made the pushed changes.
+// Only the changes of other actors are regarded as concurrent, and selections
+// are not regarded as conflicts because they do not modify the document.
+func countConflicts(pushed []*change.Change, concurrent []*db.ChangeInfo) (int, error) {
+	if len(pushed) == 0 || len(concurrent) == 0 {
+		return 0, nil
+	}
+
+	actorID := pushed[0].ID().ActorID()
+	targets := make(map[string]struct{})
+	for _, info := range concurrent {
+		cn, err := info.ToChange()
+		if err != nil {
+			return 0, err
+		}
+		if cn.ID().ActorID().Compare(actorID) == 0 {
+			continue
+		}
+
+		for _, op := range cn.Operations() {
+			if _, ok := op.(*operation.Select); ok {
+				continue
+			}
+			targets[op.ParentCreatedAt().Key()] = struct{}{}
+		}
+	}
+
+	conflicts := 0
+	for _, cn := range pushed {
+		for _, op := range cn.Operations() {
+			if _, ok := op.(*operation.Select); ok {
+				continue
+			}
+			if _, ok := targets[op.ParentCreatedAt().Key()]; ok {
+				conflicts++
+			}
+		}
+	}
+
+	return conflicts, nil
+}
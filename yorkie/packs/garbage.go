@@ -0,0 +1,64 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs
+
+import (
+	"context"
+
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/yorkie/backend"
+	"github.com/yorkie-team/yorkie/yorkie/backend/db"
+)
+
+// GarbageReport is a report of the garbage collection of a document that is
+// not actually run.
+type GarbageReport struct {
+	// ServerSeq is the server sequence of the document that the report is
+	// built at.
+	ServerSeq uint64
+
+	// GCDisabled is whether the garbage collection of the document is
+	// disabled, so that the snapshots do not purge the garbage.
+	GCDisabled bool
+
+	// Garbage is the list of the elements and the text nodes that would be
+	// purged. The changes that removed them are identified by their removedAt.
+	Garbage []*json.Garbage
+}
+
+// DryRunGarbageCollection returns the elements and the text nodes of the given
+// document that would be purged with the given minSyncedTicket, without purging
+// them. The document is built at its latest serverSeq and no snapshot is
+// stored.
+func DryRunGarbageCollection(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *db.DocInfo,
+	minSyncedTicket *time.Ticket,
+) (*GarbageReport, error) {
+	doc, err := buildDocument(ctx, be, docInfo, docInfo.ServerSeq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GarbageReport{
+		ServerSeq:  docInfo.ServerSeq,
+		GCDisabled: docInfo.Settings.DisableGarbageCollection,
+		Garbage:    doc.FindGarbage(minSyncedTicket),
+	}, nil
+}
@@ -0,0 +1,157 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/yorkie/backend"
+	"github.com/yorkie-team/yorkie/yorkie/backend/db"
+)
+
+const (
+	// historyRef is the git ref that the exported history is committed to.
+	historyRef = "refs/heads/master"
+
+	// historyBatchSize is the number of changes read from the DB at once while
+	// exporting the history.
+	historyBatchSize = 1000
+)
+
+// ExportHistory writes the changes of the given document to the given writer
+// as a git fast-import stream. Each change becomes a commit authored by the
+// actor of the change, and the commit contains the document as JSON after the
+// change is applied, so that `git fast-import` can rebuild the history. If the
+// changes of the document have been compacted, the history starts with a
+// commit of the snapshot at the compacted serverSeq. The writes are not
+// buffered, so the caller should buffer the writer if needed.
+func ExportHistory(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *db.DocInfo,
+	w io.Writer,
+) error {
+	docKey, err := docInfo.GetKey()
+	if err != nil {
+		return err
+	}
+
+	doc := document.NewInternalDocument(docKey.Collection, docKey.Document)
+	path := fmt.Sprintf("%s/%s.json", docKey.Collection, docKey.Document)
+	start := uint64(1)
+
+	// NOTE: The changes up to the compacted serverSeq have been deleted, so
+	//       they are replaced with a single commit of the snapshot.
+	if docInfo.CompactedServerSeq > 0 {
+		snapshotInfo, err := be.DB.FindClosestSnapshotInfo(ctx, docInfo.ID, docInfo.CompactedServerSeq)
+		if err != nil {
+			return err
+		}
+
+		doc, snapshotInfo, err = newDocumentFromSnapshot(ctx, be, docInfo, snapshotInfo)
+		if err != nil {
+			return err
+		}
+
+		if err := writeHistoryCommit(w, docInfo, &db.ChangeInfo{
+			ServerSeq: snapshotInfo.ServerSeq,
+			ActorID:   db.ID(time.InitialActorID.String()),
+			Message:   fmt.Sprintf("snapshot %d", snapshotInfo.ServerSeq),
+			CreatedAt: snapshotInfo.CreatedAt,
+		}, path, doc.Marshal()); err != nil {
+			return err
+		}
+		start = snapshotInfo.ServerSeq + 1
+	}
+
+	for from := start; from <= docInfo.ServerSeq; from += historyBatchSize {
+		to := from + historyBatchSize - 1
+		if to > docInfo.ServerSeq {
+			to = docInfo.ServerSeq
+		}
+
+		infos, err := be.DB.FindChangeInfosBetweenServerSeqs(ctx, docInfo.ID, from, to)
+		if err != nil {
+			return err
+		}
+
+		for _, info := range infos {
+			c, err := info.ToChange()
+			if err != nil {
+				return err
+			}
+
+			if err := doc.ApplyChangePack(change.NewPack(
+				docKey,
+				change.InitialCheckpoint.NextServerSeq(info.ServerSeq),
+				[]*change.Change{c},
+				nil,
+			)); err != nil {
+				return err
+			}
+
+			if err := writeHistoryCommit(w, docInfo, info, path, doc.Marshal()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// writeHistoryCommit writes the commit of the given change to the given writer
+// in git fast-import format.
+func writeHistoryCommit(
+	w io.Writer,
+	docInfo *db.DocInfo,
+	info *db.ChangeInfo,
+	path string,
+	content string,
+) error {
+	// NOTE: The changes stored before the creation time was introduced do not
+	// have it, so the creation time of the document is used instead.
+	createdAt := info.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = docInfo.CreatedAt
+	}
+
+	message := info.Message
+	if message == "" {
+		message = fmt.Sprintf("change %d", info.ServerSeq)
+	}
+
+	ident := fmt.Sprintf("%s <> %d +0000", info.ActorID.String(), createdAt.Unix())
+	if _, err := fmt.Fprintf(w, "commit %s\nmark :%d\n", historyRef, info.ServerSeq); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "author %s\ncommitter %s\n", ident, ident); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "data %d\n%s\n", len(message), message); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "M 100644 inline %s\ndata %d\n%s\n\n", path, len(content), content); err != nil {
+		return err
+	}
+
+	return nil
+}
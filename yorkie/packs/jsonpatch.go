@@ -0,0 +1,195 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/jsonpatch"
+	"github.com/yorkie-team/yorkie/yorkie/backend"
+	"github.com/yorkie-team/yorkie/yorkie/backend/db"
+	"github.com/yorkie-team/yorkie/yorkie/logging"
+)
+
+// JSONPatchPack is a pack of the changes of a document represented as JSON
+// patches for the clients without the CRDT implementation.
+type JSONPatchPack struct {
+	// ServerSeq is the server sequence of the document after applying the
+	// patches.
+	ServerSeq uint64
+
+	// Patches is the list of patches after the requested server sequence.
+	Patches []*jsonpatch.Patch
+
+	// Document is the JSON encoded document. It is set instead of Patches when
+	// the whole document is requested or the changes can not be represented
+	// as patches.
+	Document []byte
+
+	// HasMore is whether there are more changes to pull.
+	HasMore bool
+}
+
+// PullJSONPatches returns the changes of the given document after the given
+// serverSeq as JSON patches. If the given serverSeq is 0, the whole document
+// is returned.
+func PullJSONPatches(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *db.DocInfo,
+	serverSeq uint64,
+) (*JSONPatchPack, error) {
+	if serverSeq > docInfo.ServerSeq {
+		return nil, fmt.Errorf(
+			"server seq(document %d, request %d): %w",
+			docInfo.ServerSeq,
+			serverSeq,
+			ErrInvalidServerSeq,
+		)
+	}
+
+	// NOTE: The changes behind the compacted serverSeq have been deleted, so
+	//       the whole document is sent.
+	if serverSeq == 0 || serverSeq < docInfo.CompactedServerSeq {
+		return pullJSONDocument(ctx, be, docInfo, docInfo.ServerSeq)
+	}
+
+	// NOTE: If the number of changes is not limited, all the changes up to
+	//       the server seq of the document are sent at once.
+	to := docInfo.ServerSeq
+	hasMore := false
+	maxChanges := be.Config.MaxChangesPerPullOf(docInfo.Settings)
+	if maxChanges > 0 && to-serverSeq > maxChanges {
+		to = serverSeq + maxChanges
+		hasMore = true
+	}
+
+	changes, err := be.DB.FindChangesBetweenServerSeqs(ctx, docInfo.ID, serverSeq+1, to)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: The changes may be compacted after the doc info was read. Then
+	//       the whole document is sent.
+	if serverSeq < to && (len(changes) == 0 || *changes[0].ServerSeq() != serverSeq+1) {
+		logging.From(ctx).Infof("PULL: '%s' sending whole document: changes compacted", docInfo.Key)
+		return pullJSONDocument(ctx, be, docInfo, docInfo.ServerSeq)
+	}
+
+	doc, err := buildDocument(ctx, be, docInfo, serverSeq)
+	if err != nil {
+		return nil, err
+	}
+
+	patches, err := jsonpatch.FromChanges(doc.Root(), changes)
+	if errors.Is(err, jsonpatch.ErrUnsupportedOperation) {
+		// NOTE: The client falls back to the whole document because some of the
+		// changes can not be represented as patches.
+		logging.From(ctx).Infof("PULL: '%s' sending whole document: %s", docInfo.Key, err)
+		pack, err := pullJSONDocument(ctx, be, docInfo, to)
+		if err != nil {
+			return nil, err
+		}
+		pack.HasMore = hasMore
+		return pack, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &JSONPatchPack{
+		ServerSeq: to,
+		Patches:   patches,
+		HasMore:   hasMore,
+	}, nil
+}
+
+// pullJSONDocument returns the whole document at the given serverSeq as JSON.
+func pullJSONDocument(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *db.DocInfo,
+	serverSeq uint64,
+) (*JSONPatchPack, error) {
+	doc, err := buildDocument(ctx, be, docInfo, serverSeq)
+	if err != nil {
+		return nil, err
+	}
+
+	encoded, err := jsonpatch.Marshal(doc.RootObject())
+	if err != nil {
+		return nil, err
+	}
+
+	return &JSONPatchPack{
+		ServerSeq: serverSeq,
+		Document:  encoded,
+	}, nil
+}
+
+// buildDocument builds the document at the given serverSeq from the closest
+// snapshot and the changes after it.
+func buildDocument(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *db.DocInfo,
+	serverSeq uint64,
+) (*document.InternalDocument, error) {
+	snapshotInfo, err := be.DB.FindClosestSnapshotInfo(ctx, docInfo.ID, serverSeq)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, snapshotInfo, err := newDocumentFromSnapshot(ctx, be, docInfo, snapshotInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	if snapshotInfo.ServerSeq == serverSeq {
+		return doc, nil
+	}
+
+	changes, err := be.DB.FindChangesBetweenServerSeqs(
+		ctx,
+		docInfo.ID,
+		snapshotInfo.ServerSeq+1,
+		serverSeq,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	docKey, err := docInfo.GetKey()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := doc.ApplyChangePack(change.NewPack(
+		docKey,
+		change.InitialCheckpoint.NextServerSeq(serverSeq),
+		changes,
+		nil,
+	)); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
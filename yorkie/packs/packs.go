@@ -18,6 +18,7 @@ package packs
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	gotime "time"
 
@@ -31,6 +32,13 @@ import (
 	"github.com/yorkie-team/yorkie/yorkie/logging"
 )
 
+// Belows are the phases of PushPull whose time is observed.
+const (
+	phasePush  = "push"
+	phasePull  = "pull"
+	phaseStore = "store"
+)
+
 // NewPushPullKey creates a new sync.Key of PushPull for the given document.
 func NewPushPullKey(documentKey *key.Key) sync.Key {
 	return sync.NewKey(fmt.Sprintf("pushpull-%s", documentKey.BSONKey()))
@@ -55,58 +63,113 @@ func PushPull(
 		be.Metrics.ObservePushPullResponseSeconds(gotime.Since(start).Seconds())
 	}()
 
-	// TODO: Changes may be reordered or missing during communication on the network.
-	// We should check the change.pack with checkpoint to make sure the changes are in the correct order.
+	if err := reqPack.DocumentKey.Validate(); err != nil {
+		return nil, err
+	}
+
 	initialServerSeq := docInfo.ServerSeq
 
+	if err := dedupeChanges(ctx, be, reqPack); err != nil {
+		return nil, err
+	}
+	logPayload(ctx, be, clientInfo, docInfo, reqPack)
+
 	// 01. push changes.
-	pushedCP, pushedChanges, err := pushChanges(ctx, clientInfo, docInfo, reqPack, initialServerSeq)
+	// NOTE: The changes are pushed even if the pull is split into several
+	// responses, so that the client far behind can still push. Its own
+	// changes are left out of the following responses by the pull.
+	pushStart := gotime.Now()
+	if err := registerActor(be, clientInfo, docInfo, reqPack); err != nil {
+		return nil, err
+	}
+
+	pushedCP, pushedChanges, err := pushChanges(ctx, be, clientInfo, docInfo, reqPack, initialServerSeq)
 	if err != nil {
 		return nil, err
 	}
-	be.Metrics.AddPushPullReceivedChanges(reqPack.ChangesLen())
-	be.Metrics.AddPushPullReceivedOperations(reqPack.OperationsLen())
+
+	if err := checkLamportSkew(ctx, be, clientInfo, docInfo, pushedChanges, initialServerSeq); err != nil {
+		return nil, err
+	}
+	be.Metrics.ObservePushPullPhaseSeconds(phasePush, gotime.Since(pushStart).Seconds())
+	if len(pushedChanges) > 0 {
+		be.Metrics.AddPushPullReceivedChanges(reqPack.ChangesLen())
+		be.Metrics.AddPushPullReceivedOperations(reqPack.OperationsLen())
+		for opType, count := range reqPack.OperationsLenByType() {
+			be.Metrics.AddPushPullReceivedOperationsByType(string(opType), count)
+		}
+	}
 
 	// 02. pull change pack.
+	pullStart := gotime.Now()
 	respPack, err := pullPack(ctx, be, clientInfo, docInfo, reqPack, pushedCP, initialServerSeq)
 	if err != nil {
 		return nil, err
 	}
+	be.Metrics.ObservePushPullPhaseSeconds(phasePull, gotime.Since(pullStart).Seconds())
 	be.Metrics.AddPushPullSentChanges(respPack.ChangesLen())
 	be.Metrics.AddPushPullSentOperations(respPack.OperationsLen())
 	be.Metrics.AddPushPullSnapshotBytes(respPack.SnapshotLen())
 
+	// NOTE: The conflicts are counted only for analytics, so the error does not
+	// fail the request.
+	conflicts, err := countConflicts(pushedChanges, respPack.ChangeInfos)
+	if err != nil {
+		logging.From(ctx).Error(err)
+	}
+	be.Metrics.AddPushPullConflicts(conflicts)
+
 	if err := clientInfo.UpdateCheckpoint(docInfo.ID, respPack.Checkpoint); err != nil {
 		return nil, err
 	}
 
 	// 03. store pushed changes, document info and checkpoint of the client to DB.
+	// NOTE: The writes below are retried on transient errors of the DB. The
+	// changes and the checkpoints are upserted, so they can be written again.
+	// If the document was updated by the failed attempt, the retry fails with
+	// ErrConflictOnUpdate instead of updating it twice.
+	storeStart := gotime.Now()
 	if len(pushedChanges) > 0 {
-		if err := be.DB.CreateChangeInfos(ctx, docInfo, initialServerSeq, pushedChanges); err != nil {
+		if err := be.RetryDB(ctx, func() error {
+			return be.DB.CreateChangeInfos(ctx, docInfo, initialServerSeq, pushedChanges)
+		}); err != nil {
 			return nil, err
 		}
 	}
 
-	if err := be.DB.UpdateClientInfoAfterPushPull(ctx, clientInfo, docInfo); err != nil {
+	if err := be.RetryDB(ctx, func() error {
+		return be.DB.UpdateClientInfoAfterPushPull(ctx, clientInfo, docInfo)
+	}); err != nil {
 		return nil, err
 	}
+	be.Metrics.ObservePushPullPhaseSeconds(phaseStore, gotime.Since(storeStart).Seconds())
 
 	// 04. update and find min synced ticket for garbage collection.
 	// NOTE(hackerwins): Since the client could not receive the response, the
 	// requested seq(reqPack) is stored instead of the response seq(resPack).
-	minSyncedTicket, err := be.DB.UpdateAndFindMinSyncedTicket(
-		ctx,
-		clientInfo,
-		docInfo.ID,
-		reqPack.Checkpoint.ServerSeq,
-	)
-	if err != nil {
+	var minSyncedTicket *time.Ticket
+	if err := be.RetryDB(ctx, func() error {
+		var err error
+		minSyncedTicket, err = be.DB.UpdateAndFindMinSyncedTicket(
+			ctx,
+			clientInfo,
+			docInfo.ID,
+			reqPack.Checkpoint.ServerSeq,
+		)
+		return err
+	}); err != nil {
 		return nil, err
 	}
+	// NOTE: The ticket is sent even if the garbage collection of the document
+	//       is disabled, so that the clients purge their tombstones as usual.
+	//       Only the snapshots of the agent keep them.
 	respPack.MinSyncedTicket = minSyncedTicket
+	respPack.PreferredRegion = be.Config.RoutingHintOf(docInfo.Settings)
 
-	// 05. publish document change event then store snapshot asynchronously.
-	if reqPack.HasChanges() {
+	// 05. publish document change event then store snapshot asynchronously
+	//     unless the snapshot is configured to be stored synchronously.
+	//     Nothing is done if no change was stored.
+	if len(pushedChanges) > 0 {
 		be.Background.AttachGoroutine(func(ctx context.Context) {
 			publisherID, err := time.ActorIDFromHex(clientInfo.ID.String())
 			if err != nil {
@@ -114,51 +177,149 @@ func PushPull(
 				return
 			}
 
-			locker, err := be.Coordinator.NewLocker(
-				ctx,
-				NewSnapshotKey(reqPack.DocumentKey),
-			)
-			if err != nil {
-				logging.From(ctx).Error(err)
-				return
-			}
-			// NOTE: If the snapshot is already being created by another routine, it
-			//       is not necessary to recreate it, so we can skip it.
-			if err := locker.TryLock(ctx); err != nil {
-				return
+			// NOTE: The event is published regardless of the snapshot lock so that
+			// the watchers aligned by the server sequence do not miss any changes.
+			//       It is skipped when nobody watches the document, but the
+			//       snapshot is still stored below.
+			if hasSubscribers(ctx, be, reqPack.DocumentKey) {
+				be.Coordinator.Publish(
+					ctx,
+					publisherID,
+					sync.DocEvent{
+						Type:          types.DocumentsChangedEvent,
+						Publisher:     types.Client{ID: publisherID},
+						DocumentKeys:  []*key.Key{reqPack.DocumentKey},
+						ServerSeq:     docInfo.ServerSeq,
+						FromServerSeq: initialServerSeq,
+					},
+				)
 			}
+		})
 
-			defer func() {
-				if err := locker.Unlock(ctx); err != nil {
+		// NOTE: The snapshot task replaces the one of the same document still
+		//       waiting for a worker, and it is dropped if the queue is full.
+		//       The snapshot is then stored by a later PushPull. In the
+		//       synchronous mode, the snapshot is stored below before
+		//       responding.
+		if !be.Config.SyncSnapshot && !be.Background.AttachKeyedGoroutine(
+			reqPack.DocumentKey.BSONKey(),
+			func(ctx context.Context) {
+				if _, err := tryStoreSnapshot(
+					ctx,
+					be,
+					reqPack.DocumentKey,
+					docInfo,
+					minSyncedTicket,
+				); err != nil {
 					logging.From(ctx).Error(err)
-					return
 				}
-			}()
-
-			be.Coordinator.Publish(
-				ctx,
-				publisherID,
-				sync.DocEvent{
-					Type:         types.DocumentsChangedEvent,
-					Publisher:    types.Client{ID: publisherID},
-					DocumentKeys: []*key.Key{reqPack.DocumentKey},
-				},
+			},
+		) {
+			logging.From(ctx).Warnf(
+				"PUSH: '%s' snapshot dropped by the full background queue",
+				reqPack.DocumentKey.BSONKey(),
 			)
+		}
 
-			start := gotime.Now()
-			if err := storeSnapshot(
+		if be.Config.SyncSnapshot {
+			purged, err := tryStoreSnapshot(
 				ctx,
 				be,
+				reqPack.DocumentKey,
 				docInfo,
 				minSyncedTicket,
-			); err != nil {
-				logging.From(ctx).Error(err)
-			}
-			be.Metrics.ObservePushPullSnapshotDurationSeconds(
-				gotime.Since(start).Seconds(),
 			)
-		})
+			if err != nil {
+				return nil, err
+			}
+			respPack.PurgedElements = purged
+		}
 	}
 
 	return respPack, nil
 }
+
+// hasSubscribers returns whether the given document has subscribers to receive
+// the document change event. If the subscribers can not be counted, it
+// returns true so that the watchers do not miss the changes.
+func hasSubscribers(ctx context.Context, be *backend.Backend, docKey *key.Key) bool {
+	count, err := be.Coordinator.SubscriberCount(ctx, docKey)
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return true
+	}
+
+	if count == 0 {
+		be.Metrics.AddPushPullPublishSkipped(1)
+		return false
+	}
+
+	return true
+}
+
+// tryStoreSnapshot stores the snapshot of the given document if enough changes
+// have accumulated since the last snapshot. It skips the snapshot if another
+// routine is creating it or too many snapshots are being created. It returns
+// the number of elements purged by the garbage collection of the snapshot.
+func tryStoreSnapshot(
+	ctx context.Context,
+	be *backend.Backend,
+	docKey *key.Key,
+	docInfo *db.DocInfo,
+	minSyncedTicket *time.Ticket,
+) (int, error) {
+	// NOTE: Until enough changes accumulate since the last snapshot, there is
+	//       no need to create a snapshot, so we can skip it without acquiring
+	//       the locker.
+	if !needsSnapshot(be, docInfo) {
+		return 0, nil
+	}
+
+	locker, err := be.Coordinator.NewLocker(ctx, NewSnapshotKey(docKey))
+	if err != nil {
+		be.Metrics.AddPushPullSnapshotLockErrors(1)
+		return 0, err
+	}
+	// NOTE: If the snapshot is already being created by another routine, it
+	//       is not necessary to recreate it, so we can skip it.
+	if err := locker.TryLock(ctx); err != nil {
+		if errors.Is(err, sync.ErrAlreadyLocked) {
+			be.Metrics.AddPushPullSnapshotLockContended(1)
+		} else {
+			be.Metrics.AddPushPullSnapshotLockErrors(1)
+			logging.From(ctx).Error(err)
+		}
+		return 0, nil
+	}
+
+	defer func() {
+		if err := locker.Unlock(ctx); err != nil {
+			logging.From(ctx).Error(err)
+		}
+	}()
+
+	// NOTE: If too many snapshots are being built at the same time, skip it.
+	//       The snapshot will be built in a later PushPull because the changes
+	//       since the last snapshot remain.
+	if !be.TryAcquireSnapshotBuild() {
+		be.Metrics.AddPushPullSnapshotDeferred(1)
+		return 0, nil
+	}
+	be.Metrics.IncPushPullSnapshotBuilds()
+	defer func() {
+		be.Metrics.DecPushPullSnapshotBuilds()
+		be.ReleaseSnapshotBuild()
+	}()
+
+	start := gotime.Now()
+	purged, err := storeSnapshot(ctx, be, docInfo, minSyncedTicket, false)
+	elapsed := gotime.Since(start)
+	be.Metrics.ObservePushPullSnapshotDurationSeconds(elapsed.Seconds())
+	guardSnapshotLatency(ctx, be, docInfo, elapsed)
+	if err != nil {
+		return 0, err
+	}
+	be.Metrics.AddPushPullGarbageCollectedElements(purged)
+
+	return purged, nil
+}
@@ -0,0 +1,207 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/operation"
+	"github.com/yorkie-team/yorkie/yorkie/backend"
+	"github.com/yorkie-team/yorkie/yorkie/backend/db"
+	"github.com/yorkie-team/yorkie/yorkie/logging"
+)
+
+// redacted is the placeholder of the redacted content.
+const redacted = "<redacted>"
+
+// logPayload logs the operations of the given pack with the content redacted
+// if the payload logging is enabled and the pack is sampled.
+func logPayload(
+	ctx context.Context,
+	be *backend.Backend,
+	clientInfo *db.ClientInfo,
+	docInfo *db.DocInfo,
+	pack *change.Pack,
+) {
+	if !be.Config.PayloadLogging || !pack.HasChanges() {
+		return
+	}
+
+	if rand.Float64() >= be.Config.PayloadLogSampleRate {
+		return
+	}
+
+	logging.From(ctx).Infof(
+		"PUSH: payload of '%s' into '%s': %s",
+		clientInfo.ID,
+		docInfo.Key,
+		DescribeChanges(be.Config, pack.Changes),
+	)
+}
+
+// DescribeChanges returns the description of the operations of the given
+// changes. The content of the operations is redacted by the redactions of the
+// given config, so only the types and the structure are left.
+func DescribeChanges(conf *backend.Config, changes []*change.Change) string {
+	d := &payloadDescriber{conf: conf}
+
+	var descs []string
+	for _, c := range changes {
+		var ops []string
+		for _, op := range c.Operations() {
+			ops = append(ops, d.operation(op))
+		}
+
+		descs = append(descs, fmt.Sprintf(
+			"%d:%d[%s]",
+			c.ID().ClientSeq(),
+			c.ID().Lamport(),
+			strings.Join(ops, ", "),
+		))
+	}
+
+	return strings.Join(descs, " ")
+}
+
+// payloadDescriber describes the operations with the content redacted.
+type payloadDescriber struct {
+	conf *backend.Config
+}
+
+// operation describes the given operation.
+func (d *payloadDescriber) operation(op operation.Operation) string {
+	switch op := op.(type) {
+	case *operation.Set:
+		return fmt.Sprintf(
+			"set(key=%s, value=%s)",
+			d.redact(backend.RedactKey, op.Key()),
+			d.element(op.Value()),
+		)
+	case *operation.Add:
+		return fmt.Sprintf("add(value=%s)", d.element(op.Value()))
+	case *operation.Move:
+		return "move"
+	case *operation.Remove:
+		return "remove"
+	case *operation.Edit:
+		return fmt.Sprintf("edit(content=%s)", d.redact(backend.RedactText, op.Content()))
+	case *operation.Select:
+		return "select"
+	case *operation.RichEdit:
+		return fmt.Sprintf(
+			"rich_edit(content=%s, attributes=%s)",
+			d.redact(backend.RedactText, op.Content()),
+			d.attributes(op.Attributes()),
+		)
+	case *operation.Style:
+		return fmt.Sprintf("style(attributes=%s)", d.attributes(op.Attributes()))
+	case *operation.Increase:
+		return fmt.Sprintf("increase(value=%s)", d.element(op.Value()))
+	default:
+		return fmt.Sprintf("unknown(%T)", op)
+	}
+}
+
+// element describes the given element. Only the primitives and the counters
+// have their values in the description.
+func (d *payloadDescriber) element(elem json.Element) string {
+	switch elem := elem.(type) {
+	case *json.Object:
+		return "object"
+	case *json.Array:
+		return "array"
+	case *json.Text:
+		return "text"
+	case *json.RichText:
+		return "rich_text"
+	case *json.Counter:
+		return fmt.Sprintf("counter(%s)", d.redact(backend.RedactValue, elem.Marshal()))
+	case *json.Primitive:
+		if elem.ValueType() == json.Null {
+			return "null"
+		}
+		// NOTE: The string is described without quotes so that the length of
+		// the redacted content is the length of the value.
+		value := elem.Marshal()
+		if elem.ValueType() == json.String {
+			value = elem.Value().(string)
+		}
+		return fmt.Sprintf(
+			"%s(%s)",
+			valueTypeName(elem.ValueType()),
+			d.redact(backend.RedactValue, value),
+		)
+	default:
+		return fmt.Sprintf("unknown(%T)", elem)
+	}
+}
+
+// attributes describes the given attributes with the values redacted. The keys
+// are sorted so that the description is deterministic.
+func (d *payloadDescriber) attributes(attrs map[string]string) string {
+	var keys []string
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var pairs []string
+	for _, k := range keys {
+		pairs = append(pairs, k+":"+d.redact(backend.RedactAttributes, attrs[k]))
+	}
+
+	return "{" + strings.Join(pairs, ", ") + "}"
+}
+
+// redact returns the given content, or the placeholder with the length of the
+// content if the given kind is redacted.
+func (d *payloadDescriber) redact(kind string, content string) string {
+	if !d.conf.RedactsPayload(kind) {
+		return content
+	}
+
+	return fmt.Sprintf("%s(%d)", redacted, utf8.RuneCountInString(content))
+}
+
+// valueTypeName returns the name of the given value type.
+func valueTypeName(valueType json.ValueType) string {
+	switch valueType {
+	case json.Boolean:
+		return "boolean"
+	case json.Integer:
+		return "integer"
+	case json.Long:
+		return "long"
+	case json.Double:
+		return "double"
+	case json.String:
+		return "string"
+	case json.Bytes:
+		return "bytes"
+	case json.Date:
+		return "date"
+	default:
+		return "null"
+	}
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/yorkie/backend"
+	"github.com/yorkie-team/yorkie/yorkie/packs"
+)
+
+func TestPayload(t *testing.T) {
+	newDocument := func(t *testing.T) *document.Document {
+		doc := document.New("c1", "d1")
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("name", "secret")
+			root.SetNewRichText("memo").Edit(0, 0, "hello", map[string]string{"b": "1"})
+			root.SetNewCounter("count", 42)
+			root.SetNewArray("arr").AddInteger(7)
+			return nil
+		}))
+		return doc
+	}
+
+	t.Run("redact all content test", func(t *testing.T) {
+		desc := packs.DescribeChanges(&backend.Config{}, newDocument(t).CreateChangePack().Changes)
+		assert.Equal(
+			t,
+			"1:1[set(key=<redacted>(4), value=string(<redacted>(6))), "+
+				"set(key=<redacted>(4), value=rich_text), "+
+				"rich_edit(content=<redacted>(5), attributes={b:<redacted>(1)}), "+
+				"set(key=<redacted>(5), value=counter(<redacted>(2))), "+
+				"set(key=<redacted>(3), value=array), "+
+				"add(value=integer(<redacted>(1)))]",
+			desc,
+		)
+		assert.NotContains(t, desc, "secret")
+		assert.NotContains(t, desc, "hello")
+	})
+
+	t.Run("redact some content test", func(t *testing.T) {
+		desc := packs.DescribeChanges(&backend.Config{
+			PayloadLogRedactions: []string{backend.RedactValue, backend.RedactText},
+		}, newDocument(t).CreateChangePack().Changes)
+		assert.Contains(t, desc, "set(key=name, value=string(<redacted>(6)))")
+		assert.Contains(t, desc, "rich_edit(content=<redacted>(5), attributes={b:1})")
+		assert.NotContains(t, desc, "secret")
+	})
+}
@@ -17,6 +17,7 @@
 package packs
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -24,8 +25,8 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/yorkie-team/yorkie/api/converter"
-	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/yorkie/backend"
 	"github.com/yorkie-team/yorkie/yorkie/backend/db"
 	"github.com/yorkie-team/yorkie/yorkie/logging"
@@ -35,25 +36,399 @@ var (
 	// ErrInvalidServerSeq is returned when the given server seq greater than
 	// the initial server seq.
 	ErrInvalidServerSeq = errors.New("invalid server seq")
+
+	// ErrTooManyActors is returned when a new actor pushes changes to a
+	// document that already has the maximum number of actors.
+	ErrTooManyActors = errors.New("too many actors in document")
+
+	// ErrDuplicateChange is returned when a pack contains the same change more
+	// than once.
+	ErrDuplicateChange = errors.New("duplicate change in pack")
+
+	// ErrLamportSkew is returned when the lamport of a pushed change advances
+	// anomalously fast compared to the other changes of the document.
+	ErrLamportSkew = errors.New("lamport skew in pack")
+
+	// ErrUnsignedChange is returned when a change pushed to a document that
+	// requires signatures is not signed.
+	ErrUnsignedChange = errors.New("unsigned change")
+
+	// ErrInvalidSignature is returned when the signature of a pushed change is
+	// not made by the client that pushes it.
+	ErrInvalidSignature = errors.New("invalid signature of change")
+
+	// ErrChangeRejected is returned when a pushed change is rejected by the
+	// change interceptor of the backend.
+	ErrChangeRejected = errors.New("change rejected")
+
+	// ErrTooManyChanges is returned when a pack contains more changes than the
+	// maximum number of changes per push.
+	ErrTooManyChanges = errors.New("too many changes in pack")
+
+	// ErrChangePackOutOfOrder is returned when the client seqs of the changes
+	// in a pack have a gap or go backwards, which means the changes were
+	// reordered or missing.
+	ErrChangePackOutOfOrder = errors.New("change pack out of order")
+
+	// errChangesCompacted is returned when the changes to pull have been
+	// compacted, so that the snapshot is pulled instead.
+	errChangesCompacted = errors.New("changes compacted")
 )
 
+// registerActor registers the actor of the given client to the document if
+// the number of actors of the document is limited. It returns
+// ErrTooManyActors if the actor is new and the document is full.
+func registerActor(
+	be *backend.Backend,
+	clientInfo *db.ClientInfo,
+	docInfo *db.DocInfo,
+	pack *change.Pack,
+) error {
+	if !pack.HasChanges() {
+		return nil
+	}
+
+	limit := be.Config.MaxActorsOf(pack.DocumentKey.Collection)
+	if limit == 0 {
+		return nil
+	}
+
+	actor := clientInfo.ID.String()
+	if docInfo.HasActor(actor) {
+		return nil
+	}
+
+	if len(docInfo.Actors) >= limit {
+		return fmt.Errorf(
+			"%s(limit %d): %w",
+			docInfo.Key,
+			limit,
+			ErrTooManyActors,
+		)
+	}
+
+	docInfo.Actors = append(docInfo.Actors, actor)
+	return nil
+}
+
+// changeIdentity identifies a change by its ID except the server sequence.
+type changeIdentity struct {
+	actorID   time.ActorID
+	clientSeq uint32
+	lamport   uint64
+}
+
+// dedupeChanges removes the changes that appear more than once in the given
+// pack with the same ID. If the agent is configured to reject them, it
+// returns ErrDuplicateChange instead.
+func dedupeChanges(
+	ctx context.Context,
+	be *backend.Backend,
+	pack *change.Pack,
+) error {
+	seen := make(map[changeIdentity]struct{}, len(pack.Changes))
+	changes := make([]*change.Change, 0, len(pack.Changes))
+	for _, cn := range pack.Changes {
+		identity := changeIdentity{
+			actorID:   *cn.ID().ActorID(),
+			clientSeq: cn.ID().ClientSeq(),
+			lamport:   cn.ID().Lamport(),
+		}
+		if _, ok := seen[identity]; !ok {
+			seen[identity] = struct{}{}
+			changes = append(changes, cn)
+			continue
+		}
+
+		if be.Config.RejectDuplicateChanges() {
+			return fmt.Errorf(
+				"%s(clientSeq %d): %w",
+				pack.DocumentKey.BSONKey(),
+				cn.ID().ClientSeq(),
+				ErrDuplicateChange,
+			)
+		}
+		logging.From(ctx).Warnf(
+			"PUSH: duplicate change dropped: '%s' clientSeq: %d",
+			pack.DocumentKey.BSONKey(),
+			cn.ID().ClientSeq(),
+		)
+	}
+
+	pack.Changes = changes
+	return nil
+}
+
+// checkLamportSkew checks whether the lamport of the given changes pushed by
+// the client advances anomalously fast, and logs the offender. If the agent
+// is configured to reject them, it returns ErrLamportSkew instead. It also
+// advances the max lamport of the document with the changes.
+//
+// NOTE: The lamport of the changes can not be clamped by the agent, because
+// the tickets of the operations that the client already applied are derived
+// from it. The changes are stored as is unless they are rejected.
+func checkLamportSkew(
+	ctx context.Context,
+	be *backend.Backend,
+	clientInfo *db.ClientInfo,
+	docInfo *db.DocInfo,
+	changes []*change.Change,
+	initialServerSeq uint64,
+) error {
+	maxLamport := docInfo.MaxLamport
+
+	// NOTE: The changes of a client advance the lamport by one from the
+	// latest lamport that the client has seen, so each change can not go
+	// further than the latest lamport of the document plus its position in
+	// the pack. The documents that do not have the max lamport yet are not
+	// checked until it is tracked.
+	skewed := 0
+	checked := be.Config.MaxLamportSkew > 0 && (maxLamport > 0 || initialServerSeq == 0)
+	for i, cn := range changes {
+		lamport := cn.ID().Lamport()
+		expected := docInfo.MaxLamport + uint64(i+1)
+		if checked && lamport > expected+be.Config.MaxLamportSkew {
+			skewed++
+			logging.From(ctx).Warnf(
+				"PUSH: lamport skew of '%s' in '%s': lamport %d, expected at most %d",
+				clientInfo.ID,
+				docInfo.Key,
+				lamport,
+				expected,
+			)
+
+			if be.Config.RejectLamportSkew() {
+				be.Metrics.AddPushPullLamportSkew(skewed)
+				return fmt.Errorf(
+					"%s(lamport %d, expected %d): %w",
+					docInfo.Key,
+					lamport,
+					expected,
+					ErrLamportSkew,
+				)
+			}
+		}
+
+		if lamport > maxLamport {
+			maxLamport = lamport
+		}
+	}
+	be.Metrics.AddPushPullLamportSkew(skewed)
+
+	docInfo.MaxLamport = maxLamport
+	return nil
+}
+
+// verifySignature verifies that the given change is signed by the given
+// client with its registered public key. The unsigned change is accepted
+// unless the document requires signatures.
+func verifySignature(
+	clientInfo *db.ClientInfo,
+	docInfo *db.DocInfo,
+	cn *change.Change,
+) error {
+	if len(cn.Signature()) == 0 {
+		if docInfo.Settings.RequireSignedChanges {
+			return fmt.Errorf(
+				"change %d of '%s' into '%s': %w",
+				cn.ClientSeq(),
+				clientInfo.ID,
+				docInfo.Key,
+				ErrUnsignedChange,
+			)
+		}
+		return nil
+	}
+
+	// NOTE: The change should be authored by the client that pushes it, since
+	// the signature is verified with the public key of the client.
+	verified := false
+	if cn.ID().ActorID().String() == clientInfo.ID.String() && len(clientInfo.PublicKey) > 0 {
+		var err error
+		verified, err = converter.VerifyChange(clientInfo.PublicKey, cn)
+		if err != nil {
+			return err
+		}
+	}
+	if !verified {
+		return fmt.Errorf(
+			"change %d of '%s' into '%s': %w",
+			cn.ClientSeq(),
+			clientInfo.ID,
+			docInfo.Key,
+			ErrInvalidSignature,
+		)
+	}
+
+	return nil
+}
+
+// interceptChange returns the change to store instead of the given change by
+// the change interceptor of the backend. If there is no interceptor, the
+// change is returned as is. The signature is verified on the pushed change
+// before the interception, so it is kept only if the interceptor does not
+// modify the content of the change. Otherwise, the signature is removed, or
+// the change is rejected if the document requires signatures.
+func interceptChange(
+	ctx context.Context,
+	be *backend.Backend,
+	clientInfo *db.ClientInfo,
+	docInfo *db.DocInfo,
+	pack *change.Pack,
+	cn *change.Change,
+) (*change.Change, error) {
+	if be.ChangeInterceptor == nil {
+		return cn, nil
+	}
+
+	// NOTE: The digest is taken before the interception, because the
+	//       interceptor may modify the given change in place.
+	signature := cn.Signature()
+	var digest []byte
+	if len(signature) > 0 {
+		var err error
+		if digest, err = converter.ChangeDigest(cn); err != nil {
+			return nil, err
+		}
+	}
+
+	intercepted, err := be.ChangeInterceptor.Intercept(ctx, pack.DocumentKey, cn)
+	if err == nil && intercepted == nil {
+		err = errors.New("no change returned")
+	}
+	if err != nil {
+		return nil, fmt.Errorf(
+			"change %d of '%s' into '%s': %s: %w",
+			cn.ClientSeq(),
+			clientInfo.ID,
+			pack.DocumentKey.BSONKey(),
+			err.Error(),
+			ErrChangeRejected,
+		)
+	}
+	if len(signature) == 0 {
+		return intercepted, nil
+	}
+
+	interceptedDigest, err := converter.ChangeDigest(intercepted)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(digest, interceptedDigest) {
+		intercepted.SetSignature(signature)
+		return intercepted, nil
+	}
+
+	if docInfo.Settings.RequireSignedChanges {
+		return nil, fmt.Errorf(
+			"change %d of '%s' into '%s': signed change modified: %w",
+			cn.ClientSeq(),
+			clientInfo.ID,
+			pack.DocumentKey.BSONKey(),
+			ErrChangeRejected,
+		)
+	}
+	intercepted.SetSignature(nil)
+	return intercepted, nil
+}
+
+// checkChangesOrder checks whether the client seqs of the given changes
+// increase one by one from the checkpoint of the client. The changes already
+// pushed can be sent again if the client did not receive the response, so
+// the changes may start before the checkpoint. It returns
+// ErrChangePackOutOfOrder with the expected and actual client seq if there is
+// a gap or the client seq goes backwards.
+func checkChangesOrder(
+	clientInfo *db.ClientInfo,
+	docInfo *db.DocInfo,
+	changes []*change.Change,
+) error {
+	// NOTE: The checkpoint of the document being detached has already been
+	//       reset, so the order of the changes can not be checked against it.
+	attached, err := clientInfo.IsAttached(docInfo.ID)
+	if err != nil {
+		return err
+	}
+	if !attached || len(changes) == 0 {
+		return nil
+	}
+
+	expected := clientInfo.Checkpoint(docInfo.ID).ClientSeq + 1
+	if changes[0].ClientSeq() < expected {
+		expected = changes[0].ClientSeq()
+	}
+	for _, cn := range changes {
+		if cn.ClientSeq() != expected {
+			return fmt.Errorf(
+				"change of '%s' into '%s'(expected clientSeq %d, actual %d): %w",
+				clientInfo.ID,
+				docInfo.Key,
+				expected,
+				cn.ClientSeq(),
+				ErrChangePackOutOfOrder,
+			)
+		}
+		expected++
+	}
+
+	return nil
+}
+
 // pushChanges returns the changes excluding already saved in DB.
 func pushChanges(
 	ctx context.Context,
+	be *backend.Backend,
 	clientInfo *db.ClientInfo,
 	docInfo *db.DocInfo,
 	pack *change.Pack,
 	initialServerSeq uint64,
 ) (*change.Checkpoint, []*change.Change, error) {
+	// NOTE: The pack is rejected before storing any of its changes, so the
+	//       client can split it and push again.
+	if maxChanges := be.Config.MaxPushChanges; maxChanges > 0 && uint64(pack.ChangesLen()) > maxChanges {
+		return nil, nil, fmt.Errorf(
+			"%d changes, limit %d: %w",
+			pack.ChangesLen(),
+			maxChanges,
+			ErrTooManyChanges,
+		)
+	}
+
+	if err := checkChangesOrder(clientInfo, docInfo, pack.Changes); err != nil {
+		return nil, nil, err
+	}
+
 	cp := clientInfo.Checkpoint(docInfo.ID)
 
+	// NOTE: The lamports of the changes are stored as the client assigned
+	//       them. A change of a client with a stale clock may have a smaller
+	//       lamport than the stored ones, but it is still ordered after them
+	//       by its server seq, and the replicas converge with the tickets of
+	//       its operations. The lamport can not be reassigned here, because the
+	//       tickets were derived from it when the client applied the change.
+	//
+	// NOTE: The server seqs are assigned in memory while the document is
+	//       locked, and they are stored with the changes by a single bulk
+	//       write and a single conditional update of the doc info. So the
+	//       server seqs are not reserved in batches ahead of the push: it
+	//       would not save any round trip, and the unused reserved ones would
+	//       leave gaps that the pull takes for compacted changes.
 	var pushedChanges []*change.Change
 	for _, cn := range pack.Changes {
 		if cn.ID().ClientSeq() > cp.ClientSeq {
+			if err := verifySignature(clientInfo, docInfo, cn); err != nil {
+				return nil, nil, err
+			}
+			intercepted, err := interceptChange(ctx, be, clientInfo, docInfo, pack, cn)
+			if err != nil {
+				return nil, nil, err
+			}
 			serverSeq := docInfo.IncreaseServerSeq()
 			cp = cp.NextServerSeq(serverSeq)
-			cn.SetServerSeq(serverSeq)
-			pushedChanges = append(pushedChanges, cn)
+			if err := intercepted.AssignServerSeq(serverSeq); err != nil {
+				return nil, nil, err
+			}
+			pushedChanges = append(pushedChanges, intercepted)
 		} else {
 			logging.From(ctx).Warnf("change already pushed: %d vs %d ", cn.ID().ClientSeq(), cp.ClientSeq)
 		}
@@ -100,15 +475,49 @@ func pullPack(
 		)
 	}
 
-	if initialServerSeq-requestPack.Checkpoint.ServerSeq < be.Config.SnapshotThreshold {
-		pulledCP, pulledChanges, err := pullChangeInfos(ctx, be, clientInfo, docInfo, requestPack, pushedCP, initialServerSeq)
-		if err != nil {
+	database := findPullDB(ctx, be, docInfo, requestPack, initialServerSeq)
+
+	// NOTE: The changes behind the compacted serverSeq have been deleted, so
+	//       the client behind it receives a snapshot.
+	if initialServerSeq-requestPack.Checkpoint.ServerSeq < be.Config.SnapshotThresholdOf(docInfo.Settings) &&
+		requestPack.Checkpoint.ServerSeq >= docInfo.CompactedServerSeq {
+		pulledCP, pulledChanges, err := pullChangeInfos(
+			ctx,
+			be,
+			database,
+			clientInfo,
+			docInfo,
+			requestPack,
+			pushedCP,
+			initialServerSeq,
+		)
+		if err == nil {
+			respPack := NewServerPack(docKey, pulledCP, pulledChanges, nil)
+			respPack.HasMore = hasMoreChanges(be, docInfo, requestPack, initialServerSeq)
+			return respPack, nil
+		}
+		if !errors.Is(err, errChangesCompacted) {
 			return nil, err
 		}
-		return NewServerPack(docKey, pulledCP, pulledChanges, nil), err
+
+		logging.From(ctx).Infof(
+			"PULL: '%s' falls back to snapshot, changes of '%s' compacted after serverSeq %d",
+			clientInfo.ID,
+			docInfo.Key,
+			requestPack.Checkpoint.ServerSeq,
+		)
 	}
 
-	pulledCP, snapshot, err := pullSnapshot(ctx, be, clientInfo, docInfo, requestPack, pushedCP, initialServerSeq)
+	pulledCP, snapshot, err := pullSnapshot(
+		ctx,
+		be,
+		database,
+		clientInfo,
+		docInfo,
+		requestPack,
+		pushedCP,
+		initialServerSeq,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -116,26 +525,87 @@ func pullPack(
 	return NewServerPack(docKey, pulledCP, nil, snapshot), err
 }
 
+// findPullDB returns the DB to pull the given document from. The read replica
+// is used for the PushPulls without changes to push, unless it has not
+// replicated the document up to the given serverSeq yet.
+func findPullDB(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *db.DocInfo,
+	requestPack *change.Pack,
+	initialServerSeq uint64,
+) db.DB {
+	if be.ReadReplica == nil || requestPack.HasChanges() {
+		return be.DB
+	}
+
+	replicaInfo, err := be.ReadReplica.FindDocInfoByID(ctx, docInfo.ID)
+	if err != nil {
+		logging.From(ctx).Warnf("PULL: '%s' falls back to primary: %s", docInfo.Key, err)
+		return be.DB
+	}
+
+	// NOTE: The replica may lag behind the primary, so the changes up to the
+	//       server seq may not be there yet.
+	if replicaInfo.ServerSeq < initialServerSeq {
+		logging.From(ctx).Infof(
+			"PULL: '%s' falls back to primary, replica serverSeq %d < %d",
+			docInfo.Key,
+			replicaInfo.ServerSeq,
+			initialServerSeq,
+		)
+		return be.DB
+	}
+
+	return be.ReadReplica
+}
+
 func pullChangeInfos(
 	ctx context.Context,
 	be *backend.Backend,
+	database db.DB,
 	clientInfo *db.ClientInfo,
 	docInfo *db.DocInfo,
 	requestPack *change.Pack,
 	pushedCP *change.Checkpoint,
 	initialServerSeq uint64,
 ) (*change.Checkpoint, []*db.ChangeInfo, error) {
-	pulledChanges, err := be.DB.FindChangeInfosBetweenServerSeqs(
+	// NOTE: If there are too many changes to pull, only a part of them are sent
+	// and the checkpoint is advanced to the last delivered change, so that the
+	// client pulls the rest in subsequent requests.
+	to := initialServerSeq
+	hasMore := hasMoreChanges(be, docInfo, requestPack, initialServerSeq)
+	if hasMore {
+		to = requestPack.Checkpoint.ServerSeq + be.Config.MaxChangesPerPullOf(docInfo.Settings)
+	}
+
+	pulledChanges, err := database.FindChangeInfosBetweenServerSeqs(
 		ctx,
 		docInfo.ID,
 		requestPack.Checkpoint.ServerSeq+1,
-		initialServerSeq,
+		to,
 	)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	// NOTE: The changes may be compacted after the doc info was read, because
+	//       the pulls without changes do not hold the pushpull locker. The
+	//       pulled changes must start right after the checkpoint.
+	from := requestPack.Checkpoint.ServerSeq + 1
+	if from <= to && (len(pulledChanges) == 0 || pulledChanges[0].ServerSeq != from) {
+		return nil, nil, errChangesCompacted
+	}
+	pulledChanges = excludeOwnChanges(ctx, clientInfo, docInfo, pulledChanges)
+
+	// NOTE: The changes are pulled up to initialServerSeq, but the checkpoint
+	// is advanced to the server seq of the document including the changes
+	// pushed by this request, so that they are not pulled back to the client
+	// by the next request even if there is nothing to pull now.
 	pulledCP := pushedCP.NextServerSeq(docInfo.ServerSeq)
+	if hasMore {
+		pulledCP = pushedCP.NextServerSeq(to)
+	}
 
 	if len(pulledChanges) > 0 {
 		logging.From(ctx).Infof(
@@ -152,29 +622,75 @@ func pullChangeInfos(
 	return pulledCP, pulledChanges, nil
 }
 
+// excludeOwnChanges excludes the changes that the client already has from the
+// given pulled changes. They are the changes of the client stored up to the
+// client seq of its checkpoint, which are pulled when the client retries a
+// PushPull whose response was lost, or when its pull is split into several
+// responses after its changes were pushed with the first one.
+func excludeOwnChanges(
+	ctx context.Context,
+	clientInfo *db.ClientInfo,
+	docInfo *db.DocInfo,
+	infos []*db.ChangeInfo,
+) []*db.ChangeInfo {
+	// NOTE: The checkpoint of the client is not updated until the response is
+	//       made, so it still has the client seq stored before this request.
+	//       The changes pushed by this request are after initialServerSeq, so
+	//       they are never pulled by it.
+	storedSeq := clientInfo.Checkpoint(docInfo.ID).ClientSeq
+	if storedSeq == 0 {
+		return infos
+	}
+
+	filtered := make([]*db.ChangeInfo, 0, len(infos))
+	for _, info := range infos {
+		if info.ActorID == clientInfo.ID && info.ClientSeq <= storedSeq {
+			continue
+		}
+		filtered = append(filtered, info)
+	}
+
+	if len(filtered) < len(infos) {
+		logging.From(ctx).Infof(
+			"PULL: '%s' already has clientSeq ~%d of '%s', excluded %d changes",
+			clientInfo.ID,
+			storedSeq,
+			docInfo.Key,
+			len(infos)-len(filtered),
+		)
+	}
+	return filtered
+}
+
 func pullSnapshot(
 	ctx context.Context,
 	be *backend.Backend,
+	database db.DB,
 	clientInfo *db.ClientInfo,
 	docInfo *db.DocInfo,
 	pack *change.Pack,
 	pushedCP *change.Checkpoint,
 	initialServerSeq uint64,
 ) (*change.Checkpoint, []byte, error) {
-	snapshotInfo, err := be.DB.FindLastSnapshotInfo(ctx, docInfo.ID)
+	snapshotInfo, err := database.FindLastSnapshotInfo(ctx, docInfo.ID)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	// NOTE: The snapshot sent as is is only checked against its hash. It is
+	// rebuilt below if it does not match or can not be decompressed.
 	if snapshotInfo.ServerSeq >= initialServerSeq {
-		pulledCP := pushedCP.NextServerSeq(docInfo.ServerSeq)
-		logging.From(ctx).Infof(
-			"PULL: '%s' pulls snapshot without changes from '%s', cp: %s",
-			clientInfo.ID,
-			docInfo.Key,
-			pulledCP.String(),
-		)
-		return pushedCP.NextServerSeq(docInfo.ServerSeq), snapshotInfo.Snapshot, nil
+		snapshot, err := readSnapshot(snapshotInfo)
+		if err == nil {
+			pulledCP := pushedCP.NextServerSeq(docInfo.ServerSeq)
+			logging.From(ctx).Infof(
+				"PULL: '%s' pulls snapshot without changes from '%s', cp: %s",
+				clientInfo.ID,
+				docInfo.Key,
+				pulledCP.String(),
+			)
+			return pulledCP, snapshot, nil
+		}
 	}
 
 	docKey, err := docInfo.GetKey()
@@ -182,12 +698,7 @@ func pullSnapshot(
 		return nil, nil, err
 	}
 
-	doc, err := document.NewInternalDocumentFromSnapshot(
-		docKey.Collection,
-		docKey.Document,
-		snapshotInfo.ServerSeq,
-		snapshotInfo.Snapshot,
-	)
+	doc, snapshotInfo, err := newDocumentFromSnapshot(ctx, be, docInfo, snapshotInfo)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -195,7 +706,7 @@ func pullSnapshot(
 	// TODO(hackerwins): If the Snapshot is missing, we may have a very large
 	// number of changes to read at once here. We need to split changes by a
 	// certain size (e.g. 100) and read and gradually reflect it into the document.
-	changes, err := be.DB.FindChangesBetweenServerSeqs(
+	changes, err := database.FindChangesBetweenServerSeqs(
 		ctx,
 		docInfo.ID,
 		snapshotInfo.ServerSeq+1,
@@ -235,10 +746,28 @@ func pullSnapshot(
 		pulledCP.String(),
 	)
 
-	snapshot, err := converter.ObjectToBytes(doc.RootObject())
+	snapshot, err := encodeSnapshot(be, doc)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	return pulledCP, snapshot, nil
 }
+
+// hasMoreChanges returns whether the changes to pull exceed the maximum number
+// of changes in a single response. If the changes are sent with a snapshot or
+// the number of changes is not limited, it returns false.
+func hasMoreChanges(
+	be *backend.Backend,
+	docInfo *db.DocInfo,
+	requestPack *change.Pack,
+	initialServerSeq uint64,
+) bool {
+	maxChanges := be.Config.MaxChangesPerPullOf(docInfo.Settings)
+	if maxChanges == 0 || initialServerSeq < requestPack.Checkpoint.ServerSeq {
+		return false
+	}
+
+	count := initialServerSeq - requestPack.Checkpoint.ServerSeq
+	return count < be.Config.SnapshotThresholdOf(docInfo.Settings) && count > maxChanges
+}
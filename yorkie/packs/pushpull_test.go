@@ -0,0 +1,980 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/test/helper"
+	"github.com/yorkie-team/yorkie/yorkie/backend"
+	"github.com/yorkie-team/yorkie/yorkie/backend/db"
+	"github.com/yorkie-team/yorkie/yorkie/backend/db/mongo"
+	"github.com/yorkie-team/yorkie/yorkie/backend/housekeeping"
+	"github.com/yorkie-team/yorkie/yorkie/clients"
+	"github.com/yorkie-team/yorkie/yorkie/packs"
+	"github.com/yorkie-team/yorkie/yorkie/profiling/prometheus"
+)
+
+// changeInterceptorFunc is an adapter to use a function as
+// backend.ChangeInterceptor.
+type changeInterceptorFunc func(cn *change.Change) (*change.Change, error)
+
+// Intercept calls the function with the given change.
+func (f changeInterceptorFunc) Intercept(
+	_ context.Context,
+	_ *key.Key,
+	cn *change.Change,
+) (*change.Change, error) {
+	return f(cn)
+}
+
+// replicaDB is a read replica that reads from the wrapped DB but lags behind
+// it by the given number of server sequences.
+type replicaDB struct {
+	db.DB
+	lag   uint64
+	pulls int
+}
+
+// FindDocInfoByID returns the document of the given ID as replicated.
+func (r *replicaDB) FindDocInfoByID(ctx context.Context, docID db.ID) (*db.DocInfo, error) {
+	docInfo, err := r.DB.FindDocInfoByID(ctx, docID)
+	if err != nil {
+		return nil, err
+	}
+	docInfo.ServerSeq -= r.lag
+	return docInfo, nil
+}
+
+// FindChangeInfosBetweenServerSeqs counts the pulls from this replica.
+func (r *replicaDB) FindChangeInfosBetweenServerSeqs(
+	ctx context.Context,
+	docID db.ID,
+	from uint64,
+	to uint64,
+) ([]*db.ChangeInfo, error) {
+	r.pulls++
+	return r.DB.FindChangeInfosBetweenServerSeqs(ctx, docID, from, to)
+}
+
+func TestPushPull(t *testing.T) {
+	// NOTE: PushPull should behave identically against both DBs.
+	t.Run("memory db test", func(t *testing.T) {
+		testPushPull(t, nil)
+	})
+
+	t.Run("mongo db test", func(t *testing.T) {
+		testPushPull(t, &mongo.Config{
+			ConnectionURI:     helper.MongoConnectionURI,
+			ConnectionTimeout: helper.MongoConnectionTimeout,
+			PingTimeout:       helper.MongoPingTimeout,
+			YorkieDatabase:    helper.TestDBName(),
+		})
+	})
+}
+
+func testPushPull(t *testing.T, mongoConf *mongo.Config) {
+	ctx := context.Background()
+
+	newBackend := func(t *testing.T, conf *backend.Config) *backend.Backend {
+		met, err := prometheus.NewMetrics()
+		assert.NoError(t, err)
+
+		conf.SnapshotThreshold = helper.SnapshotThreshold
+		conf.AuthWebhookCacheSize = helper.AuthWebhookSize
+		conf.MaxChangesPerPull = helper.MaxChangesPerPull
+		be, err := backend.New(conf, mongoConf, nil, nil, &housekeeping.Config{
+			Interval:            helper.HousekeepingInterval.String(),
+			DeactivateThreshold: helper.HousekeepingDeactivateThreshold.String(),
+			CandidatesLimit:     helper.HousekeepingCandidatesLimit,
+		}, "", met)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() {
+			assert.NoError(t, be.Shutdown())
+		})
+		return be
+	}
+
+	// pushDuplicateChange pushes a pack that contains the same change twice.
+	pushDuplicateChange := func(t *testing.T, be *backend.Backend) (uint64, error) {
+		clientInfo, err := be.DB.ActivateClient(ctx, t.Name())
+		assert.NoError(t, err)
+		bytesID, err := clientInfo.ID.Bytes()
+		assert.NoError(t, err)
+		actorID, err := time.ActorIDFromBytes(bytesID)
+		assert.NoError(t, err)
+
+		docInfo, err := be.DB.FindDocInfoByKey(ctx, clientInfo, fmt.Sprintf("tests$%s", t.Name()), true)
+		assert.NoError(t, err)
+		assert.NoError(t, clientInfo.AttachDocument(docInfo.ID))
+
+		doc := document.New("tests", t.Name())
+		doc.SetActor(actorID)
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v")
+			return nil
+		}))
+
+		pack := doc.CreateChangePack()
+		pack.Changes = append(pack.Changes, pack.Changes[0])
+		if _, err := packs.PushPull(ctx, be, clientInfo, docInfo, pack); err != nil {
+			return 0, err
+		}
+
+		stored, err := be.DB.FindDocInfoByID(ctx, docInfo.ID)
+		assert.NoError(t, err)
+		return stored.ServerSeq, nil
+	}
+
+	t.Run("dedupe duplicate change test", func(t *testing.T) {
+		be := newBackend(t, &backend.Config{
+			DuplicateChangePolicy: backend.DuplicateChangeDedupe,
+		})
+
+		serverSeq, err := pushDuplicateChange(t, be)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(1), serverSeq)
+	})
+
+	t.Run("reject duplicate change test", func(t *testing.T) {
+		be := newBackend(t, &backend.Config{
+			DuplicateChangePolicy: backend.DuplicateChangeReject,
+		})
+
+		_, err := pushDuplicateChange(t, be)
+		assert.ErrorIs(t, err, packs.ErrDuplicateChange)
+	})
+	t.Run("routing hint test", func(t *testing.T) {
+		be := newBackend(t, &backend.Config{Region: "us"})
+
+		clientInfo, err := be.DB.ActivateClient(ctx, t.Name())
+		assert.NoError(t, err)
+		docInfo, err := be.DB.FindDocInfoByKey(ctx, clientInfo, fmt.Sprintf("tests$%s", t.Name()), true)
+		assert.NoError(t, err)
+		assert.NoError(t, clientInfo.AttachDocument(docInfo.ID))
+
+		doc := document.New("tests", t.Name())
+		respPack, err := packs.PushPull(ctx, be, clientInfo, docInfo, doc.CreateChangePack())
+		assert.NoError(t, err)
+		assert.Equal(t, "", respPack.PreferredRegion)
+
+		docInfo, err = be.DB.UpdateDocSettings(
+			ctx,
+			docInfo.ID,
+			db.DocSettings{PreferredRegion: "eu"},
+			[]string{db.SettingPreferredRegion},
+		)
+		assert.NoError(t, err)
+		respPack, err = packs.PushPull(ctx, be, clientInfo, docInfo, doc.CreateChangePack())
+		assert.NoError(t, err)
+		assert.Equal(t, "eu", respPack.PreferredRegion)
+
+		pbPack, err := respPack.ToPBChangePack()
+		assert.NoError(t, err)
+		assert.Equal(t, "eu", pbPack.PreferredRegion)
+	})
+	t.Run("conflicts metric test", func(t *testing.T) {
+		be := newBackend(t, &backend.Config{})
+
+		bsonDocKey := fmt.Sprintf("tests$%s", t.Name())
+		pushPull := func(clientKey string, k string) {
+			clientInfo, err := be.DB.ActivateClient(ctx, clientKey)
+			assert.NoError(t, err)
+			bytesID, err := clientInfo.ID.Bytes()
+			assert.NoError(t, err)
+			actorID, err := time.ActorIDFromBytes(bytesID)
+			assert.NoError(t, err)
+
+			docInfo, err := be.DB.FindDocInfoByKey(ctx, clientInfo, bsonDocKey, true)
+			assert.NoError(t, err)
+			assert.NoError(t, clientInfo.AttachDocument(docInfo.ID))
+
+			doc := document.New("tests", t.Name())
+			doc.SetActor(actorID)
+			assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+				root.SetString(k, "v")
+				return nil
+			}))
+			_, err = packs.PushPull(ctx, be, clientInfo, docInfo, doc.CreateChangePack())
+			assert.NoError(t, err)
+		}
+
+		conflicts := func() float64 {
+			families, err := be.Metrics.Registry().Gather()
+			assert.NoError(t, err)
+			for _, family := range families {
+				if family.GetName() == "yorkie_pushpull_conflicts_total" {
+					return family.GetMetric()[0].GetCounter().GetValue()
+				}
+			}
+			return 0
+		}
+
+		// NOTE: Both clients set a key of the root object without seeing the
+		// change of the other.
+		pushPull(t.Name()+"1", "k1")
+		assert.Equal(t, float64(0), conflicts())
+		pushPull(t.Name()+"2", "k2")
+		assert.Equal(t, float64(1), conflicts())
+	})
+
+	t.Run("lamport skew test", func(t *testing.T) {
+		// pushSkewedChange pushes a change whose lamport is inflated to the
+		// given lamport.
+		pushSkewedChange := func(t *testing.T, be *backend.Backend, lamport uint64) (*db.DocInfo, error) {
+			clientInfo, err := be.DB.ActivateClient(ctx, t.Name())
+			assert.NoError(t, err)
+			bytesID, err := clientInfo.ID.Bytes()
+			assert.NoError(t, err)
+			actorID, err := time.ActorIDFromBytes(bytesID)
+			assert.NoError(t, err)
+
+			docInfo, err := be.DB.FindDocInfoByKey(ctx, clientInfo, fmt.Sprintf("tests$%s", t.Name()), true)
+			assert.NoError(t, err)
+			assert.NoError(t, clientInfo.AttachDocument(docInfo.ID))
+
+			doc := document.New("tests", t.Name())
+			doc.SetActor(actorID)
+			assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+				root.SetString("k1", "v")
+				return nil
+			}))
+
+			pack := doc.CreateChangePack()
+			cn := pack.Changes[0]
+			pack.Changes[0] = change.New(
+				change.NewID(cn.ClientSeq(), lamport, actorID),
+				cn.Message(),
+				cn.Operations(),
+			)
+			if _, err := packs.PushPull(ctx, be, clientInfo, docInfo, pack); err != nil {
+				return nil, err
+			}
+
+			return be.DB.FindDocInfoByID(ctx, docInfo.ID)
+		}
+
+		be := newBackend(t, &backend.Config{MaxLamportSkew: 10})
+		docInfo, err := pushSkewedChange(t, be, 100)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(1), docInfo.ServerSeq)
+		assert.Equal(t, uint64(100), docInfo.MaxLamport)
+
+		families, err := be.Metrics.Registry().Gather()
+		assert.NoError(t, err)
+		for _, family := range families {
+			if family.GetName() == "yorkie_pushpull_lamport_skew_total" {
+				assert.Equal(t, float64(1), family.GetMetric()[0].GetCounter().GetValue())
+			}
+		}
+
+		be = newBackend(t, &backend.Config{
+			MaxLamportSkew:    10,
+			LamportSkewPolicy: backend.LamportSkewReject,
+		})
+		_, err = pushSkewedChange(t, be, 100)
+		assert.ErrorIs(t, err, packs.ErrLamportSkew)
+
+		docInfo, err = pushSkewedChange(t, be, 11)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(11), docInfo.MaxLamport)
+	})
+
+	t.Run("signed changes test", func(t *testing.T) {
+		be := newBackend(t, &backend.Config{})
+		publicKey, privateKey, err := ed25519.GenerateKey(nil)
+		assert.NoError(t, err)
+		_, otherKey, err := ed25519.GenerateKey(nil)
+		assert.NoError(t, err)
+
+		clientInfo, err := clients.Activate(ctx, be, t.Name(), nil, publicKey)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte(publicKey), clientInfo.PublicKey)
+		bytesID, err := clientInfo.ID.Bytes()
+		assert.NoError(t, err)
+		actorID, err := time.ActorIDFromBytes(bytesID)
+		assert.NoError(t, err)
+
+		// NOTE: The registered public key can not be replaced.
+		_, err = clients.Activate(ctx, be, t.Name(), nil, otherKey.Public().(ed25519.PublicKey))
+		assert.ErrorIs(t, err, clients.ErrPublicKeyMismatch)
+
+		docInfo, err := be.DB.FindDocInfoByKey(ctx, clientInfo, fmt.Sprintf("tests$%s", t.Name()), true)
+		assert.NoError(t, err)
+		assert.NoError(t, clientInfo.AttachDocument(docInfo.ID))
+		docInfo, err = be.DB.UpdateDocSettings(
+			ctx,
+			docInfo.ID,
+			db.DocSettings{RequireSignedChanges: true},
+			[]string{db.SettingRequireSignedChanges},
+		)
+		assert.NoError(t, err)
+
+		doc := document.New("tests", t.Name())
+		doc.SetActor(actorID)
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v")
+			return nil
+		}))
+
+		// 01. the unsigned change and the change signed by another key are rejected.
+		pack := doc.CreateChangePack()
+		_, err = packs.PushPull(ctx, be, clientInfo, docInfo, pack)
+		assert.ErrorIs(t, err, packs.ErrUnsignedChange)
+
+		assert.NoError(t, converter.SignChange(otherKey, pack.Changes[0]))
+		_, err = packs.PushPull(ctx, be, clientInfo, docInfo, pack)
+		assert.ErrorIs(t, err, packs.ErrInvalidSignature)
+
+		// 02. the change signed by the registered key is stored with the signature.
+		assert.NoError(t, converter.SignChange(privateKey, pack.Changes[0]))
+		_, err = packs.PushPull(ctx, be, clientInfo, docInfo, pack)
+		assert.NoError(t, err)
+
+		changes, err := be.DB.FindChangesBetweenServerSeqs(ctx, docInfo.ID, 1, 1)
+		assert.NoError(t, err)
+		assert.Len(t, changes, 1)
+		verified, err := converter.VerifyChange(publicKey, changes[0])
+		assert.NoError(t, err)
+		assert.True(t, verified)
+	})
+
+	t.Run("out of order change pack test", func(t *testing.T) {
+		be := newBackend(t, &backend.Config{})
+
+		clientInfo, err := be.DB.ActivateClient(ctx, t.Name())
+		assert.NoError(t, err)
+		bytesID, err := clientInfo.ID.Bytes()
+		assert.NoError(t, err)
+		actorID, err := time.ActorIDFromBytes(bytesID)
+		assert.NoError(t, err)
+
+		docInfo, err := be.DB.FindDocInfoByKey(ctx, clientInfo, fmt.Sprintf("tests$%s", t.Name()), true)
+		assert.NoError(t, err)
+		assert.NoError(t, clientInfo.AttachDocument(docInfo.ID))
+
+		doc := document.New("tests", t.Name())
+		doc.SetActor(actorID)
+		for _, k := range []string{"k1", "k2", "k3"} {
+			assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+				root.SetString(k, "v")
+				return nil
+			}))
+		}
+		changes := doc.CreateChangePack().Changes
+
+		// a change is missing.
+		pack := doc.CreateChangePack()
+		pack.Changes = []*change.Change{changes[1], changes[2]}
+		_, err = packs.PushPull(ctx, be, clientInfo, docInfo, pack)
+		assert.ErrorIs(t, err, packs.ErrChangePackOutOfOrder)
+
+		// the changes are reordered.
+		pack = doc.CreateChangePack()
+		pack.Changes = []*change.Change{changes[1], changes[0], changes[2]}
+		_, err = packs.PushPull(ctx, be, clientInfo, docInfo, pack)
+		assert.ErrorIs(t, err, packs.ErrChangePackOutOfOrder)
+
+		// the changes already pushed are sent again with the new one.
+		pack = doc.CreateChangePack()
+		pack.Changes = []*change.Change{changes[0], changes[1]}
+		_, err = packs.PushPull(ctx, be, clientInfo, docInfo, pack)
+		assert.NoError(t, err)
+
+		docInfo, err = be.DB.FindDocInfoByID(ctx, docInfo.ID)
+		assert.NoError(t, err)
+		_, err = packs.PushPull(ctx, be, clientInfo, docInfo, doc.CreateChangePack())
+		assert.NoError(t, err)
+
+		docInfo, err = be.DB.FindDocInfoByID(ctx, docInfo.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(3), docInfo.ServerSeq)
+	})
+
+	t.Run("checkpoint after push without changes to pull test", func(t *testing.T) {
+		be := newBackend(t, &backend.Config{})
+
+		clientInfo, err := be.DB.ActivateClient(ctx, t.Name())
+		assert.NoError(t, err)
+		bytesID, err := clientInfo.ID.Bytes()
+		assert.NoError(t, err)
+		actorID, err := time.ActorIDFromBytes(bytesID)
+		assert.NoError(t, err)
+		docInfo, err := be.DB.FindDocInfoByKey(ctx, clientInfo, fmt.Sprintf("tests$%s", t.Name()), true)
+		assert.NoError(t, err)
+		assert.NoError(t, clientInfo.AttachDocument(docInfo.ID))
+
+		doc := document.New("tests", t.Name())
+		doc.SetActor(actorID)
+		for _, k := range []string{"k1", "k2", "k3"} {
+			assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+				root.SetString(k, "v")
+				return nil
+			}))
+		}
+
+		// 01. the checkpoint reflects the server seqs of the pushed changes
+		//     even though there is nothing to pull.
+		respPack, err := packs.PushPull(ctx, be, clientInfo, docInfo, doc.CreateChangePack())
+		assert.NoError(t, err)
+		assert.Len(t, respPack.ChangeInfos, 0)
+		assert.Equal(t, uint64(3), respPack.Checkpoint.ServerSeq)
+		assert.Equal(t, uint32(3), respPack.Checkpoint.ClientSeq)
+
+		pbPack, err := respPack.ToPBChangePack()
+		assert.NoError(t, err)
+		pack, err := converter.FromChangePack(pbPack)
+		assert.NoError(t, err)
+		assert.NoError(t, doc.ApplyChangePack(pack))
+		assert.Equal(t, uint64(3), doc.Checkpoint().ServerSeq)
+
+		// 02. the subsequent PushPull does not pull the own changes back.
+		docInfo, err = be.DB.FindDocInfoByID(ctx, docInfo.ID)
+		assert.NoError(t, err)
+		respPack, err = packs.PushPull(ctx, be, clientInfo, docInfo, doc.CreateChangePack())
+		assert.NoError(t, err)
+		assert.Len(t, respPack.ChangeInfos, 0)
+		assert.Equal(t, uint64(3), respPack.Checkpoint.ServerSeq)
+		assert.Equal(t, `{"k1":"v","k2":"v","k3":"v"}`, doc.Marshal())
+	})
+
+	t.Run("interleaved push with stale lamport test", func(t *testing.T) {
+		be := newBackend(t, &backend.Config{})
+		bsonDocKey := fmt.Sprintf("tests$%s", t.Name())
+
+		newClient := func(name string) (*db.ClientInfo, *document.Document) {
+			clientInfo, err := be.DB.ActivateClient(ctx, name)
+			assert.NoError(t, err)
+			bytesID, err := clientInfo.ID.Bytes()
+			assert.NoError(t, err)
+			actorID, err := time.ActorIDFromBytes(bytesID)
+			assert.NoError(t, err)
+			docInfo, err := be.DB.FindDocInfoByKey(ctx, clientInfo, bsonDocKey, true)
+			assert.NoError(t, err)
+			assert.NoError(t, clientInfo.AttachDocument(docInfo.ID))
+
+			doc := document.New("tests", t.Name())
+			doc.SetActor(actorID)
+			return clientInfo, doc
+		}
+
+		pushPull := func(clientInfo *db.ClientInfo, doc *document.Document) {
+			docInfo, err := be.DB.FindDocInfoByKey(ctx, clientInfo, bsonDocKey, false)
+			assert.NoError(t, err)
+			respPack, err := packs.PushPull(ctx, be, clientInfo, docInfo, doc.CreateChangePack())
+			assert.NoError(t, err)
+			pbPack, err := respPack.ToPBChangePack()
+			assert.NoError(t, err)
+			pack, err := converter.FromChangePack(pbPack)
+			assert.NoError(t, err)
+			assert.NoError(t, doc.ApplyChangePack(pack))
+		}
+
+		clientA, docA := newClient(t.Name() + "A")
+		clientB, docB := newClient(t.Name() + "B")
+
+		// 01. A advances its clock with several changes while B does not pull
+		//     them, so B pushes with a stale lamport.
+		for i := 0; i < 3; i++ {
+			assert.NoError(t, docA.Update(func(root *proxy.ObjectProxy) error {
+				root.SetString("k1", fmt.Sprintf("a%d", i))
+				return nil
+			}))
+		}
+		pushPull(clientA, docA)
+
+		assert.NoError(t, docB.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "b")
+			root.SetString("k2", "b")
+			return nil
+		}))
+		pushPull(clientB, docB)
+		pushPull(clientA, docA)
+
+		// 02. the change of B is ordered after the changes of A by its server
+		//     seq with its own lamport, and both replicas converge.
+		docInfo, err := be.DB.FindDocInfoByKey(ctx, clientA, bsonDocKey, false)
+		assert.NoError(t, err)
+		infos, err := be.DB.FindChangeInfosBetweenServerSeqs(ctx, docInfo.ID, 1, docInfo.ServerSeq)
+		assert.NoError(t, err)
+		assert.Len(t, infos, 4)
+		assert.Equal(t, uint64(4), infos[3].ServerSeq)
+		assert.Equal(t, uint64(1), infos[3].Lamport)
+		assert.Equal(t, docA.Marshal(), docB.Marshal())
+	})
+
+	t.Run("partial pull test", func(t *testing.T) {
+		be := newBackend(t, &backend.Config{})
+		bsonDocKey := fmt.Sprintf("tests$%s", t.Name())
+
+		clientInfo, err := be.DB.ActivateClient(ctx, t.Name()+"1")
+		assert.NoError(t, err)
+		bytesID, err := clientInfo.ID.Bytes()
+		assert.NoError(t, err)
+		actorID, err := time.ActorIDFromBytes(bytesID)
+		assert.NoError(t, err)
+		docInfo, err := be.DB.FindDocInfoByKey(ctx, clientInfo, bsonDocKey, true)
+		assert.NoError(t, err)
+		assert.NoError(t, clientInfo.AttachDocument(docInfo.ID))
+
+		doc := document.New("tests", t.Name())
+		doc.SetActor(actorID)
+		for i := 0; i < helper.MaxChangesPerPull+2; i++ {
+			assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+				root.SetInteger(fmt.Sprintf("k%d", i), i)
+				return nil
+			}))
+		}
+		_, err = packs.PushPull(ctx, be, clientInfo, docInfo, doc.CreateChangePack())
+		assert.NoError(t, err)
+
+		// NOTE: The other client pulls the changes over the limit in two
+		//       responses, and each checkpoint reflects only the sent changes.
+		//       Its own change is pushed with the first response and is not
+		//       pulled back by the second one.
+		other, err := be.DB.ActivateClient(ctx, t.Name()+"2")
+		assert.NoError(t, err)
+		otherBytesID, err := other.ID.Bytes()
+		assert.NoError(t, err)
+		otherActorID, err := time.ActorIDFromBytes(otherBytesID)
+		assert.NoError(t, err)
+		docInfo, err = be.DB.FindDocInfoByKey(ctx, other, bsonDocKey, false)
+		assert.NoError(t, err)
+		assert.NoError(t, other.AttachDocument(docInfo.ID))
+
+		otherDoc := document.New("tests", t.Name())
+		otherDoc.SetActor(otherActorID)
+		assert.NoError(t, otherDoc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetInteger("other", 0)
+			return nil
+		}))
+		respPack, err := packs.PushPull(ctx, be, other, docInfo, otherDoc.CreateChangePack())
+		assert.NoError(t, err)
+		assert.True(t, respPack.HasMore)
+		assert.Len(t, respPack.ChangeInfos, helper.MaxChangesPerPull)
+		assert.Equal(t, uint64(helper.MaxChangesPerPull), respPack.Checkpoint.ServerSeq)
+		assert.Equal(t, uint32(1), respPack.Checkpoint.ClientSeq)
+		assert.Equal(t, uint64(helper.MaxChangesPerPull+3), docInfo.ServerSeq)
+
+		pbPack, err := respPack.ToPBChangePack()
+		assert.NoError(t, err)
+		pack, err := converter.FromChangePack(pbPack)
+		assert.NoError(t, err)
+		assert.NoError(t, otherDoc.ApplyChangePack(pack))
+
+		docInfo, err = be.DB.FindDocInfoByKey(ctx, other, bsonDocKey, false)
+		assert.NoError(t, err)
+		respPack, err = packs.PushPull(ctx, be, other, docInfo, otherDoc.CreateChangePack())
+		assert.NoError(t, err)
+		assert.False(t, respPack.HasMore)
+		assert.Len(t, respPack.ChangeInfos, 2)
+		assert.Equal(t, uint64(helper.MaxChangesPerPull+3), respPack.Checkpoint.ServerSeq)
+
+		pbPack, err = respPack.ToPBChangePack()
+		assert.NoError(t, err)
+		pack, err = converter.FromChangePack(pbPack)
+		assert.NoError(t, err)
+		assert.NoError(t, otherDoc.ApplyChangePack(pack))
+		assert.Contains(t, otherDoc.Marshal(), fmt.Sprintf(`"k%d":%d`, helper.MaxChangesPerPull+1, helper.MaxChangesPerPull+1))
+		assert.Contains(t, otherDoc.Marshal(), `"other":0`)
+	})
+
+	t.Run("unlimited pull test", func(t *testing.T) {
+		be := newBackend(t, &backend.Config{})
+		be.Config.MaxChangesPerPull = 0
+		bsonDocKey := fmt.Sprintf("tests$%s", t.Name())
+
+		clientInfo, err := be.DB.ActivateClient(ctx, t.Name()+"1")
+		assert.NoError(t, err)
+		bytesID, err := clientInfo.ID.Bytes()
+		assert.NoError(t, err)
+		actorID, err := time.ActorIDFromBytes(bytesID)
+		assert.NoError(t, err)
+		docInfo, err := be.DB.FindDocInfoByKey(ctx, clientInfo, bsonDocKey, true)
+		assert.NoError(t, err)
+		assert.NoError(t, clientInfo.AttachDocument(docInfo.ID))
+
+		doc := document.New("tests", t.Name())
+		doc.SetActor(actorID)
+		for i := 0; i < helper.MaxChangesPerPull+2; i++ {
+			assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+				root.SetInteger(fmt.Sprintf("k%d", i), i)
+				return nil
+			}))
+		}
+		_, err = packs.PushPull(ctx, be, clientInfo, docInfo, doc.CreateChangePack())
+		assert.NoError(t, err)
+
+		// NOTE: Without the limit, the other client pulls all the changes in a
+		//       single response and its own changes are pushed with it.
+		other, err := be.DB.ActivateClient(ctx, t.Name()+"2")
+		assert.NoError(t, err)
+		bytesID, err = other.ID.Bytes()
+		assert.NoError(t, err)
+		otherActorID, err := time.ActorIDFromBytes(bytesID)
+		assert.NoError(t, err)
+		docInfo, err = be.DB.FindDocInfoByKey(ctx, other, bsonDocKey, false)
+		assert.NoError(t, err)
+		assert.NoError(t, other.AttachDocument(docInfo.ID))
+
+		otherDoc := document.New("tests", t.Name())
+		otherDoc.SetActor(otherActorID)
+		assert.NoError(t, otherDoc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("other", "v")
+			return nil
+		}))
+		respPack, err := packs.PushPull(ctx, be, other, docInfo, otherDoc.CreateChangePack())
+		assert.NoError(t, err)
+		assert.False(t, respPack.HasMore)
+		assert.Len(t, respPack.ChangeInfos, helper.MaxChangesPerPull+2)
+		assert.Equal(t, uint64(helper.MaxChangesPerPull+3), respPack.Checkpoint.ServerSeq)
+	})
+
+	t.Run("read replica pull test", func(t *testing.T) {
+		be := newBackend(t, &backend.Config{})
+		replica := &replicaDB{DB: be.DB}
+		be.ReadReplica = replica
+		bsonDocKey := fmt.Sprintf("tests$%s", t.Name())
+
+		clientInfo, err := be.DB.ActivateClient(ctx, t.Name()+"1")
+		assert.NoError(t, err)
+		bytesID, err := clientInfo.ID.Bytes()
+		assert.NoError(t, err)
+		actorID, err := time.ActorIDFromBytes(bytesID)
+		assert.NoError(t, err)
+		docInfo, err := be.DB.FindDocInfoByKey(ctx, clientInfo, bsonDocKey, true)
+		assert.NoError(t, err)
+		assert.NoError(t, clientInfo.AttachDocument(docInfo.ID))
+
+		// 01. the pack with changes is pulled from the primary.
+		doc := document.New("tests", t.Name())
+		doc.SetActor(actorID)
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		_, err = packs.PushPull(ctx, be, clientInfo, docInfo, doc.CreateChangePack())
+		assert.NoError(t, err)
+		assert.Equal(t, 0, replica.pulls)
+
+		other, err := be.DB.ActivateClient(ctx, t.Name()+"2")
+		assert.NoError(t, err)
+		docInfo, err = be.DB.FindDocInfoByKey(ctx, other, bsonDocKey, false)
+		assert.NoError(t, err)
+		assert.NoError(t, other.AttachDocument(docInfo.ID))
+
+		// 02. the pack without changes falls back to the primary if the
+		//     replica lags behind.
+		replica.lag = 1
+		respPack, err := packs.PushPull(ctx, be, other, docInfo, document.New("tests", t.Name()).CreateChangePack())
+		assert.NoError(t, err)
+		assert.Len(t, respPack.ChangeInfos, 1)
+		assert.Equal(t, 0, replica.pulls)
+
+		// 03. the pack without changes is pulled from the replica.
+		replica.lag = 0
+		respPack, err = packs.PushPull(ctx, be, other, docInfo, document.New("tests", t.Name()).CreateChangePack())
+		assert.NoError(t, err)
+		assert.Len(t, respPack.ChangeInfos, 1)
+		assert.Equal(t, 1, replica.pulls)
+	})
+
+	t.Run("phase metrics test", func(t *testing.T) {
+		be := newBackend(t, &backend.Config{})
+
+		clientInfo, err := be.DB.ActivateClient(ctx, t.Name())
+		assert.NoError(t, err)
+		docInfo, err := be.DB.FindDocInfoByKey(ctx, clientInfo, fmt.Sprintf("tests$%s", t.Name()), true)
+		assert.NoError(t, err)
+		assert.NoError(t, clientInfo.AttachDocument(docInfo.ID))
+
+		doc := document.New("tests", t.Name())
+		_, err = packs.PushPull(ctx, be, clientInfo, docInfo, doc.CreateChangePack())
+		assert.NoError(t, err)
+
+		counts := make(map[string]uint64)
+		families, err := be.Metrics.Registry().Gather()
+		assert.NoError(t, err)
+		for _, family := range families {
+			if family.GetName() != "yorkie_pushpull_phase_seconds" {
+				continue
+			}
+			for _, metric := range family.GetMetric() {
+				counts[metric.GetLabel()[0].GetValue()] = metric.GetHistogram().GetSampleCount()
+			}
+		}
+		assert.Equal(t, map[string]uint64{"push": 1, "pull": 1, "store": 1}, counts)
+	})
+
+	t.Run("operations by type metrics test", func(t *testing.T) {
+		be := newBackend(t, &backend.Config{})
+
+		clientInfo, err := be.DB.ActivateClient(ctx, t.Name())
+		assert.NoError(t, err)
+		bytesID, err := clientInfo.ID.Bytes()
+		assert.NoError(t, err)
+		actorID, err := time.ActorIDFromBytes(bytesID)
+		assert.NoError(t, err)
+		docInfo, err := be.DB.FindDocInfoByKey(ctx, clientInfo, fmt.Sprintf("tests$%s", t.Name()), true)
+		assert.NoError(t, err)
+		assert.NoError(t, clientInfo.AttachDocument(docInfo.ID))
+
+		doc := document.New("tests", t.Name())
+		doc.SetActor(actorID)
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v")
+			root.SetNewArray("k2").AddString("a", "b")
+			root.Delete("k1")
+			return nil
+		}))
+		_, err = packs.PushPull(ctx, be, clientInfo, docInfo, doc.CreateChangePack())
+		assert.NoError(t, err)
+
+		counts := make(map[string]float64)
+		families, err := be.Metrics.Registry().Gather()
+		assert.NoError(t, err)
+		for _, family := range families {
+			if family.GetName() != "yorkie_pushpull_received_operations_by_type_total" {
+				continue
+			}
+			for _, metric := range family.GetMetric() {
+				counts[metric.GetLabel()[0].GetValue()] = metric.GetCounter().GetValue()
+			}
+		}
+		assert.Equal(t, map[string]float64{"set": 2, "add": 2, "remove": 1}, counts)
+	})
+
+	t.Run("max push changes test", func(t *testing.T) {
+		be := newBackend(t, &backend.Config{MaxPushChanges: 2})
+
+		clientInfo, err := be.DB.ActivateClient(ctx, t.Name())
+		assert.NoError(t, err)
+		bytesID, err := clientInfo.ID.Bytes()
+		assert.NoError(t, err)
+		actorID, err := time.ActorIDFromBytes(bytesID)
+		assert.NoError(t, err)
+		docInfo, err := be.DB.FindDocInfoByKey(ctx, clientInfo, fmt.Sprintf("tests$%s", t.Name()), true)
+		assert.NoError(t, err)
+		assert.NoError(t, clientInfo.AttachDocument(docInfo.ID))
+
+		doc := document.New("tests", t.Name())
+		doc.SetActor(actorID)
+		for _, k := range []string{"k1", "k2", "k3"} {
+			assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+				root.SetString(k, "v")
+				return nil
+			}))
+		}
+
+		// NOTE: The pack beyond the limit is rejected without storing any of
+		//       its changes.
+		_, err = packs.PushPull(ctx, be, clientInfo, docInfo, doc.CreateChangePack())
+		assert.ErrorIs(t, err, packs.ErrTooManyChanges)
+		assert.Contains(t, err.Error(), "limit 2")
+
+		stored, err := be.DB.FindDocInfoByID(ctx, docInfo.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(0), stored.ServerSeq)
+
+		pack := doc.CreateChangePack()
+		pack.Changes = pack.Changes[:2]
+		_, err = packs.PushPull(ctx, be, clientInfo, docInfo, pack)
+		assert.NoError(t, err)
+	})
+
+	t.Run("retried push pull test", func(t *testing.T) {
+		be := newBackend(t, &backend.Config{})
+
+		clientInfo, err := be.DB.ActivateClient(ctx, t.Name())
+		assert.NoError(t, err)
+		bytesID, err := clientInfo.ID.Bytes()
+		assert.NoError(t, err)
+		actorID, err := time.ActorIDFromBytes(bytesID)
+		assert.NoError(t, err)
+		docInfo, err := be.DB.FindDocInfoByKey(ctx, clientInfo, fmt.Sprintf("tests$%s", t.Name()), true)
+		assert.NoError(t, err)
+		assert.NoError(t, clientInfo.AttachDocument(docInfo.ID))
+
+		doc := document.New("tests", t.Name())
+		doc.SetActor(actorID)
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetNewCounter("cnt", 0).Increase(1)
+			return nil
+		}))
+
+		// NOTE: The client does not receive the response of the first request,
+		//       so it sends the same pack again.
+		_, err = packs.PushPull(ctx, be, clientInfo, docInfo, doc.CreateChangePack())
+		assert.NoError(t, err)
+
+		docInfo, err = be.DB.FindDocInfoByID(ctx, docInfo.ID)
+		assert.NoError(t, err)
+		respPack, err := packs.PushPull(ctx, be, clientInfo, docInfo, doc.CreateChangePack())
+		assert.NoError(t, err)
+		assert.Len(t, respPack.ChangeInfos, 0)
+		assert.Equal(t, uint64(1), respPack.Checkpoint.ServerSeq)
+		assert.Equal(t, uint32(1), respPack.Checkpoint.ClientSeq)
+
+		pbPack, err := respPack.ToPBChangePack()
+		assert.NoError(t, err)
+		pack, err := converter.FromChangePack(pbPack)
+		assert.NoError(t, err)
+		assert.NoError(t, doc.ApplyChangePack(pack))
+		assert.Equal(t, `{"cnt":1}`, doc.Marshal())
+		assert.False(t, doc.HasLocalChanges())
+
+		docInfo, err = be.DB.FindDocInfoByID(ctx, docInfo.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(1), docInfo.ServerSeq)
+	})
+
+	t.Run("change interceptor test", func(t *testing.T) {
+		be := newBackend(t, &backend.Config{})
+		be.ChangeInterceptor = changeInterceptorFunc(func(cn *change.Change) (*change.Change, error) {
+			if cn.Message() == "reject" {
+				return nil, errors.New("rejected by test")
+			}
+			return change.New(cn.ID(), "redacted", cn.Operations()), nil
+		})
+
+		clientInfo, err := be.DB.ActivateClient(ctx, t.Name())
+		assert.NoError(t, err)
+		bytesID, err := clientInfo.ID.Bytes()
+		assert.NoError(t, err)
+		actorID, err := time.ActorIDFromBytes(bytesID)
+		assert.NoError(t, err)
+		docInfo, err := be.DB.FindDocInfoByKey(ctx, clientInfo, fmt.Sprintf("tests$%s", t.Name()), true)
+		assert.NoError(t, err)
+		assert.NoError(t, clientInfo.AttachDocument(docInfo.ID))
+
+		doc := document.New("tests", t.Name())
+		doc.SetActor(actorID)
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v")
+			return nil
+		}, "secret"))
+		_, err = packs.PushPull(ctx, be, clientInfo, docInfo, doc.CreateChangePack())
+		assert.NoError(t, err)
+
+		changes, err := be.DB.FindChangesBetweenServerSeqs(ctx, docInfo.ID, 1, 1)
+		assert.NoError(t, err)
+		assert.Len(t, changes, 1)
+		assert.Equal(t, "redacted", changes[0].Message())
+
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k2", "v")
+			return nil
+		}, "reject"))
+		docInfo, err = be.DB.FindDocInfoByID(ctx, docInfo.ID)
+		assert.NoError(t, err)
+		_, err = packs.PushPull(ctx, be, clientInfo, docInfo, doc.CreateChangePack())
+		assert.ErrorIs(t, err, packs.ErrChangeRejected)
+	})
+
+	t.Run("signed change interceptor test", func(t *testing.T) {
+		be := newBackend(t, &backend.Config{})
+		be.ChangeInterceptor = changeInterceptorFunc(func(cn *change.Change) (*change.Change, error) {
+			switch cn.Message() {
+			case "nil":
+				return nil, nil
+			case "secret":
+				return change.New(cn.ID(), "redacted", cn.Operations()), nil
+			}
+			return change.New(cn.ID(), cn.Message(), cn.Operations()), nil
+		})
+		publicKey, privateKey, err := ed25519.GenerateKey(nil)
+		assert.NoError(t, err)
+
+		clientInfo, err := clients.Activate(ctx, be, t.Name(), nil, publicKey)
+		assert.NoError(t, err)
+		bytesID, err := clientInfo.ID.Bytes()
+		assert.NoError(t, err)
+		actorID, err := time.ActorIDFromBytes(bytesID)
+		assert.NoError(t, err)
+		docInfo, err := be.DB.FindDocInfoByKey(ctx, clientInfo, fmt.Sprintf("tests$%s", t.Name()), true)
+		assert.NoError(t, err)
+		assert.NoError(t, clientInfo.AttachDocument(docInfo.ID))
+
+		doc := document.New("tests", t.Name())
+		doc.SetActor(actorID)
+		pushSigned := func(message string) error {
+			assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+				root.SetString(message, "v")
+				return nil
+			}, message))
+			pack := doc.CreateChangePack()
+			assert.NoError(t, converter.SignChange(privateKey, pack.Changes[len(pack.Changes)-1]))
+
+			docInfo, err = be.DB.FindDocInfoByID(ctx, docInfo.ID)
+			assert.NoError(t, err)
+			respPack, err := packs.PushPull(ctx, be, clientInfo, docInfo, pack)
+			if err != nil {
+				return err
+			}
+
+			pbPack, err := respPack.ToPBChangePack()
+			assert.NoError(t, err)
+			pulled, err := converter.FromChangePack(pbPack)
+			assert.NoError(t, err)
+			return doc.ApplyChangePack(pulled)
+		}
+
+		// 01. the interceptor that returns no change rejects it.
+		assert.ErrorIs(t, pushSigned("nil"), packs.ErrChangeRejected)
+		doc = document.New("tests", t.Name())
+		doc.SetActor(actorID)
+
+		// 02. the signature is kept if the content is not modified, and
+		//     removed if it is modified.
+		assert.NoError(t, pushSigned("public"))
+		assert.NoError(t, pushSigned("secret"))
+
+		changes, err := be.DB.FindChangesBetweenServerSeqs(ctx, docInfo.ID, 1, 2)
+		assert.NoError(t, err)
+		assert.Len(t, changes, 2)
+		verified, err := converter.VerifyChange(publicKey, changes[0])
+		assert.NoError(t, err)
+		assert.True(t, verified)
+		assert.Equal(t, "redacted", changes[1].Message())
+		assert.Len(t, changes[1].Signature(), 0)
+
+		// 03. the modification is rejected if the document requires signatures.
+		_, err = be.DB.UpdateDocSettings(
+			ctx,
+			docInfo.ID,
+			db.DocSettings{RequireSignedChanges: true},
+			[]string{db.SettingRequireSignedChanges},
+		)
+		assert.NoError(t, err)
+		assert.NoError(t, pushSigned("public"))
+		assert.ErrorIs(t, pushSigned("secret"), packs.ErrChangeRejected)
+	})
+}
@@ -44,6 +44,19 @@ type ServerPack struct {
 	// MinSyncedTicket is the minimum logical time taken by clients who attach the document.
 	// It used to collect garbage on the replica on the client.
 	MinSyncedTicket *time.Ticket
+
+	// HasMore indicates whether there are more changes to pull. The client
+	// should pull the rest in subsequent requests.
+	HasMore bool
+
+	// PreferredRegion is the region the document prefers. It is set when this
+	// agent is not in the region, as a hint to route the client to it.
+	PreferredRegion string
+
+	// PurgedElements is the number of removed elements purged by the garbage
+	// collection of the snapshot stored while handling the request. It is set
+	// only when snapshots are stored synchronously.
+	PurgedElements int
 }
 
 // NewServerPack creates a new instance of ServerPack.
@@ -88,7 +101,11 @@ func (p *ServerPack) ToPBChangePack() (*api.ChangePack, error) {
 		if err != nil {
 			return nil, err
 		}
-		changeID := change.NewID(changeInfo.ClientSeq, changeInfo.Lamport, actorID)
+		vector, err := converter.FromVectorClock(changeInfo.Vector)
+		if err != nil {
+			return nil, err
+		}
+		changeID := change.NewID(changeInfo.ClientSeq, changeInfo.Lamport, actorID).SetVector(vector)
 
 		var pbOps []*api.Operation
 		for _, bytesOp := range changeInfo.Operations {
@@ -113,5 +130,7 @@ func (p *ServerPack) ToPBChangePack() (*api.ChangePack, error) {
 		Changes:         pbChanges,
 		Snapshot:        p.Snapshot,
 		MinSyncedTicket: converter.ToTimeTicket(p.MinSyncedTicket),
+		HasMore:         p.HasMore,
+		PreferredRegion: p.PreferredRegion,
 	}, nil
 }
@@ -17,37 +17,148 @@
 package packs
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	gotime "time"
 
+	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/yorkie/backend"
 	"github.com/yorkie-team/yorkie/yorkie/backend/db"
+	"github.com/yorkie-team/yorkie/yorkie/backend/sync"
 	"github.com/yorkie-team/yorkie/yorkie/logging"
 )
 
+var (
+	// ErrSnapshotCorrupted is returned when the stored snapshot can not be
+	// decoded or does not match its hash.
+	ErrSnapshotCorrupted = errors.New("snapshot corrupted")
+
+	// ErrSnapshotNotFound is returned when the requested snapshot does not
+	// exist.
+	ErrSnapshotNotFound = errors.New("snapshot not found")
+
+	// ErrInvalidSnapshotOffset is returned when the given offset is beyond the
+	// size of the snapshot.
+	ErrInvalidSnapshotOffset = errors.New("invalid snapshot offset")
+
+	// ErrSnapshotInProgress is returned when the snapshot of the document is
+	// being created by another routine.
+	ErrSnapshotInProgress = errors.New("snapshot creation in progress")
+)
+
+// gzipMagic is the magic header of the snapshots compressed with gzip.
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// SnapshotRange is a part of the stored snapshot of a document from a byte
+// offset to the end.
+type SnapshotRange struct {
+	// ServerSeq is the server sequence of the snapshot.
+	ServerSeq uint64
+
+	// Size is the size of the whole snapshot.
+	Size uint64
+
+	// Offset is the byte offset of Data in the whole snapshot.
+	Offset uint64
+
+	// Data is the snapshot from Offset to the end.
+	Data []byte
+}
+
+// needsSnapshot returns whether enough changes have accumulated in the given
+// document since its last snapshot recorded on the document to create a new
+// one. It is checked before acquiring the snapshot locker so that the locker
+// is not contended on every PushPull.
+func needsSnapshot(be *backend.Backend, docInfo *db.DocInfo) bool {
+	if docInfo.SnapshotServerSeq >= docInfo.ServerSeq {
+		return false
+	}
+
+	return docInfo.ServerSeq-docInfo.SnapshotServerSeq >=
+		be.Config.SnapshotIntervalOf(docInfo.Key, docInfo.Settings)
+}
+
+// CreateSnapshot creates the snapshot of the given document at its latest
+// server seq regardless of the snapshot interval. It returns
+// ErrSnapshotInProgress if the snapshot is being created by another routine.
+//
+// NOTE: No tombstone is purged by the snapshot, since the min synced ticket is
+// only updated by the clients.
+func CreateSnapshot(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *db.DocInfo,
+) error {
+	docKey, err := docInfo.GetKey()
+	if err != nil {
+		return err
+	}
+
+	locker, err := be.Coordinator.NewLocker(ctx, NewSnapshotKey(docKey))
+	if err != nil {
+		return err
+	}
+	if err := locker.TryLock(ctx); err != nil {
+		if errors.Is(err, sync.ErrAlreadyLocked) {
+			return fmt.Errorf("%s: %w", docInfo.Key, ErrSnapshotInProgress)
+		}
+		return fmt.Errorf("%s: %w", docInfo.Key, err)
+	}
+	defer func() {
+		if err := locker.Unlock(ctx); err != nil {
+			logging.From(ctx).Error(err)
+		}
+	}()
+
+	start := gotime.Now()
+	_, err = storeSnapshot(ctx, be, docInfo, nil, true)
+	be.Metrics.ObservePushPullSnapshotDurationSeconds(gotime.Since(start).Seconds())
+	return err
+}
+
+// storeSnapshot stores the snapshot of the given document if the snapshot
+// interval is reached or force is set. It returns the number of elements
+// purged by the garbage collection with the given minSyncedTicket.
 func storeSnapshot(
 	ctx context.Context,
 	be *backend.Backend,
 	docInfo *db.DocInfo,
 	minSyncedTicket *time.Ticket,
-) error {
+	force bool,
+) (int, error) {
 	// 01. get the last snapshot of this docInfo
 	// TODO: For performance issue, we only need to read the snapshot's metadata.
 	snapshotInfo, err := be.DB.FindLastSnapshotInfo(ctx, docInfo.ID)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	if snapshotInfo.ServerSeq >= docInfo.ServerSeq {
-		return nil
+		return 0, nil
 	}
-	if docInfo.ServerSeq-snapshotInfo.ServerSeq < be.Config.SnapshotInterval {
-		return nil
+	// NOTE: A snapshot that does not match its hash is replaced with a fresh
+	// one without waiting for the interval.
+	if !force &&
+		docInfo.ServerSeq-snapshotInfo.ServerSeq < be.Config.SnapshotIntervalOf(docInfo.Key, docInfo.Settings) &&
+		isSnapshotIntact(snapshotInfo) {
+		return 0, nil
+	}
+
+	// 02. create document instance of the docInfo
+	doc, snapshotInfo, err := newDocumentFromSnapshot(ctx, be, docInfo, snapshotInfo)
+	if err != nil {
+		return 0, err
 	}
 
-	// 02. retrieve the changes between last snapshot and current docInfo
+	// 03. retrieve the changes between last snapshot and current docInfo
 	changes, err := be.DB.FindChangesBetweenServerSeqs(
 		ctx,
 		docInfo.ID,
@@ -55,23 +166,12 @@ func storeSnapshot(
 		docInfo.ServerSeq,
 	)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	// 03. create document instance of the docInfo
 	docKey, err := docInfo.GetKey()
 	if err != nil {
-		return err
-	}
-
-	doc, err := document.NewInternalDocumentFromSnapshot(
-		docKey.Collection,
-		docKey.Document,
-		snapshotInfo.ServerSeq,
-		snapshotInfo.Snapshot,
-	)
-	if err != nil {
-		return err
+		return 0, err
 	}
 
 	pack := change.NewPack(
@@ -80,15 +180,38 @@ func storeSnapshot(
 		changes,
 		nil,
 	)
-	pack.MinSyncedTicket = minSyncedTicket
-
 	if err := doc.ApplyChangePack(pack); err != nil {
-		return err
+		return 0, err
+	}
+
+	// NOTE: Tombstones removed before minSyncedTicket have been seen by all
+	// clients, so they are purged and are not included in the snapshot unless
+	// the garbage collection of the document is disabled.
+	purged := 0
+	if minSyncedTicket != nil && !docInfo.Settings.DisableGarbageCollection {
+		purged = doc.GarbageCollect(minSyncedTicket)
 	}
 
 	// 04. save the snapshot of the docInfo
-	if err := be.DB.CreateSnapshotInfo(ctx, docInfo.ID, doc); err != nil {
-		return err
+	snapshot, err := encodeSnapshot(be, doc)
+	if err != nil {
+		return 0, err
+	}
+	hash := db.SnapshotHash(snapshot)
+	snapshot, err = compressSnapshot(be, snapshot)
+	if err != nil {
+		return 0, err
+	}
+	be.Metrics.AddPushPullSnapshotStoredBytes(len(snapshot))
+
+	if err := be.DB.CreateSnapshotInfo(
+		ctx,
+		docInfo.ID,
+		doc.Checkpoint().ServerSeq,
+		snapshot,
+		hash,
+	); err != nil {
+		return 0, err
 	}
 
 	logging.From(ctx).Infof(
@@ -96,5 +219,300 @@ func storeSnapshot(
 		docInfo.Key,
 		doc.Checkpoint().ServerSeq,
 	)
-	return nil
+	return purged, nil
+}
+
+// guardSnapshotLatency reports the snapshot creation of the given document
+// that took longer than the slow threshold. If configured, the snapshot
+// interval of the document is doubled so that the slow snapshot is created
+// less often.
+func guardSnapshotLatency(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *db.DocInfo,
+	elapsed gotime.Duration,
+) {
+	threshold := be.Config.ParseSlowSnapshotThreshold()
+	if threshold == 0 || elapsed <= threshold {
+		return
+	}
+
+	be.Metrics.AddPushPullSnapshotSlow(1)
+	logging.From(ctx).Warnf(
+		"SNAP: '%s' took %s, exceeding %s",
+		docInfo.Key,
+		elapsed,
+		threshold,
+	)
+
+	if !be.Config.RaiseSnapshotIntervalOnSlow {
+		return
+	}
+
+	// NOTE: Only the snapshot interval is updated, so that the settings
+	// updated meanwhile by the admin, such as disabling the garbage
+	// collection, are not overwritten.
+	latest, err := be.DB.FindDocInfoByID(ctx, docInfo.ID)
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return
+	}
+	settings := db.DocSettings{
+		SnapshotInterval: be.Config.SnapshotIntervalOf(latest.Key, latest.Settings) * 2,
+	}
+	if _, err := be.DB.UpdateDocSettings(
+		ctx,
+		docInfo.ID,
+		settings,
+		[]string{db.SettingSnapshotInterval},
+	); err != nil {
+		logging.From(ctx).Error(err)
+		return
+	}
+
+	logging.From(ctx).Warnf(
+		"SNAP: '%s' snapshot interval raised to %d",
+		docInfo.Key,
+		settings.SnapshotInterval,
+	)
+}
+
+// FindLastSnapshotInfo finds the last snapshot of the document of the given
+// key. If the document has no snapshot yet, an empty snapshotInfo is returned.
+func FindLastSnapshotInfo(
+	ctx context.Context,
+	be *backend.Backend,
+	docKey *key.Key,
+) (*db.SnapshotInfo, error) {
+	docInfo, err := be.DB.FindDocInfoByKey(ctx, nil, docKey.BSONKey(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	return be.DB.FindLastSnapshotInfo(ctx, docInfo.ID)
+}
+
+// FindSnapshotRange returns the stored snapshot of the given document at the
+// given serverSeq from the given byte offset. If serverSeq is 0, the last
+// snapshot is returned. A client whose transfer failed midway resumes it by
+// requesting the same serverSeq with the bytes already received as offset.
+func FindSnapshotRange(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *db.DocInfo,
+	serverSeq uint64,
+	offset uint64,
+) (*SnapshotRange, error) {
+	var snapshotInfo *db.SnapshotInfo
+	var err error
+	if serverSeq == 0 {
+		snapshotInfo, err = be.DB.FindLastSnapshotInfo(ctx, docInfo.ID)
+	} else {
+		snapshotInfo, err = be.DB.FindClosestSnapshotInfo(ctx, docInfo.ID, serverSeq)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: Snapshots are never overwritten, so the snapshot at the requested
+	// serverSeq is the same one whose transfer was started.
+	if snapshotInfo.ServerSeq == 0 || (serverSeq != 0 && snapshotInfo.ServerSeq != serverSeq) {
+		return nil, fmt.Errorf("%s at %d: %w", docInfo.Key, serverSeq, ErrSnapshotNotFound)
+	}
+
+	snapshot, err := readSnapshot(snapshotInfo)
+	if err != nil {
+		be.Metrics.AddPushPullSnapshotCorrupted(1)
+		return nil, err
+	}
+
+	size := uint64(len(snapshot))
+	if offset > size {
+		return nil, fmt.Errorf(
+			"offset %d of size %d: %w",
+			offset,
+			size,
+			ErrInvalidSnapshotOffset,
+		)
+	}
+
+	return &SnapshotRange{
+		ServerSeq: snapshotInfo.ServerSeq,
+		Size:      size,
+		Offset:    offset,
+		Data:      snapshot[offset:],
+	}, nil
+}
+
+// encodeSnapshot encodes the root object of the given document with the
+// configured number of workers.
+func encodeSnapshot(be *backend.Backend, doc *document.InternalDocument) ([]byte, error) {
+	return converter.ObjectToBytesConcurrently(doc.RootObject(), be.Config.SnapshotWorkers)
+}
+
+// compressSnapshot compresses the given encoded snapshot with the configured
+// codec before storing it.
+func compressSnapshot(be *backend.Backend, snapshot []byte) ([]byte, error) {
+	if !be.Config.CompressesSnapshot() || len(snapshot) == 0 {
+		return snapshot, nil
+	}
+
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write(snapshot); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// decompressSnapshot returns the encoded snapshot of the given stored one. The
+// codec is detected by the magic header, so the snapshots stored before the
+// compression is enabled are returned as is. It returns ErrSnapshotCorrupted
+// if the snapshot can not be decompressed.
+//
+// NOTE: The magic header of gzip can not be the first byte of an encoded
+// snapshot, because 0x1f is not a valid tag of protobuf.
+func decompressSnapshot(snapshot []byte) ([]byte, error) {
+	if len(snapshot) < 2 || snapshot[0] != gzipMagic[0] || snapshot[1] != gzipMagic[1] {
+		return snapshot, nil
+	}
+
+	reader, err := gzip.NewReader(bytes.NewReader(snapshot))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", err.Error(), ErrSnapshotCorrupted)
+	}
+	defer func() {
+		_ = reader.Close()
+	}()
+
+	decompressed, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", err.Error(), ErrSnapshotCorrupted)
+	}
+
+	return decompressed, nil
+}
+
+// newDocumentFromSnapshot creates a document from the given snapshot. If the
+// snapshot is corrupt and the agent is configured to recover, the document is
+// created from the closest valid snapshot before it, or from the beginning if
+// there is none. It returns the snapshotInfo the document is created from.
+// The document can not be recovered from before the compacted serverSeq,
+// because the changes up to it have been deleted.
+func newDocumentFromSnapshot(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *db.DocInfo,
+	snapshotInfo *db.SnapshotInfo,
+) (*document.InternalDocument, *db.SnapshotInfo, error) {
+	docKey, err := docInfo.GetKey()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for {
+		doc, err := decodeSnapshot(docKey, snapshotInfo)
+		if err == nil {
+			return doc, snapshotInfo, nil
+		}
+		if !errors.Is(err, ErrSnapshotCorrupted) {
+			return nil, nil, err
+		}
+
+		be.Metrics.AddPushPullSnapshotCorrupted(1)
+		logging.From(ctx).Errorf("SNAP: '%s' %s", docInfo.Key, err)
+		if be.Config.FailOnCorruptSnapshot() {
+			return nil, nil, err
+		}
+
+		// NOTE: The empty snapshot is always decoded, so the loop ends at the
+		// beginning of the document at the latest.
+		corruptSeq := snapshotInfo.ServerSeq
+		if corruptSeq == 0 {
+			snapshotInfo = &db.SnapshotInfo{}
+		} else {
+			snapshotInfo, err = be.DB.FindClosestSnapshotInfo(
+				ctx,
+				docInfo.ID,
+				corruptSeq-1,
+			)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+
+		if snapshotInfo.ServerSeq < docInfo.CompactedServerSeq {
+			return nil, nil, fmt.Errorf(
+				"unrecoverable at %d, changes compacted up to %d: %w",
+				corruptSeq,
+				docInfo.CompactedServerSeq,
+				ErrSnapshotCorrupted,
+			)
+		}
+	}
+}
+
+// decodeSnapshot creates a document from the given snapshot. It returns
+// ErrSnapshotCorrupted if the snapshot can not be decoded.
+func decodeSnapshot(
+	docKey *key.Key,
+	snapshotInfo *db.SnapshotInfo,
+) (*document.InternalDocument, error) {
+	snapshot, err := readSnapshot(snapshotInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := document.NewInternalDocumentFromSnapshot(
+		docKey.Collection,
+		docKey.Document,
+		snapshotInfo.ServerSeq,
+		snapshot,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"decode at %d: %s: %w",
+			snapshotInfo.ServerSeq,
+			err.Error(),
+			ErrSnapshotCorrupted,
+		)
+	}
+
+	return doc, nil
+}
+
+// readSnapshot returns the encoded snapshot of the given stored one after
+// checking it against its hash. It returns ErrSnapshotCorrupted if the
+// snapshot can not be decompressed or does not match its hash. The snapshots
+// stored without a hash are regarded as intact.
+func readSnapshot(snapshotInfo *db.SnapshotInfo) ([]byte, error) {
+	snapshot, err := decompressSnapshot(snapshotInfo.Snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("decompress at %d: %w", snapshotInfo.ServerSeq, err)
+	}
+
+	// NOTE: The snapshots compressed before the hash was taken of the encoded
+	// snapshot have the hash of the compressed one.
+	if snapshotInfo.Hash != "" &&
+		db.SnapshotHash(snapshot) != snapshotInfo.Hash &&
+		db.SnapshotHash(snapshotInfo.Snapshot) != snapshotInfo.Hash {
+		return nil, fmt.Errorf(
+			"hash mismatch at %d: %w",
+			snapshotInfo.ServerSeq,
+			ErrSnapshotCorrupted,
+		)
+	}
+
+	return snapshot, nil
+}
+
+// isSnapshotIntact returns whether the given snapshot can be read and matches
+// its hash.
+func isSnapshotIntact(snapshotInfo *db.SnapshotInfo) bool {
+	_, err := readSnapshot(snapshotInfo)
+	return err == nil
 }
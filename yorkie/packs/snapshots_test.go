@@ -0,0 +1,1031 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packs_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	gotime "time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/pkg/types"
+	"github.com/yorkie-team/yorkie/test/helper"
+	"github.com/yorkie-team/yorkie/yorkie/backend"
+	"github.com/yorkie-team/yorkie/yorkie/backend/db"
+	"github.com/yorkie-team/yorkie/yorkie/backend/housekeeping"
+	"github.com/yorkie-team/yorkie/yorkie/backend/sync"
+	"github.com/yorkie-team/yorkie/yorkie/packs"
+	"github.com/yorkie-team/yorkie/yorkie/profiling/prometheus"
+)
+
+// holdingCoordinator is a sync.Coordinator whose lockers hold the lock right
+// after acquiring it until release is closed, so that the other routines
+// contend for the lock in the meantime.
+type holdingCoordinator struct {
+	sync.Coordinator
+	locked  chan struct{}
+	release chan struct{}
+}
+
+// NewLocker creates a locker of the given key that holds the lock.
+func (c *holdingCoordinator) NewLocker(ctx context.Context, key sync.Key) (sync.Locker, error) {
+	locker, err := c.Coordinator.NewLocker(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &holdingLocker{Locker: locker, coordinator: c}, nil
+}
+
+type holdingLocker struct {
+	sync.Locker
+	coordinator *holdingCoordinator
+}
+
+// TryLock locks the mutex and holds it until the release of the coordinator.
+func (l *holdingLocker) TryLock(ctx context.Context) error {
+	if err := l.Locker.TryLock(ctx); err != nil {
+		return err
+	}
+
+	l.coordinator.locked <- struct{}{}
+	<-l.coordinator.release
+	return nil
+}
+
+// failingCoordinator is a sync.Coordinator that fails to create lockers.
+type failingCoordinator struct {
+	sync.Coordinator
+}
+
+// NewLocker returns an error.
+func (c *failingCoordinator) NewLocker(_ context.Context, _ sync.Key) (sync.Locker, error) {
+	return nil, errors.New("unavailable")
+}
+
+// tryLockFailingCoordinator is a sync.Coordinator whose lockers fail to lock
+// with the given error.
+type tryLockFailingCoordinator struct {
+	sync.Coordinator
+	err error
+}
+
+// NewLocker creates a locker of the given key that fails to lock.
+func (c *tryLockFailingCoordinator) NewLocker(ctx context.Context, key sync.Key) (sync.Locker, error) {
+	locker, err := c.Coordinator.NewLocker(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tryLockFailingLocker{Locker: locker, err: c.err}, nil
+}
+
+type tryLockFailingLocker struct {
+	sync.Locker
+	err error
+}
+
+// TryLock returns the error of the coordinator.
+func (l *tryLockFailingLocker) TryLock(_ context.Context) error {
+	return l.err
+}
+
+// hashlessDB is a DB that returns the snapshots without their hashes, like
+// the ones stored before the hash was introduced.
+type hashlessDB struct {
+	db.DB
+}
+
+// FindLastSnapshotInfo returns the last snapshot without its hash.
+func (d *hashlessDB) FindLastSnapshotInfo(ctx context.Context, docID db.ID) (*db.SnapshotInfo, error) {
+	info, err := d.DB.FindLastSnapshotInfo(ctx, docID)
+	if err != nil {
+		return nil, err
+	}
+
+	hashless := *info
+	hashless.Hash = ""
+	return &hashless, nil
+}
+
+// FindClosestSnapshotInfo returns the closest snapshot without its hash.
+func (d *hashlessDB) FindClosestSnapshotInfo(
+	ctx context.Context,
+	docID db.ID,
+	serverSeq uint64,
+) (*db.SnapshotInfo, error) {
+	info, err := d.DB.FindClosestSnapshotInfo(ctx, docID, serverSeq)
+	if err != nil {
+		return nil, err
+	}
+
+	hashless := *info
+	hashless.Hash = ""
+	return &hashless, nil
+}
+
+func TestSnapshots(t *testing.T) {
+	ctx := context.Background()
+
+	newBackend := func(t *testing.T, conf *backend.Config) *backend.Backend {
+		met, err := prometheus.NewMetrics()
+		assert.NoError(t, err)
+
+		conf.AuthWebhookCacheSize = helper.AuthWebhookSize
+		conf.MaxChangesPerPull = helper.MaxChangesPerPull
+		be, err := backend.New(conf, nil, nil, nil, &housekeeping.Config{
+			Interval:            helper.HousekeepingInterval.String(),
+			DeactivateThreshold: helper.HousekeepingDeactivateThreshold.String(),
+			CandidatesLimit:     helper.HousekeepingCandidatesLimit,
+		}, "", met)
+		assert.NoError(t, err)
+		t.Cleanup(func() {
+			assert.NoError(t, be.Shutdown())
+		})
+		return be
+	}
+
+	// newCorruptDocument creates a document with two changes and snapshots
+	// at each of them. The last snapshot is stored corrupt.
+	newCorruptDocument := func(t *testing.T, be *backend.Backend) *db.DocInfo {
+		clientInfo, err := be.DB.ActivateClient(ctx, t.Name())
+		assert.NoError(t, err)
+		bytesID, err := clientInfo.ID.Bytes()
+		assert.NoError(t, err)
+		actorID, err := time.ActorIDFromBytes(bytesID)
+		assert.NoError(t, err)
+
+		docKey := fmt.Sprintf("tests$%s", t.Name())
+		docInfo, err := be.DB.FindDocInfoByKey(ctx, clientInfo, docKey, true)
+		assert.NoError(t, err)
+
+		doc := document.New("tests", t.Name())
+		doc.SetActor(actorID)
+		for _, k := range []string{"k1", "k2"} {
+			assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+				root.SetString(k, "v")
+				return nil
+			}))
+
+			pack := doc.CreateChangePack()
+			initialServerSeq := docInfo.ServerSeq
+			for _, c := range pack.Changes {
+				c.SetServerSeq(docInfo.IncreaseServerSeq())
+			}
+			assert.NoError(t, be.DB.CreateChangeInfos(ctx, docInfo, initialServerSeq, pack.Changes))
+			assert.NoError(t, doc.ApplyChangePack(change.NewPack(
+				doc.Key(),
+				pack.Checkpoint.NextServerSeq(docInfo.ServerSeq),
+				nil,
+				nil,
+			)))
+
+			snapshot := []byte("corrupt")
+			if k == "k1" {
+				snapshot, err = converter.ObjectToBytes(doc.RootObject())
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, be.DB.CreateSnapshotInfo(ctx, docInfo.ID, docInfo.ServerSeq, snapshot, db.SnapshotHash(snapshot)))
+		}
+
+		snapshotInfo, err := be.DB.FindLastSnapshotInfo(ctx, docInfo.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(2), snapshotInfo.ServerSeq)
+
+		return docInfo
+	}
+
+	t.Run("recover corrupt snapshot test", func(t *testing.T) {
+		be := newBackend(t, &backend.Config{
+			SnapshotCorruptionPolicy: backend.SnapshotCorruptionRecover,
+		})
+		docInfo := newCorruptDocument(t, be)
+
+		pulled, err := packs.PullJSONPatches(ctx, be, docInfo, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(2), pulled.ServerSeq)
+		assert.Equal(t, `{"k1":"v","k2":"v"}`, string(pulled.Document))
+	})
+
+	t.Run("recover corrupt snapshot without hash test", func(t *testing.T) {
+		be := newBackend(t, &backend.Config{})
+		docInfo := newCorruptDocument(t, be)
+		be.DB = &hashlessDB{DB: be.DB}
+
+		pulled, err := packs.PullJSONPatches(ctx, be, docInfo, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"k1":"v","k2":"v"}`, string(pulled.Document))
+	})
+
+	t.Run("fail on corrupt snapshot test", func(t *testing.T) {
+		be := newBackend(t, &backend.Config{
+			SnapshotCorruptionPolicy: backend.SnapshotCorruptionFail,
+		})
+		docInfo := newCorruptDocument(t, be)
+
+		_, err := packs.PullJSONPatches(ctx, be, docInfo, 0)
+		assert.ErrorIs(t, err, packs.ErrSnapshotCorrupted)
+	})
+	t.Run("raise snapshot interval on slow snapshot test", func(t *testing.T) {
+		be := newBackend(t, &backend.Config{
+			SnapshotThreshold:           helper.SnapshotThreshold,
+			SnapshotInterval:            1,
+			SlowSnapshotThreshold:       "1ns",
+			RaiseSnapshotIntervalOnSlow: true,
+		})
+
+		clientInfo, err := be.DB.ActivateClient(ctx, t.Name())
+		assert.NoError(t, err)
+		bytesID, err := clientInfo.ID.Bytes()
+		assert.NoError(t, err)
+		actorID, err := time.ActorIDFromBytes(bytesID)
+		assert.NoError(t, err)
+
+		docInfo, err := be.DB.FindDocInfoByKey(ctx, clientInfo, fmt.Sprintf("tests$%s", t.Name()), true)
+		assert.NoError(t, err)
+		assert.NoError(t, clientInfo.AttachDocument(docInfo.ID))
+
+		doc := document.New("tests", t.Name())
+		doc.SetActor(actorID)
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v")
+			return nil
+		}))
+
+		_, err = packs.PushPull(ctx, be, clientInfo, docInfo, doc.CreateChangePack())
+		assert.NoError(t, err)
+
+		assert.Eventually(t, func() bool {
+			found, err := be.DB.FindDocInfoByID(ctx, docInfo.ID)
+			assert.NoError(t, err)
+			return found.Settings.SnapshotInterval == 2
+		}, 2*gotime.Second, 10*gotime.Millisecond)
+	})
+
+	t.Run("snapshot interval by document key test", func(t *testing.T) {
+		be := newBackend(t, &backend.Config{
+			SnapshotThreshold: helper.SnapshotThreshold,
+			SnapshotInterval:  1000,
+			SnapshotIntervals: map[string]int{"tests$" + t.Name(): 3},
+		})
+		bsonDocKey := fmt.Sprintf("tests$%s", t.Name())
+
+		pushPull := func(clientKey string) {
+			clientInfo, err := be.DB.ActivateClient(ctx, clientKey)
+			assert.NoError(t, err)
+			bytesID, err := clientInfo.ID.Bytes()
+			assert.NoError(t, err)
+			actorID, err := time.ActorIDFromBytes(bytesID)
+			assert.NoError(t, err)
+
+			docInfo, err := be.DB.FindDocInfoByKey(ctx, clientInfo, bsonDocKey, true)
+			assert.NoError(t, err)
+			assert.NoError(t, clientInfo.AttachDocument(docInfo.ID))
+
+			doc := document.New("tests", t.Name())
+			doc.SetActor(actorID)
+			assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+				root.SetString(clientKey, "v")
+				return nil
+			}))
+			_, err = packs.PushPull(ctx, be, clientInfo, docInfo, doc.CreateChangePack())
+			assert.NoError(t, err)
+		}
+
+		// 01. the snapshot is skipped until the changes reach the interval.
+		pushPull(t.Name() + "1")
+		pushPull(t.Name() + "2")
+		docInfo, err := be.DB.FindDocInfoByKey(ctx, nil, bsonDocKey, false)
+		assert.NoError(t, err)
+		snapshotInfo, err := be.DB.FindLastSnapshotInfo(ctx, docInfo.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(0), snapshotInfo.ServerSeq)
+
+		// 02. the snapshot is created and recorded on the document.
+		pushPull(t.Name() + "3")
+		assert.Eventually(t, func() bool {
+			found, err := be.DB.FindDocInfoByID(ctx, docInfo.ID)
+			assert.NoError(t, err)
+			return found.SnapshotServerSeq == 3
+		}, 2*gotime.Second, 10*gotime.Millisecond)
+		snapshotInfo, err = be.DB.FindLastSnapshotInfo(ctx, docInfo.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(3), snapshotInfo.ServerSeq)
+	})
+
+	t.Run("sync snapshot test", func(t *testing.T) {
+		be := newBackend(t, &backend.Config{
+			SnapshotThreshold: helper.SnapshotThreshold,
+			SnapshotInterval:  1,
+			SyncSnapshot:      true,
+		})
+
+		clientInfo, err := be.DB.ActivateClient(ctx, t.Name())
+		assert.NoError(t, err)
+		bytesID, err := clientInfo.ID.Bytes()
+		assert.NoError(t, err)
+		actorID, err := time.ActorIDFromBytes(bytesID)
+		assert.NoError(t, err)
+
+		docInfo, err := be.DB.FindDocInfoByKey(ctx, clientInfo, fmt.Sprintf("tests$%s", t.Name()), true)
+		assert.NoError(t, err)
+		assert.NoError(t, clientInfo.AttachDocument(docInfo.ID))
+
+		doc := document.New("tests", t.Name())
+		doc.SetActor(actorID)
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v")
+			return nil
+		}))
+
+		_, err = packs.PushPull(ctx, be, clientInfo, docInfo, doc.CreateChangePack())
+		assert.NoError(t, err)
+
+		// the snapshot exists as soon as PushPull returns.
+		snapshotInfo, err := be.DB.FindLastSnapshotInfo(ctx, docInfo.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(1), snapshotInfo.ServerSeq)
+	})
+	t.Run("purged elements test", func(t *testing.T) {
+		be := newBackend(t, &backend.Config{
+			SnapshotThreshold: helper.SnapshotThreshold,
+			SnapshotInterval:  1,
+			SyncSnapshot:      true,
+		})
+		docKey := fmt.Sprintf("tests$%s", t.Name())
+
+		purged := 0
+		newClient := func(name string) func(func(root *proxy.ObjectProxy) error) {
+			clientInfo, err := be.DB.ActivateClient(ctx, name)
+			assert.NoError(t, err)
+			bytesID, err := clientInfo.ID.Bytes()
+			assert.NoError(t, err)
+			actorID, err := time.ActorIDFromBytes(bytesID)
+			assert.NoError(t, err)
+
+			docInfo, err := be.DB.FindDocInfoByKey(ctx, clientInfo, docKey, true)
+			assert.NoError(t, err)
+			assert.NoError(t, clientInfo.AttachDocument(docInfo.ID))
+
+			doc := document.New("tests", t.Name())
+			doc.SetActor(actorID)
+
+			// NOTE: Each call updates the document with the given updater, if
+			//       any, and synchronizes it with the agent.
+			return func(updater func(root *proxy.ObjectProxy) error) {
+				if updater != nil {
+					assert.NoError(t, doc.Update(updater))
+				}
+
+				docInfo, err := be.DB.FindDocInfoByKey(ctx, clientInfo, docKey, false)
+				assert.NoError(t, err)
+				respPack, err := packs.PushPull(ctx, be, clientInfo, docInfo, doc.CreateChangePack())
+				assert.NoError(t, err)
+				purged += respPack.PurgedElements
+
+				pbPack, err := respPack.ToPBChangePack()
+				assert.NoError(t, err)
+				pack, err := converter.FromChangePack(pbPack)
+				assert.NoError(t, err)
+				assert.NoError(t, doc.ApplyChangePack(pack))
+			}
+		}
+
+		sync1 := newClient(t.Name() + "1")
+		sync2 := newClient(t.Name() + "2")
+
+		sync1(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v")
+			root.SetNewArray("k2").AddString("a", "b", "c")
+			return nil
+		})
+		sync2(nil)
+
+		// NOTE: Both clients remove elements, but the tombstones are not
+		//       purged until every client has seen the removals.
+		sync1(func(root *proxy.ObjectProxy) error {
+			root.Delete("k1")
+			return nil
+		})
+		sync2(func(root *proxy.ObjectProxy) error {
+			root.GetArray("k2").Delete(0)
+			return nil
+		})
+		assert.Equal(t, 0, purged)
+
+		sync1(nil)
+		sync2(nil)
+		sync1(func(root *proxy.ObjectProxy) error {
+			root.SetString("k3", "v")
+			return nil
+		})
+		sync2(nil)
+		sync1(func(root *proxy.ObjectProxy) error {
+			root.SetString("k4", "v")
+			return nil
+		})
+		assert.Equal(t, 2, purged)
+
+		families, err := be.Metrics.Registry().Gather()
+		assert.NoError(t, err)
+		for _, family := range families {
+			if family.GetName() == "yorkie_pushpull_garbage_collected_elements_total" {
+				assert.Equal(t, float64(2), family.GetMetric()[0].GetCounter().GetValue())
+			}
+		}
+	})
+
+	t.Run("disable garbage collection test", func(t *testing.T) {
+		be := newBackend(t, &backend.Config{
+			SnapshotThreshold: helper.SnapshotThreshold,
+			SnapshotInterval:  1,
+			SyncSnapshot:      true,
+		})
+
+		clientInfo, err := be.DB.ActivateClient(ctx, t.Name())
+		assert.NoError(t, err)
+		bytesID, err := clientInfo.ID.Bytes()
+		assert.NoError(t, err)
+		actorID, err := time.ActorIDFromBytes(bytesID)
+		assert.NoError(t, err)
+
+		docInfo, err := be.DB.FindDocInfoByKey(ctx, clientInfo, fmt.Sprintf("tests$%s", t.Name()), true)
+		assert.NoError(t, err)
+		assert.NoError(t, clientInfo.AttachDocument(docInfo.ID))
+		docInfo, err = be.DB.UpdateDocSettings(
+			ctx,
+			docInfo.ID,
+			db.DocSettings{DisableGarbageCollection: true},
+			[]string{db.SettingDisableGarbageCollection},
+		)
+		assert.NoError(t, err)
+
+		doc := document.New("tests", t.Name())
+		doc.SetActor(actorID)
+		pushPull := func(updater func(root *proxy.ObjectProxy) error) *packs.ServerPack {
+			assert.NoError(t, doc.Update(updater))
+			docInfo, err = be.DB.FindDocInfoByID(ctx, docInfo.ID)
+			assert.NoError(t, err)
+			respPack, err := packs.PushPull(ctx, be, clientInfo, docInfo, doc.CreateChangePack())
+			assert.NoError(t, err)
+
+			pbPack, err := respPack.ToPBChangePack()
+			assert.NoError(t, err)
+			pack, err := converter.FromChangePack(pbPack)
+			assert.NoError(t, err)
+			assert.NoError(t, doc.ApplyChangePack(pack))
+			return respPack
+		}
+
+		pushPull(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v")
+			root.SetString("k2", "v")
+			return nil
+		})
+		pushPull(func(root *proxy.ObjectProxy) error {
+			root.Delete("k1")
+			return nil
+		})
+
+		// NOTE: The client has seen the removal and receives the min synced
+		//       ticket as usual, but the snapshot keeps the tombstone.
+		respPack := pushPull(func(root *proxy.ObjectProxy) error {
+			root.SetString("k3", "v")
+			return nil
+		})
+		assert.Equal(t, 0, respPack.PurgedElements)
+		assert.NotEqual(t, time.InitialTicket, respPack.MinSyncedTicket)
+		assert.Equal(t, 0, doc.GarbageLen())
+
+		// NOTE: After the garbage collection is enabled again, the snapshot
+		//       purges the tombstones.
+		_, err = be.DB.UpdateDocSettings(
+			ctx,
+			docInfo.ID,
+			db.DocSettings{},
+			[]string{db.SettingDisableGarbageCollection},
+		)
+		assert.NoError(t, err)
+		respPack = pushPull(func(root *proxy.ObjectProxy) error {
+			root.Delete("k2")
+			return nil
+		})
+		assert.Equal(t, 1, respPack.PurgedElements)
+	})
+
+	t.Run("create snapshot test", func(t *testing.T) {
+		be := newBackend(t, &backend.Config{
+			SnapshotThreshold: helper.SnapshotThreshold,
+			SnapshotInterval:  100,
+		})
+
+		clientInfo, err := be.DB.ActivateClient(ctx, t.Name())
+		assert.NoError(t, err)
+		bytesID, err := clientInfo.ID.Bytes()
+		assert.NoError(t, err)
+		actorID, err := time.ActorIDFromBytes(bytesID)
+		assert.NoError(t, err)
+
+		docInfo, err := be.DB.FindDocInfoByKey(ctx, clientInfo, fmt.Sprintf("tests$%s", t.Name()), true)
+		assert.NoError(t, err)
+		assert.NoError(t, clientInfo.AttachDocument(docInfo.ID))
+
+		doc := document.New("tests", t.Name())
+		doc.SetActor(actorID)
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v")
+			return nil
+		}))
+		_, err = packs.PushPull(ctx, be, clientInfo, docInfo, doc.CreateChangePack())
+		assert.NoError(t, err)
+
+		// the snapshot is created before the interval is reached.
+		docInfo, err = be.DB.FindDocInfoByID(ctx, docInfo.ID)
+		assert.NoError(t, err)
+		assert.NoError(t, packs.CreateSnapshot(ctx, be, docInfo))
+		snapshotInfo, err := be.DB.FindLastSnapshotInfo(ctx, docInfo.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(1), snapshotInfo.ServerSeq)
+
+		// nothing changes if the snapshot is already the latest.
+		assert.NoError(t, packs.CreateSnapshot(ctx, be, docInfo))
+		snapshotInfo, err = be.DB.FindLastSnapshotInfo(ctx, docInfo.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(1), snapshotInfo.ServerSeq)
+
+		// only the contention for the lock is reported as in progress.
+		coordinator := be.Coordinator
+		be.Coordinator = &tryLockFailingCoordinator{Coordinator: coordinator, err: sync.ErrAlreadyLocked}
+		assert.ErrorIs(t, packs.CreateSnapshot(ctx, be, docInfo), packs.ErrSnapshotInProgress)
+
+		errUnavailable := errors.New("unavailable")
+		be.Coordinator = &tryLockFailingCoordinator{Coordinator: coordinator, err: errUnavailable}
+		err = packs.CreateSnapshot(ctx, be, docInfo)
+		assert.ErrorIs(t, err, errUnavailable)
+		assert.NotErrorIs(t, err, packs.ErrSnapshotInProgress)
+		be.Coordinator = coordinator
+	})
+
+	t.Run("snapshot compression test", func(t *testing.T) {
+		be := newBackend(t, &backend.Config{
+			SnapshotThreshold:   helper.SnapshotThreshold,
+			SnapshotInterval:    1,
+			SnapshotCompression: backend.SnapshotCompressionNone,
+			SyncSnapshot:        true,
+		})
+
+		clientInfo, err := be.DB.ActivateClient(ctx, t.Name())
+		assert.NoError(t, err)
+		bytesID, err := clientInfo.ID.Bytes()
+		assert.NoError(t, err)
+		actorID, err := time.ActorIDFromBytes(bytesID)
+		assert.NoError(t, err)
+
+		docInfo, err := be.DB.FindDocInfoByKey(ctx, clientInfo, fmt.Sprintf("tests$%s", t.Name()), true)
+		assert.NoError(t, err)
+		assert.NoError(t, clientInfo.AttachDocument(docInfo.ID))
+
+		doc := document.New("tests", t.Name())
+		doc.SetActor(actorID)
+		pushPull := func(k string) {
+			assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+				root.SetString(k, strings.Repeat("v", 1024))
+				return nil
+			}))
+			docInfo, err = be.DB.FindDocInfoByID(ctx, docInfo.ID)
+			assert.NoError(t, err)
+			_, err = packs.PushPull(ctx, be, clientInfo, docInfo, doc.CreateChangePack())
+			assert.NoError(t, err)
+		}
+
+		// 01. store an uncompressed snapshot.
+		pushPull("k1")
+		snapshotInfo, err := be.DB.FindLastSnapshotInfo(ctx, docInfo.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(1), snapshotInfo.ServerSeq)
+		uncompressedLen := len(snapshotInfo.Snapshot)
+
+		// 02. the next snapshot is built from the uncompressed one and is
+		//     stored compressed.
+		be.Config.SnapshotCompression = backend.SnapshotCompressionGzip
+		pushPull("k2")
+		snapshotInfo, err = be.DB.FindLastSnapshotInfo(ctx, docInfo.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(2), snapshotInfo.ServerSeq)
+		assert.Less(t, len(snapshotInfo.Snapshot), uncompressedLen)
+
+		// 03. both snapshots are loaded decompressed.
+		for serverSeq, marshaled := range map[uint64]string{
+			1: fmt.Sprintf(`{"k1":"%s"}`, strings.Repeat("v", 1024)),
+			2: doc.Marshal(),
+		} {
+			snapshotRange, err := packs.FindSnapshotRange(ctx, be, docInfo, serverSeq, 0)
+			assert.NoError(t, err)
+			obj, err := converter.BytesToObject(snapshotRange.Data)
+			assert.NoError(t, err)
+			assert.Equal(t, marshaled, obj.Marshal())
+
+			// NOTE: The hash is of the encoded snapshot regardless of the
+			//       compression.
+			snapshotInfo, err := be.DB.FindClosestSnapshotInfo(ctx, docInfo.ID, serverSeq)
+			assert.NoError(t, err)
+			assert.Equal(t, db.SnapshotHash(snapshotRange.Data), snapshotInfo.Hash)
+		}
+	})
+
+	t.Run("skip publish without subscribers test", func(t *testing.T) {
+		be := newBackend(t, &backend.Config{
+			SnapshotThreshold: helper.SnapshotThreshold,
+			SnapshotInterval:  1,
+		})
+
+		clientInfo, err := be.DB.ActivateClient(ctx, t.Name())
+		assert.NoError(t, err)
+		bytesID, err := clientInfo.ID.Bytes()
+		assert.NoError(t, err)
+		actorID, err := time.ActorIDFromBytes(bytesID)
+		assert.NoError(t, err)
+
+		docInfo, err := be.DB.FindDocInfoByKey(ctx, clientInfo, fmt.Sprintf("tests$%s", t.Name()), true)
+		assert.NoError(t, err)
+		assert.NoError(t, clientInfo.AttachDocument(docInfo.ID))
+
+		doc := document.New("tests", t.Name())
+		doc.SetActor(actorID)
+		pushPull := func(k string) {
+			assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+				root.SetString(k, "v")
+				return nil
+			}))
+			docInfo, err = be.DB.FindDocInfoByID(ctx, docInfo.ID)
+			assert.NoError(t, err)
+			respPack, err := packs.PushPull(ctx, be, clientInfo, docInfo, doc.CreateChangePack())
+			assert.NoError(t, err)
+
+			pbPack, err := respPack.ToPBChangePack()
+			assert.NoError(t, err)
+			pack, err := converter.FromChangePack(pbPack)
+			assert.NoError(t, err)
+			assert.NoError(t, doc.ApplyChangePack(pack))
+		}
+		publishSkipped := func() float64 {
+			families, err := be.Metrics.Registry().Gather()
+			assert.NoError(t, err)
+			for _, family := range families {
+				if family.GetName() == "yorkie_pushpull_publish_skipped_total" {
+					return family.GetMetric()[0].GetCounter().GetValue()
+				}
+			}
+			return 0
+		}
+
+		// 01. the event is skipped without subscribers, but the snapshot is
+		//     still stored.
+		pushPull("k1")
+		assert.Eventually(t, func() bool {
+			found, err := be.DB.FindDocInfoByID(ctx, docInfo.ID)
+			assert.NoError(t, err)
+			return found.SnapshotServerSeq == 1
+		}, 2*gotime.Second, 10*gotime.Millisecond)
+		assert.Equal(t, float64(1), publishSkipped())
+
+		// 02. the event is published to the subscriber.
+		watcher := types.Client{ID: &time.ActorID{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}}
+		docKeys := []*key.Key{doc.Key()}
+		sub, _, err := be.Coordinator.Subscribe(ctx, watcher, docKeys)
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, be.Coordinator.Unsubscribe(ctx, docKeys, sub))
+		}()
+
+		pushPull("k2")
+		event := <-sub.Events()
+		assert.Equal(t, types.DocumentsChangedEvent, event.Type)
+		assert.Equal(t, uint64(2), event.ServerSeq)
+		assert.Equal(t, uint64(1), event.FromServerSeq)
+		assert.Equal(t, float64(1), publishSkipped())
+	})
+
+	t.Run("snapshot lock contention metric test", func(t *testing.T) {
+		be := newBackend(t, &backend.Config{
+			SnapshotThreshold: helper.SnapshotThreshold,
+			SnapshotInterval:  1,
+			SyncSnapshot:      true,
+		})
+		coordinator := &holdingCoordinator{
+			Coordinator: be.Coordinator,
+			locked:      make(chan struct{}),
+			release:     make(chan struct{}),
+		}
+		be.Coordinator = coordinator
+
+		bsonDocKey := fmt.Sprintf("tests$%s", t.Name())
+		pushPull := func(clientKey string, k string) error {
+			clientInfo, err := be.DB.ActivateClient(ctx, clientKey)
+			assert.NoError(t, err)
+			bytesID, err := clientInfo.ID.Bytes()
+			assert.NoError(t, err)
+			actorID, err := time.ActorIDFromBytes(bytesID)
+			assert.NoError(t, err)
+
+			docInfo, err := be.DB.FindDocInfoByKey(ctx, clientInfo, bsonDocKey, true)
+			assert.NoError(t, err)
+			assert.NoError(t, clientInfo.AttachDocument(docInfo.ID))
+
+			doc := document.New("tests", t.Name())
+			doc.SetActor(actorID)
+			assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+				root.SetString(k, "v")
+				return nil
+			}))
+			_, err = packs.PushPull(ctx, be, clientInfo, docInfo, doc.CreateChangePack())
+			return err
+		}
+		counter := func(name string) float64 {
+			families, err := be.Metrics.Registry().Gather()
+			assert.NoError(t, err)
+			for _, family := range families {
+				if family.GetName() == name {
+					return family.GetMetric()[0].GetCounter().GetValue()
+				}
+			}
+			return 0
+		}
+
+		// 01. the first PushPull holds the snapshot lock while creating the
+		//     snapshot.
+		done := make(chan error, 1)
+		go func() {
+			done <- pushPull(t.Name()+"1", "k1")
+		}()
+		<-coordinator.locked
+
+		// 02. the second PushPull skips the snapshot because of the lock.
+		assert.NoError(t, pushPull(t.Name()+"2", "k2"))
+		assert.Equal(t, float64(1), counter("yorkie_pushpull_snapshot_lock_contended_total"))
+
+		close(coordinator.release)
+		assert.NoError(t, <-done)
+		assert.Equal(t, float64(1), counter("yorkie_pushpull_snapshot_lock_contended_total"))
+		assert.Equal(t, float64(0), counter("yorkie_pushpull_snapshot_lock_errors_total"))
+
+		// 03. the errors while acquiring the snapshot lock are counted
+		//     separately.
+		be.Coordinator = &failingCoordinator{Coordinator: coordinator.Coordinator}
+		assert.Error(t, pushPull(t.Name()+"3", "k3"))
+		assert.Equal(t, float64(1), counter("yorkie_pushpull_snapshot_lock_errors_total"))
+		assert.Equal(t, float64(1), counter("yorkie_pushpull_snapshot_lock_contended_total"))
+	})
+
+	t.Run("compact changes test", func(t *testing.T) {
+		be := newBackend(t, &backend.Config{})
+
+		clientInfo, err := be.DB.ActivateClient(ctx, t.Name())
+		assert.NoError(t, err)
+		bytesID, err := clientInfo.ID.Bytes()
+		assert.NoError(t, err)
+		actorID, err := time.ActorIDFromBytes(bytesID)
+		assert.NoError(t, err)
+
+		docKey := fmt.Sprintf("tests$%s", t.Name())
+		docInfo, err := be.DB.FindDocInfoByKey(ctx, clientInfo, docKey, true)
+		assert.NoError(t, err)
+
+		// 01. create a document with three changes and snapshots at each of
+		//     them.
+		doc := document.New("tests", t.Name())
+		doc.SetActor(actorID)
+		for _, k := range []string{"k1", "k2", "k3"} {
+			assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+				root.SetString(k, "v")
+				return nil
+			}))
+
+			pack := doc.CreateChangePack()
+			initialServerSeq := docInfo.ServerSeq
+			for _, c := range pack.Changes {
+				c.SetServerSeq(docInfo.IncreaseServerSeq())
+			}
+			assert.NoError(t, be.DB.CreateChangeInfos(ctx, docInfo, initialServerSeq, pack.Changes))
+			assert.NoError(t, doc.ApplyChangePack(change.NewPack(
+				doc.Key(),
+				pack.Checkpoint.NextServerSeq(docInfo.ServerSeq),
+				nil,
+				nil,
+			)))
+			snapshot, err := converter.ObjectToBytes(doc.RootObject())
+			assert.NoError(t, err)
+			assert.NoError(t, be.DB.CreateSnapshotInfo(ctx, docInfo.ID, docInfo.ServerSeq, snapshot, db.SnapshotHash(snapshot)))
+		}
+
+		// 02. the documents whose garbage collection is disabled are not
+		//     compacted.
+		_, err = be.DB.UpdateDocSettings(
+			ctx,
+			docInfo.ID,
+			db.DocSettings{DisableGarbageCollection: true},
+			[]string{db.SettingDisableGarbageCollection},
+		)
+		assert.NoError(t, err)
+		compacted, err := packs.CompactChanges(ctx, be, docInfo, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, compacted)
+		_, err = be.DB.UpdateDocSettings(
+			ctx,
+			docInfo.ID,
+			db.DocSettings{},
+			[]string{db.SettingDisableGarbageCollection},
+		)
+		assert.NoError(t, err)
+
+		// 03. the changes before the given serverSeq are deleted.
+		compacted, err = packs.CompactChanges(ctx, be, docInfo, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, compacted)
+
+		// 04. the change at the serverSeq of an attached client is kept.
+		assert.NoError(t, clientInfo.AttachDocument(docInfo.ID))
+		assert.NoError(t, be.DB.UpdateSyncedSeq(ctx, clientInfo, docInfo.ID, 2))
+		compacted, err = packs.CompactChanges(ctx, be, docInfo, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, compacted)
+
+		assert.NoError(t, be.DB.UpdateSyncedSeq(ctx, clientInfo, docInfo.ID, 3))
+		compacted, err = packs.CompactChanges(ctx, be, docInfo, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, compacted)
+
+		infos, err := be.DB.FindChangeInfosBetweenServerSeqs(ctx, docInfo.ID, 1, 3)
+		assert.NoError(t, err)
+		assert.Len(t, infos, 1)
+		assert.Equal(t, uint64(3), infos[0].ServerSeq)
+
+		// 05. the client behind the compacted serverSeq receives the whole
+		//     document.
+		docInfo, err = be.DB.FindDocInfoByID(ctx, docInfo.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(2), docInfo.CompactedServerSeq)
+
+		pulled, err := packs.PullJSONPatches(ctx, be, docInfo, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(3), pulled.ServerSeq)
+		assert.Equal(t, `{"k1":"v","k2":"v","k3":"v"}`, string(pulled.Document))
+
+		pulled, err = packs.PullJSONPatches(ctx, be, docInfo, 2)
+		assert.NoError(t, err)
+		assert.Len(t, pulled.Patches, 1)
+
+		// NOTE: The pull with the doc info read before the compaction does
+		//       not find the deleted changes, so it falls back to the whole
+		//       document as well.
+		staleInfo := docInfo.DeepCopy()
+		staleInfo.CompactedServerSeq = 0
+		pulled, err = packs.PullJSONPatches(ctx, be, staleInfo, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"k1":"v","k2":"v","k3":"v"}`, string(pulled.Document))
+
+		other, err := be.DB.ActivateClient(ctx, t.Name()+"2")
+		assert.NoError(t, err)
+		assert.NoError(t, other.AttachDocument(docInfo.ID))
+		respPack, err := packs.PushPull(ctx, be, other, staleInfo, change.NewPack(
+			doc.Key(),
+			change.InitialCheckpoint,
+			nil,
+			nil,
+		))
+		assert.NoError(t, err)
+		assert.Empty(t, respPack.ChangeInfos)
+		assert.NotEmpty(t, respPack.Snapshot)
+
+		// 06. the history starts with the snapshot at the compacted serverSeq.
+		var history bytes.Buffer
+		assert.NoError(t, packs.ExportHistory(ctx, be, docInfo, &history))
+		assert.Equal(t, 2, strings.Count(history.String(), "commit refs/heads/master"))
+		assert.Contains(t, history.String(), "snapshot 2")
+		assert.Contains(t, history.String(), `{"k1":"v","k2":"v","k3":"v"}`)
+	})
+
+	t.Run("corrupt snapshot after compaction test", func(t *testing.T) {
+		be := newBackend(t, &backend.Config{
+			SnapshotCorruptionPolicy: backend.SnapshotCorruptionRecover,
+		})
+		docInfo := newCorruptDocument(t, be)
+
+		doc := document.New("tests", t.Name())
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k3", "v")
+			return nil
+		}))
+		pack := doc.CreateChangePack()
+		initialServerSeq := docInfo.ServerSeq
+		for _, c := range pack.Changes {
+			c.SetServerSeq(docInfo.IncreaseServerSeq())
+		}
+		assert.NoError(t, be.DB.CreateChangeInfos(ctx, docInfo, initialServerSeq, pack.Changes))
+
+		// NOTE: The hash of the corrupt snapshot matches, so the changes are
+		//       compacted up to it and the snapshot before it can not be used
+		//       to recover the document.
+		compacted, err := packs.CompactChanges(ctx, be, docInfo, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, compacted)
+
+		docInfo, err = be.DB.FindDocInfoByID(ctx, docInfo.ID)
+		assert.NoError(t, err)
+		_, err = packs.PullJSONPatches(ctx, be, docInfo, 0)
+		assert.ErrorIs(t, err, packs.ErrSnapshotCorrupted)
+	})
+
+	t.Run("dry run garbage collection test", func(t *testing.T) {
+		be := newBackend(t, &backend.Config{})
+
+		clientInfo, err := be.DB.ActivateClient(ctx, t.Name())
+		assert.NoError(t, err)
+		bytesID, err := clientInfo.ID.Bytes()
+		assert.NoError(t, err)
+		actorID, err := time.ActorIDFromBytes(bytesID)
+		assert.NoError(t, err)
+
+		docKey := fmt.Sprintf("tests$%s", t.Name())
+		docInfo, err := be.DB.FindDocInfoByKey(ctx, clientInfo, docKey, true)
+		assert.NoError(t, err)
+
+		// 01. create a document whose elements are removed by the second and
+		//     the third changes.
+		doc := document.New("tests", t.Name())
+		doc.SetActor(actorID)
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v")
+			root.SetString("k2", "v")
+			return nil
+		}))
+		for _, k := range []string{"k1", "k2"} {
+			assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+				root.Delete(k)
+				return nil
+			}))
+		}
+		pack := doc.CreateChangePack()
+		for _, c := range pack.Changes {
+			c.SetServerSeq(docInfo.IncreaseServerSeq())
+		}
+		assert.NoError(t, be.DB.CreateChangeInfos(ctx, docInfo, 0, pack.Changes))
+
+		// 02. only the elements removed before the given ticket are reported.
+		report, err := packs.DryRunGarbageCollection(ctx, be, docInfo, time.NewTicket(2, time.MaxDelimiter, actorID))
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(3), report.ServerSeq)
+		assert.False(t, report.GCDisabled)
+		assert.Len(t, report.Garbage, 1)
+		assert.Equal(t, uint64(2), report.Garbage[0].RemovedAt.Lamport())
+		assert.Equal(t, 1, report.Garbage[0].Count)
+
+		report, err = packs.DryRunGarbageCollection(ctx, be, docInfo, time.MaxTicket)
+		assert.NoError(t, err)
+		assert.Len(t, report.Garbage, 2)
+
+		// 03. the documents whose garbage collection is disabled are still
+		//     reported.
+		docInfo, err = be.DB.UpdateDocSettings(
+			ctx,
+			docInfo.ID,
+			db.DocSettings{DisableGarbageCollection: true},
+			[]string{db.SettingDisableGarbageCollection},
+		)
+		assert.NoError(t, err)
+		report, err = packs.DryRunGarbageCollection(ctx, be, docInfo, time.MaxTicket)
+		assert.NoError(t, err)
+		assert.True(t, report.GCDisabled)
+		assert.Len(t, report.Garbage, 2)
+
+		// 04. no snapshot is stored by the dry run.
+		snapshotInfo, err := be.DB.FindLastSnapshotInfo(ctx, docInfo.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(0), snapshotInfo.ServerSeq)
+	})
+}
@@ -36,14 +36,39 @@ type Metrics struct {
 	serverMetrics *grpcprometheus.ServerMetrics
 
 	agentVersion *prometheus.GaugeVec
+	buildInfo    *prometheus.GaugeVec
 
-	pushPullResponseSeconds         prometheus.Histogram
-	pushPullReceivedChangesTotal    prometheus.Counter
-	pushPullSentChangesTotal        prometheus.Counter
-	pushPullReceivedOperationsTotal prometheus.Counter
-	pushPullSentOperationsTotal     prometheus.Counter
-	pushPullSnapshotDurationSeconds prometheus.Histogram
-	pushPullSnapshotBytesTotal      prometheus.Counter
+	pushPullResponseSeconds            prometheus.Histogram
+	pushPullPhaseSeconds               *prometheus.HistogramVec
+	pushPullReceivedChangesTotal       prometheus.Counter
+	pushPullSentChangesTotal           prometheus.Counter
+	pushPullReceivedOperationsTotal    prometheus.Counter
+	pushPullReceivedOperationsByType   *prometheus.CounterVec
+	pushPullSentOperationsTotal        prometheus.Counter
+	pushPullSnapshotDurationSeconds    prometheus.Histogram
+	pushPullSnapshotBytesTotal         prometheus.Counter
+	pushPullSnapshotStoredBytesTotal   prometheus.Counter
+	pushPullSnapshotBuilds             prometheus.Gauge
+	pushPullSnapshotDeferredTotal      prometheus.Counter
+	pushPullSnapshotCorruptedTotal     prometheus.Counter
+	pushPullSnapshotSlowTotal          prometheus.Counter
+	pushPullSnapshotLockContendedTotal prometheus.Counter
+	pushPullSnapshotLockErrorsTotal    prometheus.Counter
+	pushPullConflictsTotal             prometheus.Counter
+	pushPullLamportSkewTotal           prometheus.Counter
+	pushPullGarbageCollectedTotal      prometheus.Counter
+	pushPullPublishSkippedTotal        prometheus.Counter
+
+	authWebhookCacheHitsTotal   prometheus.Counter
+	authWebhookCacheMissesTotal prometheus.Counter
+	authWebhookCacheEntries     prometheus.Gauge
+
+	rpcPanicsTotal *prometheus.CounterVec
+
+	housekeepingDeletedClients prometheus.Gauge
+
+	backgroundQueueDepth   prometheus.Gauge
+	backgroundDrainSeconds prometheus.Gauge
 }
 
 // NewMetrics creates a new instance of Metrics.
@@ -70,12 +95,23 @@ func NewMetrics() (*Metrics, error) {
 			Name:      "version",
 			Help:      "Which version is running. 1 for 'agent_version' label with current version.",
 		}, []string{"agent_version"}),
+		buildInfo: promauto.With(reg).NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "build_info",
+			Help:      "Which build is running. 1 for 'version' and 'commit' labels with current build.",
+		}, []string{"version", "commit"}),
 		pushPullResponseSeconds: promauto.With(reg).NewHistogram(prometheus.HistogramOpts{
 			Namespace: namespace,
 			Subsystem: "pushpull",
 			Name:      "response_seconds",
 			Help:      "The response time of PushPull.",
 		}),
+		pushPullPhaseSeconds: promauto.With(reg).NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "pushpull",
+			Name:      "phase_seconds",
+			Help:      "The time taken by each phase of PushPull: push, pull and store.",
+		}, []string{"phase"}),
 		pushPullReceivedChangesTotal: promauto.With(reg).NewCounter(prometheus.CounterOpts{
 			Namespace: namespace,
 			Subsystem: "pushpull",
@@ -96,6 +132,12 @@ func NewMetrics() (*Metrics, error) {
 				Help: "The total count of operations included in request" +
 					" packs in PushPull.",
 			}),
+		pushPullReceivedOperationsByType: promauto.With(reg).NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "pushpull",
+			Name:      "received_operations_by_type_total",
+			Help:      "The total count of operations included in request packs in PushPull by type.",
+		}, []string{"type"}),
 		pushPullSentOperationsTotal: promauto.With(reg).NewCounter(prometheus.
 			CounterOpts{
 			Namespace: namespace,
@@ -116,11 +158,123 @@ func NewMetrics() (*Metrics, error) {
 			Name:      "snapshot_bytes_total",
 			Help:      "The total bytes of snapshots for response packs in PushPull.",
 		}),
+		pushPullSnapshotStoredBytesTotal: promauto.With(reg).NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "pushpull",
+			Name:      "snapshot_stored_bytes_total",
+			Help:      "The total bytes of snapshots stored, after compression.",
+		}),
+		pushPullSnapshotBuilds: promauto.With(reg).NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "pushpull",
+			Name:      "snapshot_builds",
+			Help:      "The number of snapshots being built concurrently in PushPull.",
+		}),
+		pushPullSnapshotDeferredTotal: promauto.With(reg).NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "pushpull",
+			Name:      "snapshot_deferred_total",
+			Help:      "The total count of snapshots deferred by the limit of concurrent builds.",
+		}),
+		pushPullSnapshotCorruptedTotal: promauto.With(reg).NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "pushpull",
+			Name:      "snapshot_corrupted_total",
+			Help:      "The total count of stored snapshots that could not be decoded.",
+		}),
+		pushPullSnapshotSlowTotal: promauto.With(reg).NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "pushpull",
+			Name:      "snapshot_slow_total",
+			Help:      "The total count of snapshots whose creation exceeded the slow threshold.",
+		}),
+		pushPullSnapshotLockContendedTotal: promauto.With(reg).NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "pushpull",
+			Name:      "snapshot_lock_contended_total",
+			Help:      "The total count of snapshots skipped because another routine held the snapshot lock.",
+		}),
+		pushPullSnapshotLockErrorsTotal: promauto.With(reg).NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "pushpull",
+			Name:      "snapshot_lock_errors_total",
+			Help:      "The total count of errors while acquiring the snapshot lock.",
+		}),
+		pushPullConflictsTotal: promauto.With(reg).NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "pushpull",
+			Name:      "conflicts_total",
+			Help:      "The total count of pushed operations targeting the same element as concurrent operations.",
+		}),
+		pushPullLamportSkewTotal: promauto.With(reg).NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "pushpull",
+			Name:      "lamport_skew_total",
+			Help:      "The total count of pushed changes whose lamport exceeded the max lamport skew.",
+		}),
+		pushPullGarbageCollectedTotal: promauto.With(reg).NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "pushpull",
+			Name:      "garbage_collected_elements_total",
+			Help:      "The total count of removed elements purged by the garbage collection of snapshots.",
+		}),
+		pushPullPublishSkippedTotal: promauto.With(reg).NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "pushpull",
+			Name:      "publish_skipped_total",
+			Help:      "The total count of document change events skipped because there are no subscribers.",
+		}),
+		authWebhookCacheHitsTotal: promauto.With(reg).NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "auth_webhook",
+			Name:      "cache_hits_total",
+			Help:      "The total count of authorizations answered from the cache of the webhook.",
+		}),
+		authWebhookCacheMissesTotal: promauto.With(reg).NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "auth_webhook",
+			Name:      "cache_misses_total",
+			Help:      "The total count of authorizations not found in the cache of the webhook.",
+		}),
+		authWebhookCacheEntries: promauto.With(reg).NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "auth_webhook",
+			Name:      "cache_entries",
+			Help:      "The number of entries in the cache of the webhook.",
+		}),
+		housekeepingDeletedClients: promauto.With(reg).NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "housekeeping",
+			Name:      "deleted_clients",
+			Help:      "The number of deactivated clients deleted in the last housekeeping run.",
+		}),
+		backgroundQueueDepth: promauto.With(reg).NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "background",
+			Name:      "queue_depth",
+			Help:      "The number of background tasks waiting for a worker.",
+		}),
+		backgroundDrainSeconds: promauto.With(reg).NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "background",
+			Name:      "drain_seconds",
+			Help:      "The seconds taken to wait for the background tasks on shutdown.",
+		}),
+		rpcPanicsTotal: promauto.With(reg).NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "rpc",
+			Name:      "panics_total",
+			Help:      "The total count of panics recovered in the RPC handlers.",
+		}, []string{"method"}),
 	}
 
 	metrics.agentVersion.With(prometheus.Labels{
 		"agent_version": version.Version,
 	}).Set(1)
+	metrics.buildInfo.With(prometheus.Labels{
+		"version": version.Version,
+		"commit":  version.GitCommit,
+	}).Set(1)
 
 	return metrics, nil
 }
@@ -131,6 +285,12 @@ func (m *Metrics) ObservePushPullResponseSeconds(seconds float64) {
 	m.pushPullResponseSeconds.Observe(seconds)
 }
 
+// ObservePushPullPhaseSeconds adds an observation for the time taken by the
+// given phase of PushPull.
+func (m *Metrics) ObservePushPullPhaseSeconds(phase string, seconds float64) {
+	m.pushPullPhaseSeconds.WithLabelValues(phase).Observe(seconds)
+}
+
 // AddPushPullReceivedChanges sets the number of changes
 // included in the request pack of PushPull.
 func (m *Metrics) AddPushPullReceivedChanges(count int) {
@@ -149,6 +309,12 @@ func (m *Metrics) AddPushPullReceivedOperations(count int) {
 	m.pushPullReceivedOperationsTotal.Add(float64(count))
 }
 
+// AddPushPullReceivedOperationsByType adds the number of operations of the
+// given type included in the request pack of PushPull.
+func (m *Metrics) AddPushPullReceivedOperationsByType(opType string, count int) {
+	m.pushPullReceivedOperationsByType.WithLabelValues(opType).Add(float64(count))
+}
+
 // AddPushPullSentOperations adds the number of operations
 // included in the response pack of PushPull.
 func (m *Metrics) AddPushPullSentOperations(count int) {
@@ -166,6 +332,118 @@ func (m *Metrics) AddPushPullSnapshotBytes(bytes int) {
 	m.pushPullSnapshotBytesTotal.Add(float64(bytes))
 }
 
+// AddPushPullSnapshotStoredBytes adds the bytes of a stored snapshot after
+// compression.
+func (m *Metrics) AddPushPullSnapshotStoredBytes(bytes int) {
+	m.pushPullSnapshotStoredBytesTotal.Add(float64(bytes))
+}
+
+// IncPushPullSnapshotBuilds increases the number of snapshots being built.
+func (m *Metrics) IncPushPullSnapshotBuilds() {
+	m.pushPullSnapshotBuilds.Inc()
+}
+
+// DecPushPullSnapshotBuilds decreases the number of snapshots being built.
+func (m *Metrics) DecPushPullSnapshotBuilds() {
+	m.pushPullSnapshotBuilds.Dec()
+}
+
+// AddPushPullSnapshotDeferred adds the number of snapshots deferred by the
+// limit of concurrent builds.
+func (m *Metrics) AddPushPullSnapshotDeferred(count int) {
+	m.pushPullSnapshotDeferredTotal.Add(float64(count))
+}
+
+// AddPushPullGarbageCollectedElements adds the number of removed elements
+// purged by the garbage collection of snapshots.
+func (m *Metrics) AddPushPullGarbageCollectedElements(count int) {
+	m.pushPullGarbageCollectedTotal.Add(float64(count))
+}
+
+// AddPushPullPublishSkipped adds the number of document change events skipped
+// because there are no subscribers.
+func (m *Metrics) AddPushPullPublishSkipped(count int) {
+	m.pushPullPublishSkippedTotal.Add(float64(count))
+}
+
+// AddPushPullSnapshotCorrupted adds the number of stored snapshots that could
+// not be decoded.
+func (m *Metrics) AddPushPullSnapshotCorrupted(count int) {
+	m.pushPullSnapshotCorruptedTotal.Add(float64(count))
+}
+
+// AddPushPullSnapshotSlow adds the number of snapshots whose creation
+// exceeded the slow threshold.
+func (m *Metrics) AddPushPullSnapshotSlow(count int) {
+	m.pushPullSnapshotSlowTotal.Add(float64(count))
+}
+
+// AddPushPullSnapshotLockContended adds the number of snapshots skipped
+// because another routine held the snapshot lock.
+func (m *Metrics) AddPushPullSnapshotLockContended(count int) {
+	m.pushPullSnapshotLockContendedTotal.Add(float64(count))
+}
+
+// AddPushPullSnapshotLockErrors adds the number of errors while acquiring the
+// snapshot lock.
+func (m *Metrics) AddPushPullSnapshotLockErrors(count int) {
+	m.pushPullSnapshotLockErrorsTotal.Add(float64(count))
+}
+
+// AddPushPullConflicts adds the number of pushed operations targeting the
+// same element as concurrent operations.
+func (m *Metrics) AddPushPullConflicts(count int) {
+	m.pushPullConflictsTotal.Add(float64(count))
+}
+
+// AddPushPullLamportSkew adds the number of pushed changes whose lamport
+// exceeded the max lamport skew.
+func (m *Metrics) AddPushPullLamportSkew(count int) {
+	m.pushPullLamportSkewTotal.Add(float64(count))
+}
+
+// AddAuthWebhookCacheHits adds the number of authorizations answered from
+// the cache of the webhook.
+func (m *Metrics) AddAuthWebhookCacheHits(count int) {
+	m.authWebhookCacheHitsTotal.Add(float64(count))
+}
+
+// AddAuthWebhookCacheMisses adds the number of authorizations not found in
+// the cache of the webhook.
+func (m *Metrics) AddAuthWebhookCacheMisses(count int) {
+	m.authWebhookCacheMissesTotal.Add(float64(count))
+}
+
+// SetAuthWebhookCacheEntries sets the number of entries in the cache of the
+// webhook.
+func (m *Metrics) SetAuthWebhookCacheEntries(count int) {
+	m.authWebhookCacheEntries.Set(float64(count))
+}
+
+// SetHousekeepingDeletedClients sets the number of deactivated clients deleted
+// in the last housekeeping run.
+func (m *Metrics) SetHousekeepingDeletedClients(count int) {
+	m.housekeepingDeletedClients.Set(float64(count))
+}
+
+// SetBackgroundQueueDepth sets the number of background tasks waiting for a
+// worker.
+func (m *Metrics) SetBackgroundQueueDepth(depth int) {
+	m.backgroundQueueDepth.Set(float64(depth))
+}
+
+// SetBackgroundDrainSeconds sets the seconds taken to wait for the background
+// tasks on shutdown.
+func (m *Metrics) SetBackgroundDrainSeconds(seconds float64) {
+	m.backgroundDrainSeconds.Set(seconds)
+}
+
+// AddRPCPanics adds the number of panics recovered in the RPCs of the given
+// method.
+func (m *Metrics) AddRPCPanics(method string, count int) {
+	m.rpcPanicsTotal.WithLabelValues(method).Add(float64(count))
+}
+
 // RegisterGRPCServer registers the given gRPC server.
 func (m *Metrics) RegisterGRPCServer(server *grpc.Server) {
 	m.serverMetrics.InitializeMetrics(server)
@@ -0,0 +1,522 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rpc
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	gotime "time"
+
+	protoTypes "github.com/gogo/protobuf/types"
+
+	"github.com/yorkie-team/yorkie/api"
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/pkg/types"
+	"github.com/yorkie-team/yorkie/yorkie/auth"
+	"github.com/yorkie-team/yorkie/yorkie/backend"
+	"github.com/yorkie-team/yorkie/yorkie/backend/db"
+	"github.com/yorkie-team/yorkie/yorkie/backend/sync"
+	"github.com/yorkie-team/yorkie/yorkie/clients"
+	"github.com/yorkie-team/yorkie/yorkie/logging"
+	"github.com/yorkie-team/yorkie/yorkie/packs"
+)
+
+const (
+	// logStreamBufferSize is the number of log entries buffered for a single
+	// StreamLogs. Entries beyond it are dropped.
+	logStreamBufferSize = 1000
+
+	// logStreamRateLimit is the maximum number of log entries sent per second
+	// in a single StreamLogs. Entries beyond it are dropped.
+	logStreamRateLimit = 100
+)
+
+// adminServer is a normal server that processes the administrative requests
+// such as looking up the information of clients.
+type adminServer struct {
+	backend    *backend.Backend
+	serviceCtx context.Context
+}
+
+// newAdminServer creates a new instance of adminServer.
+func newAdminServer(serviceCtx context.Context, be *backend.Backend) *adminServer {
+	return &adminServer{
+		backend:    be,
+		serviceCtx: serviceCtx,
+	}
+}
+
+// GetClientInfo returns the information of the given client including the
+// attached documents and their checkpoints. Only the admin can call it.
+func (s *adminServer) GetClientInfo(
+	ctx context.Context,
+	req *api.GetClientInfoRequest,
+) (*api.GetClientInfoResponse, error) {
+	if err := auth.VerifyAdmin(ctx, s.backend); err != nil {
+		return nil, err
+	}
+
+	if len(req.ClientId) == 0 {
+		return nil, clients.ErrInvalidClientID
+	}
+
+	clientInfo, docInfos, err := clients.FindClientInfo(ctx, s.backend, db.IDFromBytes(req.ClientId))
+	if err != nil {
+		return nil, err
+	}
+
+	pbClientID, err := clientInfo.ID.Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	pbDocuments, err := toClientDocInfos(clientInfo, docInfos)
+	if err != nil {
+		return nil, err
+	}
+
+	pbUpdatedAt, err := protoTypes.TimestampProto(clientInfo.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.GetClientInfoResponse{
+		ClientId:  pbClientID,
+		ClientKey: clientInfo.Key,
+		Status:    clientInfo.Status,
+		Metadata:  clientInfo.Metadata,
+		Documents: pbDocuments,
+		UpdatedAt: pbUpdatedAt,
+	}, nil
+}
+
+// GetSnapshotMeta returns the metadata of the last snapshot of the given
+// document. The hash can be compared across nodes to detect divergence
+// between replicas. Only the admin can call it.
+func (s *adminServer) GetSnapshotMeta(
+	ctx context.Context,
+	req *api.GetSnapshotMetaRequest,
+) (*api.GetSnapshotMetaResponse, error) {
+	if err := auth.VerifyAdmin(ctx, s.backend); err != nil {
+		return nil, err
+	}
+
+	if req.DocumentKey == nil {
+		return nil, converter.ErrDocumentKeyRequired
+	}
+
+	snapshotInfo, err := packs.FindLastSnapshotInfo(
+		ctx,
+		s.backend,
+		converter.FromDocumentKey(req.DocumentKey),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbCreatedAt *protoTypes.Timestamp
+	if !snapshotInfo.CreatedAt.IsZero() {
+		pbCreatedAt, err = protoTypes.TimestampProto(snapshotInfo.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &api.GetSnapshotMetaResponse{
+		ServerSeq: snapshotInfo.ServerSeq,
+		Hash:      snapshotInfo.Hash,
+		CreatedAt: pbCreatedAt,
+	}, nil
+}
+
+// StreamLogs streams the log entries of this agent filtered by the given level
+// and logger name prefixes. Entries exceeding the rate limit or the buffer are
+// dropped, and the number of them is sent with the next entry. Only the admin
+// can call it.
+func (s *adminServer) StreamLogs(
+	req *api.StreamLogsRequest,
+	stream api.Admin_StreamLogsServer,
+) error {
+	if err := auth.VerifyAdmin(stream.Context(), s.backend); err != nil {
+		return err
+	}
+
+	subscription, err := logging.Subscribe(req.Level, req.LoggerPrefixes, logStreamBufferSize)
+	if err != nil {
+		return err
+	}
+	defer logging.Unsubscribe(subscription)
+
+	var dropped uint64
+	var sent int
+	window := gotime.Now()
+	for {
+		select {
+		case <-s.serviceCtx.Done():
+			return nil
+		case <-stream.Context().Done():
+			return nil
+		case entry := <-subscription.Entries():
+			if gotime.Since(window) >= gotime.Second {
+				window = gotime.Now()
+				sent = 0
+			}
+			if sent >= logStreamRateLimit {
+				dropped++
+				continue
+			}
+
+			pbTime, err := protoTypes.TimestampProto(entry.Time)
+			if err != nil {
+				return err
+			}
+
+			if err := stream.Send(&api.StreamLogsResponse{
+				Time:    pbTime,
+				Level:   entry.Level.String(),
+				Logger:  entry.Logger,
+				Message: entry.Message,
+				Dropped: dropped + subscription.Dropped(),
+			}); err != nil {
+				return err
+			}
+			sent++
+			dropped = 0
+		}
+	}
+}
+
+// QuarantineDocument quarantines the given document on all the agents. The
+// following PushPull and watch requests for it are rejected, and the watches
+// of it are closed after the clients are notified. Only the admin can call it.
+func (s *adminServer) QuarantineDocument(
+	ctx context.Context,
+	req *api.QuarantineDocumentRequest,
+) (*api.QuarantineDocumentResponse, error) {
+	if err := auth.VerifyAdmin(ctx, s.backend); err != nil {
+		return nil, err
+	}
+
+	if req.DocumentKey == nil {
+		return nil, converter.ErrDocumentKeyRequired
+	}
+	docKey := converter.FromDocumentKey(req.DocumentKey)
+
+	quarantined, err := s.backend.QuarantineDocument(ctx, docKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if quarantined {
+		logging.From(ctx).Warnf("QUARANTINE: '%s' quarantined", docKey.BSONKey())
+
+		s.backend.Coordinator.Publish(ctx, time.InitialActorID, sync.DocEvent{
+			Type:         types.DocumentsQuarantinedEvent,
+			Publisher:    types.Client{ID: time.InitialActorID},
+			DocumentKeys: []*key.Key{docKey},
+		})
+	}
+
+	return &api.QuarantineDocumentResponse{
+		Quarantined: quarantined,
+	}, nil
+}
+
+// ReleaseDocument releases the given document from the quarantine on all the
+// agents. Only the admin can call it.
+func (s *adminServer) ReleaseDocument(
+	ctx context.Context,
+	req *api.ReleaseDocumentRequest,
+) (*api.ReleaseDocumentResponse, error) {
+	if err := auth.VerifyAdmin(ctx, s.backend); err != nil {
+		return nil, err
+	}
+
+	if req.DocumentKey == nil {
+		return nil, converter.ErrDocumentKeyRequired
+	}
+	docKey := converter.FromDocumentKey(req.DocumentKey)
+
+	released, err := s.backend.ReleaseDocument(ctx, docKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if released {
+		logging.From(ctx).Infof("QUARANTINE: '%s' released", docKey.BSONKey())
+	}
+
+	return &api.ReleaseDocumentResponse{
+		Released: released,
+	}, nil
+}
+
+// UpdateDocumentSettings updates the settings of the given document that
+// override the global config. Only the fields in update_mask are updated, or
+// all of them if it is empty. The fields that are not set fall back to the
+// global config. Only the admin can call it.
+func (s *adminServer) UpdateDocumentSettings(
+	ctx context.Context,
+	req *api.UpdateDocumentSettingsRequest,
+) (*api.UpdateDocumentSettingsResponse, error) {
+	if err := auth.VerifyAdmin(ctx, s.backend); err != nil {
+		return nil, err
+	}
+
+	if req.DocumentKey == nil {
+		return nil, converter.ErrDocumentKeyRequired
+	}
+	docKey := converter.FromDocumentKey(req.DocumentKey)
+
+	docInfo, err := s.backend.DB.FindDocInfoByKey(ctx, nil, docKey.BSONKey(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := docSettingFieldsOf(req.UpdateMask)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: Only the given fields are validated against the stored ones and
+	//       then written, so that the fields managed by the server, such as
+	//       the quarantine and the snapshot interval raised on slow
+	//       snapshots, are kept unless they are given explicitly.
+	settings := fromDocumentSettings(req.Settings)
+	merged := docInfo.Settings
+	if err := merged.CopyFields(settings, fields); err != nil {
+		return nil, err
+	}
+	if err := s.backend.Config.ValidateDocSettings(merged); err != nil {
+		return nil, err
+	}
+
+	docInfo, err = s.backend.DB.UpdateDocSettings(ctx, docInfo.ID, settings, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.From(ctx).Infof("SETTINGS: '%s' updated: %+v", docInfo.Key, docInfo.Settings)
+
+	return &api.UpdateDocumentSettingsResponse{
+		Settings: toDocumentSettings(docInfo.Settings),
+	}, nil
+}
+
+// ExportDocumentHistory streams the changes of the given document in git
+// fast-import format, so that the history can be mirrored into a git
+// repository. Only the admin can call it.
+func (s *adminServer) ExportDocumentHistory(
+	req *api.ExportDocumentHistoryRequest,
+	stream api.Admin_ExportDocumentHistoryServer,
+) error {
+	ctx := stream.Context()
+	if err := auth.VerifyAdmin(ctx, s.backend); err != nil {
+		return err
+	}
+
+	if req.DocumentKey == nil {
+		return converter.ErrDocumentKeyRequired
+	}
+	docKey := converter.FromDocumentKey(req.DocumentKey)
+
+	docInfo, err := s.backend.DB.FindDocInfoByKey(ctx, nil, docKey.BSONKey(), false)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriterSize(&historyStreamWriter{stream: stream}, snapshotChunkSize)
+	if err := packs.ExportHistory(ctx, s.backend, docInfo, w); err != nil {
+		return err
+	}
+
+	return w.Flush()
+}
+
+// historyStreamWriter is an io.Writer that sends the written bytes over the
+// stream of ExportDocumentHistory.
+type historyStreamWriter struct {
+	stream api.Admin_ExportDocumentHistoryServer
+}
+
+// Write sends the given bytes as a chunk of the history.
+func (w *historyStreamWriter) Write(p []byte) (int, error) {
+	// NOTE: The bytes are copied because the writer must not retain them.
+	chunk := make([]byte, len(p))
+	copy(chunk, p)
+
+	if err := w.stream.Send(&api.ExportDocumentHistoryResponse{
+		Chunk: chunk,
+	}); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// CheckAccess returns whether the given token is allowed to call the given
+// method on the given documents, without performing the method. It goes
+// through the same webhook and cache as the actual calls. Only the admin can
+// call it, so that the others can not probe the access of the tokens.
+func (s *adminServer) CheckAccess(
+	ctx context.Context,
+	req *api.CheckAccessRequest,
+) (*api.CheckAccessResponse, error) {
+	if err := auth.VerifyAdmin(ctx, s.backend); err != nil {
+		return nil, err
+	}
+
+	if !types.IsAuthMethod(req.Method) {
+		return nil, fmt.Errorf("%s: %w", req.Method, auth.ErrInvalidMethod)
+	}
+
+	attrs, err := fromAccessAttributes(req.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	authResp, err := auth.CheckAccess(ctx, s.backend, req.Token, &types.AccessInfo{
+		Method:     types.Method(req.Method),
+		Attributes: attrs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.CheckAccessResponse{
+		Allowed: authResp.Allowed,
+		Reason:  authResp.Reason,
+	}, nil
+}
+
+// fromAccessAttributes converts the given Protobuf formats to model format.
+func fromAccessAttributes(pbAttrs []*api.CheckAccessRequest_Attribute) ([]types.AccessAttribute, error) {
+	var attrs []types.AccessAttribute
+	for _, pbAttr := range pbAttrs {
+		if pbAttr.DocumentKey == nil {
+			return nil, converter.ErrDocumentKeyRequired
+		}
+
+		verb := types.VerbType(pbAttr.Verb)
+		if verb != types.Read && verb != types.ReadWrite {
+			return nil, fmt.Errorf("%s: %w", pbAttr.Verb, auth.ErrInvalidVerb)
+		}
+
+		attrs = append(attrs, types.AccessAttribute{
+			Key:  converter.FromDocumentKey(pbAttr.DocumentKey).BSONKey(),
+			Verb: verb,
+		})
+	}
+
+	return attrs, nil
+}
+
+// adminDocSettingFields is the fields of the settings that the admin can
+// update. The quarantine is not one of them, as it is updated by the
+// quarantine and the release of the document.
+var adminDocSettingFields = []string{
+	db.SettingSnapshotThreshold,
+	db.SettingSnapshotInterval,
+	db.SettingMaxChangesPerPull,
+	db.SettingDisableGarbageCollection,
+	db.SettingPreferredRegion,
+	db.SettingRequireSignedChanges,
+}
+
+// docSettingFieldsOf returns the fields of the settings in the given mask. If
+// the mask is empty, all the fields that the admin can update are returned.
+func docSettingFieldsOf(mask *protoTypes.FieldMask) ([]string, error) {
+	if mask == nil || len(mask.Paths) == 0 {
+		return adminDocSettingFields, nil
+	}
+
+	for _, path := range mask.Paths {
+		found := false
+		for _, field := range adminDocSettingFields {
+			if path == field {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("%s: %w", path, db.ErrUnknownDocSetting)
+		}
+	}
+
+	return mask.Paths, nil
+}
+
+// toDocumentSettings converts the given settings to Protobuf format.
+func toDocumentSettings(settings db.DocSettings) *api.DocumentSettings {
+	return &api.DocumentSettings{
+		SnapshotThreshold:        settings.SnapshotThreshold,
+		SnapshotInterval:         settings.SnapshotInterval,
+		MaxChangesPerPull:        settings.MaxChangesPerPull,
+		DisableGarbageCollection: settings.DisableGarbageCollection,
+		PreferredRegion:          settings.PreferredRegion,
+		RequireSignedChanges:     settings.RequireSignedChanges,
+	}
+}
+
+// fromDocumentSettings converts the given Protobuf format to settings. If it
+// is nil, the settings without any override is returned.
+func fromDocumentSettings(pbSettings *api.DocumentSettings) db.DocSettings {
+	if pbSettings == nil {
+		return db.DocSettings{}
+	}
+
+	return db.DocSettings{
+		SnapshotThreshold:        pbSettings.SnapshotThreshold,
+		SnapshotInterval:         pbSettings.SnapshotInterval,
+		MaxChangesPerPull:        pbSettings.MaxChangesPerPull,
+		DisableGarbageCollection: pbSettings.DisableGarbageCollection,
+		PreferredRegion:          pbSettings.PreferredRegion,
+		RequireSignedChanges:     pbSettings.RequireSignedChanges,
+	}
+}
+
+// toClientDocInfos converts the documents of the given clientInfo to Protobuf
+// format.
+func toClientDocInfos(
+	clientInfo *db.ClientInfo,
+	docInfos map[db.ID]*db.DocInfo,
+) ([]*api.ClientDocInfo, error) {
+	var pbDocuments []*api.ClientDocInfo
+	for docID, clientDocInfo := range clientInfo.Documents {
+		pbDocID, err := docID.Bytes()
+		if err != nil {
+			return nil, err
+		}
+
+		docKey, err := docInfos[docID].GetKey()
+		if err != nil {
+			return nil, err
+		}
+
+		pbDocuments = append(pbDocuments, &api.ClientDocInfo{
+			DocumentId:  pbDocID,
+			DocumentKey: converter.ToDocumentKey(docKey),
+			Status:      clientDocInfo.Status,
+			Checkpoint:  converter.ToCheckpoint(clientInfo.Checkpoint(docID)),
+		})
+	}
+
+	return pbDocuments, nil
+}
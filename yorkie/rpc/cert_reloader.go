@@ -0,0 +1,113 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rpc
+
+import (
+	"crypto/tls"
+	"os"
+	gosync "sync"
+	"time"
+
+	"github.com/yorkie-team/yorkie/yorkie/logging"
+)
+
+// certReloader holds the TLS certificate of the server and reloads it when
+// the certificate or the key file is modified, so that a rotated certificate
+// is picked up without restarting the server.
+type certReloader struct {
+	certFile string
+	keyFile  string
+
+	mu          gosync.RWMutex
+	cert        *tls.Certificate
+	certModTime time.Time
+	keyModTime  time.Time
+}
+
+// newCertReloader creates an instance of certReloader with the certificate
+// loaded from the given files.
+func newCertReloader(certFile, keyFile string) (*certReloader, error) {
+	r := &certReloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+	}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// Reload loads the certificate from the files regardless of their
+// modification times.
+func (r *certReloader) Reload() error {
+	certInfo, err := os.Stat(r.certFile)
+	if err != nil {
+		return err
+	}
+	keyInfo, err := os.Stat(r.keyFile)
+	if err != nil {
+		return err
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cert = &cert
+	r.certModTime = certInfo.ModTime()
+	r.keyModTime = keyInfo.ModTime()
+	return nil
+}
+
+// GetCertificate returns the certificate for each handshake. If the files
+// are modified since the last load, the certificate is reloaded first. If
+// the reload fails, for example while the files are being replaced, the last
+// certificate is returned.
+func (r *certReloader) GetCertificate(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	if r.isModified() {
+		if err := r.Reload(); err != nil {
+			logging.DefaultLogger().Warnf("RPC: fail to reload certificate: %s", err)
+		} else {
+			logging.DefaultLogger().Infof("RPC: certificate reloaded from %s", r.certFile)
+		}
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert, nil
+}
+
+// isModified returns whether the certificate or the key file is modified
+// since the last load.
+func (r *certReloader) isModified() bool {
+	certInfo, err := os.Stat(r.certFile)
+	if err != nil {
+		return false
+	}
+	keyInfo, err := os.Stat(r.keyFile)
+	if err != nil {
+		return false
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return !certInfo.ModTime().Equal(r.certModTime) || !keyInfo.ModTime().Equal(r.keyModTime)
+}
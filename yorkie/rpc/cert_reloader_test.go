@@ -0,0 +1,133 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rpc_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	gotime "time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/test/helper"
+	"github.com/yorkie-team/yorkie/yorkie/backend"
+	"github.com/yorkie-team/yorkie/yorkie/backend/housekeeping"
+	"github.com/yorkie-team/yorkie/yorkie/profiling/prometheus"
+	"github.com/yorkie-team/yorkie/yorkie/rpc"
+)
+
+// writeCertificate writes a self-signed certificate of the given serial
+// number and its key to the given files.
+func writeCertificate(t *testing.T, certFile, keyFile string, serial int64) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		NotBefore:    gotime.Now().Add(-gotime.Hour),
+		NotAfter:     gotime.Now().Add(gotime.Hour),
+		DNSNames:     []string{"localhost"},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	assert.NoError(t, os.WriteFile(certFile, certPEM, 0600))
+	assert.NoError(t, os.WriteFile(keyFile, keyPEM, 0600))
+
+	// NOTE: The files are rewritten within the resolution of the modification
+	//       time in the test, so the time is moved forward explicitly.
+	modTime := gotime.Now().Add(gotime.Duration(serial) * gotime.Second)
+	assert.NoError(t, os.Chtimes(certFile, modTime, modTime))
+	assert.NoError(t, os.Chtimes(keyFile, modTime, modTime))
+}
+
+func TestCertReloader(t *testing.T) {
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+	writeCertificate(t, certFile, keyFile, 1)
+
+	met, err := prometheus.NewMetrics()
+	assert.NoError(t, err)
+	be, err := backend.New(&backend.Config{
+		SnapshotThreshold:    helper.SnapshotThreshold,
+		AuthWebhookCacheSize: helper.AuthWebhookSize,
+	}, nil, nil, nil, &housekeeping.Config{
+		Interval:            helper.HousekeepingInterval.String(),
+		DeactivateThreshold: helper.HousekeepingDeactivateThreshold.String(),
+		CandidatesLimit:     helper.HousekeepingCandidatesLimit,
+	}, "", met)
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, be.Shutdown())
+	}()
+
+	port := helper.RPCPort + 1
+	server, err := rpc.NewServer(&rpc.Config{
+		Port:            port,
+		MaxRequestBytes: helper.RPCMaxRequestBytes,
+		CertFile:        certFile,
+		KeyFile:         keyFile,
+	}, be)
+	assert.NoError(t, err)
+	assert.NoError(t, server.Start())
+	defer server.Shutdown(false)
+
+	// serial returns the serial number of the certificate of the server.
+	serial := func() int64 {
+		conn, err := tls.Dial("tcp", fmt.Sprintf("localhost:%d", port), &tls.Config{
+			InsecureSkipVerify: true, // #nosec G402 the certificate is self-signed.
+			NextProtos:         []string{"h2"},
+		})
+		assert.NoError(t, err)
+		defer func() {
+			assert.NoError(t, conn.Close())
+		}()
+		return conn.ConnectionState().PeerCertificates[0].SerialNumber.Int64()
+	}
+	assert.Equal(t, int64(1), serial())
+
+	t.Run("reload rotated certificate on handshake test", func(t *testing.T) {
+		writeCertificate(t, certFile, keyFile, 2)
+		assert.Equal(t, int64(2), serial())
+	})
+
+	t.Run("reload certificate explicitly test", func(t *testing.T) {
+		writeCertificate(t, certFile, keyFile, 3)
+		assert.NoError(t, server.ReloadCertificates())
+		assert.Equal(t, int64(3), serial())
+	})
+
+	t.Run("keep certificate on invalid files test", func(t *testing.T) {
+		assert.NoError(t, os.WriteFile(keyFile, []byte("invalid"), 0600))
+		assert.Error(t, server.ReloadCertificates())
+		assert.Equal(t, int64(3), serial())
+	})
+}
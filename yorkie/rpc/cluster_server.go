@@ -21,10 +21,14 @@ import (
 
 	"github.com/yorkie-team/yorkie/api"
 	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/internal/version"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/pkg/types"
+	"github.com/yorkie-team/yorkie/yorkie/auth"
 	"github.com/yorkie-team/yorkie/yorkie/backend"
+	"github.com/yorkie-team/yorkie/yorkie/backend/db"
 	"github.com/yorkie-team/yorkie/yorkie/logging"
+	"github.com/yorkie-team/yorkie/yorkie/packs"
 )
 
 // clusterServer is a normal server that processes the broadcast by the agent.
@@ -57,7 +61,8 @@ func (s *clusterServer) BroadcastEvent(
 	switch docEvent.Type {
 	case types.DocumentsWatchedEvent,
 		types.DocumentsUnwatchedEvent,
-		types.DocumentsChangedEvent:
+		types.DocumentsChangedEvent,
+		types.PeersChangedEvent:
 		s.backend.Coordinator.PublishToLocal(ctx, actorID, *docEvent)
 	case types.MetadataChangedEvent:
 		if _, err := s.backend.Coordinator.UpdateMetadata(
@@ -73,3 +78,207 @@ func (s *clusterServer) BroadcastEvent(
 
 	return &api.BroadcastEventResponse{}, nil
 }
+
+// CreateSnapshot creates the snapshot of the given document immediately
+// without waiting for the next PushPull. Only the admin can call it.
+func (s *clusterServer) CreateSnapshot(
+	ctx context.Context,
+	req *api.CreateSnapshotRequest,
+) (*api.CreateSnapshotResponse, error) {
+	if err := auth.VerifyAdmin(ctx, s.backend); err != nil {
+		return nil, err
+	}
+
+	if req.DocumentKey == nil {
+		return nil, converter.ErrDocumentKeyRequired
+	}
+	docKey := converter.FromDocumentKey(req.DocumentKey)
+
+	docInfo, err := s.backend.DB.FindDocInfoByKey(ctx, nil, docKey.BSONKey(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := packs.CreateSnapshot(ctx, s.backend, docInfo); err != nil {
+		return nil, err
+	}
+
+	snapshotInfo, err := s.backend.DB.FindLastSnapshotInfo(ctx, docInfo.ID)
+	if err != nil {
+		return nil, err
+	}
+	logging.From(ctx).Infof("SNAP: '%s' created by admin, serverSeq: %d", docKey.BSONKey(), snapshotInfo.ServerSeq)
+
+	return &api.CreateSnapshotResponse{
+		ServerSeq: snapshotInfo.ServerSeq,
+	}, nil
+}
+
+// SetDocumentGCDisabled sets whether the garbage collection of the given
+// document is disabled, such as for the documents under legal hold. It
+// updates DisableGarbageCollection of the settings of the document.
+func (s *clusterServer) SetDocumentGCDisabled(
+	ctx context.Context,
+	req *api.SetDocumentGCDisabledRequest,
+) (*api.SetDocumentGCDisabledResponse, error) {
+	if err := auth.VerifyAdmin(ctx, s.backend); err != nil {
+		return nil, err
+	}
+
+	if req.DocumentKey == nil {
+		return nil, converter.ErrDocumentKeyRequired
+	}
+	docKey := converter.FromDocumentKey(req.DocumentKey)
+
+	docInfo, err := s.backend.DB.FindDocInfoByKey(ctx, nil, docKey.BSONKey(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := db.DocSettings{DisableGarbageCollection: req.GcDisabled}
+	if _, err := s.backend.DB.UpdateDocSettings(
+		ctx,
+		docInfo.ID,
+		settings,
+		[]string{db.SettingDisableGarbageCollection},
+	); err != nil {
+		return nil, err
+	}
+	logging.From(ctx).Infof("GC: '%s' gc disabled set to %t by admin", docKey.BSONKey(), req.GcDisabled)
+
+	return &api.SetDocumentGCDisabledResponse{}, nil
+}
+
+// CompactChanges deletes the changes of the given documents that are already
+// covered by their snapshots and received by all the attached clients. If
+// before_server_seq is given, only the changes before it are deleted. Only the
+// admin can call it.
+func (s *clusterServer) CompactChanges(
+	ctx context.Context,
+	req *api.CompactChangesRequest,
+) (*api.CompactChangesResponse, error) {
+	if err := auth.VerifyAdmin(ctx, s.backend); err != nil {
+		return nil, err
+	}
+
+	if len(req.DocumentKeys) == 0 {
+		return nil, converter.ErrDocumentKeyRequired
+	}
+
+	resp := &api.CompactChangesResponse{}
+	for _, pbDocKey := range req.DocumentKeys {
+		docKey := converter.FromDocumentKey(pbDocKey)
+		docInfo, err := s.backend.DB.FindDocInfoByKey(ctx, nil, docKey.BSONKey(), false)
+		if err != nil {
+			return nil, err
+		}
+
+		compacted, err := packs.CompactChanges(ctx, s.backend, docInfo, req.BeforeServerSeq)
+		if err != nil {
+			return nil, err
+		}
+		if compacted > 0 {
+			resp.CompactedDocuments++
+			resp.CompactedChanges += uint64(compacted)
+		}
+	}
+
+	return resp, nil
+}
+
+// GetDocumentStats returns the statistics of the given document, such as the
+// number of the changes since the last snapshot and the size of it, so that
+// how big the document is can be checked. Only the admin can call it.
+func (s *clusterServer) GetDocumentStats(
+	ctx context.Context,
+	req *api.GetDocumentStatsRequest,
+) (*api.GetDocumentStatsResponse, error) {
+	if err := auth.VerifyAdmin(ctx, s.backend); err != nil {
+		return nil, err
+	}
+
+	if req.DocumentKey == nil {
+		return nil, converter.ErrDocumentKeyRequired
+	}
+	docKey := converter.FromDocumentKey(req.DocumentKey)
+
+	docInfo, err := s.backend.DB.FindDocInfoByKey(ctx, nil, docKey.BSONKey(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.backend.DB.FindDocStats(ctx, docInfo.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.GetDocumentStatsResponse{
+		ServerSeq:            stats.ServerSeq,
+		SnapshotServerSeq:    stats.SnapshotServerSeq,
+		ChangesSinceSnapshot: uint64(stats.ChangesSinceSnapshot),
+		SnapshotBytes:        uint64(stats.SnapshotBytes),
+		ActorCount:           uint64(stats.ActorCount),
+	}, nil
+}
+
+// DryRunGarbageCollection returns the elements and the text nodes of the given
+// document that would be purged with the given min_synced_ticket, without
+// purging them, so that the garbage collection can be audited before it runs.
+// Only the admin can call it.
+func (s *clusterServer) DryRunGarbageCollection(
+	ctx context.Context,
+	req *api.DryRunGarbageCollectionRequest,
+) (*api.DryRunGarbageCollectionResponse, error) {
+	if err := auth.VerifyAdmin(ctx, s.backend); err != nil {
+		return nil, err
+	}
+
+	if req.DocumentKey == nil {
+		return nil, converter.ErrDocumentKeyRequired
+	}
+	docKey := converter.FromDocumentKey(req.DocumentKey)
+
+	minSyncedTicket, err := converter.FromTimeTicket(req.MinSyncedTicket)
+	if err != nil {
+		return nil, err
+	}
+
+	docInfo, err := s.backend.DB.FindDocInfoByKey(ctx, nil, docKey.BSONKey(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := packs.DryRunGarbageCollection(ctx, s.backend, docInfo, minSyncedTicket)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbGarbage []*api.Garbage
+	for _, garbage := range report.Garbage {
+		pbGarbage = append(pbGarbage, &api.Garbage{
+			Id:        garbage.ID,
+			ParentId:  garbage.ParentID,
+			RemovedAt: converter.ToTimeTicket(garbage.RemovedAt),
+			Count:     uint32(garbage.Count),
+		})
+	}
+
+	return &api.DryRunGarbageCollectionResponse{
+		ServerSeq:  report.ServerSeq,
+		GcDisabled: report.GCDisabled,
+		Garbage:    pbGarbage,
+	}, nil
+}
+
+// GetServerInfo returns the build information of the agent, so that which
+// build is running can be checked.
+func (s *clusterServer) GetServerInfo(
+	_ context.Context,
+	_ *api.GetServerInfoRequest,
+) (*api.GetServerInfoResponse, error) {
+	return &api.GetServerInfoResponse{
+		Version:   version.Version,
+		GitCommit: version.GitCommit,
+		BuildDate: version.BuildDate,
+	}, nil
+}
@@ -19,16 +19,66 @@ package rpc
 import (
 	"errors"
 	"fmt"
+	"math"
+	"net"
 	"os"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/keepalive"
+)
+
+const (
+	// DefaultKeepaliveTime is the default idle duration after which the server
+	// pings the client to see if the connection is still alive. It is shorter
+	// than the idle timeouts of common NATs and firewalls.
+	DefaultKeepaliveTime = 1 * time.Minute
+
+	// DefaultKeepaliveTimeout is the default duration the server waits for
+	// the response of a keepalive ping before closing the connection.
+	DefaultKeepaliveTimeout = 20 * time.Second
+
+	// DefaultKeepaliveMinTime is the default minimum interval at which the
+	// clients are allowed to send keepalive pings.
+	DefaultKeepaliveMinTime = 30 * time.Second
 )
 
 var (
 	// ErrInvalidRPCPort occurs when the port in the config is invalid.
 	ErrInvalidRPCPort = errors.New("invalid port number for RPC server")
+	// ErrInvalidSocketPath occurs when the socket path is set together with
+	// the port.
+	ErrInvalidSocketPath = errors.New("invalid socket path for RPC server")
+	// ErrInvalidHost occurs when the host to bind is not an IP address.
+	ErrInvalidHost = errors.New("invalid host for RPC server")
 	// ErrInvalidCertFile occurs when the certificate file is invalid.
 	ErrInvalidCertFile = errors.New("invalid cert file for RPC server")
 	// ErrInvalidKeyFile occurs when the key file is invalid.
 	ErrInvalidKeyFile = errors.New("invalid key file for RPC server")
+	// ErrInvalidMaxRequestBytes occurs when the maximum request size is
+	// larger than gRPC can handle.
+	ErrInvalidMaxRequestBytes = errors.New("invalid max request bytes for RPC server")
+	// ErrInvalidMaxResponseBytes occurs when the maximum response size is
+	// larger than gRPC can handle.
+	ErrInvalidMaxResponseBytes = errors.New("invalid max response bytes for RPC server")
+	// ErrInvalidWarmupDelay occurs when the warmup delay is invalid.
+	ErrInvalidWarmupDelay = errors.New("invalid warmup delay for RPC server")
+	// ErrInvalidWatchKeepaliveInterval occurs when the keepalive interval of
+	// watch streams is invalid.
+	ErrInvalidWatchKeepaliveInterval = errors.New("invalid watch keepalive interval for RPC server")
+	// ErrInvalidKeepaliveMaxConnectionIdle occurs when the maximum idle
+	// duration of connections is invalid.
+	ErrInvalidKeepaliveMaxConnectionIdle = errors.New("invalid keepalive max connection idle for RPC server")
+	// ErrInvalidKeepaliveTime occurs when the keepalive time is invalid.
+	ErrInvalidKeepaliveTime = errors.New("invalid keepalive time for RPC server")
+	// ErrInvalidKeepaliveTimeout occurs when the keepalive timeout is invalid.
+	ErrInvalidKeepaliveTimeout = errors.New("invalid keepalive timeout for RPC server")
+	// ErrInvalidKeepaliveMinTime occurs when the minimum interval of keepalive
+	// pings from the clients is invalid.
+	ErrInvalidKeepaliveMinTime = errors.New("invalid keepalive min time for RPC server")
+	// ErrInvalidHealthCheckInterval occurs when the interval of the health
+	// checks of the dependencies is invalid.
+	ErrInvalidHealthCheckInterval = errors.New("invalid health check interval for RPC server")
 )
 
 // Config is the configuration for creating a Server instance.
@@ -36,6 +86,15 @@ type Config struct {
 	// Port is the port number for the RPC server.
 	Port int `yaml:"Port"`
 
+	// Host is the IP address of the interface the RPC server binds to. If it
+	// is empty, the server listens on all interfaces.
+	Host string `yaml:"Host"`
+
+	// SocketPath is the path to the UNIX domain socket for the RPC server. If
+	// it is set, the server listens on the socket instead of the port, so
+	// the port must not be set.
+	SocketPath string `yaml:"SocketPath"`
+
 	// CertFile is the path to the certificate file.
 	CertFile string `yaml:"CertFile"`
 
@@ -44,14 +103,97 @@ type Config struct {
 
 	// MaxRequestBytes is the maximum client request size in bytes the server will accept.
 	MaxRequestBytes uint64 `yaml:"MaxRequestBytes"`
+
+	// MaxResponseBytes is the maximum response size in bytes the server will
+	// send. A PushPull response carries up to MaxChangesPerPull changes of the
+	// backend, or a snapshot if the changes exceed SnapshotThreshold, so they
+	// should be tuned together. The clients must also accept responses of this
+	// size. If it is zero, the responses are not limited.
+	MaxResponseBytes uint64 `yaml:"MaxResponseBytes"`
+
+	// WarmupDelay is the duration after the start during which the health
+	// status stays NOT_SERVING, so that the load balancer does not route
+	// requests to the server until it is warm. If it is empty or zero, the
+	// server is SERVING as soon as it starts.
+	WarmupDelay string `yaml:"WarmupDelay"`
+
+	// WatchKeepaliveInterval is the interval at which the server sends a
+	// keepalive message over the watch streams, so that the idle streams are
+	// not dropped by the proxies in between. If it is empty or zero, no
+	// keepalive is sent. The streams of the dead clients are closed by the
+	// transport keepalive, see KeepaliveTime and KeepaliveTimeout.
+	WatchKeepaliveInterval string `yaml:"WatchKeepaliveInterval"`
+
+	// KeepaliveMaxConnectionIdle is the duration after which an idle
+	// connection without any RPCs is closed. If it is empty or zero, the idle
+	// connections are never closed.
+	KeepaliveMaxConnectionIdle string `yaml:"KeepaliveMaxConnectionIdle"`
+
+	// KeepaliveTime is the idle duration after which the server pings the
+	// client to see if the connection is still alive. If it is empty or zero,
+	// DefaultKeepaliveTime is used.
+	KeepaliveTime string `yaml:"KeepaliveTime"`
+
+	// KeepaliveTimeout is the duration the server waits for the response of a
+	// keepalive ping before closing the connection. If it is empty or zero,
+	// DefaultKeepaliveTimeout is used.
+	KeepaliveTimeout string `yaml:"KeepaliveTimeout"`
+
+	// KeepaliveMinTime is the minimum interval at which the clients are
+	// allowed to send keepalive pings. The connections of the clients pinging
+	// more often are closed. If it is empty or zero, DefaultKeepaliveMinTime
+	// is used.
+	KeepaliveMinTime string `yaml:"KeepaliveMinTime"`
+
+	// KeepalivePermitWithoutStream is whether the clients are allowed to send
+	// keepalive pings even when there are no active streams.
+	KeepalivePermitWithoutStream bool `yaml:"KeepalivePermitWithoutStream"`
+
+	// EnableReflection is whether to register the gRPC server reflection
+	// service, which lets tools like grpcurl inspect the services of a running
+	// server without the proto files. It should be disabled in production.
+	EnableReflection bool `yaml:"EnableReflection"`
+
+	// HealthCheckInterval is the interval at which the server pings the
+	// database and the coordinator of the backend. While either of them is
+	// down, the health status is NOT_SERVING. If it is empty or zero, the
+	// dependencies are not checked.
+	HealthCheckInterval string `yaml:"HealthCheckInterval"`
+
+	// RequestLogging is whether to log every RPC with the method, the client,
+	// the documents and the duration for audit. The contents of the documents
+	// in the requests are redacted.
+	RequestLogging bool `yaml:"RequestLogging"`
+
+	// RequestLogSkipMethods is the list of the full names of the methods whose
+	// RPCs are not logged, e.g. "/grpc.health.v1.Health/Check".
+	RequestLogSkipMethods []string `yaml:"RequestLogSkipMethods"`
 }
 
 // Validate validates the port number and the files for certification.
 func (c *Config) Validate() error {
-	if c.Port < 1 || 65535 < c.Port {
+	if c.SocketPath != "" {
+		if c.Port != 0 {
+			return fmt.Errorf(
+				"port(%d) and socket path(%s) are exclusive: %w",
+				c.Port,
+				c.SocketPath,
+				ErrInvalidSocketPath,
+			)
+		}
+	} else if c.Port < 1 || 65535 < c.Port {
 		return fmt.Errorf("must be between 1 and 65535, given %d: %w", c.Port, ErrInvalidRPCPort)
 	}
 
+	if c.Host != "" {
+		if c.SocketPath != "" {
+			return fmt.Errorf("host(%s) can not be used with socket path: %w", c.Host, ErrInvalidHost)
+		}
+		if net.ParseIP(c.Host) == nil {
+			return fmt.Errorf("%s: %w", c.Host, ErrInvalidHost)
+		}
+	}
+
 	// when specific cert or key file are configured
 	if c.CertFile != "" {
 		if _, err := os.Stat(c.CertFile); err != nil {
@@ -65,5 +207,151 @@ func (c *Config) Validate() error {
 		}
 	}
 
+	if c.MaxRequestBytes > math.MaxInt32 {
+		return fmt.Errorf("must be at most %d, given %d: %w", math.MaxInt32, c.MaxRequestBytes, ErrInvalidMaxRequestBytes)
+	}
+
+	if c.MaxResponseBytes > math.MaxInt32 {
+		return fmt.Errorf("must be at most %d, given %d: %w", math.MaxInt32, c.MaxResponseBytes, ErrInvalidMaxResponseBytes)
+	}
+
+	if c.WarmupDelay != "" {
+		delay, err := time.ParseDuration(c.WarmupDelay)
+		if err != nil || delay < 0 {
+			return fmt.Errorf("%s: %w", c.WarmupDelay, ErrInvalidWarmupDelay)
+		}
+	}
+
+	if c.WatchKeepaliveInterval != "" {
+		interval, err := time.ParseDuration(c.WatchKeepaliveInterval)
+		if err != nil || interval < 0 {
+			return fmt.Errorf("%s: %w", c.WatchKeepaliveInterval, ErrInvalidWatchKeepaliveInterval)
+		}
+	}
+
+	if c.HealthCheckInterval != "" {
+		interval, err := time.ParseDuration(c.HealthCheckInterval)
+		if err != nil || interval < 0 {
+			return fmt.Errorf("%s: %w", c.HealthCheckInterval, ErrInvalidHealthCheckInterval)
+		}
+	}
+
+	keepalives := []struct {
+		value string
+		err   error
+	}{
+		{c.KeepaliveMaxConnectionIdle, ErrInvalidKeepaliveMaxConnectionIdle},
+		{c.KeepaliveTime, ErrInvalidKeepaliveTime},
+		{c.KeepaliveTimeout, ErrInvalidKeepaliveTimeout},
+		{c.KeepaliveMinTime, ErrInvalidKeepaliveMinTime},
+	}
+	for _, param := range keepalives {
+		if param.value == "" {
+			continue
+		}
+
+		duration, err := time.ParseDuration(param.value)
+		if err != nil || duration < 0 {
+			return fmt.Errorf("%s: %w", param.value, param.err)
+		}
+	}
+
 	return nil
 }
+
+// KeepaliveServerParameters returns the keepalive parameters of the server.
+// The defaults are used for the durations that are not set.
+func (c *Config) KeepaliveServerParameters() keepalive.ServerParameters {
+	return keepalive.ServerParameters{
+		MaxConnectionIdle: parseDuration(c.KeepaliveMaxConnectionIdle, 0),
+		Time:              parseDuration(c.KeepaliveTime, DefaultKeepaliveTime),
+		Timeout:           parseDuration(c.KeepaliveTimeout, DefaultKeepaliveTimeout),
+	}
+}
+
+// KeepaliveEnforcementPolicy returns the policy the server enforces on the
+// keepalive pings of the clients.
+func (c *Config) KeepaliveEnforcementPolicy() keepalive.EnforcementPolicy {
+	return keepalive.EnforcementPolicy{
+		MinTime:             parseDuration(c.KeepaliveMinTime, DefaultKeepaliveMinTime),
+		PermitWithoutStream: c.KeepalivePermitWithoutStream,
+	}
+}
+
+// Addr returns the TCP address the RPC server listens on.
+func (c *Config) Addr() string {
+	return net.JoinHostPort(c.Host, strconv.Itoa(c.Port))
+}
+
+// MaxSendMsgSize returns the maximum size of the messages the server sends.
+func (c *Config) MaxSendMsgSize() int {
+	if c.MaxResponseBytes == 0 {
+		return math.MaxInt32
+	}
+
+	return int(c.MaxResponseBytes)
+}
+
+// ParseWarmupDelay returns the duration during which the health status stays
+// NOT_SERVING after the start.
+func (c *Config) ParseWarmupDelay() time.Duration {
+	if c.WarmupDelay == "" {
+		return 0
+	}
+
+	result, err := time.ParseDuration(c.WarmupDelay)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
+
+// ParseWatchKeepaliveInterval returns the interval at which the server sends a
+// keepalive message over the watch streams.
+func (c *Config) ParseWatchKeepaliveInterval() time.Duration {
+	if c.WatchKeepaliveInterval == "" {
+		return 0
+	}
+
+	result, err := time.ParseDuration(c.WatchKeepaliveInterval)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
+
+// ParseHealthCheckInterval returns the interval at which the server checks the
+// dependencies of the backend.
+func (c *Config) ParseHealthCheckInterval() time.Duration {
+	if c.HealthCheckInterval == "" {
+		return 0
+	}
+
+	result, err := time.ParseDuration(c.HealthCheckInterval)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
+
+// parseDuration parses the given duration. It returns the given default if the
+// duration is empty or zero.
+func parseDuration(value string, defaultValue time.Duration) time.Duration {
+	if value == "" {
+		return defaultValue
+	}
+
+	result, err := time.ParseDuration(value)
+	if err != nil {
+		panic(err)
+	}
+
+	if result == 0 {
+		return defaultValue
+	}
+
+	return result
+}
@@ -0,0 +1,59 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rpc
+
+import (
+	"context"
+	"sync/atomic"
+
+	"google.golang.org/grpc/stats"
+)
+
+// connTracker is a stats.Handler that tracks the number of the open
+// connections of the server, so that the connections left after draining can
+// be reported.
+type connTracker struct {
+	conns int64
+}
+
+// TagRPC returns the given context as is.
+func (t *connTracker) TagRPC(ctx context.Context, _ *stats.RPCTagInfo) context.Context {
+	return ctx
+}
+
+// HandleRPC does nothing because only the connections are tracked.
+func (t *connTracker) HandleRPC(_ context.Context, _ stats.RPCStats) {}
+
+// TagConn returns the given context as is.
+func (t *connTracker) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+// HandleConn counts the connections that begin and end.
+func (t *connTracker) HandleConn(_ context.Context, s stats.ConnStats) {
+	switch s.(type) {
+	case *stats.ConnBegin:
+		atomic.AddInt64(&t.conns, 1)
+	case *stats.ConnEnd:
+		atomic.AddInt64(&t.conns, -1)
+	}
+}
+
+// Len returns the number of the open connections.
+func (t *connTracker) Len() int {
+	return int(atomic.LoadInt64(&t.conns))
+}
@@ -30,13 +30,14 @@ import (
 
 // AuthInterceptor is an interceptor for authentication.
 type AuthInterceptor struct {
-	webhook string
+	enabled bool
 }
 
-// NewAuthInterceptor creates a new instance of AuthInterceptor.
-func NewAuthInterceptor(webhook string) *AuthInterceptor {
+// NewAuthInterceptor creates a new instance of AuthInterceptor. If enabled,
+// the requests without the token are rejected.
+func NewAuthInterceptor(enabled bool) *AuthInterceptor {
 	return &AuthInterceptor{
-		webhook: webhook,
+		enabled: enabled,
 	}
 }
 
@@ -56,6 +57,12 @@ func (i *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
 			return handler(auth.CtxWithToken(ctx, token), req)
 		}
 
+		// NOTE: Admin RPCs are authorized with the admin token even if the
+		// authorization is not enabled.
+		if token, err := i.extractToken(ctx); err == nil {
+			return handler(auth.CtxWithToken(ctx, token), req)
+		}
+
 		return handler(ctx, req)
 	}
 }
@@ -78,12 +85,20 @@ func (i *AuthInterceptor) Stream() grpc.StreamServerInterceptor {
 			return handler(srv, wrapped)
 		}
 
+		// NOTE: Admin RPCs are authorized with the admin token even if the
+		// authorization is not enabled.
+		if token, err := i.extractToken(ss.Context()); err == nil {
+			wrapped := grpcmiddleware.WrapServerStream(ss)
+			wrapped.WrappedContext = auth.CtxWithToken(ss.Context(), token)
+			return handler(srv, wrapped)
+		}
+
 		return handler(srv, ss)
 	}
 }
 
 func (i *AuthInterceptor) needAuth() bool {
-	return len(i.webhook) > 0
+	return i.enabled
 }
 
 func (i *AuthInterceptor) extractToken(ctx context.Context) (string, error) {
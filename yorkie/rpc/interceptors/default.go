@@ -21,20 +21,26 @@ import (
 	"errors"
 	gotime "time"
 
+	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/rs/xid"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/yorkie/auth"
+	"github.com/yorkie-team/yorkie/yorkie/backend"
 	"github.com/yorkie-team/yorkie/yorkie/backend/db"
+	"github.com/yorkie-team/yorkie/yorkie/backend/fairqueue"
 	"github.com/yorkie-team/yorkie/yorkie/clients"
 	"github.com/yorkie-team/yorkie/yorkie/logging"
 	"github.com/yorkie-team/yorkie/yorkie/packs"
 )
 
-// DefaultInterceptor is a interceptor for default.
+// DefaultInterceptor is a interceptor for default. It generates the ID of
+// each request and puts it in the context.
 type DefaultInterceptor struct{}
 
 // NewDefaultInterceptor creates a new instance of DefaultInterceptor.
@@ -50,6 +56,8 @@ func (i *DefaultInterceptor) Unary() grpc.UnaryServerInterceptor {
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
+		ctx = logging.WithRequestID(ctx, xid.New().String())
+
 		start := gotime.Now()
 		resp, err := handler(ctx, req)
 		reqLogger := logging.From(ctx)
@@ -73,10 +81,12 @@ func (i *DefaultInterceptor) Stream() grpc.StreamServerInterceptor {
 		info *grpc.StreamServerInfo,
 		handler grpc.StreamHandler,
 	) error {
-		reqLogger := logging.From(ss.Context())
+		wrapped := grpcmiddleware.WrapServerStream(ss)
+		wrapped.WrappedContext = logging.WithRequestID(ss.Context(), xid.New().String())
+		reqLogger := logging.From(wrapped.Context())
 
 		start := gotime.Now()
-		err := handler(srv, ss)
+		err := handler(srv, wrapped)
 		if err != nil {
 			reqLogger.Warnf("RPC : stream %q %s => %q", info.FullMethod, gotime.Since(start), err.Error())
 			return toStatusError(err)
@@ -97,12 +107,32 @@ func toStatusError(err error) error {
 		return status.Error(codes.Unauthenticated, err.Error())
 	}
 
+	if errors.Is(err, auth.ErrNotAdmin) ||
+		errors.Is(err, auth.ErrAdminDisabled) {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+
 	if errors.Is(err, converter.ErrPackRequired) ||
 		errors.Is(err, converter.ErrCheckpointRequired) ||
+		errors.Is(err, converter.ErrDocumentKeyRequired) ||
+		errors.Is(err, converter.ErrTimeTicketRequired) ||
+		errors.Is(err, key.ErrInvalidKey) ||
 		errors.Is(err, time.ErrInvalidHexString) ||
 		errors.Is(err, db.ErrInvalidID) ||
 		errors.Is(err, clients.ErrInvalidClientID) ||
-		errors.Is(err, clients.ErrInvalidClientKey) {
+		errors.Is(err, clients.ErrInvalidClientKey) ||
+		errors.Is(err, logging.ErrInvalidLogLevel) ||
+		errors.Is(err, auth.ErrInvalidMethod) ||
+		errors.Is(err, auth.ErrInvalidVerb) ||
+		errors.Is(err, packs.ErrInvalidSnapshotOffset) ||
+		errors.Is(err, backend.ErrMaxChangesPerPullTooLarge) ||
+		errors.Is(err, db.ErrUnknownDocSetting) ||
+		errors.Is(err, packs.ErrDuplicateChange) ||
+		errors.Is(err, packs.ErrLamportSkew) ||
+		errors.Is(err, packs.ErrInvalidSignature) ||
+		errors.Is(err, packs.ErrChangeRejected) ||
+		errors.Is(err, clients.ErrInvalidPublicKey) ||
+		errors.Is(err, converter.ErrInvalidPublicKey) {
 		return status.Error(codes.InvalidArgument, err.Error())
 	}
 
@@ -115,7 +145,8 @@ func toStatusError(err error) error {
 	}
 
 	if errors.Is(err, db.ErrClientNotFound) ||
-		errors.Is(err, db.ErrDocumentNotFound) {
+		errors.Is(err, db.ErrDocumentNotFound) ||
+		errors.Is(err, packs.ErrSnapshotNotFound) {
 		return status.Error(codes.NotFound, err.Error())
 	}
 
@@ -123,9 +154,22 @@ func toStatusError(err error) error {
 		err == db.ErrDocumentNotAttached ||
 		err == db.ErrDocumentAlreadyAttached ||
 		errors.Is(err, packs.ErrInvalidServerSeq) ||
+		errors.Is(err, packs.ErrChangePackOutOfOrder) ||
+		errors.Is(err, packs.ErrSnapshotInProgress) ||
+		errors.Is(err, backend.ErrDocumentQuarantined) ||
+		errors.Is(err, packs.ErrUnsignedChange) ||
+		errors.Is(err, clients.ErrPublicKeyMismatch) ||
 		errors.Is(err, db.ErrConflictOnUpdate) {
 		return status.Error(codes.FailedPrecondition, err.Error())
 	}
 
+	if errors.Is(err, packs.ErrTooManyActors) ||
+		errors.Is(err, packs.ErrTooManyChanges) ||
+		errors.Is(err, auth.ErrWebhookBodyTooLarge) ||
+		errors.Is(err, fairqueue.ErrQueueFull) ||
+		errors.Is(err, backend.ErrRateLimited) {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+
 	return status.Error(codes.Internal, err.Error())
 }
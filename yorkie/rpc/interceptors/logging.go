@@ -2,12 +2,18 @@ package interceptors
 
 import (
 	"context"
+	"encoding/hex"
 	"strconv"
 	"sync/atomic"
+	gotime "time"
 
+	"github.com/golang/protobuf/proto"
 	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
 
+	"github.com/yorkie-team/yorkie/api"
+	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/yorkie/logging"
 )
 
@@ -18,14 +24,40 @@ func (c *reqID) next() string {
 	return "r" + strconv.Itoa(int(next))
 }
 
-// LoggingInterceptor is an interceptor for request logging.
+// RedactFunc returns the request to be logged in place of the given request,
+// so that the sensitive fields of it are not logged.
+type RedactFunc func(req interface{}) interface{}
+
+// LoggingInterceptor is an interceptor for request logging. If the request
+// logging is enabled, it also logs every RPC with the method, the client, the
+// documents and the duration.
 type LoggingInterceptor struct {
 	reqID reqID
+
+	requestLogging bool
+	skipMethods    map[string]struct{}
+	redact         RedactFunc
 }
 
-// NewLoggingInterceptor creates a new instance of LoggingInterceptor.
-func NewLoggingInterceptor() *LoggingInterceptor {
-	return &LoggingInterceptor{}
+// NewLoggingInterceptor creates a new instance of LoggingInterceptor. The
+// RPCs of the given methods are not logged, and the requests are logged after
+// the given redact function is applied. If redact is nil, the requests
+// themselves are not logged.
+func NewLoggingInterceptor(
+	requestLogging bool,
+	skipMethods []string,
+	redact RedactFunc,
+) *LoggingInterceptor {
+	methods := make(map[string]struct{})
+	for _, method := range skipMethods {
+		methods[method] = struct{}{}
+	}
+
+	return &LoggingInterceptor{
+		requestLogging: requestLogging,
+		skipMethods:    methods,
+		redact:         redact,
+	}
 }
 
 // Unary creates a unary server interceptor for request logging.
@@ -37,11 +69,19 @@ func (i *LoggingInterceptor) Unary() grpc.UnaryServerInterceptor {
 		handler grpc.UnaryHandler,
 	) (resp interface{}, err error) {
 		reqLogger := logging.New(i.reqID.next())
-		return handler(logging.With(ctx, reqLogger), req)
+		if !i.needLogging(info.FullMethod) {
+			return handler(logging.With(ctx, reqLogger), req)
+		}
+
+		start := gotime.Now()
+		resp, err = handler(logging.With(ctx, reqLogger), req)
+		i.logRequest(reqLogger, info.FullMethod, req, gotime.Since(start), err)
+		return resp, err
 	}
 }
 
-// Stream creates a stream server interceptor for request logging.
+// Stream creates a stream server interceptor for request logging. The client
+// and the documents of a stream are taken from its first received message.
 func (i *LoggingInterceptor) Stream() grpc.StreamServerInterceptor {
 	return func(
 		srv interface{},
@@ -52,6 +92,121 @@ func (i *LoggingInterceptor) Stream() grpc.StreamServerInterceptor {
 		reqLogger := logging.New(i.reqID.next())
 		wrapped := grpcmiddleware.WrapServerStream(ss)
 		wrapped.WrappedContext = logging.With(ss.Context(), reqLogger)
-		return handler(srv, wrapped)
+		if !i.needLogging(info.FullMethod) {
+			return handler(srv, wrapped)
+		}
+
+		stream := &recordedStream{WrappedServerStream: wrapped}
+		start := gotime.Now()
+		err := handler(srv, stream)
+		i.logRequest(reqLogger, info.FullMethod, stream.first, gotime.Since(start), err)
+		return err
+	}
+}
+
+// needLogging returns whether the RPCs of the given method should be logged.
+func (i *LoggingInterceptor) needLogging(method string) bool {
+	if !i.requestLogging {
+		return false
+	}
+
+	_, skip := i.skipMethods[method]
+	return !skip
+}
+
+// logRequest logs the given RPC with its fields.
+func (i *LoggingInterceptor) logRequest(
+	reqLogger logging.Logger,
+	method string,
+	req interface{},
+	duration gotime.Duration,
+	err error,
+) {
+	fields := []interface{}{
+		"method", method,
+		"client_id", clientIDOf(req),
+		"document_keys", documentKeysOf(req),
+		"duration", duration,
+		"code", status.Code(err).String(),
+	}
+	if i.redact != nil && req != nil {
+		fields = append(fields, "request", i.redact(req))
+	}
+
+	reqLogger.Infow("RPC", fields...)
+}
+
+// recordedStream is a server stream that records the first received message.
+type recordedStream struct {
+	*grpcmiddleware.WrappedServerStream
+	first interface{}
+}
+
+// RecvMsg receives a message and records it if it is the first one.
+func (s *recordedStream) RecvMsg(m interface{}) error {
+	if err := s.WrappedServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	if s.first == nil {
+		s.first = m
+	}
+	return nil
+}
+
+// RedactPayloads is a RedactFunc that removes the changes and the snapshot of
+// the change pack of the given request, so that the contents of the documents
+// are not logged.
+func RedactPayloads(req interface{}) interface{} {
+	withPack, ok := req.(interface{ GetChangePack() *api.ChangePack })
+	if !ok || withPack.GetChangePack() == nil {
+		return req
+	}
+
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return req
+	}
+
+	redacted := proto.Clone(msg)
+	pack := redacted.(interface{ GetChangePack() *api.ChangePack }).GetChangePack()
+	pack.Changes = nil
+	pack.Snapshot = nil
+	return redacted
+}
+
+// clientIDOf returns the hex encoded ID of the client that sent the given
+// request. It returns an empty string if the request does not have the client.
+func clientIDOf(req interface{}) string {
+	switch req := req.(type) {
+	case interface{ GetClientId() []byte }:
+		return hex.EncodeToString(req.GetClientId())
+	case *api.WatchDocumentsRequest:
+		return hex.EncodeToString(req.GetClient().GetId())
+	default:
+		return ""
+	}
+}
+
+// documentKeysOf returns the keys of the documents of the given request.
+func documentKeysOf(req interface{}) []string {
+	var pbKeys []*api.DocumentKey
+	switch req := req.(type) {
+	case interface{ GetChangePack() *api.ChangePack }:
+		if pack := req.GetChangePack(); pack != nil && pack.DocumentKey != nil {
+			pbKeys = append(pbKeys, pack.DocumentKey)
+		}
+	case interface{ GetDocumentKey() *api.DocumentKey }:
+		if pbKey := req.GetDocumentKey(); pbKey != nil {
+			pbKeys = append(pbKeys, pbKey)
+		}
+	case *api.WatchDocumentsRequest:
+		pbKeys = req.GetDocumentKeys()
+	}
+
+	var keys []string
+	for _, docKey := range converter.FromDocumentKeys(pbKeys) {
+		keys = append(keys, docKey.BSONKey())
 	}
+	return keys
 }
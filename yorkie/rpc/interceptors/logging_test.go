@@ -0,0 +1,51 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interceptors_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api"
+	"github.com/yorkie-team/yorkie/yorkie/rpc/interceptors"
+)
+
+func TestRedactPayloads(t *testing.T) {
+	req := &api.PushPullRequest{
+		ClientId: []byte{1, 2, 3},
+		ChangePack: &api.ChangePack{
+			DocumentKey: &api.DocumentKey{Collection: "c1", Document: "d1"},
+			Checkpoint:  &api.Checkpoint{ServerSeq: 1, ClientSeq: 1},
+			Snapshot:    []byte("snapshot"),
+			Changes:     []*api.Change{{}},
+		},
+	}
+
+	redacted := interceptors.RedactPayloads(req).(*api.PushPullRequest)
+	assert.Equal(t, req.ClientId, redacted.ClientId)
+	assert.Equal(t, req.ChangePack.DocumentKey.Document, redacted.ChangePack.DocumentKey.Document)
+	assert.Nil(t, redacted.ChangePack.Snapshot)
+	assert.Nil(t, redacted.ChangePack.Changes)
+
+	// NOTE: The request itself is not modified.
+	assert.Equal(t, []byte("snapshot"), req.ChangePack.Snapshot)
+	assert.Len(t, req.ChangePack.Changes, 1)
+
+	other := &api.ActivateClientRequest{ClientKey: "c1"}
+	assert.Equal(t, other, interceptors.RedactPayloads(other))
+}
@@ -0,0 +1,95 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interceptors
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/yorkie-team/yorkie/yorkie/backend"
+)
+
+// RateLimitInterceptor is an interceptor that limits the rate of the requests
+// of each client.
+type RateLimitInterceptor struct {
+	be *backend.Backend
+}
+
+// NewRateLimitInterceptor creates a new instance of RateLimitInterceptor.
+func NewRateLimitInterceptor(be *backend.Backend) *RateLimitInterceptor {
+	return &RateLimitInterceptor{
+		be: be,
+	}
+}
+
+// Unary creates a unary server interceptor for rate limiting.
+func (i *RateLimitInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if err := i.allow(req); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// Stream creates a stream server interceptor for rate limiting. The messages
+// received over the stream are limited as the requests of the client.
+func (i *RateLimitInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return handler(srv, &rateLimitedStream{ServerStream: ss, interceptor: i})
+	}
+}
+
+// allow returns an error if the client of the given request has sent more
+// requests than the rate limit allows. The requests without the client are
+// not limited.
+func (i *RateLimitInterceptor) allow(req interface{}) error {
+	clientID := clientIDOf(req)
+	if clientID == "" {
+		return nil
+	}
+
+	return i.be.AllowRequest(clientID)
+}
+
+// rateLimitedStream is a server stream that limits the rate of the received
+// messages.
+type rateLimitedStream struct {
+	grpc.ServerStream
+	interceptor *RateLimitInterceptor
+}
+
+// RecvMsg receives a message and checks the rate limit of its client.
+func (s *rateLimitedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	return s.interceptor.allow(m)
+}
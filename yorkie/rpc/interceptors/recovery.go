@@ -0,0 +1,90 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interceptors
+
+import (
+	"context"
+	"runtime/debug"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/yorkie/logging"
+	"github.com/yorkie-team/yorkie/yorkie/profiling/prometheus"
+)
+
+// RecoveryInterceptor is an interceptor that recovers the panics in the RPCs,
+// so that a panic in a handler does not crash the server.
+type RecoveryInterceptor struct {
+	metrics *prometheus.Metrics
+}
+
+// NewRecoveryInterceptor creates a new instance of RecoveryInterceptor. It
+// should be the outermost interceptor to recover the panics in the other
+// interceptors as well.
+func NewRecoveryInterceptor(metrics *prometheus.Metrics) *RecoveryInterceptor {
+	return &RecoveryInterceptor{
+		metrics: metrics,
+	}
+}
+
+// Unary creates a unary server interceptor for panic recovery.
+func (i *RecoveryInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (resp interface{}, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = i.handlePanic(info.FullMethod, r)
+			}
+		}()
+
+		return handler(ctx, req)
+	}
+}
+
+// Stream creates a stream server interceptor for panic recovery.
+func (i *RecoveryInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = i.handlePanic(info.FullMethod, r)
+			}
+		}()
+
+		return handler(srv, ss)
+	}
+}
+
+// handlePanic logs the given recovered value of a panic in the given method
+// with the stack and returns the error to be sent to the client. The value is
+// not sent to the client because it may contain the internals of the server.
+func (i *RecoveryInterceptor) handlePanic(method string, r interface{}) error {
+	logging.DefaultLogger().Errorf("RPC: panic in %q: %v\n%s", method, r, debug.Stack())
+	i.metrics.AddRPCPanics(method, 1)
+
+	return status.Error(codes.Internal, "internal error")
+}
@@ -0,0 +1,87 @@
+/*
+ * Copyright 2022 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package interceptors_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yorkie-team/yorkie/yorkie/profiling/prometheus"
+	"github.com/yorkie-team/yorkie/yorkie/rpc/interceptors"
+)
+
+func TestRecoveryInterceptor(t *testing.T) {
+	met, err := prometheus.NewMetrics()
+	assert.NoError(t, err)
+	interceptor := interceptors.NewRecoveryInterceptor(met)
+
+	// panics returns the recovered panics of the given method in the metrics.
+	panics := func(method string) float64 {
+		families, err := met.Registry().Gather()
+		assert.NoError(t, err)
+		for _, family := range families {
+			if family.GetName() != "yorkie_rpc_panics_total" {
+				continue
+			}
+			for _, metric := range family.GetMetric() {
+				if metric.GetLabel()[0].GetValue() == method {
+					return metric.GetCounter().GetValue()
+				}
+			}
+		}
+		return 0
+	}
+
+	t.Run("unary panic test", func(t *testing.T) {
+		info := &grpc.UnaryServerInfo{FullMethod: "/api.Yorkie/PushPull"}
+		_, err := interceptor.Unary()(context.Background(), nil, info, func(
+			ctx context.Context,
+			req interface{},
+		) (interface{}, error) {
+			panic("unexpected")
+		})
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Equal(t, float64(1), panics(info.FullMethod))
+	})
+
+	t.Run("stream panic test", func(t *testing.T) {
+		info := &grpc.StreamServerInfo{FullMethod: "/api.Yorkie/WatchDocuments"}
+		err := interceptor.Stream()(nil, nil, info, func(srv interface{}, stream grpc.ServerStream) error {
+			panic("unexpected")
+		})
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Equal(t, float64(1), panics(info.FullMethod))
+	})
+
+	t.Run("no panic test", func(t *testing.T) {
+		info := &grpc.UnaryServerInfo{FullMethod: "/api.Yorkie/ActivateClient"}
+		resp, err := interceptor.Unary()(context.Background(), nil, info, func(
+			ctx context.Context,
+			req interface{},
+		) (interface{}, error) {
+			return "ok", nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", resp)
+		assert.Equal(t, float64(0), panics(info.FullMethod))
+	})
+}
@@ -18,15 +18,20 @@ package rpc
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"math"
 	"net"
+	"os"
+	"sync"
+	"time"
 
 	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/health"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 
 	"github.com/yorkie-team/yorkie/api"
 	"github.com/yorkie-team/yorkie/yorkie/backend"
@@ -34,65 +39,182 @@ import (
 	"github.com/yorkie-team/yorkie/yorkie/rpc/interceptors"
 )
 
+// socketFileMode is the file mode of the UNIX domain socket.
+const socketFileMode = 0660
+
 // Server is a normal server that processes the logic requested by the client.
 type Server struct {
 	conf                *Config
+	backend             *backend.Backend
 	grpcServer          *grpc.Server
+	healthServer        *health.Server
+	connTracker         *connTracker
+	certReloader        *certReloader
+	yorkieServiceCtx    context.Context
 	yorkieServiceCancel context.CancelFunc
+
+	// healthMu guards the conditions of the health status. The status is
+	// SERVING only if the server is warmed up and the dependencies are up.
+	healthMu       sync.Mutex
+	warmedUp       bool
+	dependenciesUp bool
 }
 
 // NewServer creates a new instance of Server.
 func NewServer(conf *Config, be *backend.Backend) (*Server, error) {
-	loggingInterceptor := interceptors.NewLoggingInterceptor()
-	authInterceptor := interceptors.NewAuthInterceptor(be.Config.AuthWebhookURL)
+	recoveryInterceptor := interceptors.NewRecoveryInterceptor(be.Metrics)
+	loggingInterceptor := interceptors.NewLoggingInterceptor(
+		conf.RequestLogging,
+		conf.RequestLogSkipMethods,
+		interceptors.RedactPayloads,
+	)
+	authInterceptor := interceptors.NewAuthInterceptor(be.AuthEnabled())
 	defaultInterceptor := interceptors.NewDefaultInterceptor()
+	rateLimitInterceptor := interceptors.NewRateLimitInterceptor(be)
+	tracker := &connTracker{}
 
 	opts := []grpc.ServerOption{
+		grpc.StatsHandler(tracker),
 		grpc.UnaryInterceptor(grpcmiddleware.ChainUnaryServer(
+			recoveryInterceptor.Unary(),
 			loggingInterceptor.Unary(),
 			be.Metrics.ServerMetrics().UnaryServerInterceptor(),
 			authInterceptor.Unary(),
 			defaultInterceptor.Unary(),
+			rateLimitInterceptor.Unary(),
 		)),
 		grpc.StreamInterceptor(grpcmiddleware.ChainStreamServer(
+			recoveryInterceptor.Stream(),
 			loggingInterceptor.Stream(),
 			be.Metrics.ServerMetrics().StreamServerInterceptor(),
 			authInterceptor.Stream(),
 			defaultInterceptor.Stream(),
+			rateLimitInterceptor.Stream(),
 		)),
 	}
 
+	var reloader *certReloader
 	if conf.CertFile != "" && conf.KeyFile != "" {
-		creds, err := credentials.NewServerTLSFromFile(conf.CertFile, conf.KeyFile)
+		var err error
+		reloader, err = newCertReloader(conf.CertFile, conf.KeyFile)
 		if err != nil {
 			logging.DefaultLogger().Error(err)
 			return nil, err
 		}
-		opts = append(opts, grpc.Creds(creds))
+		opts = append(opts, grpc.Creds(credentials.NewTLS(&tls.Config{
+			GetCertificate: reloader.GetCertificate,
+		})))
 	}
 
 	opts = append(opts, grpc.MaxRecvMsgSize(int(conf.MaxRequestBytes)))
-	opts = append(opts, grpc.MaxSendMsgSize(math.MaxInt32))
+	opts = append(opts, grpc.MaxSendMsgSize(conf.MaxSendMsgSize()))
 	opts = append(opts, grpc.MaxConcurrentStreams(math.MaxUint32))
+	opts = append(opts, grpc.KeepaliveParams(conf.KeepaliveServerParameters()))
+	opts = append(opts, grpc.KeepaliveEnforcementPolicy(conf.KeepaliveEnforcementPolicy()))
 
 	yorkieServiceCtx, yorkieServiceCancel := context.WithCancel(context.Background())
 
 	grpcServer := grpc.NewServer(opts...)
-	healthpb.RegisterHealthServer(grpcServer, health.NewServer())
-	api.RegisterYorkieServer(grpcServer, newYorkieServer(yorkieServiceCtx, be))
+	healthServer := health.NewServer()
+	if conf.ParseWarmupDelay() > 0 {
+		healthServer.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	api.RegisterYorkieServer(grpcServer, newYorkieServer(yorkieServiceCtx, conf, be))
 	api.RegisterClusterServer(grpcServer, newClusterServer(be))
+	api.RegisterAdminServer(grpcServer, newAdminServer(yorkieServiceCtx, be))
 	be.Metrics.RegisterGRPCServer(grpcServer)
+	if conf.EnableReflection {
+		reflection.Register(grpcServer)
+	}
 
 	return &Server{
 		conf:                conf,
+		backend:             be,
 		grpcServer:          grpcServer,
+		healthServer:        healthServer,
+		connTracker:         tracker,
+		certReloader:        reloader,
+		yorkieServiceCtx:    yorkieServiceCtx,
 		yorkieServiceCancel: yorkieServiceCancel,
+		warmedUp:            conf.ParseWarmupDelay() == 0,
+		dependenciesUp:      true,
 	}, nil
 }
 
-// Start starts this server by opening the rpc port.
+// Start starts this server by opening the rpc port. If the warmup delay is
+// configured, the health status becomes SERVING after the delay. If the health
+// check interval is configured, the dependencies of the backend are checked
+// periodically.
 func (s *Server) Start() error {
-	return s.listenAndServeGRPC()
+	if err := s.listenAndServeGRPC(); err != nil {
+		return err
+	}
+
+	if delay := s.conf.ParseWarmupDelay(); delay > 0 {
+		go s.warmup(delay)
+	}
+
+	if interval := s.conf.ParseHealthCheckInterval(); interval > 0 {
+		go s.checkHealth(interval)
+	}
+
+	return nil
+}
+
+// warmup sets the health status to SERVING after the given delay unless this
+// server is shut down meanwhile.
+func (s *Server) warmup(delay time.Duration) {
+	select {
+	case <-time.After(delay):
+		s.healthMu.Lock()
+		s.warmedUp = true
+		s.updateServingStatus()
+		s.healthMu.Unlock()
+		logging.DefaultLogger().Infof("RPC: warmed up after %s", delay)
+	case <-s.yorkieServiceCtx.Done():
+	}
+}
+
+// checkHealth pings the dependencies of the backend at the given interval and
+// updates the health status until this server is shut down.
+func (s *Server) checkHealth(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(s.yorkieServiceCtx, interval)
+			err := s.backend.CheckHealth(ctx)
+			cancel()
+
+			s.healthMu.Lock()
+			if up := err == nil; up != s.dependenciesUp {
+				if up {
+					logging.DefaultLogger().Infof("RPC: dependencies are up")
+				} else {
+					logging.DefaultLogger().Warnf("RPC: dependencies are down: %s", err)
+				}
+				s.dependenciesUp = up
+				s.updateServingStatus()
+			}
+			s.healthMu.Unlock()
+		case <-s.yorkieServiceCtx.Done():
+			return
+		}
+	}
+}
+
+// updateServingStatus sets the health status from its conditions. It must be
+// called with healthMu held.
+func (s *Server) updateServingStatus() {
+	if s.warmedUp && s.dependenciesUp {
+		s.healthServer.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+		return
+	}
+
+	s.healthServer.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
 }
 
 // Shutdown shuts down this server.
@@ -106,20 +228,61 @@ func (s *Server) Shutdown(graceful bool) {
 	}
 }
 
+// Drain shuts down this server gracefully within the deadline of the given
+// context. It stops accepting new RPCs, cancels the watch streams that never
+// end on their own and waits for the in-flight RPCs. If the deadline elapses,
+// the remaining connections are closed forcibly. It returns whether the drain
+// completed cleanly.
+func (s *Server) Drain(ctx context.Context) bool {
+	s.healthServer.Shutdown()
+
+	stopped := make(chan struct{})
+	go func() {
+		s.grpcServer.GracefulStop()
+		close(stopped)
+	}()
+	s.yorkieServiceCancel()
+
+	select {
+	case <-stopped:
+		return true
+	case <-ctx.Done():
+		logging.DefaultLogger().Warnf(
+			"RPC: drain deadline exceeded, closing %d connections",
+			s.connTracker.Len(),
+		)
+		s.grpcServer.Stop()
+		<-stopped
+		return false
+	}
+}
+
+// ReloadCertificates reloads the TLS certificate from the files of the config.
+// The certificate is also reloaded on the handshakes after the files are
+// modified, but it can be triggered explicitly, for example on SIGHUP. It
+// does nothing if TLS is not enabled.
+func (s *Server) ReloadCertificates() error {
+	if s.certReloader == nil {
+		return nil
+	}
+
+	return s.certReloader.Reload()
+}
+
 // GRPCServer returns the gRPC server.
 func (s *Server) GRPCServer() *grpc.Server {
 	return s.grpcServer
 }
 
 func (s *Server) listenAndServeGRPC() error {
-	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", s.conf.Port))
+	lis, err := s.listen()
 	if err != nil {
 		logging.DefaultLogger().Error(err)
 		return err
 	}
 
 	go func() {
-		logging.DefaultLogger().Infof("serving RPC on %d", s.conf.Port)
+		logging.DefaultLogger().Infof("serving RPC on %s", lis.Addr())
 
 		if err := s.grpcServer.Serve(lis); err != nil {
 			if err != grpc.ErrServerStopped {
@@ -130,3 +293,30 @@ func (s *Server) listenAndServeGRPC() error {
 
 	return nil
 }
+
+// listen opens the UNIX domain socket if the socket path is configured, or
+// the TCP port otherwise. The stale socket file left by the previous server is
+// removed first.
+func (s *Server) listen() (net.Listener, error) {
+	if s.conf.SocketPath == "" {
+		return net.Listen("tcp", s.conf.Addr())
+	}
+
+	if err := os.Remove(s.conf.SocketPath); err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("remove stale socket: %w", err)
+	}
+
+	lis, err := net.Listen("unix", s.conf.SocketPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: The socket is accessible only to the owner and the group, so that
+	//       the sidecars in the group can connect to it.
+	if err := os.Chmod(s.conf.SocketPath, socketFileMode); err != nil {
+		_ = lis.Close()
+		return nil, fmt.Errorf("chmod socket: %w", err)
+	}
+
+	return lis, nil
+}
@@ -19,19 +19,34 @@ package rpc_test
 import (
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"io"
 	"log"
+	"math"
 	"os"
+	"path/filepath"
+	"sync/atomic"
 	"testing"
+	gotime "time"
 
+	protoTypes "github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	"github.com/yorkie-team/yorkie/api"
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/internal/version"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/proxy"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/test/helper"
 	"github.com/yorkie-team/yorkie/yorkie/backend"
+	"github.com/yorkie-team/yorkie/yorkie/backend/db"
 	"github.com/yorkie-team/yorkie/yorkie/backend/db/mongo"
 	"github.com/yorkie-team/yorkie/yorkie/backend/housekeeping"
 	"github.com/yorkie-team/yorkie/yorkie/backend/sync/etcd"
@@ -44,105 +59,1168 @@ var (
 	emptyClientID, _   = hex.DecodeString("")
 	invalidClientID, _ = hex.DecodeString("invalid")
 
+	actorLimitedCollection = "actor-limited"
+
 	testRPCServer *rpc.Server
 	testRPCAddr   = fmt.Sprintf("localhost:%d", helper.RPCPort)
 	testClient    api.YorkieClient
+	testAdmin     api.AdminClient
+	testCluster   api.ClusterClient
+
+	invalidChangePack = &api.ChangePack{
+		DocumentKey: &api.DocumentKey{
+			Collection: "invalid", Document: "invalid",
+		},
+		Checkpoint: nil,
+	}
+)
+
+func TestMain(m *testing.M) {
+	met, err := prometheus.NewMetrics()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	be, err := backend.New(&backend.Config{
+		SnapshotThreshold:    helper.SnapshotThreshold,
+		MaxChangesPerPull:    helper.MaxChangesPerPull,
+		AdminToken:           helper.AdminToken,
+		AuthWebhookCacheSize: helper.AuthWebhookSize,
+		MaxActorsPerDocument: map[string]int{actorLimitedCollection: 1},
+	}, &mongo.Config{
+		ConnectionURI:     helper.MongoConnectionURI,
+		YorkieDatabase:    helper.TestDBName(),
+		ConnectionTimeout: helper.MongoConnectionTimeout,
+		PingTimeout:       helper.MongoPingTimeout,
+	}, &etcd.Config{
+		Endpoints:     helper.ETCDEndpoints,
+		DialTimeout:   helper.ETCDDialTimeout.String(),
+		LockLeaseTime: helper.ETCDLockLeaseTime.String(),
+	}, nil, &housekeeping.Config{
+		Interval:            helper.HousekeepingInterval.String(),
+		DeactivateThreshold: helper.HousekeepingDeactivateThreshold.String(),
+		CandidatesLimit:     helper.HousekeepingCandidatesLimit,
+	}, testRPCAddr, met)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	testRPCServer, err = rpc.NewServer(&rpc.Config{
+		Port:            helper.RPCPort,
+		MaxRequestBytes: helper.RPCMaxRequestBytes,
+	}, be)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := testRPCServer.Start(); err != nil {
+		log.Fatalf("failed rpc listen: %s\n", err)
+	}
+
+	conn, err := grpc.Dial(testRPCAddr, grpc.WithInsecure())
+	if err != nil {
+		log.Fatal(err)
+	}
+	testClient = api.NewYorkieClient(conn)
+	testAdmin = api.NewAdminClient(conn)
+	testCluster = api.NewClusterClient(conn)
+
+	code := m.Run()
+
+	if err := be.Shutdown(); err != nil {
+		log.Fatal(err)
+	}
+	testRPCServer.Shutdown(true)
+	os.Exit(code)
+}
+
+func TestRPCServerBackend(t *testing.T) {
+	t.Run("activate/deactivate client test", func(t *testing.T) {
+		activateResp, err := testClient.ActivateClient(
+			context.Background(),
+			&api.ActivateClientRequest{ClientKey: t.Name()},
+		)
+		assert.NoError(t, err)
+
+		_, err = testClient.DeactivateClient(
+			context.Background(),
+			&api.DeactivateClientRequest{ClientId: activateResp.ClientId},
+		)
+		assert.NoError(t, err)
+
+		// invalid argument
+		_, err = testClient.ActivateClient(
+			context.Background(),
+			&api.ActivateClientRequest{ClientKey: ""},
+		)
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+
+		_, err = testClient.DeactivateClient(
+			context.Background(),
+			&api.DeactivateClientRequest{ClientId: emptyClientID},
+		)
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+
+		// client not found
+		_, err = testClient.DeactivateClient(
+			context.Background(),
+			&api.DeactivateClientRequest{ClientId: nilClientID},
+		)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+	})
+
+	t.Run("client metadata test", func(t *testing.T) {
+		adminCtx := metadata.AppendToOutgoingContext(
+			context.Background(),
+			"authorization",
+			helper.AdminToken,
+		)
+
+		activateResp, err := testClient.ActivateClient(
+			context.Background(),
+			&api.ActivateClientRequest{
+				ClientKey: t.Name(),
+				Metadata:  map[string]string{"device": "desktop"},
+			},
+		)
+		assert.NoError(t, err)
+
+		infoResp, err := testAdmin.GetClientInfo(
+			adminCtx,
+			&api.GetClientInfoRequest{ClientId: activateResp.ClientId},
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, t.Name(), infoResp.ClientKey)
+		assert.Equal(t, map[string]string{"device": "desktop"}, infoResp.Metadata)
+
+		_, err = testClient.UpdateClientMetadata(
+			context.Background(),
+			&api.UpdateClientMetadataRequest{
+				ClientId: activateResp.ClientId,
+				Metadata: map[string]string{"device": "mobile", "version": "1.0.0"},
+			},
+		)
+		assert.NoError(t, err)
+
+		infoResp, err = testAdmin.GetClientInfo(
+			adminCtx,
+			&api.GetClientInfoRequest{ClientId: activateResp.ClientId},
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"device": "mobile", "version": "1.0.0"}, infoResp.Metadata)
+
+		// invalid argument
+		_, err = testAdmin.GetClientInfo(
+			adminCtx,
+			&api.GetClientInfoRequest{ClientId: emptyClientID},
+		)
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+
+		// client not found
+		_, err = testAdmin.GetClientInfo(
+			adminCtx,
+			&api.GetClientInfoRequest{ClientId: nilClientID},
+		)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+	})
+
+	t.Run("get client info test", func(t *testing.T) {
+		adminCtx := metadata.AppendToOutgoingContext(
+			context.Background(),
+			"authorization",
+			helper.AdminToken,
+		)
+
+		activateResp, err := testClient.ActivateClient(
+			context.Background(),
+			&api.ActivateClientRequest{ClientKey: t.Name()},
+		)
+		assert.NoError(t, err)
+
+		_, err = testClient.AttachDocument(
+			context.Background(),
+			&api.AttachDocumentRequest{
+				ClientId: activateResp.ClientId,
+				ChangePack: &api.ChangePack{
+					DocumentKey: &api.DocumentKey{
+						Collection: t.Name(), Document: t.Name(),
+					},
+					Checkpoint: &api.Checkpoint{ServerSeq: 0, ClientSeq: 1},
+					Changes: []*api.Change{{
+						Id: &api.ChangeID{
+							ClientSeq: 1,
+							Lamport:   1,
+							ActorId:   activateResp.ClientId,
+						},
+					}},
+				},
+			},
+		)
+		assert.NoError(t, err)
+
+		infoResp, err := testAdmin.GetClientInfo(
+			adminCtx,
+			&api.GetClientInfoRequest{ClientId: activateResp.ClientId},
+		)
+		assert.NoError(t, err)
+		assert.Len(t, infoResp.Documents, 1)
+		assert.Equal(t, t.Name(), infoResp.Documents[0].DocumentKey.Document)
+		assert.Equal(t, "attached", infoResp.Documents[0].Status)
+		assert.Equal(t, uint64(1), infoResp.Documents[0].Checkpoint.ServerSeq)
+		assert.Equal(t, uint32(1), infoResp.Documents[0].Checkpoint.ClientSeq)
+		assert.NotNil(t, infoResp.UpdatedAt)
+
+		// permission denied without the admin token
+		_, err = testAdmin.GetClientInfo(
+			context.Background(),
+			&api.GetClientInfoRequest{ClientId: activateResp.ClientId},
+		)
+		assert.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+	})
+
+	t.Run("get snapshot meta test", func(t *testing.T) {
+		adminCtx := metadata.AppendToOutgoingContext(
+			context.Background(),
+			"authorization",
+			helper.AdminToken,
+		)
+		docKey := &api.DocumentKey{Collection: t.Name(), Document: t.Name()}
+
+		// document not found
+		_, err := testAdmin.GetSnapshotMeta(
+			adminCtx,
+			&api.GetSnapshotMetaRequest{DocumentKey: docKey},
+		)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+
+		activateResp, err := testClient.ActivateClient(
+			context.Background(),
+			&api.ActivateClientRequest{ClientKey: t.Name()},
+		)
+		assert.NoError(t, err)
+
+		_, err = testClient.AttachDocument(
+			context.Background(),
+			&api.AttachDocumentRequest{
+				ClientId: activateResp.ClientId,
+				ChangePack: &api.ChangePack{
+					DocumentKey: docKey,
+					Checkpoint:  &api.Checkpoint{ServerSeq: 0, ClientSeq: 0},
+				},
+			},
+		)
+		assert.NoError(t, err)
+
+		// no snapshot has been created yet
+		metaResp, err := testAdmin.GetSnapshotMeta(
+			adminCtx,
+			&api.GetSnapshotMetaRequest{DocumentKey: docKey},
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(0), metaResp.ServerSeq)
+		assert.Empty(t, metaResp.Hash)
+
+		_, err = testAdmin.GetSnapshotMeta(
+			adminCtx,
+			&api.GetSnapshotMetaRequest{},
+		)
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+
+		// permission denied without the admin token
+		_, err = testAdmin.GetSnapshotMeta(
+			context.Background(),
+			&api.GetSnapshotMetaRequest{DocumentKey: docKey},
+		)
+		assert.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+	})
+
+	t.Run("create snapshot test", func(t *testing.T) {
+		adminCtx := metadata.AppendToOutgoingContext(
+			context.Background(),
+			"authorization",
+			helper.AdminToken,
+		)
+		docKey := &api.DocumentKey{Collection: t.Name(), Document: t.Name()}
+
+		// document not found
+		_, err := testCluster.CreateSnapshot(
+			adminCtx,
+			&api.CreateSnapshotRequest{DocumentKey: docKey},
+		)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+
+		activateResp, err := testClient.ActivateClient(
+			context.Background(),
+			&api.ActivateClientRequest{ClientKey: t.Name()},
+		)
+		assert.NoError(t, err)
+
+		_, err = testClient.AttachDocument(
+			context.Background(),
+			&api.AttachDocumentRequest{
+				ClientId: activateResp.ClientId,
+				ChangePack: &api.ChangePack{
+					DocumentKey: docKey,
+					Checkpoint:  &api.Checkpoint{ServerSeq: 0, ClientSeq: 1},
+					Changes: []*api.Change{{
+						Id: &api.ChangeID{
+							ClientSeq: 1,
+							Lamport:   1,
+							ActorId:   activateResp.ClientId,
+						},
+					}},
+				},
+			},
+		)
+		assert.NoError(t, err)
+
+		createResp, err := testCluster.CreateSnapshot(
+			adminCtx,
+			&api.CreateSnapshotRequest{DocumentKey: docKey},
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(1), createResp.ServerSeq)
+
+		_, err = testCluster.CreateSnapshot(
+			adminCtx,
+			&api.CreateSnapshotRequest{},
+		)
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+
+		// permission denied without the admin token
+		_, err = testCluster.CreateSnapshot(
+			context.Background(),
+			&api.CreateSnapshotRequest{DocumentKey: docKey},
+		)
+		assert.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+	})
+
+	t.Run("compact changes test", func(t *testing.T) {
+		adminCtx := metadata.AppendToOutgoingContext(
+			context.Background(),
+			"authorization",
+			helper.AdminToken,
+		)
+		docKey := &api.DocumentKey{Collection: t.Name(), Document: t.Name()}
+
+		// document not found
+		_, err := testCluster.CompactChanges(
+			adminCtx,
+			&api.CompactChangesRequest{DocumentKeys: []*api.DocumentKey{docKey}},
+		)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+
+		activateResp, err := testClient.ActivateClient(
+			context.Background(),
+			&api.ActivateClientRequest{ClientKey: t.Name()},
+		)
+		assert.NoError(t, err)
+
+		_, err = testClient.AttachDocument(
+			context.Background(),
+			&api.AttachDocumentRequest{
+				ClientId: activateResp.ClientId,
+				ChangePack: &api.ChangePack{
+					DocumentKey: docKey,
+					Checkpoint:  &api.Checkpoint{ServerSeq: 0, ClientSeq: 1},
+					Changes: []*api.Change{{
+						Id: &api.ChangeID{
+							ClientSeq: 1,
+							Lamport:   1,
+							ActorId:   activateResp.ClientId,
+						},
+					}},
+				},
+			},
+		)
+		assert.NoError(t, err)
+
+		// nothing to compact without a snapshot
+		compactResp, err := testCluster.CompactChanges(
+			adminCtx,
+			&api.CompactChangesRequest{DocumentKeys: []*api.DocumentKey{docKey}},
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(0), compactResp.CompactedDocuments)
+		assert.Equal(t, uint64(0), compactResp.CompactedChanges)
+
+		_, err = testCluster.CompactChanges(
+			adminCtx,
+			&api.CompactChangesRequest{},
+		)
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+
+		// permission denied without the admin token
+		_, err = testCluster.CompactChanges(
+			context.Background(),
+			&api.CompactChangesRequest{DocumentKeys: []*api.DocumentKey{docKey}},
+		)
+		assert.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+	})
+
+	t.Run("get document stats test", func(t *testing.T) {
+		adminCtx := metadata.AppendToOutgoingContext(
+			context.Background(),
+			"authorization",
+			helper.AdminToken,
+		)
+		docKey := &api.DocumentKey{Collection: t.Name(), Document: t.Name()}
+
+		// document not found
+		_, err := testCluster.GetDocumentStats(
+			adminCtx,
+			&api.GetDocumentStatsRequest{DocumentKey: docKey},
+		)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+
+		activateResp, err := testClient.ActivateClient(
+			context.Background(),
+			&api.ActivateClientRequest{ClientKey: t.Name()},
+		)
+		assert.NoError(t, err)
+
+		_, err = testClient.AttachDocument(
+			context.Background(),
+			&api.AttachDocumentRequest{
+				ClientId: activateResp.ClientId,
+				ChangePack: &api.ChangePack{
+					DocumentKey: docKey,
+					Checkpoint:  &api.Checkpoint{ServerSeq: 0, ClientSeq: 2},
+					Changes: []*api.Change{{
+						Id: &api.ChangeID{
+							ClientSeq: 1,
+							Lamport:   1,
+							ActorId:   activateResp.ClientId,
+						},
+					}, {
+						Id: &api.ChangeID{
+							ClientSeq: 2,
+							Lamport:   2,
+							ActorId:   activateResp.ClientId,
+						},
+					}},
+				},
+			},
+		)
+		assert.NoError(t, err)
+
+		_, err = testCluster.CreateSnapshot(
+			adminCtx,
+			&api.CreateSnapshotRequest{DocumentKey: docKey},
+		)
+		assert.NoError(t, err)
+
+		statsResp, err := testCluster.GetDocumentStats(
+			adminCtx,
+			&api.GetDocumentStatsRequest{DocumentKey: docKey},
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(2), statsResp.ServerSeq)
+		assert.Equal(t, uint64(2), statsResp.SnapshotServerSeq)
+		assert.Equal(t, uint64(0), statsResp.ChangesSinceSnapshot)
+		assert.Greater(t, statsResp.SnapshotBytes, uint64(0))
+		assert.Equal(t, uint64(1), statsResp.ActorCount)
+
+		_, err = testCluster.GetDocumentStats(
+			adminCtx,
+			&api.GetDocumentStatsRequest{},
+		)
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+
+		// permission denied without the admin token
+		_, err = testCluster.GetDocumentStats(
+			context.Background(),
+			&api.GetDocumentStatsRequest{DocumentKey: docKey},
+		)
+		assert.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+	})
+
+	t.Run("dry run garbage collection test", func(t *testing.T) {
+		adminCtx := metadata.AppendToOutgoingContext(
+			context.Background(),
+			"authorization",
+			helper.AdminToken,
+		)
+		docKey := &api.DocumentKey{Collection: t.Name(), Document: t.Name()}
+		ticket := converter.ToTimeTicket(time.MaxTicket)
+
+		// document not found
+		_, err := testCluster.DryRunGarbageCollection(
+			adminCtx,
+			&api.DryRunGarbageCollectionRequest{DocumentKey: docKey, MinSyncedTicket: ticket},
+		)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+
+		activateResp, err := testClient.ActivateClient(
+			context.Background(),
+			&api.ActivateClientRequest{ClientKey: t.Name()},
+		)
+		assert.NoError(t, err)
+
+		_, err = testClient.AttachDocument(
+			context.Background(),
+			&api.AttachDocumentRequest{
+				ClientId: activateResp.ClientId,
+				ChangePack: &api.ChangePack{
+					DocumentKey: docKey,
+					Checkpoint:  &api.Checkpoint{ServerSeq: 0, ClientSeq: 1},
+					Changes: []*api.Change{{
+						Id: &api.ChangeID{
+							ClientSeq: 1,
+							Lamport:   1,
+							ActorId:   activateResp.ClientId,
+						},
+					}},
+				},
+			},
+		)
+		assert.NoError(t, err)
+
+		dryRunResp, err := testCluster.DryRunGarbageCollection(
+			adminCtx,
+			&api.DryRunGarbageCollectionRequest{DocumentKey: docKey, MinSyncedTicket: ticket},
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(1), dryRunResp.ServerSeq)
+		assert.Len(t, dryRunResp.Garbage, 0)
+
+		_, err = testCluster.DryRunGarbageCollection(
+			adminCtx,
+			&api.DryRunGarbageCollectionRequest{DocumentKey: docKey},
+		)
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+
+		_, err = testCluster.DryRunGarbageCollection(
+			adminCtx,
+			&api.DryRunGarbageCollectionRequest{MinSyncedTicket: ticket},
+		)
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+
+		// permission denied without the admin token
+		_, err = testCluster.DryRunGarbageCollection(
+			context.Background(),
+			&api.DryRunGarbageCollectionRequest{DocumentKey: docKey, MinSyncedTicket: ticket},
+		)
+		assert.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+	})
+
+	t.Run("set document gc disabled test", func(t *testing.T) {
+		adminCtx := metadata.AppendToOutgoingContext(
+			context.Background(),
+			"authorization",
+			helper.AdminToken,
+		)
+		docKey := &api.DocumentKey{Collection: t.Name(), Document: t.Name()}
+
+		// document not found
+		_, err := testCluster.SetDocumentGCDisabled(
+			adminCtx,
+			&api.SetDocumentGCDisabledRequest{DocumentKey: docKey, GcDisabled: true},
+		)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+
+		activateResp, err := testClient.ActivateClient(
+			context.Background(),
+			&api.ActivateClientRequest{ClientKey: t.Name()},
+		)
+		assert.NoError(t, err)
+
+		_, err = testClient.AttachDocument(
+			context.Background(),
+			&api.AttachDocumentRequest{
+				ClientId: activateResp.ClientId,
+				ChangePack: &api.ChangePack{
+					DocumentKey: docKey,
+					Checkpoint:  &api.Checkpoint{ServerSeq: 0, ClientSeq: 0},
+				},
+			},
+		)
+		assert.NoError(t, err)
+
+		_, err = testCluster.SetDocumentGCDisabled(
+			adminCtx,
+			&api.SetDocumentGCDisabledRequest{DocumentKey: docKey, GcDisabled: true},
+		)
+		assert.NoError(t, err)
+
+		_, err = testCluster.SetDocumentGCDisabled(
+			adminCtx,
+			&api.SetDocumentGCDisabledRequest{GcDisabled: true},
+		)
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+
+		// permission denied without the admin token
+		_, err = testCluster.SetDocumentGCDisabled(
+			context.Background(),
+			&api.SetDocumentGCDisabledRequest{DocumentKey: docKey, GcDisabled: true},
+		)
+		assert.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+	})
+
+	t.Run("get server info test", func(t *testing.T) {
+		resp, err := testCluster.GetServerInfo(
+			context.Background(),
+			&api.GetServerInfoRequest{},
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, version.Version, resp.Version)
+		assert.Equal(t, version.GitCommit, resp.GitCommit)
+		assert.Equal(t, version.BuildDate, resp.BuildDate)
+	})
+
+	t.Run("stream logs test", func(t *testing.T) {
+		adminCtx := metadata.AppendToOutgoingContext(
+			context.Background(),
+			"authorization",
+			helper.AdminToken,
+		)
+
+		streamCtx, cancel := context.WithCancel(adminCtx)
+		defer cancel()
+		stream, err := testAdmin.StreamLogs(
+			streamCtx,
+			&api.StreamLogsRequest{Level: "warn", LoggerPrefixes: []string{"r"}},
+		)
+		assert.NoError(t, err)
+
+		// NOTE: The subscription is made asynchronously after the stream is
+		// opened, so failing requests are sent until an entry arrives.
+		done := make(chan struct{})
+		defer close(done)
+		go func() {
+			for {
+				select {
+				case <-done:
+					return
+				case <-gotime.After(10 * gotime.Millisecond):
+					_, _ = testClient.ActivateClient(
+						context.Background(),
+						&api.ActivateClientRequest{ClientKey: ""},
+					)
+				}
+			}
+		}()
+
+		resp, err := stream.Recv()
+		assert.NoError(t, err)
+		assert.Equal(t, "warn", resp.Level)
+		assert.Contains(t, resp.Message, "ActivateClient")
+
+		// invalid level
+		stream, err = testAdmin.StreamLogs(
+			adminCtx,
+			&api.StreamLogsRequest{Level: "verbose"},
+		)
+		assert.NoError(t, err)
+		_, err = stream.Recv()
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+
+		// permission denied without the admin token
+		stream, err = testAdmin.StreamLogs(
+			context.Background(),
+			&api.StreamLogsRequest{},
+		)
+		assert.NoError(t, err)
+		_, err = stream.Recv()
+		assert.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+	})
+
+	t.Run("quarantine document test", func(t *testing.T) {
+		adminCtx := metadata.AppendToOutgoingContext(
+			context.Background(),
+			"authorization",
+			helper.AdminToken,
+		)
+		docKey := &api.DocumentKey{Collection: t.Name(), Document: t.Name()}
+		otherDocKey := &api.DocumentKey{Collection: t.Name(), Document: t.Name() + "other"}
+
+		activateResp, err := testClient.ActivateClient(
+			context.Background(),
+			&api.ActivateClientRequest{ClientKey: t.Name()},
+		)
+		assert.NoError(t, err)
+
+		_, err = testClient.AttachDocument(
+			context.Background(),
+			&api.AttachDocumentRequest{
+				ClientId: activateResp.ClientId,
+				ChangePack: &api.ChangePack{
+					DocumentKey: docKey,
+					Checkpoint:  &api.Checkpoint{ServerSeq: 0, ClientSeq: 0},
+				},
+			},
+		)
+		assert.NoError(t, err)
+
+		watchReq := &api.WatchDocumentsRequest{
+			Client: &api.Client{
+				Id:       activateResp.ClientId,
+				Metadata: &api.Metadata{},
+			},
+			DocumentKeys: []*api.DocumentKey{docKey},
+		}
+		watchCtx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		watchStream, err := testClient.WatchDocuments(watchCtx, watchReq)
+		assert.NoError(t, err)
+		_, err = watchStream.Recv()
+		assert.NoError(t, err)
+
+		quarantineResp, err := testAdmin.QuarantineDocument(
+			adminCtx,
+			&api.QuarantineDocumentRequest{DocumentKey: docKey},
+		)
+		assert.NoError(t, err)
+		assert.True(t, quarantineResp.Quarantined)
+
+		quarantineResp, err = testAdmin.QuarantineDocument(
+			adminCtx,
+			&api.QuarantineDocumentRequest{DocumentKey: docKey},
+		)
+		assert.NoError(t, err)
+		assert.False(t, quarantineResp.Quarantined)
+
+		// the watching client is notified
+		watchResp, err := watchStream.Recv()
+		assert.NoError(t, err)
+		assert.Equal(t, api.DocEventType_DOCUMENTS_QUARANTINED, watchResp.GetEvent().Type)
+
+		// the watch of the quarantined document is closed
+		_, err = watchStream.Recv()
+		assert.Equal(t, codes.FailedPrecondition, status.Convert(err).Code())
+
+		pushPullReq := &api.PushPullRequest{
+			ClientId: activateResp.ClientId,
+			ChangePack: &api.ChangePack{
+				DocumentKey: docKey,
+				Checkpoint:  &api.Checkpoint{ServerSeq: 0, ClientSeq: 0},
+			},
+		}
+		_, err = testClient.PushPull(context.Background(), pushPullReq)
+		assert.Equal(t, codes.FailedPrecondition, status.Convert(err).Code())
+
+		// the quarantine is kept after the settings are updated
+		_, err = testAdmin.UpdateDocumentSettings(
+			adminCtx,
+			&api.UpdateDocumentSettingsRequest{
+				DocumentKey: docKey,
+				Settings:    &api.DocumentSettings{},
+			},
+		)
+		assert.NoError(t, err)
+		_, err = testClient.PushPull(context.Background(), pushPullReq)
+		assert.Equal(t, codes.FailedPrecondition, status.Convert(err).Code())
+
+		watchStream, err = testClient.WatchDocuments(watchCtx, watchReq)
+		assert.NoError(t, err)
+		_, err = watchStream.Recv()
+		assert.Equal(t, codes.FailedPrecondition, status.Convert(err).Code())
+
+		// the other documents are not affected
+		_, err = testClient.AttachDocument(
+			context.Background(),
+			&api.AttachDocumentRequest{
+				ClientId: activateResp.ClientId,
+				ChangePack: &api.ChangePack{
+					DocumentKey: otherDocKey,
+					Checkpoint:  &api.Checkpoint{ServerSeq: 0, ClientSeq: 0},
+				},
+			},
+		)
+		assert.NoError(t, err)
+
+		releaseResp, err := testAdmin.ReleaseDocument(
+			adminCtx,
+			&api.ReleaseDocumentRequest{DocumentKey: docKey},
+		)
+		assert.NoError(t, err)
+		assert.True(t, releaseResp.Released)
+
+		_, err = testClient.PushPull(context.Background(), pushPullReq)
+		assert.NoError(t, err)
+
+		releaseResp, err = testAdmin.ReleaseDocument(
+			adminCtx,
+			&api.ReleaseDocumentRequest{DocumentKey: docKey},
+		)
+		assert.NoError(t, err)
+		assert.False(t, releaseResp.Released)
+
+		_, err = testAdmin.QuarantineDocument(
+			adminCtx,
+			&api.QuarantineDocumentRequest{},
+		)
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+
+		_, err = testAdmin.QuarantineDocument(
+			adminCtx,
+			&api.QuarantineDocumentRequest{DocumentKey: &api.DocumentKey{
+				Collection: t.Name(),
+				Document:   "not-found",
+			}},
+		)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+
+		// permission denied without the admin token
+		_, err = testAdmin.QuarantineDocument(
+			context.Background(),
+			&api.QuarantineDocumentRequest{DocumentKey: docKey},
+		)
+		assert.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+	})
+
+	t.Run("update document settings test", func(t *testing.T) {
+		adminCtx := metadata.AppendToOutgoingContext(
+			context.Background(),
+			"authorization",
+			helper.AdminToken,
+		)
+		docKey := &api.DocumentKey{Collection: t.Name(), Document: t.Name()}
+		settings := &api.DocumentSettings{
+			SnapshotThreshold:        10,
+			DisableGarbageCollection: true,
+		}
+
+		_, err := testAdmin.UpdateDocumentSettings(
+			adminCtx,
+			&api.UpdateDocumentSettingsRequest{DocumentKey: docKey, Settings: settings},
+		)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+
+		activateResp, err := testClient.ActivateClient(
+			context.Background(),
+			&api.ActivateClientRequest{ClientKey: t.Name()},
+		)
+		assert.NoError(t, err)
+
+		_, err = testClient.AttachDocument(
+			context.Background(),
+			&api.AttachDocumentRequest{
+				ClientId: activateResp.ClientId,
+				ChangePack: &api.ChangePack{
+					DocumentKey: docKey,
+					Checkpoint:  &api.Checkpoint{ServerSeq: 0, ClientSeq: 0},
+				},
+			},
+		)
+		assert.NoError(t, err)
+
+		updateResp, err := testAdmin.UpdateDocumentSettings(
+			adminCtx,
+			&api.UpdateDocumentSettingsRequest{DocumentKey: docKey, Settings: settings},
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, settings, updateResp.Settings)
+
+		_, err = testClient.PushPull(context.Background(), &api.PushPullRequest{
+			ClientId: activateResp.ClientId,
+			ChangePack: &api.ChangePack{
+				DocumentKey: docKey,
+				Checkpoint:  &api.Checkpoint{ServerSeq: 0, ClientSeq: 0},
+			},
+		})
+		assert.NoError(t, err)
+
+		// only the fields in the mask are updated
+		updateResp, err = testAdmin.UpdateDocumentSettings(
+			adminCtx,
+			&api.UpdateDocumentSettingsRequest{
+				DocumentKey: docKey,
+				Settings:    &api.DocumentSettings{SnapshotInterval: 20},
+				UpdateMask:  &protoTypes.FieldMask{Paths: []string{"snapshot_interval"}},
+			},
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, &api.DocumentSettings{
+			SnapshotThreshold:        10,
+			SnapshotInterval:         20,
+			DisableGarbageCollection: true,
+		}, updateResp.Settings)
+
+		// the fields that are not the settings of the admin are rejected
+		for _, path := range []string{"quarantined", "unknown"} {
+			_, err = testAdmin.UpdateDocumentSettings(
+				adminCtx,
+				&api.UpdateDocumentSettingsRequest{
+					DocumentKey: docKey,
+					Settings:    &api.DocumentSettings{},
+					UpdateMask:  &protoTypes.FieldMask{Paths: []string{path}},
+				},
+			)
+			assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+		}
+
+		// the settings are cleared without the settings
+		updateResp, err = testAdmin.UpdateDocumentSettings(
+			adminCtx,
+			&api.UpdateDocumentSettingsRequest{DocumentKey: docKey},
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, &api.DocumentSettings{}, updateResp.Settings)
+
+		_, err = testAdmin.UpdateDocumentSettings(
+			adminCtx,
+			&api.UpdateDocumentSettingsRequest{},
+		)
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+
+		// the max changes per pull must be less than the snapshot threshold
+		_, err = testAdmin.UpdateDocumentSettings(
+			adminCtx,
+			&api.UpdateDocumentSettingsRequest{
+				DocumentKey: docKey,
+				Settings:    &api.DocumentSettings{MaxChangesPerPull: helper.SnapshotThreshold},
+			},
+		)
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
 
-	invalidChangePack = &api.ChangePack{
-		DocumentKey: &api.DocumentKey{
-			Collection: "invalid", Document: "invalid",
-		},
-		Checkpoint: nil,
-	}
-)
+		// permission denied without the admin token
+		_, err = testAdmin.UpdateDocumentSettings(
+			context.Background(),
+			&api.UpdateDocumentSettingsRequest{DocumentKey: docKey, Settings: settings},
+		)
+		assert.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
+	})
 
-func TestMain(m *testing.M) {
-	met, err := prometheus.NewMetrics()
-	if err != nil {
-		log.Fatal(err)
-	}
+	t.Run("max actors per document test", func(t *testing.T) {
+		docKey := &api.DocumentKey{Collection: actorLimitedCollection, Document: t.Name()}
 
-	be, err := backend.New(&backend.Config{
-		SnapshotThreshold:    helper.SnapshotThreshold,
-		AuthWebhookCacheSize: helper.AuthWebhookSize,
-	}, &mongo.Config{
-		ConnectionURI:     helper.MongoConnectionURI,
-		YorkieDatabase:    helper.TestDBName(),
-		ConnectionTimeout: helper.MongoConnectionTimeout,
-		PingTimeout:       helper.MongoPingTimeout,
-	}, &etcd.Config{
-		Endpoints:     helper.ETCDEndpoints,
-		DialTimeout:   helper.ETCDDialTimeout.String(),
-		LockLeaseTime: helper.ETCDLockLeaseTime.String(),
-	}, &housekeeping.Config{
-		Interval:            helper.HousekeepingInterval.String(),
-		DeactivateThreshold: helper.HousekeepingDeactivateThreshold.String(),
-		CandidatesLimit:     helper.HousekeepingCandidatesLimit,
-	}, testRPCAddr, met)
-	if err != nil {
-		log.Fatal(err)
-	}
+		newDocument := func(clientKey string) ([]byte, *document.Document) {
+			activateResp, err := testClient.ActivateClient(
+				context.Background(),
+				&api.ActivateClientRequest{ClientKey: clientKey},
+			)
+			assert.NoError(t, err)
+			actorID, err := time.ActorIDFromBytes(activateResp.ClientId)
+			assert.NoError(t, err)
 
-	testRPCServer, err = rpc.NewServer(&rpc.Config{
-		Port:            helper.RPCPort,
-		MaxRequestBytes: helper.RPCMaxRequestBytes,
-	}, be)
-	if err != nil {
-		log.Fatal(err)
-	}
+			doc := document.New(docKey.Collection, docKey.Document)
+			doc.SetActor(actorID)
 
-	if err := testRPCServer.Start(); err != nil {
-		log.Fatalf("failed rpc listen: %s\n", err)
-	}
+			attachResp, err := testClient.AttachDocument(
+				context.Background(),
+				&api.AttachDocumentRequest{
+					ClientId: activateResp.ClientId,
+					ChangePack: &api.ChangePack{
+						DocumentKey: docKey,
+						Checkpoint:  &api.Checkpoint{ServerSeq: 0, ClientSeq: 0},
+					},
+				},
+			)
+			assert.NoError(t, err)
+			pulledPack, err := converter.FromChangePack(attachResp.ChangePack)
+			assert.NoError(t, err)
+			assert.NoError(t, doc.ApplyChangePack(pulledPack))
 
-	conn, err := grpc.Dial(testRPCAddr, grpc.WithInsecure())
-	if err != nil {
-		log.Fatal(err)
-	}
-	testClient = api.NewYorkieClient(conn)
+			return activateResp.ClientId, doc
+		}
 
-	code := m.Run()
+		pushPull := func(clientID []byte, doc *document.Document, k string) error {
+			assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+				root.SetString(k, "v")
+				return nil
+			}))
+			pbPack, err := converter.ToChangePack(doc.CreateChangePack())
+			assert.NoError(t, err)
 
-	if err := be.Shutdown(); err != nil {
-		log.Fatal(err)
-	}
-	testRPCServer.Shutdown(true)
-	os.Exit(code)
-}
+			resp, err := testClient.PushPull(
+				context.Background(),
+				&api.PushPullRequest{ClientId: clientID, ChangePack: pbPack},
+			)
+			if err != nil {
+				return err
+			}
+
+			pulledPack, err := converter.FromChangePack(resp.ChangePack)
+			assert.NoError(t, err)
+			return doc.ApplyChangePack(pulledPack)
+		}
+
+		clientID1, doc1 := newDocument(t.Name() + "1")
+		clientID2, doc2 := newDocument(t.Name() + "2")
+
+		// the first actor is allowed
+		assert.NoError(t, pushPull(clientID1, doc1, "k1"))
+
+		// the second actor exceeds the limit
+		err := pushPull(clientID2, doc2, "k2")
+		assert.Equal(t, codes.ResourceExhausted, status.Convert(err).Code())
+
+		// the existing actor continues
+		assert.NoError(t, pushPull(clientID1, doc1, "k3"))
+	})
+
+	t.Run("pull json patches test", func(t *testing.T) {
+		docKey := &api.DocumentKey{Collection: t.Name(), Document: t.Name()}
+
+		// document not found
+		_, err := testClient.PullJSONPatches(
+			context.Background(),
+			&api.PullJSONPatchesRequest{DocumentKey: docKey},
+		)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
 
-func TestRPCServerBackend(t *testing.T) {
-	t.Run("activate/deactivate client test", func(t *testing.T) {
 		activateResp, err := testClient.ActivateClient(
 			context.Background(),
 			&api.ActivateClientRequest{ClientKey: t.Name()},
 		)
 		assert.NoError(t, err)
+		actorID, err := time.ActorIDFromBytes(activateResp.ClientId)
+		assert.NoError(t, err)
 
-		_, err = testClient.DeactivateClient(
+		doc := document.New(t.Name(), t.Name())
+		doc.SetActor(actorID)
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		pbPack, err := converter.ToChangePack(doc.CreateChangePack())
+		assert.NoError(t, err)
+
+		attachResp, err := testClient.AttachDocument(
 			context.Background(),
-			&api.DeactivateClientRequest{ClientId: activateResp.ClientId},
+			&api.AttachDocumentRequest{
+				ClientId:   activateResp.ClientId,
+				ChangePack: pbPack,
+			},
 		)
 		assert.NoError(t, err)
+		pulledPack, err := converter.FromChangePack(attachResp.ChangePack)
+		assert.NoError(t, err)
+		assert.NoError(t, doc.ApplyChangePack(pulledPack))
 
-		// invalid argument
-		_, err = testClient.ActivateClient(
+		// the whole document is delivered for the initial pull
+		pullResp, err := testClient.PullJSONPatches(
 			context.Background(),
-			&api.ActivateClientRequest{ClientKey: ""},
+			&api.PullJSONPatchesRequest{DocumentKey: docKey},
 		)
-		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(1), pullResp.ServerSeq)
+		assert.Equal(t, `{"k1":"v1"}`, pullResp.Document)
+		assert.Empty(t, pullResp.Patches)
 
-		_, err = testClient.DeactivateClient(
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k2", "v2")
+			root.Delete("k1")
+			return nil
+		}))
+		pbPack, err = converter.ToChangePack(doc.CreateChangePack())
+		assert.NoError(t, err)
+		_, err = testClient.PushPull(
 			context.Background(),
-			&api.DeactivateClientRequest{ClientId: emptyClientID},
+			&api.PushPullRequest{
+				ClientId:   activateResp.ClientId,
+				ChangePack: pbPack,
+			},
+		)
+		assert.NoError(t, err)
+
+		pullResp, err = testClient.PullJSONPatches(
+			context.Background(),
+			&api.PullJSONPatchesRequest{
+				DocumentKey: docKey,
+				ServerSeq:   pullResp.ServerSeq,
+			},
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(2), pullResp.ServerSeq)
+		assert.Empty(t, pullResp.Document)
+		assert.False(t, pullResp.HasMore)
+		assert.Equal(t, []*api.JSONPatch{
+			{Op: "add", Path: "/k2", Value: `"v2"`},
+			{Op: "remove", Path: "/k1"},
+		}, pullResp.Patches)
+
+		// server seq ahead of the document
+		_, err = testClient.PullJSONPatches(
+			context.Background(),
+			&api.PullJSONPatchesRequest{DocumentKey: docKey, ServerSeq: 10},
+		)
+		assert.Equal(t, codes.FailedPrecondition, status.Convert(err).Code())
+
+		_, err = testClient.PullJSONPatches(
+			context.Background(),
+			&api.PullJSONPatchesRequest{},
 		)
 		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+	})
 
-		// client not found
-		_, err = testClient.DeactivateClient(
+	t.Run("export document history test", func(t *testing.T) {
+		adminCtx := metadata.AppendToOutgoingContext(
 			context.Background(),
-			&api.DeactivateClientRequest{ClientId: nilClientID},
+			"authorization",
+			helper.AdminToken,
 		)
+		docKey := &api.DocumentKey{Collection: t.Name(), Document: t.Name()}
+
+		export := func(ctx context.Context, req *api.ExportDocumentHistoryRequest) ([]byte, error) {
+			stream, err := testAdmin.ExportDocumentHistory(ctx, req)
+			assert.NoError(t, err)
+
+			var history []byte
+			for {
+				resp, err := stream.Recv()
+				if err == io.EOF {
+					return history, nil
+				}
+				if err != nil {
+					return nil, err
+				}
+				history = append(history, resp.Chunk...)
+			}
+		}
+
+		_, err := export(adminCtx, &api.ExportDocumentHistoryRequest{DocumentKey: docKey})
 		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+
+		activateResp, err := testClient.ActivateClient(
+			context.Background(),
+			&api.ActivateClientRequest{ClientKey: t.Name()},
+		)
+		assert.NoError(t, err)
+		actorID, err := time.ActorIDFromBytes(activateResp.ClientId)
+		assert.NoError(t, err)
+
+		doc := document.New(t.Name(), t.Name())
+		doc.SetActor(actorID)
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k1", "v1")
+			return nil
+		}, "add k1"))
+		assert.NoError(t, doc.Update(func(root *proxy.ObjectProxy) error {
+			root.SetString("k2", "v2")
+			return nil
+		}))
+		pbPack, err := converter.ToChangePack(doc.CreateChangePack())
+		assert.NoError(t, err)
+
+		_, err = testClient.AttachDocument(
+			context.Background(),
+			&api.AttachDocumentRequest{
+				ClientId:   activateResp.ClientId,
+				ChangePack: pbPack,
+			},
+		)
+		assert.NoError(t, err)
+
+		history, err := export(adminCtx, &api.ExportDocumentHistoryRequest{DocumentKey: docKey})
+		assert.NoError(t, err)
+		assert.Contains(t, string(history), "commit refs/heads/master\nmark :1\n")
+		assert.Contains(t, string(history), fmt.Sprintf("author %s <> ", actorID.String()))
+		assert.Contains(t, string(history), "data 6\nadd k1\n")
+		assert.Contains(t, string(history), "data 11\n{\"k1\":\"v1\"}\n")
+		assert.Contains(t, string(history), "data 8\nchange 2\n")
+		assert.Contains(t, string(history), "data 21\n{\"k1\":\"v1\",\"k2\":\"v2\"}\n")
+
+		_, err = export(adminCtx, &api.ExportDocumentHistoryRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+
+		// permission denied without the admin token
+		_, err = export(
+			context.Background(),
+			&api.ExportDocumentHistoryRequest{DocumentKey: docKey},
+		)
+		assert.Equal(t, codes.PermissionDenied, status.Convert(err).Code())
 	})
 
 	t.Run("attach/detach document test", func(t *testing.T) {
@@ -208,6 +1286,21 @@ func TestRPCServerBackend(t *testing.T) {
 		)
 		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
 
+		// try to attach with invalid document key
+		_, err = testClient.AttachDocument(
+			context.Background(),
+			&api.AttachDocumentRequest{
+				ClientId: activateResp.ClientId,
+				ChangePack: &api.ChangePack{
+					DocumentKey: &api.DocumentKey{
+						Collection: t.Name(), Document: "invalid\x00key",
+					},
+					Checkpoint: &api.Checkpoint{ServerSeq: 0, ClientSeq: 0},
+				},
+			},
+		)
+		assert.Equal(t, codes.InvalidArgument, status.Convert(err).Code())
+
 		_, err = testClient.DetachDocument(
 			context.Background(),
 			&api.DetachDocumentRequest{
@@ -395,8 +1488,339 @@ func TestConfig_Validate(t *testing.T) {
 		{config: &rpc.Config{Port: 11101, CertFile: "", KeyFile: ""}, expected: nil},
 		// pass any file existing
 		{config: &rpc.Config{Port: 11101, CertFile: "server_test.go", KeyFile: "server_test.go"}, expected: nil},
+		{config: &rpc.Config{Port: 11101, MaxRequestBytes: math.MaxInt32 + 1}, expected: rpc.ErrInvalidMaxRequestBytes},
+		{config: &rpc.Config{Port: 11101, MaxResponseBytes: math.MaxInt32 + 1}, expected: rpc.ErrInvalidMaxResponseBytes},
+		{config: &rpc.Config{Port: 11101, MaxResponseBytes: 16 * 1024 * 1024}, expected: nil},
+		{config: &rpc.Config{Port: 11101, WarmupDelay: "-1s"}, expected: rpc.ErrInvalidWarmupDelay},
+		{config: &rpc.Config{Port: 11101, WarmupDelay: "warm"}, expected: rpc.ErrInvalidWarmupDelay},
+		{config: &rpc.Config{Port: 11101, WarmupDelay: "10s"}, expected: nil},
+		{config: &rpc.Config{Port: 11101, WatchKeepaliveInterval: "-1s"}, expected: rpc.ErrInvalidWatchKeepaliveInterval},
+		{config: &rpc.Config{Port: 11101, WatchKeepaliveInterval: "5s"}, expected: nil},
+		{config: &rpc.Config{Port: 11101, KeepaliveMaxConnectionIdle: "-1s"}, expected: rpc.ErrInvalidKeepaliveMaxConnectionIdle},
+		{config: &rpc.Config{Port: 11101, KeepaliveTime: "often"}, expected: rpc.ErrInvalidKeepaliveTime},
+		{config: &rpc.Config{Port: 11101, KeepaliveTimeout: "-1s"}, expected: rpc.ErrInvalidKeepaliveTimeout},
+		{config: &rpc.Config{Port: 11101, KeepaliveMinTime: "-1s"}, expected: rpc.ErrInvalidKeepaliveMinTime},
+		{config: &rpc.Config{Port: 11101, HealthCheckInterval: "-1s"}, expected: rpc.ErrInvalidHealthCheckInterval},
+		{config: &rpc.Config{Port: 11101, HealthCheckInterval: "10s"}, expected: nil},
+		{config: &rpc.Config{SocketPath: "/tmp/yorkie.sock"}, expected: nil},
+		{config: &rpc.Config{Port: 11101, SocketPath: "/tmp/yorkie.sock"}, expected: rpc.ErrInvalidSocketPath},
+		{config: &rpc.Config{}, expected: rpc.ErrInvalidRPCPort},
+		{config: &rpc.Config{Port: 11101, Host: "127.0.0.1"}, expected: nil},
+		{config: &rpc.Config{Port: 11101, Host: "::1"}, expected: nil},
+		{config: &rpc.Config{Port: 11101, Host: "internal-nic"}, expected: rpc.ErrInvalidHost},
+		{config: &rpc.Config{Host: "127.0.0.1", SocketPath: "/tmp/yorkie.sock"}, expected: rpc.ErrInvalidHost},
+		{config: &rpc.Config{
+			Port:                       11101,
+			KeepaliveMaxConnectionIdle: "15m",
+			KeepaliveTime:              "30s",
+			KeepaliveTimeout:           "10s",
+			KeepaliveMinTime:           "10s",
+		}, expected: nil},
 	}
 	for _, scenario := range scenarios {
 		assert.ErrorIs(t, scenario.config.Validate(), scenario.expected, "provided config: %#v", scenario.config)
 	}
 }
+
+func TestConfig_Addr(t *testing.T) {
+	assert.Equal(t, ":11101", (&rpc.Config{Port: 11101}).Addr())
+	assert.Equal(t, "127.0.0.1:11101", (&rpc.Config{Port: 11101, Host: "127.0.0.1"}).Addr())
+	assert.Equal(t, "[::1]:11101", (&rpc.Config{Port: 11101, Host: "::1"}).Addr())
+}
+
+func TestConfig_Keepalive(t *testing.T) {
+	conf := &rpc.Config{Port: 11101, KeepaliveTime: "0s", KeepaliveMinTime: "10s"}
+	params := conf.KeepaliveServerParameters()
+	assert.Equal(t, gotime.Duration(0), params.MaxConnectionIdle)
+	assert.Equal(t, rpc.DefaultKeepaliveTime, params.Time)
+	assert.Equal(t, rpc.DefaultKeepaliveTimeout, params.Timeout)
+
+	policy := conf.KeepaliveEnforcementPolicy()
+	assert.Equal(t, 10*gotime.Second, policy.MinTime)
+	assert.False(t, policy.PermitWithoutStream)
+}
+
+func TestServerReflection(t *testing.T) {
+	met, err := prometheus.NewMetrics()
+	assert.NoError(t, err)
+	be, err := backend.New(&backend.Config{
+		SnapshotThreshold:    helper.SnapshotThreshold,
+		AuthWebhookCacheSize: helper.AuthWebhookSize,
+	}, nil, nil, nil, &housekeeping.Config{
+		Interval:            helper.HousekeepingInterval.String(),
+		DeactivateThreshold: helper.HousekeepingDeactivateThreshold.String(),
+		CandidatesLimit:     helper.HousekeepingCandidatesLimit,
+	}, "", met)
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, be.Shutdown())
+	}()
+
+	const reflectionService = "grpc.reflection.v1alpha.ServerReflection"
+	for _, enabled := range []bool{false, true} {
+		server, err := rpc.NewServer(&rpc.Config{
+			Port:             helper.RPCPort + 2,
+			MaxRequestBytes:  helper.RPCMaxRequestBytes,
+			EnableReflection: enabled,
+		}, be)
+		assert.NoError(t, err)
+
+		_, registered := server.GRPCServer().GetServiceInfo()[reflectionService]
+		assert.Equal(t, enabled, registered)
+	}
+}
+
+func TestServerRateLimit(t *testing.T) {
+	met, err := prometheus.NewMetrics()
+	assert.NoError(t, err)
+	be, err := backend.New(&backend.Config{
+		SnapshotThreshold:    helper.SnapshotThreshold,
+		AuthWebhookCacheSize: helper.AuthWebhookSize,
+		ClientRateLimit:      1,
+		ClientRateBurst:      2,
+	}, nil, nil, nil, &housekeeping.Config{
+		Interval:            helper.HousekeepingInterval.String(),
+		DeactivateThreshold: helper.HousekeepingDeactivateThreshold.String(),
+		CandidatesLimit:     helper.HousekeepingCandidatesLimit,
+	}, "", met)
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, be.Shutdown())
+	}()
+
+	port := helper.RPCPort + 3
+	server, err := rpc.NewServer(&rpc.Config{
+		Port:            port,
+		MaxRequestBytes: helper.RPCMaxRequestBytes,
+	}, be)
+	assert.NoError(t, err)
+	assert.NoError(t, server.Start())
+	defer server.Shutdown(false)
+
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", port), grpc.WithInsecure())
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, conn.Close())
+	}()
+	cli := api.NewYorkieClient(conn)
+
+	activateResp, err := cli.ActivateClient(context.Background(), &api.ActivateClientRequest{
+		ClientKey: t.Name(),
+	})
+	assert.NoError(t, err)
+
+	updateMetadata := func(clientID []byte) error {
+		_, err := cli.UpdateClientMetadata(context.Background(), &api.UpdateClientMetadataRequest{
+			ClientId: clientID,
+			Metadata: map[string]string{"name": "yorkie"},
+		})
+		return err
+	}
+
+	assert.NoError(t, updateMetadata(activateResp.ClientId))
+	assert.NoError(t, updateMetadata(activateResp.ClientId))
+	assert.Equal(t, codes.ResourceExhausted, status.Convert(updateMetadata(activateResp.ClientId)).Code())
+
+	// NOTE: The other clients are not affected by the limited client.
+	otherResp, err := cli.ActivateClient(context.Background(), &api.ActivateClientRequest{
+		ClientKey: t.Name() + "-other",
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, updateMetadata(otherResp.ClientId))
+}
+
+func TestServerPushPullQueue(t *testing.T) {
+	met, err := prometheus.NewMetrics()
+	assert.NoError(t, err)
+	be, err := backend.New(&backend.Config{
+		SnapshotThreshold:    helper.SnapshotThreshold,
+		AuthWebhookCacheSize: helper.AuthWebhookSize,
+		PushPullConcurrency:  1,
+		PushPullQueueSize:    1,
+	}, nil, nil, nil, &housekeeping.Config{
+		Interval:            helper.HousekeepingInterval.String(),
+		DeactivateThreshold: helper.HousekeepingDeactivateThreshold.String(),
+		CandidatesLimit:     helper.HousekeepingCandidatesLimit,
+	}, "", met)
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, be.Shutdown())
+	}()
+
+	port := helper.RPCPort + 5
+	server, err := rpc.NewServer(&rpc.Config{
+		Port:            port,
+		MaxRequestBytes: helper.RPCMaxRequestBytes,
+	}, be)
+	assert.NoError(t, err)
+	assert.NoError(t, server.Start())
+	defer server.Shutdown(false)
+
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", port), grpc.WithInsecure())
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, conn.Close())
+	}()
+	cli := api.NewYorkieClient(conn)
+
+	activateResp, err := cli.ActivateClient(context.Background(), &api.ActivateClientRequest{
+		ClientKey: t.Name(),
+	})
+	assert.NoError(t, err)
+
+	newPack := func(clientSeq uint32) *api.ChangePack {
+		return &api.ChangePack{
+			DocumentKey: &api.DocumentKey{Collection: "tests", Document: "queue"},
+			Checkpoint:  &api.Checkpoint{ServerSeq: 0, ClientSeq: clientSeq},
+		}
+	}
+	_, err = cli.AttachDocument(context.Background(), &api.AttachDocumentRequest{
+		ClientId:   activateResp.ClientId,
+		ChangePack: newPack(0),
+	})
+	assert.NoError(t, err)
+
+	// NOTE: Another client holds the only turn, so the PushPull of the
+	//       flooding client waits in the queue.
+	assert.NoError(t, be.AcquirePushPull(context.Background(), "holder"))
+
+	queued := make(chan error, 1)
+	go func() {
+		_, err := cli.PushPull(context.Background(), &api.PushPullRequest{
+			ClientId:   activateResp.ClientId,
+			ChangePack: newPack(0),
+		})
+		queued <- err
+	}()
+	assert.Never(t, func() bool {
+		return len(queued) > 0
+	}, 100*gotime.Millisecond, 10*gotime.Millisecond)
+
+	// NOTE: The queue is full, so the next requests are rejected.
+	_, err = cli.PushPull(context.Background(), &api.PushPullRequest{
+		ClientId:   activateResp.ClientId,
+		ChangePack: newPack(0),
+	})
+	assert.Equal(t, codes.ResourceExhausted, status.Convert(err).Code())
+	_, err = cli.DetachDocument(context.Background(), &api.DetachDocumentRequest{
+		ClientId:   activateResp.ClientId,
+		ChangePack: newPack(0),
+	})
+	assert.Equal(t, codes.ResourceExhausted, status.Convert(err).Code())
+
+	// NOTE: The queued PushPull is processed once the turn is released.
+	be.ReleasePushPull()
+	assert.NoError(t, <-queued)
+}
+
+// unreachableDB is a database whose ping fails while it is down.
+type unreachableDB struct {
+	db.DB
+	down int32
+}
+
+func (d *unreachableDB) Ping(ctx context.Context) error {
+	if atomic.LoadInt32(&d.down) == 1 {
+		return errors.New("connection refused")
+	}
+
+	return d.DB.Ping(ctx)
+}
+
+func TestServerHealthCheck(t *testing.T) {
+	met, err := prometheus.NewMetrics()
+	assert.NoError(t, err)
+	be, err := backend.New(&backend.Config{
+		SnapshotThreshold:    helper.SnapshotThreshold,
+		AuthWebhookCacheSize: helper.AuthWebhookSize,
+	}, nil, nil, nil, &housekeeping.Config{
+		Interval:            helper.HousekeepingInterval.String(),
+		DeactivateThreshold: helper.HousekeepingDeactivateThreshold.String(),
+		CandidatesLimit:     helper.HousekeepingCandidatesLimit,
+	}, "", met)
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, be.Shutdown())
+	}()
+	database := &unreachableDB{DB: be.DB}
+	be.DB = database
+
+	port := helper.RPCPort + 4
+	server, err := rpc.NewServer(&rpc.Config{
+		Port:                port,
+		MaxRequestBytes:     helper.RPCMaxRequestBytes,
+		HealthCheckInterval: "10ms",
+	}, be)
+	assert.NoError(t, err)
+	assert.NoError(t, server.Start())
+	defer server.Shutdown(false)
+
+	conn, err := grpc.Dial(fmt.Sprintf("localhost:%d", port), grpc.WithInsecure())
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, conn.Close())
+	}()
+	healthClient := healthpb.NewHealthClient(conn)
+
+	status := func() healthpb.HealthCheckResponse_ServingStatus {
+		resp, err := healthClient.Check(context.Background(), &healthpb.HealthCheckRequest{})
+		assert.NoError(t, err)
+		return resp.Status
+	}
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, status())
+
+	atomic.StoreInt32(&database.down, 1)
+	assert.Eventually(t, func() bool {
+		return status() == healthpb.HealthCheckResponse_NOT_SERVING
+	}, gotime.Second, 10*gotime.Millisecond)
+
+	atomic.StoreInt32(&database.down, 0)
+	assert.Eventually(t, func() bool {
+		return status() == healthpb.HealthCheckResponse_SERVING
+	}, gotime.Second, 10*gotime.Millisecond)
+}
+
+func TestServerSocket(t *testing.T) {
+	met, err := prometheus.NewMetrics()
+	assert.NoError(t, err)
+	be, err := backend.New(&backend.Config{
+		SnapshotThreshold:    helper.SnapshotThreshold,
+		AuthWebhookCacheSize: helper.AuthWebhookSize,
+	}, nil, nil, nil, &housekeeping.Config{
+		Interval:            helper.HousekeepingInterval.String(),
+		DeactivateThreshold: helper.HousekeepingDeactivateThreshold.String(),
+		CandidatesLimit:     helper.HousekeepingCandidatesLimit,
+	}, "", met)
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, be.Shutdown())
+	}()
+
+	// NOTE: The socket file left by the previous server is replaced.
+	socketPath := filepath.Join(t.TempDir(), "yorkie.sock")
+	assert.NoError(t, os.WriteFile(socketPath, nil, 0600))
+
+	server, err := rpc.NewServer(&rpc.Config{
+		SocketPath:      socketPath,
+		MaxRequestBytes: helper.RPCMaxRequestBytes,
+	}, be)
+	assert.NoError(t, err)
+	assert.NoError(t, server.Start())
+	defer server.Shutdown(false)
+
+	info, err := os.Stat(socketPath)
+	assert.NoError(t, err)
+	assert.Equal(t, os.ModeSocket, info.Mode()&os.ModeSocket)
+	assert.Equal(t, os.FileMode(0660), info.Mode().Perm())
+
+	conn, err := grpc.Dial("unix://"+socketPath, grpc.WithInsecure())
+	assert.NoError(t, err)
+	defer func() {
+		assert.NoError(t, conn.Close())
+	}()
+
+	_, err = api.NewYorkieClient(conn).ActivateClient(context.Background(), &api.ActivateClientRequest{
+		ClientKey: t.Name(),
+	})
+	assert.NoError(t, err)
+}
@@ -18,10 +18,14 @@ package rpc
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	gotime "time"
 
 	"github.com/yorkie-team/yorkie/api"
 	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/pkg/types"
 	"github.com/yorkie-team/yorkie/yorkie/auth"
 	"github.com/yorkie-team/yorkie/yorkie/backend"
@@ -32,14 +36,26 @@ import (
 	"github.com/yorkie-team/yorkie/yorkie/packs"
 )
 
+const (
+	// snapshotChunkSize is the maximum size of the snapshot sent in a single
+	// FetchSnapshotResponse.
+	snapshotChunkSize = 64 * 1024
+)
+
 type yorkieServer struct {
+	conf       *Config
 	backend    *backend.Backend
 	serviceCtx context.Context
 }
 
 // newYorkieServer creates a new instance of yorkieServer
-func newYorkieServer(serviceCtx context.Context, be *backend.Backend) *yorkieServer {
+func newYorkieServer(
+	serviceCtx context.Context,
+	conf *Config,
+	be *backend.Backend,
+) *yorkieServer {
 	return &yorkieServer{
+		conf:       conf,
 		backend:    be,
 		serviceCtx: serviceCtx,
 	}
@@ -60,7 +76,7 @@ func (s *yorkieServer) ActivateClient(
 		return nil, err
 	}
 
-	client, err := clients.Activate(ctx, s.backend, req.ClientKey)
+	client, err := clients.Activate(ctx, s.backend, req.ClientKey, req.Metadata, req.PublicKey)
 	if err != nil {
 		return nil, err
 	}
@@ -116,6 +132,10 @@ func (s *yorkieServer) AttachDocument(
 		return nil, err
 	}
 
+	if err := pack.DocumentKey.Validate(); err != nil {
+		return nil, err
+	}
+
 	if err := auth.VerifyAccess(ctx, s.backend, &types.AccessInfo{
 		Method:     types.AttachDocument,
 		Attributes: auth.AccessAttributes(pack),
@@ -123,6 +143,11 @@ func (s *yorkieServer) AttachDocument(
 		return nil, err
 	}
 
+	if err := s.backend.AcquirePushPull(ctx, s.pushPullClientKey(ctx, req.ClientId)); err != nil {
+		return nil, err
+	}
+	defer s.backend.ReleasePushPull()
+
 	if pack.HasChanges() {
 		locker, err := s.backend.Coordinator.NewLocker(
 			ctx,
@@ -152,6 +177,10 @@ func (s *yorkieServer) AttachDocument(
 	if err != nil {
 		return nil, err
 	}
+
+	if err := backend.CheckQuarantine(docInfo); err != nil {
+		return nil, err
+	}
 	if err := clientInfo.AttachDocument(docInfo.ID); err != nil {
 		return nil, err
 	}
@@ -166,6 +195,8 @@ func (s *yorkieServer) AttachDocument(
 		return nil, err
 	}
 
+	s.publishPeersChanged(ctx, clientInfo, pack.DocumentKey, false)
+
 	return &api.AttachDocumentResponse{
 		ChangePack: pbChangePack,
 	}, nil
@@ -188,6 +219,11 @@ func (s *yorkieServer) DetachDocument(
 		return nil, err
 	}
 
+	if err := s.backend.AcquirePushPull(ctx, s.pushPullClientKey(ctx, req.ClientId)); err != nil {
+		return nil, err
+	}
+	defer s.backend.ReleasePushPull()
+
 	if pack.HasChanges() {
 		locker, err := s.backend.Coordinator.NewLocker(
 			ctx,
@@ -217,6 +253,10 @@ func (s *yorkieServer) DetachDocument(
 	if err != nil {
 		return nil, err
 	}
+
+	if err := backend.CheckQuarantine(docInfo); err != nil {
+		return nil, err
+	}
 	if err := clientInfo.EnsureDocumentAttached(docInfo.ID); err != nil {
 		return nil, err
 	}
@@ -234,6 +274,8 @@ func (s *yorkieServer) DetachDocument(
 		return nil, err
 	}
 
+	s.publishPeersChanged(ctx, clientInfo, pack.DocumentKey, true)
+
 	return &api.DetachDocumentResponse{
 		ChangePack: pbChangePack,
 	}, nil
@@ -250,6 +292,10 @@ func (s *yorkieServer) PushPull(
 		return nil, err
 	}
 
+	if err := pack.DocumentKey.Validate(); err != nil {
+		return nil, err
+	}
+
 	if err := auth.VerifyAccess(ctx, s.backend, &types.AccessInfo{
 		Method:     types.PushPull,
 		Attributes: auth.AccessAttributes(pack),
@@ -257,6 +303,13 @@ func (s *yorkieServer) PushPull(
 		return nil, err
 	}
 
+	// NOTE: The turn is acquired before the locker of the document, so that
+	//       the waiting PushPulls do not hold the document.
+	if err := s.backend.AcquirePushPull(ctx, s.pushPullClientKey(ctx, req.ClientId)); err != nil {
+		return nil, err
+	}
+	defer s.backend.ReleasePushPull()
+
 	if pack.HasChanges() {
 		locker, err := s.backend.Coordinator.NewLocker(
 			ctx,
@@ -287,6 +340,10 @@ func (s *yorkieServer) PushPull(
 	if err != nil {
 		return nil, err
 	}
+
+	if err := backend.CheckQuarantine(docInfo); err != nil {
+		return nil, err
+	}
 	if err := clientInfo.EnsureDocumentAttached(docInfo.ID); err != nil {
 		return nil, err
 	}
@@ -306,6 +363,127 @@ func (s *yorkieServer) PushPull(
 	}, nil
 }
 
+// pushPullClientKey returns the key of the client to schedule its PushPulls
+// fairly. It is the token of the authenticated identity if PushPull requires
+// authorization. Otherwise, the ID of the client is used because a token that
+// is not authenticated can be changed freely to take more turns.
+func (s *yorkieServer) pushPullClientKey(ctx context.Context, clientID []byte) string {
+	if s.backend.RequireAuth(types.PushPull) {
+		if token := auth.TokenFromCtx(ctx); token != "" {
+			return token
+		}
+	}
+
+	return string(db.IDFromBytes(clientID))
+}
+
+// PullJSONPatches delivers the changes of the given document after the given
+// server sequence as JSON patches. It is used by the clients that do not have
+// the CRDT implementation.
+func (s *yorkieServer) PullJSONPatches(
+	ctx context.Context,
+	req *api.PullJSONPatchesRequest,
+) (*api.PullJSONPatchesResponse, error) {
+	if req.DocumentKey == nil {
+		return nil, converter.ErrDocumentKeyRequired
+	}
+	docKey := converter.FromDocumentKey(req.DocumentKey)
+
+	if err := auth.VerifyAccess(ctx, s.backend, &types.AccessInfo{
+		Method: types.PullJSONPatches,
+		Attributes: []types.AccessAttribute{{
+			Key:  docKey.BSONKey(),
+			Verb: types.Read,
+		}},
+	}); err != nil {
+		return nil, err
+	}
+
+	docInfo, err := s.backend.DB.FindDocInfoByKey(ctx, nil, docKey.BSONKey(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := backend.CheckQuarantine(docInfo); err != nil {
+		return nil, err
+	}
+
+	pulled, err := packs.PullJSONPatches(ctx, s.backend, docInfo, req.ServerSeq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.PullJSONPatchesResponse{
+		ServerSeq: pulled.ServerSeq,
+		Patches:   converter.ToJSONPatches(pulled.Patches),
+		Document:  string(pulled.Document),
+		HasMore:   pulled.HasMore,
+	}, nil
+}
+
+// FetchSnapshot streams the stored snapshot of the given document in chunks
+// from the requested byte offset. A client whose transfer failed midway can
+// resume it by requesting the same server sequence again with the offset of
+// the bytes already received.
+func (s *yorkieServer) FetchSnapshot(
+	req *api.FetchSnapshotRequest,
+	stream api.Yorkie_FetchSnapshotServer,
+) error {
+	if req.DocumentKey == nil {
+		return converter.ErrDocumentKeyRequired
+	}
+	docKey := converter.FromDocumentKey(req.DocumentKey)
+	ctx := stream.Context()
+
+	if err := auth.VerifyAccess(ctx, s.backend, &types.AccessInfo{
+		Method: types.FetchSnapshot,
+		Attributes: []types.AccessAttribute{{
+			Key:  docKey.BSONKey(),
+			Verb: types.Read,
+		}},
+	}); err != nil {
+		return err
+	}
+
+	docInfo, err := s.backend.DB.FindDocInfoByKey(ctx, nil, docKey.BSONKey(), false)
+	if err != nil {
+		return err
+	}
+
+	if err := backend.CheckQuarantine(docInfo); err != nil {
+		return err
+	}
+
+	snapshotRange, err := packs.FindSnapshotRange(ctx, s.backend, docInfo, req.ServerSeq, req.Offset)
+	if err != nil {
+		return err
+	}
+
+	data := snapshotRange.Data
+	offset := snapshotRange.Offset
+	for {
+		chunk := data
+		if len(chunk) > snapshotChunkSize {
+			chunk = chunk[:snapshotChunkSize]
+		}
+
+		if err := stream.Send(&api.FetchSnapshotResponse{
+			ServerSeq: snapshotRange.ServerSeq,
+			TotalSize: snapshotRange.Size,
+			Offset:    offset,
+			Chunk:     chunk,
+		}); err != nil {
+			return err
+		}
+
+		data = data[len(chunk):]
+		offset += uint64(len(chunk))
+		if len(data) == 0 {
+			return nil
+		}
+	}
+}
+
 // WatchDocuments connects the stream to deliver events from the given documents
 // to the requesting client.
 func (s *yorkieServer) WatchDocuments(
@@ -340,6 +518,10 @@ func (s *yorkieServer) WatchDocuments(
 		return err
 	}
 
+	if err := s.checkQuarantine(stream.Context(), docKeys); err != nil {
+		return err
+	}
+
 	subscription, peersMap, err := s.watchDocs(
 		stream.Context(),
 		*client,
@@ -350,16 +532,34 @@ func (s *yorkieServer) WatchDocuments(
 		return err
 	}
 
+	// NOTE: The server sequences are read after the subscription is established,
+	// so the changes after them are always delivered as events and the events
+	// of the changes up to them are skipped. It guarantees that the client
+	// receives each change after the returned server sequences exactly once.
+	var serverSeqs map[string]uint64
+	if req.Catchup {
+		serverSeqs, err = s.findServerSeqs(stream.Context(), docKeys)
+		if err != nil {
+			return s.closeWatchStream(stream, docKeys, subscription, err)
+		}
+	}
+
 	if err := stream.Send(&api.WatchDocumentsResponse{
 		Body: &api.WatchDocumentsResponse_Initialization_{
 			Initialization: &api.WatchDocumentsResponse_Initialization{
 				PeersMapByDoc: converter.ToClientsMap(peersMap),
+				ServerSeqs:    serverSeqs,
 			},
 		},
 	}); err != nil {
-		logging.From(stream.Context()).Error(err)
-		s.unwatchDocs(docKeys, subscription)
-		return err
+		return s.closeWatchStream(stream, docKeys, subscription, err)
+	}
+
+	var keepalive <-chan gotime.Time
+	if interval := s.conf.ParseWatchKeepaliveInterval(); interval > 0 {
+		ticker := gotime.NewTicker(interval)
+		defer ticker.Stop()
+		keepalive = ticker.C
 	}
 
 	for {
@@ -367,10 +567,24 @@ func (s *yorkieServer) WatchDocuments(
 		case <-s.serviceCtx.Done():
 			s.unwatchDocs(docKeys, subscription)
 			return nil
+		// NOTE: The stream of the dead client is canceled once the transport
+		//       keepalive of the server closes its connection.
 		case <-stream.Context().Done():
 			s.unwatchDocs(docKeys, subscription)
 			return nil
+		case <-keepalive:
+			if err := stream.Send(&api.WatchDocumentsResponse{
+				Body: &api.WatchDocumentsResponse_Keepalive_{
+					Keepalive: &api.WatchDocumentsResponse_Keepalive{},
+				},
+			}); err != nil {
+				return s.closeWatchStream(stream, docKeys, subscription, err)
+			}
 		case event := <-subscription.Events():
+			if isCaughtUp(serverSeqs, event) {
+				continue
+			}
+
 			eventType, err := converter.ToDocEventType(event.Type)
 			if err != nil {
 				return err
@@ -379,20 +593,103 @@ func (s *yorkieServer) WatchDocuments(
 			if err := stream.Send(&api.WatchDocumentsResponse{
 				Body: &api.WatchDocumentsResponse_Event{
 					Event: &api.DocEvent{
-						Type:         eventType,
-						Publisher:    converter.ToClient(event.Publisher),
-						DocumentKeys: converter.ToDocumentKeys(event.DocumentKeys),
+						Type:          eventType,
+						Publisher:     converter.ToClient(event.Publisher),
+						DocumentKeys:  converter.ToDocumentKeys(event.DocumentKeys),
+						ServerSeq:     event.ServerSeq,
+						FromServerSeq: event.FromServerSeq,
+						Peers:         converter.ToClients(event.Peers),
 					},
 				},
 			}); err != nil {
-				logging.From(stream.Context()).Error(err)
+				return s.closeWatchStream(stream, docKeys, subscription, err)
+			}
+
+			// NOTE: The watch of the quarantined document is closed after the
+			//       clients are notified, so that they stop watching it.
+			if event.Type == types.DocumentsQuarantinedEvent {
 				s.unwatchDocs(docKeys, subscription)
-				return err
+				return fmt.Errorf(
+					"%s: %w",
+					event.DocumentKeys[0].BSONKey(),
+					backend.ErrDocumentQuarantined,
+				)
 			}
 		}
 	}
 }
 
+// checkQuarantine returns ErrDocumentQuarantined if one of the given documents
+// is quarantined. The document that does not exist yet is not quarantined.
+func (s *yorkieServer) checkQuarantine(ctx context.Context, docKeys []*key.Key) error {
+	for _, k := range docKeys {
+		docInfo, err := s.backend.DB.FindDocInfoByKey(ctx, nil, k.BSONKey(), false)
+		if errors.Is(err, db.ErrDocumentNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := backend.CheckQuarantine(docInfo); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findServerSeqs returns the current server sequences of the given documents
+// by their keys. The document that does not exist yet has 0.
+func (s *yorkieServer) findServerSeqs(
+	ctx context.Context,
+	docKeys []*key.Key,
+) (map[string]uint64, error) {
+	serverSeqs := make(map[string]uint64)
+	for _, k := range docKeys {
+		docInfo, err := s.backend.DB.FindDocInfoByKey(ctx, nil, k.BSONKey(), false)
+		if errors.Is(err, db.ErrDocumentNotFound) {
+			serverSeqs[k.BSONKey()] = 0
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		serverSeqs[k.BSONKey()] = docInfo.ServerSeq
+	}
+
+	return serverSeqs, nil
+}
+
+// isCaughtUp returns whether the changes of the given event are already covered
+// by the server sequences returned when the watch was established.
+func isCaughtUp(serverSeqs map[string]uint64, event sync.DocEvent) bool {
+	if serverSeqs == nil || event.Type != types.DocumentsChangedEvent {
+		return false
+	}
+
+	for _, k := range event.DocumentKeys {
+		if event.ServerSeq > serverSeqs[k.BSONKey()] {
+			return false
+		}
+	}
+	return true
+}
+
+// closeWatchStream cleans up the subscription of the watch stream that failed
+// to send a response with the given error.
+func (s *yorkieServer) closeWatchStream(
+	stream api.Yorkie_WatchDocumentsServer,
+	docKeys []*key.Key,
+	subscription *sync.Subscription,
+	err error,
+) error {
+	s.unwatchDocs(docKeys, subscription)
+
+	logging.From(stream.Context()).Error(err)
+	return err
+}
+
 // UpdateMetadata updates the metadata of the given client.
 func (s *yorkieServer) UpdateMetadata(
 	ctx context.Context,
@@ -414,6 +711,34 @@ func (s *yorkieServer) UpdateMetadata(
 	return &api.UpdateMetadataResponse{}, nil
 }
 
+// UpdateClientMetadata updates the metadata stored in the clientInfo of the
+// given client. Unlike UpdateMetadata, it is not delivered to peers.
+func (s *yorkieServer) UpdateClientMetadata(
+	ctx context.Context,
+	req *api.UpdateClientMetadataRequest,
+) (*api.UpdateClientMetadataResponse, error) {
+	if len(req.ClientId) == 0 {
+		return nil, clients.ErrInvalidClientID
+	}
+
+	if err := auth.VerifyAccess(ctx, s.backend, &types.AccessInfo{
+		Method: types.UpdateClientMetadata,
+	}); err != nil {
+		return nil, err
+	}
+
+	if _, err := clients.UpdateMetadata(
+		ctx,
+		s.backend,
+		db.IDFromBytes(req.ClientId),
+		req.Metadata,
+	); err != nil {
+		return nil, err
+	}
+
+	return &api.UpdateClientMetadataResponse{}, nil
+}
+
 func (s *yorkieServer) watchDocs(
 	ctx context.Context,
 	client types.Client,
@@ -442,6 +767,49 @@ func (s *yorkieServer) watchDocs(
 	return subscription, peersMap, nil
 }
 
+// publishPeersChanged publishes the PeersChanged event of the given document
+// with its current peers after the given client attached or detached it. The
+// publisher has its metadata if it watches the document. The event is
+// published before responding, so that it precedes the later events of the
+// client.
+func (s *yorkieServer) publishPeersChanged(
+	ctx context.Context,
+	clientInfo *db.ClientInfo,
+	docKey *key.Key,
+	detached bool,
+) {
+	publisherID, err := time.ActorIDFromHex(clientInfo.ID.String())
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return
+	}
+
+	peers, err := s.backend.Coordinator.Peers(ctx, docKey)
+	if err != nil {
+		logging.From(ctx).Error(err)
+		return
+	}
+
+	publisher := types.Client{ID: publisherID}
+	var currentPeers []types.Client
+	for _, peer := range peers {
+		if peer.ID.Compare(publisherID) == 0 {
+			publisher = peer
+			if detached {
+				continue
+			}
+		}
+		currentPeers = append(currentPeers, peer)
+	}
+
+	s.backend.Coordinator.Publish(ctx, publisherID, sync.DocEvent{
+		Type:         types.PeersChangedEvent,
+		Publisher:    publisher,
+		DocumentKeys: []*key.Key{docKey},
+		Peers:        currentPeers,
+	})
+}
+
 func (s *yorkieServer) unwatchDocs(
 	docKeys []*key.Key,
 	subscription *sync.Subscription,
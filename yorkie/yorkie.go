@@ -17,6 +17,7 @@
 package yorkie
 
 import (
+	"context"
 	gosync "sync"
 
 	"github.com/yorkie-team/yorkie/yorkie/backend"
@@ -42,8 +43,30 @@ type Yorkie struct {
 	shutdownCh chan struct{}
 }
 
+// Option configures the Yorkie agent with the components that can not be set
+// by Config.
+type Option func(*options)
+
+// options is the set of the components configured by Option.
+type options struct {
+	authProvider backend.AuthProvider
+}
+
+// WithAuthProvider configures the agent to verify the access of the requests
+// with the given provider instead of the authorization webhook over HTTP.
+func WithAuthProvider(provider backend.AuthProvider) Option {
+	return func(o *options) {
+		o.authProvider = provider
+	}
+}
+
 // New creates a new instance of Yorkie.
-func New(conf *Config) (*Yorkie, error) {
+func New(conf *Config, opts ...Option) (*Yorkie, error) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	if err := conf.Validate(); err != nil {
 		return nil, err
 	}
@@ -57,6 +80,7 @@ func New(conf *Config) (*Yorkie, error) {
 		conf.Backend,
 		conf.Mongo,
 		conf.ETCD,
+		conf.Redis,
 		conf.Housekeeping,
 		conf.RPCAddr(),
 		metrics,
@@ -64,6 +88,7 @@ func New(conf *Config) (*Yorkie, error) {
 	if err != nil {
 		return nil, err
 	}
+	be.AuthProvider = o.authProvider
 
 	rpcServer, err := rpc.NewServer(conf.RPC, be)
 	if err != nil {
@@ -121,6 +146,34 @@ func (r *Yorkie) Shutdown(graceful bool) error {
 	return nil
 }
 
+// Drain shuts down this agent after draining the RPCs within the deadline of
+// the given context. It returns whether the drain completed cleanly.
+func (r *Yorkie) Drain(ctx context.Context) (bool, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.shutdown {
+		return true, nil
+	}
+
+	drained := r.rpcServer.Drain(ctx)
+	if r.profilingServer != nil {
+		r.profilingServer.Shutdown(true)
+	}
+
+	if err := r.backend.Shutdown(); err != nil {
+		return drained, err
+	}
+
+	close(r.shutdownCh)
+	r.shutdown = true
+	return drained, nil
+}
+
+// ReloadCertificates reloads the TLS certificate of the RPC server.
+func (r *Yorkie) ReloadCertificates() error {
+	return r.rpcServer.ReloadCertificates()
+}
+
 // ShutdownCh returns the shutdown channel.
 func (r *Yorkie) ShutdownCh() <-chan struct{} {
 	return r.shutdownCh